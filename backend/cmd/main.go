@@ -19,6 +19,7 @@ import (
 	"toggo/internal/server"
 	"toggo/internal/services"
 	"toggo/internal/workflows"
+	"toggo/internal/workflows/webhooks"
 )
 
 func main() {
@@ -44,18 +45,21 @@ func main() {
 	}
 	log.Println("Google Maps API connected successfully")
 
+	repo := repository.NewRepository(db)
+
 	// Initialize realtime service
-	realtimeService, err := realtime.NewRealtimeService(cfg)
+	realtimeService, err := realtime.NewRealtimeService(cfg, repo.Membership)
 	if err != nil {
 		log.Fatalf("Failed to initialize realtime service: %v", err)
 	}
 	realtimeService.Start()
 
 	// Initialize activity feed service
-	repo := repository.NewRepository(db)
 	activityFeedService := services.NewActivityFeedService(
 		realtimeService.GetUnderlyingRedisClient(),
 		repo.Membership,
+		repo.ActivityFeed,
+		repo.User,
 	)
 	activityFeedService.Start()
 
@@ -67,7 +71,15 @@ func main() {
 
 	ctx := setupSignalHandler()
 
-	app := server.CreateApp(cfg, db, realtimeService.GetPublisher(), realtimeService.GetHandler(), activityFeedService, temporalClient)
+	// Deliver trip events to webhook subscriptions
+	webhookSubscriber := realtime.NewWebhookSubscriber(
+		repo.TripWebhook,
+		webhooks.NewDeliveryScheduler(temporalClient),
+		realtimeService.GetUnderlyingRedisClient(),
+	)
+	go webhookSubscriber.Start(ctx)
+
+	app := server.CreateApp(cfg, db, realtimeService.GetPublisher(), realtimeService.GetHandler(), realtimeService.GetSSEHandler(), activityFeedService, temporalClient)
 
 	go startServer(app, cfg.App.Port)
 
@@ -15,6 +15,27 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/api/realtime/events/catalogue": {
+            "get": {
+                "description": "Returns the JSON Schema for the realtime event envelope and the versioned payload schema of every topic",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "realtime"
+                ],
+                "summary": "Get realtime event catalogue",
+                "operationId": "getRealtimeEventCatalogue",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/realtime.EventCatalogue"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/comments": {
             "post": {
                 "description": "Creates a new comment",
@@ -905,11 +926,6 @@ const docTemplate = `{
         },
         "/api/v1/search/places/details": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
                 "description": "Retrieves detailed information about a specific place using Google Maps Places API. Accepts either a place_id (from typeahead results) or input text for direct search. Returns address, coordinates, photos, ratings, opening hours, and more.",
                 "consumes": [
                     "application/json"
@@ -952,16 +968,16 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/search/places/health": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
+                ]
+            }
+        },
+        "/api/v1/search/places/health": {
+            "get": {
                 "description": "Checks if Google Maps API is connected and accessible",
                 "produces": [
                     "application/json"
@@ -986,16 +1002,16 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/search/places/typeahead": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
+                ]
+            }
+        },
+        "/api/v1/search/places/typeahead": {
+            "get": {
                 "description": "Quick typeahead/autocomplete endpoint for real-time place search as users type. Returns a list of place predictions with place IDs that can be used to fetch detailed information.",
                 "produces": [
                     "application/json"
@@ -1045,7 +1061,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
         "/api/v1/search/trips": {
@@ -1299,8 +1320,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.Membership"
                         }
                     },
+                    "202": {
+                        "description": "Join request awaiting admin approval",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripInviteJoin"
+                        }
+                    },
                     "400": {
-                        "description": "Invalid or expired invite code",
+                        "description": "Invalid, expired or used-up invite code",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -1320,99 +1347,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips": {
+        "/api/v1/trip-templates": {
             "get": {
-                "description": "Retrieves trips with cursor-based pagination. Use limit and cursor query params.",
+                "description": "Returns the caller's templates and every public template, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "trips"
-                ],
-                "summary": "Get all trips",
-                "operationId": "getAllTrips",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Max items per page (default 20, max 100)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Opaque cursor from previous response next_cursor for next page",
-                        "name": "cursor",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Only include trips where end_date is before this RFC3339 timestamp",
-                        "name": "end_date_before",
-                        "in": "query"
-                    }
+                    "trip-templates"
                 ],
+                "summary": "List trip templates",
+                "operationId": "listTripTemplates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.TripCursorPageResult"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid cursor",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "description": "Creates a new trip for the authenticated user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "trips"
-                ],
-                "summary": "Create a trip",
-                "operationId": "createTrip",
-                "parameters": [
-                    {
-                        "description": "Create trip request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateTripRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/models.Trip"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
+                            "$ref": "#/definitions/models.TripTemplateListResponse"
                         }
                     },
                     "401": {
@@ -1421,12 +1371,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1436,22 +1380,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}": {
+        "/api/v1/trip-templates/{templateID}": {
             "get": {
-                "description": "Retrieves a trip by ID",
+                "description": "Returns a public template or one of the caller's own, including its contents",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "trips"
+                    "trip-templates"
                 ],
-                "summary": "Get a trip",
-                "operationId": "getTrip",
+                "summary": "Get a trip template",
+                "operationId": "getTripTemplate",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trip ID",
-                        "name": "tripID",
+                        "description": "Template ID",
+                        "name": "templateID",
                         "in": "path",
                         "required": true
                     }
@@ -1460,7 +1404,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.TripAPIResponse"
+                            "$ref": "#/definitions/models.TripTemplate"
                         }
                     },
                     "400": {
@@ -1469,6 +1413,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1484,17 +1434,17 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a trip by ID",
+                "description": "Deletes one of the caller's templates. Trips started from it are kept.",
                 "tags": [
-                    "trips"
+                    "trip-templates"
                 ],
-                "summary": "Delete a trip",
-                "operationId": "deleteTrip",
+                "summary": "Delete a trip template",
+                "operationId": "deleteTripTemplate",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trip ID",
-                        "name": "tripID",
+                        "description": "Template ID",
+                        "name": "templateID",
                         "in": "path",
                         "required": true
                     }
@@ -1515,6 +1465,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1528,9 +1484,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Updates an existing trip by ID",
+            }
+        },
+        "/api/v1/trip-templates/{templateID}/trips": {
+            "post": {
+                "description": "Starts a new trip owned by the caller from a template. Dates are shifted so the trip starts on start_date; without it the trip is undated.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1538,31 +1496,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "trips"
+                    "trip-templates"
                 ],
-                "summary": "Update a trip",
-                "operationId": "updateTrip",
+                "summary": "Create a trip from a template",
+                "operationId": "createTripFromTemplate",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trip ID",
-                        "name": "tripID",
+                        "description": "Template ID",
+                        "name": "templateID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update trip request",
+                        "description": "Optional name and start date",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateTripRequest"
+                            "$ref": "#/definitions/models.CreateTripFromTemplateRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/models.Trip"
                         }
@@ -1600,53 +1557,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/activities": {
+        "/api/v1/trips": {
             "get": {
-                "description": "Retrieves paginated activities for a trip, optionally filtered by category, time of day, and/or date",
+                "description": "Retrieves trips with cursor-based pagination. Use limit and cursor query params.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "activities"
+                    "trips"
                 ],
-                "summary": "Get activities by trip",
-                "operationId": "getActivitiesByTripID",
+                "summary": "Get all trips",
+                "operationId": "getAllTrips",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Trip ID",
-                        "name": "tripID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by category name",
-                        "name": "category",
+                        "type": "integer",
+                        "description": "Max items per page (default 20, max 100)",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by time of day (morning, afternoon, evening)",
-                        "name": "time_of_day",
+                        "description": "Opaque cursor from previous response next_cursor for next page",
+                        "name": "cursor",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by calendar date (YYYY-MM-DD); activity must have a date range containing this day",
-                        "name": "date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Max items per page (default 20, max 100)",
-                        "name": "limit",
+                        "description": "Only include trips where end_date is before this RFC3339 timestamp",
+                        "name": "end_date_before",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Opaque cursor returned in next_cursor",
-                        "name": "cursor",
+                        "type": "boolean",
+                        "description": "Include archived trips (default false)",
+                        "name": "include_archived",
                         "in": "query"
                     }
                 ],
@@ -1654,11 +1598,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ActivityCursorPageResult"
+                            "$ref": "#/definitions/models.TripCursorPageResult"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid cursor",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -1669,18 +1613,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1690,7 +1622,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new activity for a trip",
+                "description": "Creates a new trip for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1698,25 +1630,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "activities"
+                    "trips"
                 ],
-                "summary": "Create activity",
-                "operationId": "createActivity",
+                "summary": "Create a trip",
+                "operationId": "createTrip",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Trip ID",
-                        "name": "tripID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Create activity request",
+                        "description": "Create trip request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateActivityRequest"
+                            "$ref": "#/definitions/models.CreateTripRequest"
                         }
                     }
                 ],
@@ -1724,7 +1649,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Activity"
+                            "$ref": "#/definitions/models.Trip"
                         }
                     },
                     "400": {
@@ -1739,20 +1664,41 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/deleted": {
+            "get": {
+                "description": "Returns the caller's deleted trips that can still be restored, most recently deleted first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "List deleted trips",
+                "operationId": "listDeletedTrips",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeletedTripsResponse"
+                        }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -1766,20 +1712,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/activities/parse-link": {
+        "/api/v1/trips/deleted/{tripID}/restore": {
             "post": {
-                "description": "Fetches a URL and extracts structured activity fields (name, description, thumbnail) for form autofill. Supports Airbnb, Booking.com, TikTok, Instagram, and generic travel blog URLs.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Restores a trip deleted in the last 30 days (members who could delete it only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "activities"
+                    "trips"
                 ],
-                "summary": "Parse link into activity data",
-                "operationId": "parseActivityLink",
+                "summary": "Restore a deleted trip",
+                "operationId": "restoreTrip",
                 "parameters": [
                     {
                         "type": "string",
@@ -1787,22 +1730,13 @@ const docTemplate = `{
                         "name": "tripID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "URL to parse",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.ParseLinkRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ParsedActivityData"
+                            "$ref": "#/definitions/models.Trip"
                         }
                     },
                     "400": {
@@ -1823,8 +1757,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -1838,17 +1772,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/activities/{activityID}": {
+        "/api/v1/trips/{tripID}": {
             "get": {
-                "description": "Retrieves a specific activity",
+                "description": "Retrieves a trip by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "activities"
+                    "trips"
                 ],
-                "summary": "Get activity by ID",
-                "operationId": "getActivity",
+                "summary": "Get a trip",
+                "operationId": "getTrip",
                 "parameters": [
                     {
                         "type": "string",
@@ -1856,20 +1790,13 @@ const docTemplate = `{
                         "name": "tripID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Activity ID",
-                        "name": "activityID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ActivityAPIResponse"
+                            "$ref": "#/definitions/models.TripAPIResponse"
                         }
                     },
                     "400": {
@@ -1878,18 +1805,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1904,19 +1819,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Updates an existing activity",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+            "delete": {
+                "description": "Deletes a trip by ID. It can be restored for 30 days, after which it and its media are permanently removed.",
                 "tags": [
-                    "activities"
+                    "trips"
                 ],
-                "summary": "Update activity",
-                "operationId": "updateActivity",
+                "summary": "Delete a trip",
+                "operationId": "deleteTrip",
                 "parameters": [
                     {
                         "type": "string",
@@ -1924,30 +1833,11 @@ const docTemplate = `{
                         "name": "tripID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Activity ID",
-                        "name": "activityID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update activity request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdateActivityRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.Activity"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1973,12 +1863,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1987,13 +1871,19 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Deletes an activity",
+            "patch": {
+                "description": "Updates an existing trip by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "activities"
+                    "trips"
                 ],
-                "summary": "Delete activity",
-                "operationId": "deleteActivity",
+                "summary": "Update a trip",
+                "operationId": "updateTrip",
                 "parameters": [
                     {
                         "type": "string",
@@ -2003,16 +1893,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Activity ID",
-                        "name": "activityID",
-                        "in": "path",
-                        "required": true
+                        "description": "Update trip request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateTripRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Trip"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2026,14 +1921,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -2047,17 +1942,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/activities/{activityID}/categories": {
+        "/api/v1/trips/{tripID}/activities": {
             "get": {
-                "description": "Retrieves categories for an activity with pagination",
+                "description": "Retrieves paginated activities for a trip, optionally filtered by category, time of day, and/or date",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "activities"
                 ],
-                "summary": "Get activity categories",
-                "operationId": "getActivityCategories",
+                "summary": "Get activities by trip",
+                "operationId": "getActivitiesByTripID",
                 "parameters": [
                     {
                         "type": "string",
@@ -2068,10 +1963,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Activity ID",
-                        "name": "activityID",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter by category name",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by time of day (morning, afternoon, evening)",
+                        "name": "time_of_day",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by calendar date (YYYY-MM-DD); activity must have a date range containing this day",
+                        "name": "date",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
@@ -2090,7 +1996,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ActivityCategoriesPageResult"
+                            "$ref": "#/definitions/models.ActivityCursorPageResult"
                         }
                     },
                     "400": {
@@ -2124,11 +2030,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/trips/{tripID}/activities/{activityID}/categories/{categoryName}": {
-            "put": {
-                "description": "Adds a category to an activity (idempotent)",
+            },
+            "post": {
+                "description": "Creates a new activity for a trip",
                 "consumes": [
                     "application/json"
                 ],
@@ -2138,8 +2042,8 @@ const docTemplate = `{
                 "tags": [
                     "activities"
                 ],
-                "summary": "Add category to activity",
-                "operationId": "addCategoryToActivity",
+                "summary": "Create activity",
+                "operationId": "createActivity",
                 "parameters": [
                     {
                         "type": "string",
@@ -2149,25 +2053,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Activity ID",
-                        "name": "activityID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Category Name",
-                        "name": "categoryName",
-                        "in": "path",
-                        "required": true
+                        "description": "Create activity request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateActivityRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.AddCategoryResponse"
+                            "$ref": "#/definitions/models.Activity"
                         }
                     },
                     "400": {
@@ -2207,14 +2106,22 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Removes a category from an activity",
+            }
+        },
+        "/api/v1/trips/{tripID}/activities/parse-link": {
+            "post": {
+                "description": "Fetches a URL and extracts structured activity fields (name, description, thumbnail) for form autofill. Supports Airbnb, Booking.com, TikTok, Instagram, and generic travel blog URLs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "activities"
                 ],
-                "summary": "Remove category from activity",
-                "operationId": "removeCategoryFromActivity",
+                "summary": "Parse link into activity data",
+                "operationId": "parseActivityLink",
                 "parameters": [
                     {
                         "type": "string",
@@ -2224,23 +2131,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Activity ID",
-                        "name": "activityID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Category Name",
-                        "name": "categoryName",
-                        "in": "path",
-                        "required": true
+                        "description": "URL to parse",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ParseLinkRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ParsedActivityData"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2260,12 +2165,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "422": {
                         "description": "Unprocessable Entity",
                         "schema": {
@@ -2281,19 +2180,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/activities/{activityID}/rsvp": {
-            "post": {
-                "description": "Allows a user to RSVP to a specific activity within a trip.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/activities/{activityID}": {
+            "get": {
+                "description": "Retrieves a specific activity",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "activities"
                 ],
-                "summary": "RSVP to activity",
+                "summary": "Get activity by ID",
+                "operationId": "getActivity",
                 "parameters": [
                     {
                         "type": "string",
@@ -2308,22 +2205,13 @@ const docTemplate = `{
                         "name": "activityID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "RSVP request payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.ActivityRSVPRequestPayload"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ActivityRSVPAPIResponse"
+                            "$ref": "#/definitions/models.ActivityAPIResponse"
                         }
                     },
                     "400": {
@@ -2350,12 +2238,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2363,18 +2245,20 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/trips/{tripID}/activities/{activityID}/rsvps": {
-            "get": {
-                "description": "Returns paginated RSVPs for a specific activity within a trip, optionally filtered by RSVP status.",
+            },
+            "put": {
+                "description": "Updates an existing activity",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "activities"
                 ],
-                "summary": "Get activity RSVPs",
+                "summary": "Update activity",
+                "operationId": "updateActivity",
                 "parameters": [
                     {
                         "type": "string",
@@ -2391,29 +2275,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Max items per page (default 20, max 100)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Opaque cursor returned in next_cursor",
-                        "name": "cursor",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by RSVP status",
-                        "name": "status",
-                        "in": "query"
+                        "description": "Update activity request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateActivityRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ActivityRSVPsPageResult"
+                            "$ref": "#/definitions/models.Activity"
                         }
                     },
                     "400": {
@@ -2453,15 +2328,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/trips/{tripID}/activities/{activityID}/rsvps/{userID}": {
+            },
             "delete": {
-                "description": "Removes a user's RSVP from an activity. Only the activity proposer or a trip admin can remove another user's RSVP.",
+                "description": "Deletes an activity",
                 "tags": [
                     "activities"
                 ],
-                "summary": "Remove activity RSVP",
+                "summary": "Delete activity",
+                "operationId": "deleteActivity",
                 "parameters": [
                     {
                         "type": "string",
@@ -2476,13 +2350,6 @@ const docTemplate = `{
                         "name": "activityID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID of the RSVP to remove",
-                        "name": "userID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -2522,38 +2389,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/activity": {
+        "/api/v1/trips/{tripID}/activities/{activityID}/categories": {
             "get": {
-                "description": "Returns all unread events in the caller's trip feed. Events persist until explicitly dismissed via the mark-read endpoint.",
+                "description": "Retrieves categories for an activity with pagination",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "activity-feed"
+                    "activities"
                 ],
-                "summary": "Get trip activity feed",
-                "operationId": "getTripActivityFeed",
+                "summary": "Get activity categories",
+                "operationId": "getActivityCategories",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trip ID (UUID)",
+                        "description": "Trip ID",
                         "name": "tripID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activityID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max items per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor returned in next_cursor",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/realtime.Event"
-                            }
+                            "$ref": "#/definitions/models.ActivityCategoriesPageResult"
                         }
                     },
                     "400": {
-                        "description": "Invalid trip ID",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -2564,6 +2447,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2573,35 +2468,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/activity/unread-count": {
-            "get": {
-                "description": "Returns the number of events in the caller's trip feed that have not yet been marked as read.",
+        "/api/v1/trips/{tripID}/activities/{activityID}/categories/{categoryName}": {
+            "put": {
+                "description": "Adds a category to an activity (idempotent)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "activity-feed"
+                    "activities"
                 ],
-                "summary": "Get unread activity count",
-                "operationId": "getUnreadActivityCount",
+                "summary": "Add category to activity",
+                "operationId": "addCategoryToActivity",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trip ID (UUID)",
+                        "description": "Trip ID",
                         "name": "tripID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activityID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category Name",
+                        "name": "categoryName",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/realtime.UnreadCountResponse"
+                            "$ref": "#/definitions/models.AddCategoryResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid trip ID",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -2612,6 +2524,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2619,16 +2549,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/trips/{tripID}/activity/{eventID}": {
+            },
             "delete": {
-                "description": "Removes a specific event from the caller's trip activity feed.",
+                "description": "Removes a category from an activity",
                 "tags": [
-                    "activity-feed"
+                    "activities"
                 ],
-                "summary": "Mark activity event as read",
-                "operationId": "markActivityEventRead",
+                "summary": "Remove category from activity",
+                "operationId": "removeCategoryFromActivity",
                 "parameters": [
                     {
                         "type": "string",
@@ -2639,8 +2567,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Event ID",
-                        "name": "eventID",
+                        "description": "Activity ID",
+                        "name": "activityID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category Name",
+                        "name": "categoryName",
                         "in": "path",
                         "required": true
                     }
@@ -2661,6 +2596,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2670,17 +2623,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/categories": {
-            "get": {
-                "description": "Retrieves all categories for a trip",
+        "/api/v1/trips/{tripID}/activities/{activityID}/rsvp": {
+            "post": {
+                "description": "Allows a user to RSVP to a specific activity within a trip.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "activities"
                 ],
-                "summary": "Get categories by trip",
-                "operationId": "getCategoriesByTripID",
+                "summary": "RSVP to activity",
                 "parameters": [
                     {
                         "type": "string",
@@ -2690,17 +2645,27 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Include hidden categories (admin only)",
-                        "name": "include_hidden",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activityID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "RSVP request payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ActivityRSVPRequestPayload"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CategoryListResponse"
+                            "$ref": "#/definitions/models.ActivityRSVPAPIResponse"
                         }
                     },
                     "400": {
@@ -2727,6 +2692,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2734,20 +2705,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates a new custom category for a trip (any trip member)",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/trips/{tripID}/activities/{activityID}/rsvps": {
+            "get": {
+                "description": "Returns paginated RSVPs for a specific activity within a trip, optionally filtered by RSVP status.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "activities"
                 ],
-                "summary": "Create category",
-                "operationId": "createCategory",
+                "summary": "Get activity RSVPs",
                 "parameters": [
                     {
                         "type": "string",
@@ -2757,20 +2726,36 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Category details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateCategoryRequest"
-                        }
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activityID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max items per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor returned in next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by RSVP status",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CategoryAPIResponse"
+                            "$ref": "#/definitions/models.ActivityRSVPsPageResult"
                         }
                     },
                     "400": {
@@ -2797,6 +2782,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2806,14 +2797,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/categories/{name}": {
+        "/api/v1/trips/{tripID}/activities/{activityID}/rsvps/{userID}": {
             "delete": {
-                "description": "Deletes a custom category from a trip (admin only, cannot delete default categories)",
+                "description": "Removes a user's RSVP from an activity. Only the activity proposer or a trip admin can remove another user's RSVP.",
                 "tags": [
-                    "categories"
+                    "activities"
                 ],
-                "summary": "Delete category",
-                "operationId": "deleteCategory",
+                "summary": "Remove activity RSVP",
                 "parameters": [
                     {
                         "type": "string",
@@ -2824,8 +2814,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Category name",
-                        "name": "name",
+                        "description": "Activity ID",
+                        "name": "activityID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the RSVP to remove",
+                        "name": "userID",
                         "in": "path",
                         "required": true
                     }
@@ -2867,36 +2864,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/categories/{name}/hide": {
-            "put": {
-                "description": "Hides a category from all members (admin only)",
+        "/api/v1/trips/{tripID}/activity": {
+            "get": {
+                "description": "Returns the caller's trip feed newest first. Repeated actions on the same subject are aggregated into one item (e.g. \"Ana and 3 others voted on Where to eat\"). Reading the feed does not mark items as read.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "categories"
+                    "activity-feed"
                 ],
-                "summary": "Hide category",
-                "operationId": "hideCategory",
+                "summary": "Get trip activity feed",
+                "operationId": "getTripActivityFeed",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trip ID",
+                        "description": "Trip ID (UUID)",
                         "name": "tripID",
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "integer",
+                        "description": "Max items to return (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Category name",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "Opaque cursor returned in next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only return items with unread events",
+                        "name": "unread_only",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ActivityFeedPageResult"
+                        }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid trip ID or cursor",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -2907,18 +2921,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2928,14 +2930,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/categories/{name}/show": {
-            "put": {
-                "description": "Makes a previously hidden category visible again (admin only)",
+        "/api/v1/trips/{tripID}/activity/read": {
+            "post": {
+                "description": "Marks every item in the caller's trip activity feed as read.",
                 "tags": [
-                    "categories"
+                    "activity-feed"
                 ],
-                "summary": "Show category",
-                "operationId": "showCategory",
+                "summary": "Mark all activity as read",
+                "operationId": "markAllActivityRead",
                 "parameters": [
                     {
                         "type": "string",
@@ -2943,13 +2945,6 @@ const docTemplate = `{
                         "name": "tripID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Category name",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -2968,18 +2963,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2989,47 +2972,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/invites": {
-            "post": {
-                "description": "Creates a shareable invite for the trip. Caller must be a trip member.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/activity/unread-count": {
+            "get": {
+                "description": "Returns the number of items in the caller's trip feed that have unread events.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "trips"
+                    "activity-feed"
                 ],
-                "summary": "Create a trip invite",
-                "operationId": "createTripInvite",
+                "summary": "Get unread activity count",
+                "operationId": "getUnreadActivityCount",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trip ID",
+                        "description": "Trip ID (UUID)",
                         "name": "tripID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Optional expires_at; default 7 days",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateTripInviteRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.TripInviteAPIResponse"
+                            "$ref": "#/definitions/realtime.UnreadCountResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid trip ID",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -3040,18 +3011,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3061,17 +3020,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/memberships": {
-            "get": {
-                "description": "Retrieves all members of a trip",
-                "produces": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/activity/{itemID}/read": {
+            "post": {
+                "description": "Marks every event in an activity feed item as read.",
                 "tags": [
-                    "memberships"
+                    "activity-feed"
                 ],
-                "summary": "Get trip members",
-                "operationId": "getTripMembers",
+                "summary": "Mark activity item as read",
+                "operationId": "markActivityItemRead",
                 "parameters": [
                     {
                         "type": "string",
@@ -3080,25 +3036,17 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "integer",
-                        "description": "Max items per page (default 20, max 100)",
-                        "name": "limit",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Opaque cursor returned in next_cursor",
-                        "name": "cursor",
-                        "in": "query"
+                        "description": "Feed item ID",
+                        "name": "itemID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.MembershipCursorPageResult"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3112,8 +3060,51 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/activity/{itemID}/unread": {
+            "post": {
+                "description": "Restores an activity feed item to unread.",
+                "tags": [
+                    "activity-feed"
+                ],
+                "summary": "Mark activity item as unread",
+                "operationId": "markActivityItemUnread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Feed item ID",
+                        "name": "itemID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -3127,17 +3118,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/memberships/{userID}": {
-            "get": {
-                "description": "Retrieves the membership for a user in a trip",
+        "/api/v1/trips/{tripID}/archive": {
+            "post": {
+                "description": "Makes the trip read-only and hides it from the trip list unless include_archived is set (trip admins only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "memberships"
+                    "trips"
                 ],
-                "summary": "Get membership",
-                "operationId": "getMembership",
+                "summary": "Archive a trip",
+                "operationId": "archiveTrip",
                 "parameters": [
                     {
                         "type": "string",
@@ -3145,20 +3136,13 @@ const docTemplate = `{
                         "name": "tripID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.MembershipAPIResponse"
+                            "$ref": "#/definitions/models.Trip"
                         }
                     },
                     "400": {
@@ -3173,6 +3157,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3186,14 +3176,19 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Removes a user from a trip. Only admins can remove members.",
+            }
+        },
+        "/api/v1/trips/{tripID}/categories": {
+            "get": {
+                "description": "Retrieves all categories for a trip",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "memberships"
+                    "categories"
                 ],
-                "summary": "Remove member from trip",
-                "operationId": "removeMember",
+                "summary": "Get categories by trip",
+                "operationId": "getCategoriesByTripID",
                 "parameters": [
                     {
                         "type": "string",
@@ -3203,16 +3198,18 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userID",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Include hidden categories (admin only)",
+                        "name": "include_hidden",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CategoryListResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3246,8 +3243,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
-                "description": "Updates a membership",
+            "post": {
+                "description": "Creates a new custom category for a trip (any trip member)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3255,10 +3252,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "memberships"
+                    "categories"
                 ],
-                "summary": "Update membership",
-                "operationId": "updateMembership",
+                "summary": "Create category",
+                "operationId": "createCategory",
                 "parameters": [
                     {
                         "type": "string",
@@ -3268,27 +3265,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update membership request",
+                        "description": "Category details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateMembershipRequest"
+                            "$ref": "#/definitions/models.CreateCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Membership"
+                            "$ref": "#/definitions/models.CategoryAPIResponse"
                         }
                     },
                     "400": {
@@ -3303,14 +3293,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -3324,14 +3314,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/memberships/{userID}/demote": {
-            "post": {
-                "description": "Demotes an admin to regular member role",
+        "/api/v1/trips/{tripID}/categories/{name}": {
+            "delete": {
+                "description": "Deletes a custom category from a trip (admin only, cannot delete default categories)",
                 "tags": [
-                    "memberships"
+                    "categories"
                 ],
-                "summary": "Demote admin to member",
-                "operationId": "demoteFromAdmin",
+                "summary": "Delete category",
+                "operationId": "deleteCategory",
                 "parameters": [
                     {
                         "type": "string",
@@ -3342,21 +3332,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userID",
+                        "description": "Category name",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3370,6 +3354,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3385,20 +3375,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/memberships/{userID}/notification-preferences": {
-            "patch": {
-                "description": "Updates the notification preferences for a membership (self only)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/categories/{name}/hide": {
+            "put": {
+                "description": "Hides a category from all members (admin only)",
                 "tags": [
-                    "memberships"
+                    "categories"
                 ],
-                "summary": "Update notification preferences",
-                "operationId": "updateMembershipNotificationPreferences",
+                "summary": "Hide category",
+                "operationId": "hideCategory",
                 "parameters": [
                     {
                         "type": "string",
@@ -3409,27 +3393,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userID",
+                        "description": "Category name",
+                        "name": "name",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Notification preferences",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdateNotificationPreferencesRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.Membership"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3455,12 +3427,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3470,14 +3436,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/memberships/{userID}/promote": {
-            "post": {
-                "description": "Promotes a member to admin role",
+        "/api/v1/trips/{tripID}/categories/{name}/show": {
+            "put": {
+                "description": "Makes a previously hidden category visible again (admin only)",
                 "tags": [
-                    "memberships"
+                    "categories"
                 ],
-                "summary": "Promote member to admin",
-                "operationId": "promoteToAdmin",
+                "summary": "Show category",
+                "operationId": "showCategory",
                 "parameters": [
                     {
                         "type": "string",
@@ -3488,21 +3454,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userID",
+                        "description": "Category name",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3516,6 +3476,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3531,17 +3497,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/pitches": {
+        "/api/v1/trips/{tripID}/direct-invites": {
             "get": {
-                "description": "Returns pitches for the trip with cursor-based pagination",
+                "description": "Returns the trip's pending direct invites (requires manage_invites)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "pitches"
+                    "trips"
                 ],
-                "summary": "Get all pitches for a trip",
-                "operationId": "listPitches",
+                "summary": "List trip direct invites",
+                "operationId": "listTripDirectInvites",
                 "parameters": [
                     {
                         "type": "string",
@@ -3549,25 +3515,13 @@ const docTemplate = `{
                         "name": "tripID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Max items per page (default 20, max 100)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Opaque cursor from previous response next_cursor",
-                        "name": "cursor",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PitchCursorPageResult"
+                            "$ref": "#/definitions/models.TripDirectInvitesResponse"
                         }
                     },
                     "400": {
@@ -3576,8 +3530,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -3591,7 +3551,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new pitch for the trip and returns a presigned URL to upload the audio file.\nOptionally supply up to 5 confirmed image IDs (image_ids) in the request. The response includes images with presigned medium_url for each image.",
+                "description": "Invites a user, found by username or phone number, to join the trip. The invitee is notified and accepts or declines. Inviting someone with a pending invite returns that invite. Invites by phone number are answered with 202 and no body whether or not the number belongs to a user who can be invited. Rate limited per user.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3599,10 +3559,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pitches"
+                    "trips"
                 ],
-                "summary": "Create a pitch",
-                "operationId": "createPitch",
+                "summary": "Invite a user to a trip",
+                "operationId": "createTripDirectInvite",
                 "parameters": [
                     {
                         "type": "string",
@@ -3612,12 +3572,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Create pitch request",
+                        "description": "Username or phone number",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePitchRequest"
+                            "$ref": "#/definitions/models.CreateTripDirectInviteRequest"
                         }
                     }
                 ],
@@ -3625,78 +3585,44 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePitchResponse"
+                            "$ref": "#/definitions/models.TripDirectInvite"
                         }
                     },
+                    "202": {
+                        "description": "Accepted"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
-                    }
-                }
-            }
-        },
-        "/api/v1/trips/{tripID}/pitches/{pitchID}": {
-            "get": {
-                "description": "Returns a single pitch with presigned URLs for the audio file and associated images (medium_url for each image)",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "pitches"
-                ],
-                "summary": "Get a pitch by ID",
-                "operationId": "getPitch",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Trip ID",
-                        "name": "tripID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pitch ID",
-                        "name": "pitchID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.PitchAPIResponse"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -3708,14 +3634,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/trips/{tripID}/direct-invites/{inviteID}": {
             "delete": {
-                "description": "Deletes a pitch by ID",
+                "description": "Withdraws a pending direct invite. Inviters can cancel their own; members with manage_invites can cancel any.",
                 "tags": [
-                    "pitches"
+                    "trips"
                 ],
-                "summary": "Delete a pitch",
-                "operationId": "deletePitch",
+                "summary": "Cancel a direct invite",
+                "operationId": "cancelTripDirectInvite",
                 "parameters": [
                     {
                         "type": "string",
@@ -3726,8 +3654,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Pitch ID",
-                        "name": "pitchID",
+                        "description": "Invite ID",
+                        "name": "inviteID",
                         "in": "path",
                         "required": true
                     }
@@ -3742,6 +3670,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3755,9 +3695,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Updates pitch metadata (title, description, duration).\nWhen image_ids is omitted from the request, existing image associations remain unchanged.\nWhen image_ids is an empty array, all image associations are removed.\nWhen image_ids is a non-empty array, the provided IDs are added to existing associations (additive, not replacement).\nThe response includes images with presigned medium_url for each image.",
+            }
+        },
+        "/api/v1/trips/{tripID}/duplicate": {
+            "post": {
+                "description": "Copies the trip's categories, activities, polls and budget into a new trip owned by the caller. Members, pitches, comments, RSVPs, votes and images are not copied. Dates are shifted so the copy starts on start_date; without it the copy is undated.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3765,10 +3707,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pitches"
+                    "trips"
                 ],
-                "summary": "Update a pitch",
-                "operationId": "updatePitch",
+                "summary": "Duplicate a trip",
+                "operationId": "duplicateTrip",
                 "parameters": [
                     {
                         "type": "string",
@@ -3778,27 +3720,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Pitch ID",
-                        "name": "pitchID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update pitch request",
+                        "description": "Optional name and start date",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdatePitchRequest"
+                            "$ref": "#/definitions/models.DuplicateTripRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.PitchAPIResponse"
+                            "$ref": "#/definitions/models.Trip"
                         }
                     },
                     "400": {
@@ -3807,6 +3741,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3828,14 +3774,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/pitches/{pitchID}/confirm-upload": {
-            "post": {
-                "description": "Verifies that the audio file was successfully uploaded to S3 and notifies trip members.\nMust be called by the pitch creator after the presigned PUT upload completes.",
+        "/api/v1/trips/{tripID}/invites": {
+            "get": {
+                "description": "Returns the trip's invites, newest first, with how many people joined through each (trip admins only)",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "pitches"
+                    "trips"
                 ],
-                "summary": "Confirm pitch audio upload",
-                "operationId": "confirmPitchUpload",
+                "summary": "List trip invites",
+                "operationId": "listTripInvites",
                 "parameters": [
                     {
                         "type": "string",
@@ -3843,18 +3792,14 @@ const docTemplate = `{
                         "name": "tripID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pitch ID",
-                        "name": "pitchID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripInvitesResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3862,14 +3807,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -3881,11 +3826,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/trips/{tripID}/rank-polls": {
+            },
             "post": {
-                "description": "Creates a new ranking poll with initial options",
+                "description": "Creates a shareable invite for the trip. Caller must be a trip member.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3893,10 +3836,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "trips"
                 ],
-                "summary": "Create a rank poll",
-                "operationId": "createRankPoll",
+                "summary": "Create a trip invite",
+                "operationId": "createTripInvite",
                 "parameters": [
                     {
                         "type": "string",
@@ -3906,12 +3849,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Create rank poll request",
+                        "description": "Optional expires_at (default 7 days), max_uses or single_use, and requires_approval",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePollRequest"
+                            "$ref": "#/definitions/models.CreateTripInviteRequest"
                         }
                     }
                 ],
@@ -3919,7 +3862,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.RankPollAPIResponse"
+                            "$ref": "#/definitions/models.TripInviteAPIResponse"
                         }
                     },
                     "400": {
@@ -3934,8 +3877,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -3955,17 +3898,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/rank-polls/{pollId}": {
-            "get": {
-                "description": "Retrieves a rank poll with Borda count scores and user's personal ranking",
-                "produces": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/invites/{inviteID}": {
+            "delete": {
+                "description": "Stops the invite from being used (trip admins and the invite's creator only). Pending join requests can still be decided.",
                 "tags": [
-                    "polls"
+                    "trips"
                 ],
-                "summary": "Get rank poll results",
-                "operationId": "getRankPollResults",
+                "summary": "Revoke a trip invite",
+                "operationId": "revokeTripInvite",
                 "parameters": [
                     {
                         "type": "string",
@@ -3976,18 +3916,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
+                        "description": "Invite ID",
+                        "name": "inviteID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.RankPollResultsResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4020,17 +3957,19 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a rank poll and all associated options and rankings",
+            }
+        },
+        "/api/v1/trips/{tripID}/invites/{inviteID}/joins": {
+            "get": {
+                "description": "Returns who joined, or asked to join, through the invite, newest first (trip admins only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "trips"
                 ],
-                "summary": "Delete a rank poll",
-                "operationId": "deleteRankPoll",
+                "summary": "List invite joins",
+                "operationId": "listTripInviteJoins",
                 "parameters": [
                     {
                         "type": "string",
@@ -4041,8 +3980,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
+                        "description": "Invite ID",
+                        "name": "inviteID",
                         "in": "path",
                         "required": true
                     }
@@ -4051,7 +3990,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RankPollAPIResponse"
+                            "$ref": "#/definitions/models.TripInviteJoinsResponse"
                         }
                     },
                     "400": {
@@ -4085,20 +4024,19 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Updates poll metadata (question, deadline)",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/trips/{tripID}/join-requests": {
+            "get": {
+                "description": "Returns pending requests to join the trip through invites that require approval, oldest first (trip admins only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Update a rank poll",
-                "operationId": "updateRankPoll",
+                "summary": "List join requests",
+                "operationId": "listJoinRequests",
                 "parameters": [
                     {
                         "type": "string",
@@ -4106,30 +4044,14 @@ const docTemplate = `{
                         "name": "tripID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update rank poll request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdatePollWithCategoriesRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.RankPollAPIResponse"
-                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripInviteJoinsResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4149,18 +4071,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4170,20 +4080,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/rank-polls/{pollId}/options": {
+        "/api/v1/trips/{tripID}/join-requests/{requestID}/approve": {
             "post": {
-                "description": "Adds an option to a rank poll (only if no rankings exist yet)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Adds the requester to the trip (trip admins only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Add a rank poll option",
-                "operationId": "addRankPollOption",
+                "summary": "Approve join request",
+                "operationId": "approveJoinRequest",
                 "parameters": [
                     {
                         "type": "string",
@@ -4194,26 +4101,17 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
+                        "description": "Join request ID",
+                        "name": "requestID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Create option request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreatePollOptionRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.PollOptionAPIResponse"
+                            "$ref": "#/definitions/models.Membership"
                         }
                     },
                     "400": {
@@ -4240,18 +4138,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4261,17 +4147,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/rank-polls/{pollId}/options/{optionId}": {
-            "delete": {
-                "description": "Removes an option from a rank poll (only if no rankings exist yet)",
-                "produces": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/join-requests/{requestID}/reject": {
+            "post": {
+                "description": "Declines the request and frees its use of the invite (trip admins only)",
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Delete a rank poll option",
-                "operationId": "deleteRankPollOption",
+                "summary": "Reject join request",
+                "operationId": "rejectJoinRequest",
                 "parameters": [
                     {
                         "type": "string",
@@ -4282,25 +4165,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Option ID",
-                        "name": "optionId",
+                        "description": "Join request ID",
+                        "name": "requestID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.PollOptionAPIResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4326,12 +4199,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4341,17 +4208,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/rank-polls/{pollId}/options/{optionId}/ranks/{rankPosition}/voters": {
-            "get": {
-                "description": "Returns who ranked an option at a specific rank position",
-                "produces": [
+        "/api/v1/trips/{tripID}/leave": {
+            "post": {
+                "description": "Removes the current user from the trip. The last admin must name a successor, who becomes an organiser; a leaving owner hands ownership to the successor, or to the longest-standing organiser when none is named. The user's pitches and activities are reassigned to the owner or anonymised per the trip's departed_content_policy.",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Get voters for a ranked choice",
-                "operationId": "getRankChoiceVoters",
+                "summary": "Leave trip",
+                "operationId": "leaveTrip",
                 "parameters": [
                     {
                         "type": "string",
@@ -4361,33 +4228,17 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Option ID",
-                        "name": "optionId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Rank position (1-3)",
-                        "name": "rankPosition",
-                        "in": "path",
-                        "required": true
+                        "description": "Successor",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.LeaveTripRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.RankChoiceVotersResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4401,14 +4252,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -4422,20 +4273,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/rank-polls/{pollId}/rank": {
-            "post": {
-                "description": "Submits or replaces the user's full ranking for a poll",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/memberships": {
+            "get": {
+                "description": "Retrieves all members of a trip",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Submit ranking",
-                "operationId": "submitRanking",
+                "summary": "Get trip members",
+                "operationId": "getTripMembers",
                 "parameters": [
                     {
                         "type": "string",
@@ -4445,28 +4293,23 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Max items per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
-                        "description": "Submit ranking request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.SubmitRankingRequest"
-                        }
+                        "type": "string",
+                        "description": "Opaque cursor returned in next_cursor",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.MembershipCursorPageResult"
                         }
                     },
                     "400": {
@@ -4481,24 +4324,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4508,17 +4339,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/rank-polls/{pollId}/voters": {
+        "/api/v1/trips/{tripID}/memberships/{userID}": {
             "get": {
-                "description": "Returns who has voted vs who hasn't for a rank poll",
+                "description": "Retrieves the membership for a user in a trip",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Get poll voters",
-                "operationId": "getRankPollVoters",
+                "summary": "Get membership",
+                "operationId": "getMembership",
                 "parameters": [
                     {
                         "type": "string",
@@ -4529,8 +4360,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
+                        "description": "User ID",
+                        "name": "userID",
                         "in": "path",
                         "required": true
                     }
@@ -4539,7 +4370,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PollVotersResponse"
+                            "$ref": "#/definitions/models.MembershipAPIResponse"
                         }
                     },
                     "400": {
@@ -4554,12 +4385,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -4573,19 +4398,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/trips/{tripID}/tabs": {
-            "get": {
-                "description": "Retrieves all visible categories for a trip ordered by position",
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Removes a user from a trip. Members can remove themselves; owners and organisers can remove members and viewers, and only the owner can remove organisers. If the owner leaves, the longest-standing organiser becomes owner. The member's pitches and activities are reassigned to the owner or anonymised per the trip's departed_content_policy.",
                 "tags": [
-                    "categories"
+                    "memberships"
                 ],
-                "summary": "Get trip tabs",
-                "operationId": "getTripTabs",
+                "summary": "Remove member from trip",
+                "operationId": "removeMember",
                 "parameters": [
                     {
                         "type": "string",
@@ -4593,14 +4413,18 @@ const docTemplate = `{
                         "name": "tripID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.TabListResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4633,22 +4457,20 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/trips/{tripID}/tabs/reorder": {
-            "put": {
-                "description": "Updates the position of visible categories for a trip",
-                "consumes": [
-                    "application/json"
+            },
+            "patch": {
+                "description": "Updates a membership",
+                "consumes": [
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "memberships"
                 ],
-                "summary": "Reorder trip tabs",
-                "operationId": "reorderTripTabs",
+                "summary": "Update membership",
+                "operationId": "updateMembership",
                 "parameters": [
                     {
                         "type": "string",
@@ -4658,18 +4480,28 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Reorder request",
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update membership request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateCategoryTabOrderRequest"
+                            "$ref": "#/definitions/models.UpdateMembershipRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Membership"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4683,12 +4515,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -4710,17 +4536,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/vote-polls": {
-            "get": {
-                "description": "Retrieves all polls for a trip with cursor-based pagination",
-                "produces": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/memberships/{userID}/demote": {
+            "post": {
+                "description": "Makes an organiser a member. Kept for older clients; use the role endpoint instead.",
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Get polls for a trip",
-                "operationId": "getPollsByTripID",
+                "summary": "Demote admin to member",
+                "operationId": "demoteFromAdmin",
                 "parameters": [
                     {
                         "type": "string",
@@ -4729,24 +4552,22 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "integer",
-                        "description": "Max items per page (default 20, max 100)",
-                        "name": "limit",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Opaque cursor returned in next_cursor",
-                        "name": "cursor",
-                        "in": "query"
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PollCursorPageResult"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
@@ -4761,8 +4582,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -4774,9 +4595,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates a new poll with initial options",
+            }
+        },
+        "/api/v1/trips/{tripID}/memberships/{userID}/notification-preferences": {
+            "patch": {
+                "description": "Updates the notification preferences for a membership (self only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -4784,10 +4607,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Create a poll",
-                "operationId": "createPoll",
+                "summary": "Update notification preferences",
+                "operationId": "updateMembershipNotificationPreferences",
                 "parameters": [
                     {
                         "type": "string",
@@ -4797,20 +4620,27 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Create poll request",
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Notification preferences",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePollRequest"
+                            "$ref": "#/definitions/models.UpdateNotificationPreferencesRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PollAPIResponse"
+                            "$ref": "#/definitions/models.Membership"
                         }
                     },
                     "400": {
@@ -4831,6 +4661,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "422": {
                         "description": "Unprocessable Entity",
                         "schema": {
@@ -4846,17 +4682,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/vote-polls/{pollId}": {
-            "get": {
-                "description": "Retrieves a poll with vote counts and the caller's votes",
-                "produces": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/memberships/{userID}/promote": {
+            "post": {
+                "description": "Makes a member an organiser. Kept for older clients; use the role endpoint instead.",
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Get poll results",
-                "operationId": "getPoll",
+                "summary": "Promote member to admin",
+                "operationId": "promoteToAdmin",
                 "parameters": [
                     {
                         "type": "string",
@@ -4867,8 +4700,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
+                        "description": "User ID",
+                        "name": "userID",
                         "in": "path",
                         "required": true
                     }
@@ -4877,7 +4710,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PollAPIResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
@@ -4892,12 +4728,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -4911,17 +4741,22 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a poll and all associated options and votes, returns the deleted poll",
+            }
+        },
+        "/api/v1/trips/{tripID}/memberships/{userID}/role": {
+            "put": {
+                "description": "Sets a member's role to organiser, member or viewer. Owners can change anyone else's role; organisers can change members' and viewers' roles and step down themselves. Ownership cannot be assigned here.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Delete a poll",
-                "operationId": "deletePoll",
+                "summary": "Update member role",
+                "operationId": "updateMemberRole",
                 "parameters": [
                     {
                         "type": "string",
@@ -4932,17 +4767,26 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
+                        "description": "User ID",
+                        "name": "userID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "New role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateMemberRoleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PollAPIResponse"
+                            "$ref": "#/definitions/models.Membership"
                         }
                     },
                     "400": {
@@ -4969,6 +4813,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4976,20 +4826,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Updates poll metadata (question, deadline)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/trips/{tripID}/moderation/hidden/{entityType}/{entityID}": {
+            "delete": {
+                "description": "Shows a hidden comment, pitch or activity in the trip again (requires moderate_content)",
                 "tags": [
-                    "polls"
+                    "moderation"
                 ],
-                "summary": "Update a poll",
-                "operationId": "updatePoll",
+                "summary": "Unhide content",
+                "operationId": "unhideContent",
                 "parameters": [
                     {
                         "type": "string",
@@ -5000,27 +4846,22 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
+                        "description": "Entity type (comment, pitch, activity)",
+                        "name": "entityType",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update poll request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdatePollWithCategoriesRequest"
-                        }
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entityID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.PollAPIResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5046,12 +4887,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5061,20 +4896,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/vote-polls/{pollId}/options": {
-            "post": {
-                "description": "Adds an option to a poll (only if no votes exist yet)",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/moderation/reports": {
+            "get": {
+                "description": "Returns the trip's pending reports, oldest first (requires moderate_content)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "moderation"
                 ],
-                "summary": "Add a poll option",
-                "operationId": "addPollOption",
+                "summary": "Get the moderation queue",
+                "operationId": "getModerationQueue",
                 "parameters": [
                     {
                         "type": "string",
@@ -5082,29 +4914,13 @@ const docTemplate = `{
                         "name": "tripID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Create option request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreatePollOptionRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PollOptionAPIResponse"
+                            "$ref": "#/definitions/models.ModerationQueueResponse"
                         }
                     },
                     "400": {
@@ -5125,26 +4941,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -5152,17 +4950,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/vote-polls/{pollId}/options/{optionId}": {
-            "delete": {
-                "description": "Removes an option from a poll and returns the deleted option",
-                "produces": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/moderation/reports/{reportID}/dismiss": {
+            "post": {
+                "description": "Closes a pending report without hiding the content (requires moderate_content)",
                 "tags": [
-                    "polls"
+                    "moderation"
                 ],
-                "summary": "Delete a poll option",
-                "operationId": "deletePollOption",
+                "summary": "Dismiss a report",
+                "operationId": "dismissContentReport",
                 "parameters": [
                     {
                         "type": "string",
@@ -5173,25 +4968,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Option ID",
-                        "name": "optionId",
+                        "description": "Report ID",
+                        "name": "reportID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.PollOptionAPIResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5217,12 +5002,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5232,17 +5011,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/vote-polls/{pollId}/options/{optionId}/voters": {
-            "get": {
-                "description": "Returns all users who voted for a specific option in a vote poll",
-                "produces": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/moderation/reports/{reportID}/hide": {
+            "post": {
+                "description": "Hides the reported comment, pitch or activity from the trip and resolves every pending report on it (requires moderate_content)",
                 "tags": [
-                    "polls"
+                    "moderation"
                 ],
-                "summary": "Get option voters",
-                "operationId": "getVoteOptionVoters",
+                "summary": "Hide reported content",
+                "operationId": "hideReportedContent",
                 "parameters": [
                     {
                         "type": "string",
@@ -5253,25 +5029,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Option ID",
-                        "name": "optionId",
+                        "description": "Report ID",
+                        "name": "reportID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.OptionVotersResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5306,20 +5072,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/vote-polls/{pollId}/vote": {
+        "/api/v1/trips/{tripID}/ownership": {
             "post": {
-                "description": "Casts or replaces the user's vote(s) on a poll",
+                "description": "Makes another member the trip's owner. The current owner stays on as an organiser (owner only).",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "polls"
+                    "memberships"
                 ],
-                "summary": "Cast a vote",
-                "operationId": "castVote",
+                "summary": "Transfer trip ownership",
+                "operationId": "transferTripOwnership",
                 "parameters": [
                     {
                         "type": "string",
@@ -5329,28 +5092,18 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Vote request",
+                        "description": "New owner",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CastVoteRequest"
+                            "$ref": "#/definitions/models.TransferOwnershipRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.PollAPIResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5391,17 +5144,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/trips/{tripID}/vote-polls/{pollId}/voters": {
+        "/api/v1/trips/{tripID}/pitches": {
             "get": {
-                "description": "Returns who has voted vs who hasn't for a vote poll",
+                "description": "Returns pitches for the trip with cursor-based pagination",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "polls"
+                    "pitches"
                 ],
-                "summary": "Get vote poll voters",
-                "operationId": "getVotePollVoters",
+                "summary": "Get all pitches for a trip",
+                "operationId": "listPitches",
                 "parameters": [
                     {
                         "type": "string",
@@ -5410,19 +5163,24 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "integer",
+                        "description": "Max items per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Poll ID",
-                        "name": "pollId",
-                        "in": "path",
-                        "required": true
+                        "description": "Opaque cursor from previous response next_cursor",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PollVotersResponse"
+                            "$ref": "#/definitions/models.PitchCursorPageResult"
                         }
                     },
                     "400": {
@@ -5431,18 +5189,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -5456,19 +5202,20 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/trips/{tripID}/{entityType}/{entityID}/comments": {
-            "get": {
-                "description": "Retrieves paginated comments for a trip entity",
+            },
+            "post": {
+                "description": "Creates a new pitch for the trip and returns a presigned URL to upload the audio file.\nOptionally supply up to 5 confirmed image IDs (image_ids) in the request. The response includes images with presigned medium_url for each image.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "comments"
+                    "pitches"
                 ],
-                "summary": "Get comments",
-                "operationId": "getPaginatedComments",
+                "summary": "Create a pitch",
+                "operationId": "createPitch",
                 "parameters": [
                     {
                         "type": "string",
@@ -5478,37 +5225,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Entity type (activity, pitch)",
-                        "name": "entityType",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Entity ID",
-                        "name": "entityID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Max items per page (default 20, max 100)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Opaque cursor returned in next_cursor",
-                        "name": "cursor",
-                        "in": "query"
+                        "description": "Create pitch request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePitchRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.PaginatedCommentsResponse"
+                            "$ref": "#/definitions/models.CreatePitchResponse"
                         }
                     },
                     "400": {
@@ -5517,12 +5247,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -5544,36 +5268,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users": {
-            "post": {
-                "description": "Creates a new user with the provided payload",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/trips/{tripID}/pitches/{pitchID}": {
+            "get": {
+                "description": "Returns a single pitch with presigned URLs for the audio file and associated images (medium_url for each image)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "pitches"
                 ],
-                "summary": "Create a new user",
-                "operationId": "createUser",
+                "summary": "Get a pitch by ID",
+                "operationId": "getPitch",
                 "parameters": [
                     {
-                        "description": "Create user request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateUserRequest"
-                        }
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pitch ID",
+                        "name": "pitchID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.User"
+                            "$ref": "#/definitions/models.PitchAPIResponse"
                         }
                     },
                     "400": {
@@ -5582,8 +5308,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -5595,67 +5321,36 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/users/me": {
-            "get": {
-                "description": "Retrieves the authenticated user (from JWT claims)",
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Deletes a pitch by ID",
                 "tags": [
-                    "users"
+                    "pitches"
                 ],
-                "summary": "Get current user",
-                "operationId": "getCurrentUser",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.User"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
+                "summary": "Delete a pitch",
+                "operationId": "deletePitch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Pitch ID",
+                        "name": "pitchID",
+                        "in": "path",
+                        "required": true
                     }
-                }
-            }
-        },
-        "/api/v1/users/me/notification-preferences": {
-            "get": {
-                "description": "Retrieves the notification preferences for the authenticated user",
-                "produces": [
-                    "application/json"
                 ],
-                "tags": [
-                    "notification-preferences"
-                ],
-                "summary": "Get notification preferences",
-                "operationId": "getNotificationPreferences",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.NotificationPreferences"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -5674,8 +5369,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Creates notification preferences for the authenticated user",
+            "patch": {
+                "description": "Updates pitch metadata (title, description, duration).\nWhen image_ids is omitted from the request, existing image associations remain unchanged.\nWhen image_ids is an empty array, all image associations are removed.\nWhen image_ids is a non-empty array, the provided IDs are added to existing associations (additive, not replacement).\nThe response includes images with presigned medium_url for each image.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5683,26 +5378,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notification-preferences"
+                    "pitches"
                 ],
-                "summary": "Create notification preferences",
-                "operationId": "createNotificationPreferences",
+                "summary": "Update a pitch",
+                "operationId": "updatePitch",
                 "parameters": [
                     {
-                        "description": "Create notification preferences request",
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pitch ID",
+                        "name": "pitchID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update pitch request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateNotificationPreferencesRequest"
+                            "$ref": "#/definitions/models.UpdatePitchRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.NotificationPreferences"
+                            "$ref": "#/definitions/models.PitchAPIResponse"
                         }
                     },
                     "400": {
@@ -5711,14 +5420,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errs.APIError"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -5736,20 +5439,50 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes notification preferences for the authenticated user",
+            }
+        },
+        "/api/v1/trips/{tripID}/pitches/{pitchID}/confirm-upload": {
+            "post": {
+                "description": "Verifies that the audio file was successfully uploaded to S3 and notifies trip members.\nMust be called by the pitch creator after the presigned PUT upload completes.",
                 "tags": [
-                    "notification-preferences"
+                    "pitches"
+                ],
+                "summary": "Confirm pitch audio upload",
+                "operationId": "confirmPitchUpload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pitch ID",
+                        "name": "pitchID",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Delete notification preferences",
-                "operationId": "deleteNotificationPreferences",
                 "responses": {
                     "204": {
                         "description": "No Content"
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -5761,9 +5494,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Updates notification preferences for the authenticated user",
+            }
+        },
+        "/api/v1/trips/{tripID}/rank-polls": {
+            "post": {
+                "description": "Creates a new ranking poll with initial options",
                 "consumes": [
                     "application/json"
                 ],
@@ -5771,26 +5506,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notification-preferences"
+                    "polls"
                 ],
-                "summary": "Update notification preferences",
-                "operationId": "updateNotificationPreferences",
+                "summary": "Create a rank poll",
+                "operationId": "createRankPoll",
                 "parameters": [
                     {
-                        "description": "Update notification preferences request",
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create rank poll request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateUserNotificationPreferencesRequest"
+                            "$ref": "#/definitions/models.CreatePollRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.NotificationPreferences"
+                            "$ref": "#/definitions/models.RankPollAPIResponse"
                         }
                     },
                     "400": {
@@ -5805,8 +5547,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -5826,22 +5568,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users/me/notification-preferences/default": {
-            "post": {
-                "description": "Returns existing preferences or creates default preferences for the authenticated user",
+        "/api/v1/trips/{tripID}/rank-polls/{pollId}": {
+            "get": {
+                "description": "Retrieves a rank poll with Borda count scores and user's personal ranking",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notification-preferences"
+                    "polls"
+                ],
+                "summary": "Get rank poll results",
+                "operationId": "getRankPollResults",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get or create default notification preferences",
-                "operationId": "getOrCreateDefaultNotificationPreferences",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.NotificationPreferences"
+                            "$ref": "#/definitions/models.RankPollResultsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
                         }
                     },
                     "401": {
@@ -5850,6 +5614,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5857,24 +5633,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/users/{userID}": {
-            "get": {
-                "description": "Retrieves a user by ID",
+            },
+            "delete": {
+                "description": "Deletes a rank poll and all associated options and rankings",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "polls"
                 ],
-                "summary": "Get a user",
-                "operationId": "getUser",
+                "summary": "Delete a rank poll",
+                "operationId": "deleteRankPoll",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userID",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
                         "in": "path",
                         "required": true
                     }
@@ -5883,7 +5664,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.User"
+                            "$ref": "#/definitions/models.RankPollAPIResponse"
                         }
                     },
                     "400": {
@@ -5892,8 +5673,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
@@ -5906,25 +5699,50 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Deletes a user by ID",
+            "patch": {
+                "description": "Updates poll metadata (question, deadline)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "users"
+                    "polls"
                 ],
-                "summary": "Delete a user",
-                "operationId": "deleteUser",
+                "summary": "Update a rank poll",
+                "operationId": "updateRankPoll",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userID",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update rank poll request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdatePollWithCategoriesRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RankPollAPIResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5932,12 +5750,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5945,9 +5781,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Updates an existing user by ID",
+            }
+        },
+        "/api/v1/trips/{tripID}/rank-polls/{pollId}/options": {
+            "post": {
+                "description": "Adds an option to a rank poll (only if no rankings exist yet)",
                 "consumes": [
                     "application/json"
                 ],
@@ -5955,33 +5793,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "polls"
                 ],
-                "summary": "Update a user",
-                "operationId": "updateUser",
+                "summary": "Add a rank poll option",
+                "operationId": "addRankPollOption",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userID",
+                        "description": "Trip ID",
+                        "name": "tripID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update user request",
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create option request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateUserRequest"
+                            "$ref": "#/definitions/models.CreatePollOptionRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.User"
+                            "$ref": "#/definitions/models.PollOptionAPIResponse"
                         }
                     },
                     "400": {
@@ -5990,12 +5835,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errs.APIError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
                     "422": {
                         "description": "Unprocessable Entity",
                         "schema": {
@@ -6011,601 +5874,5917 @@ const docTemplate = `{
                 }
             }
         },
-        "/healthcheck": {
-            "get": {
-                "description": "Returns OK if the server is running and database is healthy",
+        "/api/v1/trips/{tripID}/rank-polls/{pollId}/options/{optionId}": {
+            "delete": {
+                "description": "Removes an option from a rank poll (only if no rankings exist yet)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "example"
+                    "polls"
+                ],
+                "summary": "Delete a rank poll option",
+                "operationId": "deleteRankPollOption",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Option ID",
+                        "name": "optionId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Healthcheck endpoint",
-                "operationId": "healthcheck",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.PollOptionAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/errs.APIError"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "errs.APIError": {
-            "type": "object",
-            "properties": {
-                "message": {},
-                "statusCode": {
-                    "type": "integer"
-                }
-            }
         },
-        "models.Activity": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "dates": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.DateRange"
+        "/api/v1/trips/{tripID}/rank-polls/{pollId}/options/{optionId}/ranks/{rankPosition}/voters": {
+            "get": {
+                "description": "Returns who ranked an option at a specific rank position",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Get voters for a ranked choice",
+                "operationId": "getRankChoiceVoters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Option ID",
+                        "name": "optionId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rank position (1-3)",
+                        "name": "rankPosition",
+                        "in": "path",
+                        "required": true
                     }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RankChoiceVotersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/rank-polls/{pollId}/rank": {
+            "post": {
+                "description": "Submits or replaces the user's full ranking for a poll",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Submit ranking",
+                "operationId": "submitRanking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Submit ranking request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SubmitRankingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/rank-polls/{pollId}/voters": {
+            "get": {
+                "description": "Returns who has voted vs who hasn't for a rank poll",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Get poll voters",
+                "operationId": "getRankPollVoters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PollVotersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/reports": {
+            "post": {
+                "description": "Reports a comment, pitch or activity in the trip to its moderators. Reporting the same content again returns the pending report.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "moderation"
+                ],
+                "summary": "Report content",
+                "operationId": "reportContent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reported content and reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateContentReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ContentReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/share-link": {
+            "get": {
+                "description": "Returns the trip's public, read-only share link and the tabs it shows (trip admins only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Get trip share link",
+                "operationId": "getTripShareLink",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripShareLinkAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Turns on the trip's public, read-only web view, or changes which tabs it shows (trip admins only). A new link shows every tab unless visible_tabs is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Enable trip share link",
+                "operationId": "enableTripShareLink",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Visible tabs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateTripShareLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripShareLinkAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Turns off the trip's public web view (trip admins only)",
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Disable trip share link",
+                "operationId": "disableTripShareLink",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/share-link/rotate": {
+            "post": {
+                "description": "Replaces the share link's token so the previous link stops working (trip admins only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Rotate trip share link",
+                "operationId": "rotateTripShareLink",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripShareLinkAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/slack": {
+            "get": {
+                "description": "Returns the Slack channel the trip posts to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack"
+                ],
+                "summary": "Get trip Slack channel",
+                "operationId": "getTripSlackChannel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripSlackChannel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Posts the trip's new polls (with vote buttons), pitches and finalised decisions to a Slack channel, replacing any previous channel (trip admins only). The Slack app must already be in the channel.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack"
+                ],
+                "summary": "Link trip to Slack channel",
+                "operationId": "linkTripSlackChannel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Slack channel",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LinkSlackChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripSlackChannel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stops posting the trip's updates to Slack (trip admins only)",
+                "tags": [
+                    "slack"
+                ],
+                "summary": "Unlink trip from Slack",
+                "operationId": "unlinkTripSlackChannel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/tabs": {
+            "get": {
+                "description": "Retrieves all visible categories for a trip ordered by position",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Get trip tabs",
+                "operationId": "getTripTabs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TabListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/tabs/reorder": {
+            "put": {
+                "description": "Updates the position of visible categories for a trip",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Reorder trip tabs",
+                "operationId": "reorderTripTabs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reorder request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateCategoryTabOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/tasks": {
+            "get": {
+                "description": "Returns the trip's tasks, open ones first and soonest due first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List tasks",
+                "operationId": "listTripTasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only tasks with this status (todo, in_progress, done)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only tasks assigned to this user",
+                        "name": "assignee_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripTasksResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a task to the trip, optionally assigned to members and linked to an activity (requires manage_tasks). Assignees are notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Create a task",
+                "operationId": "createTripTask",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateTripTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripTask"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/tasks/{taskID}": {
+            "get": {
+                "description": "Returns a single task in the trip",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get a task",
+                "operationId": "getTripTask",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripTask"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a task and its comments (requires manage_tasks)",
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Delete a task",
+                "operationId": "deleteTripTask",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Changes a task's details, status, due date, activity or assignees (requires manage_tasks). Assignees can change the status of their own task. Newly assigned members are notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Update a task",
+                "operationId": "updateTripTask",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateTripTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripTask"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/template": {
+            "post": {
+                "description": "Saves the trip's categories, activities, polls and budget as a reusable template (trip admins only). Public templates can be used by anyone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trip-templates"
+                ],
+                "summary": "Save a trip as a template",
+                "operationId": "saveTripAsTemplate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateTripTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/unarchive": {
+            "post": {
+                "description": "Makes an archived trip editable again (trip admins only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Unarchive a trip",
+                "operationId": "unarchiveTrip",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Trip"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/vote-polls": {
+            "get": {
+                "description": "Retrieves all polls for a trip with cursor-based pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Get polls for a trip",
+                "operationId": "getPollsByTripID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max items per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor returned in next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PollCursorPageResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new poll with initial options",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Create a poll",
+                "operationId": "createPoll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create poll request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PollAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/vote-polls/{pollId}": {
+            "get": {
+                "description": "Retrieves a poll with vote counts and the caller's votes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Get poll results",
+                "operationId": "getPoll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PollAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a poll and all associated options and votes, returns the deleted poll",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Delete a poll",
+                "operationId": "deletePoll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PollAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Updates poll metadata (question, deadline)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Update a poll",
+                "operationId": "updatePoll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update poll request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdatePollWithCategoriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PollAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/vote-polls/{pollId}/options": {
+            "post": {
+                "description": "Adds an option to a poll (only if no votes exist yet)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Add a poll option",
+                "operationId": "addPollOption",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create option request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePollOptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PollOptionAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/vote-polls/{pollId}/options/{optionId}": {
+            "delete": {
+                "description": "Removes an option from a poll and returns the deleted option",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Delete a poll option",
+                "operationId": "deletePollOption",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Option ID",
+                        "name": "optionId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PollOptionAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/vote-polls/{pollId}/options/{optionId}/voters": {
+            "get": {
+                "description": "Returns all users who voted for a specific option in a vote poll",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Get option voters",
+                "operationId": "getVoteOptionVoters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Option ID",
+                        "name": "optionId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.OptionVotersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/vote-polls/{pollId}/vote": {
+            "post": {
+                "description": "Casts or replaces the user's vote(s) on a poll",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Cast a vote",
+                "operationId": "castVote",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Vote request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CastVoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PollAPIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/vote-polls/{pollId}/voters": {
+            "get": {
+                "description": "Returns who has voted vs who hasn't for a vote poll",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "polls"
+                ],
+                "summary": "Get vote poll voters",
+                "operationId": "getVotePollVoters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "pollId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PollVotersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/webhooks": {
+            "get": {
+                "description": "Lists the trip's webhook subscriptions (trip admins only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List trip webhooks",
+                "operationId": "listTripWebhooks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripWebhooksResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Subscribes a URL to the trip's realtime events (trip admins only). Deliveries are JSON events signed in the X-Toggo-Signature header as \"sha256=\" plus the hex HMAC-SHA256 of the body. The URL must not point at a local or private address, and redirects are not followed. The secret is only returned here.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create trip webhook",
+                "operationId": "createTripWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateTripWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatedTripWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/webhooks/{webhookID}": {
+            "delete": {
+                "description": "Removes a webhook subscription and its delivery log (trip admins only)",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete trip webhook",
+                "operationId": "deleteTripWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Changes a webhook's URL, topics or enabled state (trip admins only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Update trip webhook",
+                "operationId": "updateTripWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateTripWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripWebhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/webhooks/{webhookID}/deliveries": {
+            "get": {
+                "description": "Returns the webhook's most recent deliveries, newest first (trip admins only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "operationId": "listTripWebhookDeliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripWebhookDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/webhooks/{webhookID}/ping": {
+            "post": {
+                "description": "Sends a signed webhook.ping event immediately, without retries, and returns the logged delivery (trip admins only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Ping trip webhook",
+                "operationId": "pingTripWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripWebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trips/{tripID}/{entityType}/{entityID}/comments": {
+            "get": {
+                "description": "Retrieves paginated comments for a trip entity, leaving out hidden comments and comments from blocked users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Get comments",
+                "operationId": "getPaginatedComments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "tripID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entity type (activity, pitch, task)",
+                        "name": "entityType",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entityID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max items per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor returned in next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PaginatedCommentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users": {
+            "post": {
+                "description": "Creates a new user with the provided payload",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "operationId": "createUser",
+                "parameters": [
+                    {
+                        "description": "Create user request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me": {
+            "get": {
+                "description": "Retrieves the authenticated user (from JWT claims)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get current user",
+                "operationId": "getCurrentUser",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/blocks": {
+            "get": {
+                "description": "Returns the users the authenticated user has blocked, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List blocked users",
+                "operationId": "listBlockedUsers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BlockedUsersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Hides the user's comments and reactions from the authenticated user and stops them from sending direct invites. Blocking someone already blocked succeeds.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Block a user",
+                "operationId": "blockUser",
+                "parameters": [
+                    {
+                        "description": "User to block",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BlockUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/blocks/{userID}": {
+            "delete": {
+                "description": "Removes a block the authenticated user placed on another user",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unblock a user",
+                "operationId": "unblockUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blocked user ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/contacts/discover": {
+            "post": {
+                "description": "Matches SHA-256 hashes of the device address book's E.164 phone numbers against registered users. The hashes are unsalted, so they identify numbers rather than hide them; users who opted out of discovery are left out. Rate limited per user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Discover contacts",
+                "operationId": "discoverContacts",
+                "parameters": [
+                    {
+                        "description": "Up to 500 lowercase hex SHA-256 phone number hashes",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DiscoverContactsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DiscoverContactsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/devices": {
+            "get": {
+                "description": "Lists the push notification devices registered by the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "devices"
+                ],
+                "summary": "List my devices",
+                "operationId": "listDevices",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.UserDevice"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registers an Expo push token for the authenticated user. Re-registering an existing token refreshes its last seen time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "devices"
+                ],
+                "summary": "Register a device",
+                "operationId": "registerDevice",
+                "parameters": [
+                    {
+                        "description": "Register device request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterDeviceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserDevice"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes an Expo push token from the authenticated user's devices, e.g. on sign-out",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "devices"
+                ],
+                "summary": "Unregister a device",
+                "operationId": "unregisterDevice",
+                "parameters": [
+                    {
+                        "description": "Unregister device request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UnregisterDeviceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/email/verification": {
+            "post": {
+                "description": "Emails a six digit code to the authenticated user's address. The address is not used for notifications or lookups until it is verified. Codes expire after 30 minutes; sending again replaces the previous code. Rate limited per user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Send email verification code",
+                "operationId": "sendEmailVerification",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/email/verify": {
+            "post": {
+                "description": "Confirms the authenticated user's email address with the code sent to it. Rate limited per user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Verify email",
+                "operationId": "verifyEmail",
+                "parameters": [
+                    {
+                        "description": "Verification code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.VerifyEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/export": {
+            "get": {
+                "description": "Returns everything stored about the authenticated user as a JSON archive: profile, trip memberships, comments, poll votes and rankings, RSVPs, and pitches with links to their audio. Audio links expire like other download links. Rate limited per user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Export my data",
+                "operationId": "exportUserData",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserDataExport"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/inbox": {
+            "get": {
+                "description": "Returns the caller's activity feed merged across all of their trips, newest first. Pass trip_id one or more times to limit the inbox to specific trips.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inbox"
+                ],
+                "summary": "Get notification inbox",
+                "operationId": "getInbox",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Max items to return (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor returned in next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only return items with unread events",
+                        "name": "unread_only",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only include these trips",
+                        "name": "trip_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ActivityFeedPageResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid trip ID or cursor",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/inbox/read": {
+            "post": {
+                "description": "Marks every item in the caller's inbox as read. Provide trip_ids to only mark those trips.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inbox"
+                ],
+                "summary": "Mark inbox as read",
+                "operationId": "markInboxRead",
+                "parameters": [
+                    {
+                        "description": "Optional trip filter",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.MarkInboxReadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/inbox/unread-count": {
+            "get": {
+                "description": "Returns the total unread badge count across all of the caller's trips, with a per-trip breakdown.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inbox"
+                ],
+                "summary": "Get inbox unread count",
+                "operationId": "getInboxUnreadCount",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.InboxUnreadCountResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/notification-preferences": {
+            "get": {
+                "description": "Retrieves the notification preferences for the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification-preferences"
+                ],
+                "summary": "Get notification preferences",
+                "operationId": "getNotificationPreferences",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.NotificationPreferences"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates notification preferences for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification-preferences"
+                ],
+                "summary": "Create notification preferences",
+                "operationId": "createNotificationPreferences",
+                "parameters": [
+                    {
+                        "description": "Create notification preferences request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateNotificationPreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.NotificationPreferences"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes notification preferences for the authenticated user",
+                "tags": [
+                    "notification-preferences"
+                ],
+                "summary": "Delete notification preferences",
+                "operationId": "deleteNotificationPreferences",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Updates notification preferences for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification-preferences"
+                ],
+                "summary": "Update notification preferences",
+                "operationId": "updateNotificationPreferences",
+                "parameters": [
+                    {
+                        "description": "Update notification preferences request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateUserNotificationPreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.NotificationPreferences"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/notification-preferences/default": {
+            "post": {
+                "description": "Returns existing preferences or creates default preferences for the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification-preferences"
+                ],
+                "summary": "Get or create default notification preferences",
+                "operationId": "getOrCreateDefaultNotificationPreferences",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.NotificationPreferences"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/trip-invites": {
+            "get": {
+                "description": "Returns the direct invites waiting for the authenticated user, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List received trip invites",
+                "operationId": "listReceivedTripInvites",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripDirectInvitesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/trip-invites/{inviteID}/accept": {
+            "post": {
+                "description": "Accepts a direct invite sent to the authenticated user and joins the trip as a member",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Accept a trip invite",
+                "operationId": "acceptTripInvite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invite ID",
+                        "name": "inviteID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Membership"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/trip-invites/{inviteID}/decline": {
+            "post": {
+                "description": "Declines a direct invite sent to the authenticated user",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Decline a trip invite",
+                "operationId": "declineTripInvite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invite ID",
+                        "name": "inviteID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{userID}": {
+            "get": {
+                "description": "Retrieves a user by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get a user",
+                "operationId": "getUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the authenticated user's account. Trips they administer pass to another member and trips they were alone on are deleted. Their comments, pitches, activities and polls stay on their trips without an author; their votes, profile picture and pitch recordings are removed. Deleting an account that no longer exists succeeds.",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete a user",
+                "operationId": "deleteUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Updates an existing user by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update a user",
+                "operationId": "updateUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update user request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errs.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthcheck": {
+            "get": {
+                "description": "Returns OK if the server is running and database is healthy",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "example"
+                ],
+                "summary": "Healthcheck endpoint",
+                "operationId": "healthcheck",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/slack/interactions": {
+            "post": {
+                "description": "Receives Slack interaction callbacks, signed with the app's signing secret. Poll vote buttons cast the vote for the trip member whose email matches the Slack user.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "tags": [
+                    "slack"
+                ],
+                "summary": "Handle Slack interactions",
+                "operationId": "handleSlackInteraction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slack interaction payload (JSON)",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "errs.APIError": {
+            "type": "object",
+            "properties": {
+                "message": {},
+                "statusCode": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Activity": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DateRange"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "estimated_price": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location_lat": {
+                    "type": "number"
+                },
+                "location_lng": {
+                    "type": "number"
+                },
+                "location_name": {
+                    "type": "string"
+                },
+                "media_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proposed_by": {
+                    "type": "string"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "time_of_day": {
+                    "$ref": "#/definitions/models.ActivityTimeOfDay"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ActivityAPIResponse": {
+            "type": "object",
+            "properties": {
+                "category_names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "comment_previews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CommenterPreview"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DateRange"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "estimated_price": {
+                    "type": "number"
+                },
+                "going_count": {
+                    "type": "integer"
+                },
+                "going_users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActivityGoingUserResponse"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_ids": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActivityImageResponse"
+                    }
+                },
+                "location_lat": {
+                    "type": "number"
+                },
+                "location_lng": {
+                    "type": "number"
+                },
+                "location_name": {
+                    "type": "string"
+                },
+                "media_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proposed_by": {
+                    "type": "string"
+                },
+                "proposer_name": {
+                    "type": "string"
+                },
+                "proposer_picture_url": {
+                    "type": "string"
+                },
+                "proposer_username": {
+                    "type": "string"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "time_of_day": {
+                    "$ref": "#/definitions/models.ActivityTimeOfDay"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ActivityCategoriesPageResult": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ActivityCursorPageResult": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActivityAPIResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ActivityFeedActor": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ActivityFeedItem": {
+            "type": "object",
+            "properties": {
+                "actor_count": {
+                    "type": "integer"
+                },
+                "actors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActivityFeedActor"
+                    }
+                },
+                "data": {
+                    "type": "object"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "event_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_read": {
+                    "type": "boolean"
+                },
+                "latest_at": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "trip_name": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ActivityFeedPageResult": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActivityFeedItem"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ActivityGoingUserResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "profile_picture_url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ActivityImageResponse": {
+            "type": "object",
+            "properties": {
+                "image_id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ActivityRSVPAPIResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "profile_picture_url": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.RSVPStatus"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ActivityRSVPRequestPayload": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/models.RSVPStatus"
+                }
+            }
+        },
+        "models.ActivityRSVPsPageResult": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "rsvps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActivityRSVPAPIResponse"
+                    }
+                }
+            }
+        },
+        "models.ActivityTimeOfDay": {
+            "type": "string",
+            "enum": [
+                "morning",
+                "afternoon",
+                "evening"
+            ],
+            "x-enum-varnames": [
+                "ActivityTimeOfDayMorning",
+                "ActivityTimeOfDayAfternoon",
+                "ActivityTimeOfDayEvening"
+            ]
+        },
+        "models.AddCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Category added successfully"
+                }
+            }
+        },
+        "models.AddressComponent": {
+            "type": "object",
+            "properties": {
+                "long_name": {
+                    "type": "string"
+                },
+                "short_name": {
+                    "type": "string"
+                },
+                "types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.BlockUserRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BlockedUsersResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ContactUser"
+                    }
+                }
+            }
+        },
+        "models.Bounds": {
+            "type": "object",
+            "properties": {
+                "northeast": {
+                    "$ref": "#/definitions/models.LatLng"
+                },
+                "southwest": {
+                    "$ref": "#/definitions/models.LatLng"
+                }
+            }
+        },
+        "models.CastVoteRequest": {
+            "type": "object",
+            "properties": {
+                "option_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.CategoryAPIResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "is_default": {
+                    "type": "boolean"
+                },
+                "is_hidden": {
+                    "description": "only present for admins",
+                    "type": "boolean"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "view_type": {
+                    "$ref": "#/definitions/models.CategoryViewType"
+                }
+            }
+        },
+        "models.CategoryListResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CategoryAPIResponse"
+                    }
+                }
+            }
+        },
+        "models.CategoryTabOrder": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "position": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "models.CategoryViewType": {
+            "type": "string",
+            "enum": [
+                "activity",
+                "moodboard"
+            ],
+            "x-enum-varnames": [
+                "CategoryViewTypeActivity",
+                "CategoryViewTypeMoodboard"
+            ]
+        },
+        "models.Comment": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_type": {
+                    "$ref": "#/definitions/models.EntityType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CommentAPIResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_type": {
+                    "$ref": "#/definitions/models.EntityType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "profile_picture_url": {
+                    "description": "pointer since some users don't have their avatar set",
+                    "type": "string"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CommentReaction": {
+            "type": "object",
+            "properties": {
+                "comment_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "emoji": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CommentReactionSummary": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "emoji": {
+                    "type": "string"
+                },
+                "reacted_by_me": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.CommentReactionUser": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "profile_picture_url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CommentReactionUsersResponse": {
+            "type": "object",
+            "properties": {
+                "comment_id": {
+                    "type": "string"
+                },
+                "emoji": {
+                    "type": "string"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CommentReactionUser"
+                    }
+                }
+            }
+        },
+        "models.CommentReactionsSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "comment_id": {
+                    "type": "string"
+                },
+                "reactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CommentReactionSummary"
+                    }
+                }
+            }
+        },
+        "models.CommenterPreview": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "profile_picture_url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConfirmUploadRequest": {
+            "type": "object",
+            "required": [
+                "imageId"
+            ],
+            "properties": {
+                "imageId": {
+                    "type": "string"
+                },
+                "size": {
+                    "description": "Optional: if nil, confirm all sizes",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ImageSize"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.ConfirmUploadResponse": {
+            "type": "object",
+            "properties": {
+                "confirmed": {
+                    "type": "integer"
+                },
+                "imageId": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ContactMatch": {
+            "type": "object",
+            "properties": {
+                "phone_hash": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.ContactUser"
+                }
+            }
+        },
+        "models.ContactUser": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "profile_picture": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ContentReport": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_type": {
+                    "$ref": "#/definitions/models.EntityType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/models.ContentReportReason"
+                },
+                "reported_by": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "resolved_by": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.ContentReportStatus"
+                },
+                "trip_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ContentReportReason": {
+            "type": "string",
+            "enum": [
+                "spam",
+                "harassment",
+                "inappropriate",
+                "other"
+            ],
+            "x-enum-varnames": [
+                "ContentReportSpam",
+                "ContentReportHarassment",
+                "ContentReportInappropriate",
+                "ContentReportOther"
+            ]
+        },
+        "models.ContentReportStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "hidden",
+                "dismissed"
+            ],
+            "x-enum-varnames": [
+                "ContentReportPending",
+                "ContentReportHidden",
+                "ContentReportDismissed"
+            ]
+        },
+        "models.CreateActivityRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "category_names": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "dates": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "$ref": "#/definitions/models.DateRange"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "estimated_price": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "image_ids": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "location_lat": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "location_lng": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "location_name": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 1
+                },
+                "media_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "time_of_day": {
+                    "enum": [
+                        "morning",
+                        "afternoon",
+                        "evening"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ActivityTimeOfDay"
+                        }
+                    ]
+                },
+                "trip_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "label",
+                "name",
+                "trip_id"
+            ],
+            "properties": {
+                "icon": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "label": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "view_type": {
+                    "enum": [
+                        "activity",
+                        "moodboard"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CategoryViewType"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.CreateCommentReactionRequest": {
+            "type": "object",
+            "required": [
+                "emoji"
+            ],
+            "properties": {
+                "emoji": {
+                    "type": "string",
+                    "minLength": 1
+                }
+            }
+        },
+        "models.CreateCommentRequest": {
+            "type": "object",
+            "required": [
+                "content",
+                "entity_id",
+                "entity_type",
+                "trip_id"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_type": {
+                    "enum": [
+                        "activity",
+                        "pitch",
+                        "task"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EntityType"
+                        }
+                    ]
+                },
+                "trip_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreateContentReportRequest": {
+            "type": "object",
+            "required": [
+                "entity_id",
+                "entity_type",
+                "reason"
+            ],
+            "properties": {
+                "details": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "description": {
+                "entity_id": {
                     "type": "string"
                 },
-                "estimated_price": {
-                    "type": "number"
+                "entity_type": {
+                    "enum": [
+                        "comment",
+                        "pitch",
+                        "activity"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EntityType"
+                        }
+                    ]
                 },
-                "id": {
-                    "type": "string"
+                "reason": {
+                    "enum": [
+                        "spam",
+                        "harassment",
+                        "inappropriate",
+                        "other"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ContentReportReason"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.CreateMembershipRequest": {
+            "type": "object",
+            "required": [
+                "budget_max",
+                "budget_min",
+                "trip_id",
+                "user_id"
+            ],
+            "properties": {
+                "budget_max": {
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "location_lat": {
-                    "type": "number"
+                "budget_min": {
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "location_lng": {
-                    "type": "number"
+                "is_admin": {
+                    "type": "boolean"
                 },
-                "location_name": {
-                    "type": "string"
+                "role": {
+                    "description": "Role defaults to organiser when IsAdmin is set and member otherwise.",
+                    "enum": [
+                        "organiser",
+                        "member",
+                        "viewer"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TripRole"
+                        }
+                    ]
                 },
-                "media_url": {
+                "trip_id": {
                     "type": "string"
                 },
-                "name": {
+                "user_id": {
                     "type": "string"
+                }
+            }
+        },
+        "models.CreateNotificationPreferencesRequest": {
+            "type": "object",
+            "properties": {
+                "deadline_reminders": {
+                    "type": "boolean"
                 },
-                "proposed_by": {
-                    "type": "string"
+                "digest_enabled": {
+                    "type": "boolean"
                 },
-                "thumbnail_url": {
+                "digest_time": {
                     "type": "string"
                 },
-                "time_of_day": {
-                    "$ref": "#/definitions/models.ActivityTimeOfDay"
+                "email_enabled": {
+                    "type": "boolean"
                 },
-                "trip_id": {
+                "finalized_decisions": {
+                    "type": "boolean"
+                },
+                "push_enabled": {
+                    "type": "boolean"
+                },
+                "quiet_hours_enabled": {
+                    "type": "boolean"
+                },
+                "quiet_hours_end": {
                     "type": "string"
                 },
-                "updated_at": {
+                "quiet_hours_start": {
                     "type": "string"
+                },
+                "sms_enabled": {
+                    "type": "boolean"
+                },
+                "trip_activity": {
+                    "type": "boolean"
+                },
+                "upcoming_trip": {
+                    "type": "boolean"
+                },
+                "voting_reminders": {
+                    "type": "boolean"
                 }
             }
         },
-        "models.ActivityAPIResponse": {
+        "models.CreatePitchRequest": {
             "type": "object",
+            "required": [
+                "content_length",
+                "content_type",
+                "title"
+            ],
             "properties": {
-                "category_names": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "content_length": {
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "comment_count": {
-                    "type": "integer"
+                "content_type": {
+                    "type": "string",
+                    "minLength": 1
                 },
-                "comment_previews": {
+                "description": {
+                    "type": "string"
+                },
+                "image_ids": {
                     "type": "array",
+                    "maxItems": 5,
                     "items": {
-                        "$ref": "#/definitions/models.CommenterPreview"
+                        "type": "string"
                     }
                 },
-                "created_at": {
+                "title": {
+                    "type": "string",
+                    "minLength": 1
+                }
+            }
+        },
+        "models.CreatePitchResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
                     "type": "string"
                 },
-                "dates": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.DateRange"
-                    }
+                "pitch": {
+                    "$ref": "#/definitions/models.PitchAPIResponse"
                 },
-                "description": {
+                "upload_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreatePollOptionRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "option_type"
+            ],
+            "properties": {
+                "entity_id": {
                     "type": "string"
                 },
-                "estimated_price": {
-                    "type": "number"
+                "entity_type": {
+                    "type": "string"
                 },
-                "going_count": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "going_users": {
+                "option_type": {
+                    "enum": [
+                        "entity",
+                        "custom"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.OptionType"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.CreatePollRequest": {
+            "type": "object",
+            "required": [
+                "poll_type",
+                "question"
+            ],
+            "properties": {
+                "categories": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.ActivityGoingUserResponse"
+                        "type": "string"
                     }
                 },
-                "id": {
+                "deadline": {
                     "type": "string"
                 },
-                "image_ids": {
+                "is_anonymous": {
+                    "type": "boolean"
+                },
+                "options": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.ActivityImageResponse"
+                        "$ref": "#/definitions/models.CreatePollOptionRequest"
                     }
                 },
-                "location_lat": {
-                    "type": "number"
-                },
-                "location_lng": {
-                    "type": "number"
+                "poll_type": {
+                    "enum": [
+                        "single",
+                        "multi",
+                        "rank"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PollType"
+                        }
+                    ]
                 },
-                "location_name": {
+                "question": {
                     "type": "string"
                 },
-                "media_url": {
+                "should_notify_members": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.CreateTripDirectInviteRequest": {
+            "type": "object",
+            "properties": {
+                "phone_number": {
                     "type": "string"
                 },
-                "name": {
+                "username": {
                     "type": "string"
+                }
+            }
+        },
+        "models.CreateTripFromTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "minLength": 1
                 },
-                "proposed_by": {
+                "start_date": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "models.CreateTripInviteRequest": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
                     "type": "string"
                 },
-                "proposer_name": {
-                    "type": "string"
+                "max_uses": {
+                    "description": "MaxUses limits how many people can use the invite; omit for unlimited.",
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 1
+                },
+                "requires_approval": {
+                    "description": "RequiresApproval turns joins into requests that a trip admin approves.",
+                    "type": "boolean"
+                },
+                "single_use": {
+                    "description": "SingleUse is shorthand for max_uses 1.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.CreateTripRequest": {
+            "type": "object",
+            "required": [
+                "budget_max",
+                "budget_min",
+                "name"
+            ],
+            "properties": {
+                "budget_max": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "budget_min": {
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "proposer_picture_url": {
+                "cover_image_id": {
                     "type": "string"
                 },
-                "proposer_username": {
+                "currency": {
                     "type": "string"
                 },
-                "thumbnail_url": {
-                    "type": "string"
+                "end_date": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "time_of_day": {
-                    "$ref": "#/definitions/models.ActivityTimeOfDay"
+                "name": {
+                    "type": "string",
+                    "minLength": 1
                 },
-                "trip_id": {
+                "pitch_deadline": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string"
+                "start_date": {
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
-        "models.ActivityCategoriesPageResult": {
+        "models.CreateTripTaskRequest": {
             "type": "object",
+            "required": [
+                "title"
+            ],
             "properties": {
-                "categories": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "assignee_ids": {
                     "type": "array",
+                    "maxItems": 20,
                     "items": {
                         "type": "string"
                     }
                 },
-                "limit": {
-                    "type": "integer"
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
                 },
-                "next_cursor": {
+                "due_at": {
                     "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
                 }
             }
         },
-        "models.ActivityCursorPageResult": {
+        "models.CreateTripTemplateRequest": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ActivityAPIResponse"
-                    }
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
                 },
-                "limit": {
-                    "type": "integer"
+                "is_public": {
+                    "type": "boolean"
                 },
-                "next_cursor": {
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 }
             }
         },
-        "models.ActivityGoingUserResponse": {
+        "models.CreateTripWebhookRequest": {
             "type": "object",
+            "required": [
+                "topics",
+                "url"
+            ],
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "profile_picture_url": {
-                    "type": "string"
+                "secret": {
+                    "description": "Secret is generated when omitted.",
+                    "type": "string",
+                    "maxLength": 256,
+                    "minLength": 16
                 },
-                "user_id": {
-                    "type": "string"
+                "topics": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "username": {
-                    "type": "string"
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048
                 }
             }
         },
-        "models.ActivityImageResponse": {
+        "models.CreateUserRequest": {
             "type": "object",
+            "required": [
+                "name",
+                "phone_number",
+                "username"
+            ],
             "properties": {
-                "image_id": {
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "phone_number": {
                     "type": "string"
                 },
-                "image_url": {
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "models.ActivityRSVPAPIResponse": {
+        "models.CreatedTripWebhookResponse": {
             "type": "object",
             "properties": {
-                "activity_id": {
+                "created_at": {
                     "type": "string"
                 },
-                "created_at": {
+                "created_by": {
                     "type": "string"
                 },
-                "name": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
                     "type": "string"
                 },
-                "profile_picture_url": {
+                "secret": {
                     "type": "string"
                 },
-                "status": {
-                    "$ref": "#/definitions/models.RSVPStatus"
+                "topics": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "updated_at": {
+                "trip_id": {
                     "type": "string"
                 },
-                "user_id": {
+                "updated_at": {
                     "type": "string"
                 },
-                "username": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "models.ActivityRSVPRequestPayload": {
+        "models.DateRange": {
             "type": "object",
             "properties": {
-                "status": {
-                    "$ref": "#/definitions/models.RSVPStatus"
+                "end": {
+                    "description": "ISO 8601 date format (YYYY-MM-DD)",
+                    "type": "string",
+                    "format": "date",
+                    "example": "2024-01-05"
+                },
+                "start": {
+                    "description": "ISO 8601 date format (YYYY-MM-DD)",
+                    "type": "string",
+                    "format": "date",
+                    "example": "2024-01-01"
                 }
             }
         },
-        "models.ActivityRSVPsPageResult": {
+        "models.DayTime": {
             "type": "object",
             "properties": {
-                "limit": {
+                "day": {
                     "type": "integer"
                 },
-                "next_cursor": {
+                "time": {
                     "type": "string"
-                },
-                "rsvps": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ActivityRSVPAPIResponse"
-                    }
                 }
             }
         },
-        "models.ActivityTimeOfDay": {
-            "type": "string",
-            "enum": [
-                "morning",
-                "afternoon",
-                "evening"
-            ],
-            "x-enum-varnames": [
-                "ActivityTimeOfDayMorning",
-                "ActivityTimeOfDayAfternoon",
-                "ActivityTimeOfDayEvening"
-            ]
-        },
-        "models.AddCategoryResponse": {
+        "models.DeleteCommentReactionRequest": {
             "type": "object",
+            "required": [
+                "emoji"
+            ],
             "properties": {
-                "message": {
+                "emoji": {
                     "type": "string",
-                    "example": "Category added successfully"
+                    "minLength": 1
                 }
             }
         },
-        "models.AddressComponent": {
+        "models.DeletedTripAPIResponse": {
             "type": "object",
             "properties": {
-                "long_name": {
+                "deleted_at": {
                     "type": "string"
                 },
-                "short_name": {
+                "id": {
                     "type": "string"
                 },
-                "types": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                }
-            }
-        },
-        "models.Bounds": {
-            "type": "object",
-            "properties": {
-                "northeast": {
-                    "$ref": "#/definitions/models.LatLng"
+                "name": {
+                    "type": "string"
                 },
-                "southwest": {
-                    "$ref": "#/definitions/models.LatLng"
+                "purge_at": {
+                    "type": "string"
                 }
             }
         },
-        "models.CastVoteRequest": {
+        "models.DeletedTripsResponse": {
             "type": "object",
             "properties": {
-                "option_ids": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.DeletedTripAPIResponse"
                     }
                 }
             }
         },
-        "models.CategoryAPIResponse": {
+        "models.DepartedContentPolicy": {
+            "type": "string",
+            "enum": [
+                "reassign",
+                "anonymise"
+            ],
+            "x-enum-varnames": [
+                "DepartedContentReassign",
+                "DepartedContentAnonymise"
+            ]
+        },
+        "models.DevicePlatform": {
+            "type": "string",
+            "enum": [
+                "ios",
+                "android",
+                "web",
+                "unknown"
+            ],
+            "x-enum-varnames": [
+                "DevicePlatformIOS",
+                "DevicePlatformAndroid",
+                "DevicePlatformWeb",
+                "DevicePlatformUnknown"
+            ]
+        },
+        "models.DiscoverContactsRequest": {
             "type": "object",
+            "required": [
+                "phone_hashes"
+            ],
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "icon": {
-                    "type": "string"
-                },
-                "is_default": {
-                    "type": "boolean"
-                },
-                "is_hidden": {
-                    "description": "only present for admins",
-                    "type": "boolean"
-                },
-                "label": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "position": {
-                    "type": "integer"
-                },
-                "trip_id": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
-                },
-                "view_type": {
-                    "$ref": "#/definitions/models.CategoryViewType"
+                "phone_hashes": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "models.CategoryListResponse": {
+        "models.DiscoverContactsResponse": {
             "type": "object",
             "properties": {
-                "categories": {
+                "matches": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CategoryAPIResponse"
+                        "$ref": "#/definitions/models.ContactMatch"
                     }
                 }
             }
         },
-        "models.CategoryTabOrder": {
+        "models.DuplicateTripRequest": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
                 "name": {
                     "type": "string",
                     "minLength": 1
                 },
-                "position": {
-                    "type": "integer",
-                    "minimum": 0
+                "start_date": {
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
-        "models.CategoryViewType": {
+        "models.EntityType": {
             "type": "string",
             "enum": [
                 "activity",
-                "moodboard"
+                "pitch",
+                "comment",
+                "task"
             ],
             "x-enum-varnames": [
-                "CategoryViewTypeActivity",
-                "CategoryViewTypeMoodboard"
+                "ActivityEntity",
+                "PitchEntity",
+                "CommentEntity",
+                "TaskEntity"
             ]
         },
-        "models.Comment": {
+        "models.ExportedMembership": {
             "type": "object",
             "properties": {
-                "content": {
+                "availability": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "budget_max": {
+                    "type": "integer"
+                },
+                "budget_min": {
+                    "type": "integer"
+                },
+                "joined_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/models.TripRole"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "trip_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ExportedPitch": {
+            "type": "object",
+            "properties": {
+                "audio_url": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "entity_id": {
+                "description": {
                     "type": "string"
                 },
-                "entity_type": {
-                    "$ref": "#/definitions/models.EntityType"
+                "duration": {
+                    "type": "integer"
                 },
                 "id": {
                     "type": "string"
                 },
+                "title": {
+                    "type": "string"
+                },
                 "trip_id": {
                     "type": "string"
+                }
+            }
+        },
+        "models.ExportedPollRanking": {
+            "type": "object",
+            "properties": {
+                "option_id": {
+                    "type": "string"
                 },
-                "updated_at": {
+                "option_name": {
                     "type": "string"
                 },
-                "user_id": {
+                "poll_id": {
+                    "type": "string"
+                },
+                "question": {
+                    "type": "string"
+                },
+                "rank_position": {
+                    "type": "integer"
+                },
+                "trip_id": {
                     "type": "string"
                 }
             }
         },
-        "models.CommentAPIResponse": {
+        "models.ExportedPollVote": {
             "type": "object",
             "properties": {
-                "content": {
+                "created_at": {
                     "type": "string"
                 },
-                "created_at": {
+                "option_id": {
                     "type": "string"
                 },
-                "entity_id": {
+                "option_name": {
                     "type": "string"
                 },
-                "entity_type": {
-                    "$ref": "#/definitions/models.EntityType"
+                "poll_id": {
+                    "type": "string"
                 },
-                "id": {
+                "question": {
                     "type": "string"
                 },
-                "name": {
+                "trip_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ExportedRSVP": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
                     "type": "string"
                 },
-                "profile_picture_url": {
-                    "description": "pointer since some users don't have their avatar set",
+                "activity_name": {
                     "type": "string"
                 },
+                "status": {
+                    "$ref": "#/definitions/models.RSVPStatus"
+                },
                 "trip_id": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                }
+            }
+        },
+        "models.GetFileAllSizesResponse": {
+            "type": "object",
+            "properties": {
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GetFileResponse"
+                    }
                 },
-                "user_id": {
-                    "type": "string"
-                },
-                "username": {
+                "imageId": {
                     "type": "string"
                 }
             }
         },
-        "models.CommentReaction": {
+        "models.GetFileResponse": {
             "type": "object",
             "properties": {
-                "comment_id": {
+                "contentType": {
                     "type": "string"
                 },
-                "created_at": {
+                "imageId": {
                     "type": "string"
                 },
-                "emoji": {
-                    "type": "string"
+                "size": {
+                    "$ref": "#/definitions/models.ImageSize"
                 },
-                "id": {
+                "url": {
                     "type": "string"
-                },
-                "user_id": {
+                }
+            }
+        },
+        "models.ImageSize": {
+            "type": "string",
+            "enum": [
+                "large",
+                "medium",
+                "small"
+            ],
+            "x-enum-varnames": [
+                "ImageSizeLarge",
+                "ImageSizeMedium",
+                "ImageSizeSmall"
+            ]
+        },
+        "models.InboxTripUnreadCount": {
+            "type": "object",
+            "properties": {
+                "trip_id": {
                     "type": "string"
+                },
+                "unread_count": {
+                    "type": "integer"
                 }
             }
         },
-        "models.CommentReactionSummary": {
+        "models.InboxUnreadCountResponse": {
             "type": "object",
             "properties": {
-                "count": {
+                "trips": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.InboxTripUnreadCount"
+                    }
+                },
+                "unread_count": {
                     "type": "integer"
+                }
+            }
+        },
+        "models.LatLng": {
+            "type": "object",
+            "properties": {
+                "lat": {
+                    "type": "number"
                 },
-                "emoji": {
+                "lng": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.LeaveTripRequest": {
+            "type": "object",
+            "properties": {
+                "successor_id": {
                     "type": "string"
+                }
+            }
+        },
+        "models.LinkSlackChannelRequest": {
+            "type": "object",
+            "required": [
+                "channel_id"
+            ],
+            "properties": {
+                "channel_id": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "models.LinkType": {
+            "type": "string",
+            "enum": [
+                "airbnb",
+                "booking_com",
+                "tiktok",
+                "instagram",
+                "generic"
+            ],
+            "x-enum-varnames": [
+                "LinkTypeAirbnb",
+                "LinkTypeBookingCom",
+                "LinkTypeTikTok",
+                "LinkTypeInstagram",
+                "LinkTypeGeneric"
+            ]
+        },
+        "models.MarkInboxReadRequest": {
+            "type": "object",
+            "properties": {
+                "trip_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.MatchedSubstring": {
+            "type": "object",
+            "properties": {
+                "length": {
+                    "type": "integer"
                 },
-                "reacted_by_me": {
-                    "type": "boolean"
+                "offset": {
+                    "type": "integer"
                 }
             }
         },
-        "models.CommentReactionUser": {
+        "models.Membership": {
             "type": "object",
             "properties": {
-                "name": {
+                "availability": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "budget_max": {
+                    "type": "integer"
+                },
+                "budget_min": {
+                    "type": "integer"
+                },
+                "created_at": {
                     "type": "string"
                 },
-                "profile_picture_url": {
+                "is_admin": {
+                    "description": "IsAdmin is generated from Role by the database.",
+                    "type": "boolean"
+                },
+                "notify_new_comments": {
+                    "type": "boolean"
+                },
+                "notify_new_pitches": {
+                    "type": "boolean"
+                },
+                "notify_new_polls": {
+                    "type": "boolean"
+                },
+                "role": {
+                    "$ref": "#/definitions/models.TripRole"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 },
                 "user_id": {
                     "type": "string"
-                },
-                "username": {
-                    "type": "string"
                 }
             }
         },
-        "models.CommentReactionUsersResponse": {
+        "models.MembershipAPIResponse": {
             "type": "object",
             "properties": {
-                "comment_id": {
-                    "type": "string"
+                "availability": {
+                    "type": "object",
+                    "additionalProperties": true
                 },
-                "emoji": {
-                    "type": "string"
+                "budget_max": {
+                    "type": "integer"
                 },
-                "users": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.CommentReactionUser"
-                    }
-                }
-            }
-        },
-        "models.CommentReactionsSummaryResponse": {
-            "type": "object",
-            "properties": {
-                "comment_id": {
+                "budget_min": {
+                    "type": "integer"
+                },
+                "created_at": {
                     "type": "string"
                 },
-                "reactions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.CommentReactionSummary"
-                    }
-                }
-            }
-        },
-        "models.CommenterPreview": {
-            "type": "object",
-            "properties": {
+                "is_admin": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
+                "notify_new_comments": {
+                    "type": "boolean"
+                },
+                "notify_new_pitches": {
+                    "type": "boolean"
+                },
+                "notify_new_polls": {
+                    "type": "boolean"
+                },
                 "profile_picture_url": {
                     "type": "string"
                 },
+                "role": {
+                    "$ref": "#/definitions/models.TripRole"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "string"
                 },
@@ -6614,219 +11793,91 @@ const docTemplate = `{
                 }
             }
         },
-        "models.ConfirmUploadRequest": {
+        "models.MembershipCursorPageResult": {
             "type": "object",
-            "required": [
-                "imageId"
-            ],
             "properties": {
-                "imageId": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MembershipAPIResponse"
+                    }
                 },
-                "size": {
-                    "description": "Optional: if nil, confirm all sizes",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.ImageSize"
-                        }
-                    ]
-                }
-            }
-        },
-        "models.ConfirmUploadResponse": {
-            "type": "object",
-            "properties": {
-                "confirmed": {
+                "limit": {
                     "type": "integer"
                 },
-                "imageId": {
-                    "type": "string"
-                },
-                "status": {
+                "next_cursor": {
                     "type": "string"
                 }
             }
         },
-        "models.CreateActivityRequest": {
+        "models.ModerationQueueItem": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "category_names": {
-                    "type": "array",
-                    "maxItems": 10,
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "dates": {
-                    "type": "array",
-                    "maxItems": 20,
-                    "items": {
-                        "$ref": "#/definitions/models.DateRange"
-                    }
+                "author_id": {
+                    "type": "string"
                 },
-                "description": {
+                "author_name": {
                     "type": "string"
                 },
-                "estimated_price": {
-                    "type": "number",
-                    "minimum": 0
+                "created_at": {
+                    "type": "string"
                 },
-                "image_ids": {
-                    "type": "array",
-                    "maxItems": 5,
-                    "items": {
-                        "type": "string"
-                    }
+                "details": {
+                    "type": "string"
                 },
-                "location_lat": {
-                    "type": "number",
-                    "maximum": 90,
-                    "minimum": -90
+                "entity_id": {
+                    "type": "string"
                 },
-                "location_lng": {
-                    "type": "number",
-                    "maximum": 180,
-                    "minimum": -180
+                "entity_type": {
+                    "$ref": "#/definitions/models.EntityType"
                 },
-                "location_name": {
-                    "type": "string",
-                    "maxLength": 500,
-                    "minLength": 1
+                "id": {
+                    "type": "string"
                 },
-                "media_url": {
+                "preview": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "reason": {
+                    "$ref": "#/definitions/models.ContentReportReason"
                 },
-                "thumbnail_url": {
+                "reported_by": {
                     "type": "string"
                 },
-                "time_of_day": {
-                    "enum": [
-                        "morning",
-                        "afternoon",
-                        "evening"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.ActivityTimeOfDay"
-                        }
-                    ]
+                "reporter_name": {
+                    "type": "string"
                 },
-                "trip_id": {
+                "resolved_at": {
                     "type": "string"
-                }
-            }
-        },
-        "models.CreateCategoryRequest": {
-            "type": "object",
-            "required": [
-                "label",
-                "name",
-                "trip_id"
-            ],
-            "properties": {
-                "icon": {
-                    "type": "string",
-                    "maxLength": 255
                 },
-                "label": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "resolved_by": {
+                    "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "status": {
+                    "$ref": "#/definitions/models.ContentReportStatus"
                 },
                 "trip_id": {
                     "type": "string"
-                },
-                "view_type": {
-                    "enum": [
-                        "activity",
-                        "moodboard"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.CategoryViewType"
-                        }
-                    ]
-                }
-            }
-        },
-        "models.CreateCommentReactionRequest": {
-            "type": "object",
-            "required": [
-                "emoji"
-            ],
-            "properties": {
-                "emoji": {
-                    "type": "string",
-                    "minLength": 1
                 }
             }
         },
-        "models.CreateCommentRequest": {
+        "models.ModerationQueueResponse": {
             "type": "object",
-            "required": [
-                "content",
-                "entity_id",
-                "entity_type",
-                "trip_id"
-            ],
             "properties": {
-                "content": {
-                    "type": "string",
-                    "minLength": 1
-                },
-                "entity_id": {
-                    "type": "string"
-                },
-                "entity_type": {
-                    "enum": [
-                        "activity",
-                        "pitch"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.EntityType"
-                        }
-                    ]
-                },
-                "trip_id": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ModerationQueueItem"
+                    }
                 }
             }
         },
-        "models.CreateMembershipRequest": {
-            "type": "object",
-            "required": [
-                "budget_max",
-                "budget_min",
-                "trip_id",
-                "user_id"
-            ],
-            "properties": {
-                "budget_max": {
-                    "type": "integer",
-                    "minimum": 0
-                },
-                "budget_min": {
-                    "type": "integer",
-                    "minimum": 0
-                },
-                "is_admin": {
-                    "type": "boolean"
+        "models.NotificationError": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
                 },
-                "trip_id": {
+                "token": {
                     "type": "string"
                 },
                 "user_id": {
@@ -6834,82 +11885,140 @@ const docTemplate = `{
                 }
             }
         },
-        "models.CreateNotificationPreferencesRequest": {
+        "models.NotificationPreferences": {
             "type": "object",
             "properties": {
+                "created_at": {
+                    "type": "string"
+                },
                 "deadline_reminders": {
                     "type": "boolean"
                 },
+                "digest_enabled": {
+                    "type": "boolean"
+                },
+                "digest_time": {
+                    "type": "string"
+                },
+                "email_enabled": {
+                    "type": "boolean"
+                },
                 "finalized_decisions": {
                     "type": "boolean"
                 },
                 "push_enabled": {
                     "type": "boolean"
                 },
+                "quiet_hours_enabled": {
+                    "description": "Quiet hours and digest times are \"HH:MM\" in the user's timezone.",
+                    "type": "boolean"
+                },
+                "quiet_hours_end": {
+                    "type": "string"
+                },
+                "quiet_hours_start": {
+                    "type": "string"
+                },
+                "sms_enabled": {
+                    "type": "boolean"
+                },
                 "trip_activity": {
                     "type": "boolean"
                 },
                 "upcoming_trip": {
                     "type": "boolean"
                 },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
                 "voting_reminders": {
                     "type": "boolean"
                 }
             }
         },
-        "models.CreatePitchRequest": {
+        "models.NotificationResponse": {
             "type": "object",
-            "required": [
-                "content_length",
-                "content_type",
-                "title"
-            ],
             "properties": {
-                "content_length": {
-                    "type": "integer",
-                    "minimum": 1
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NotificationError"
+                    }
                 },
-                "content_type": {
-                    "type": "string",
-                    "minLength": 1
+                "failure_count": {
+                    "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "success_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.OpeningHours": {
+            "type": "object",
+            "properties": {
+                "open_now": {
+                    "type": "boolean"
                 },
-                "image_ids": {
+                "periods": {
                     "type": "array",
-                    "maxItems": 5,
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.Period"
                     }
                 },
-                "title": {
-                    "type": "string",
-                    "minLength": 1
+                "weekday_text": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "models.CreatePitchResponse": {
+        "models.OptionType": {
+            "type": "string",
+            "enum": [
+                "entity",
+                "custom"
+            ],
+            "x-enum-varnames": [
+                "OptionTypeEntity",
+                "OptionTypeCustom"
+            ]
+        },
+        "models.OptionVotersResponse": {
             "type": "object",
             "properties": {
-                "expires_at": {
+                "option_id": {
                     "type": "string"
                 },
-                "pitch": {
-                    "$ref": "#/definitions/models.PitchAPIResponse"
+                "option_name": {
+                    "type": "string"
                 },
-                "upload_url": {
+                "poll_id": {
                     "type": "string"
+                },
+                "total_voters": {
+                    "type": "integer"
+                },
+                "voters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.VoterInfo"
+                    }
                 }
             }
         },
-        "models.CreatePollOptionRequest": {
+        "models.OptionWithScore": {
             "type": "object",
-            "required": [
-                "name",
-                "option_type"
-            ],
             "properties": {
+                "average_rank": {
+                    "type": "number"
+                },
+                "borda_score": {
+                    "type": "integer"
+                },
                 "entity_id": {
                     "type": "string"
                 },
@@ -6919,124 +12028,146 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "option_id": {
+                    "type": "string"
+                },
                 "option_type": {
-                    "enum": [
-                        "entity",
-                        "custom"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.OptionType"
-                        }
-                    ]
+                    "$ref": "#/definitions/models.OptionType"
+                },
+                "rank_breakdown": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "vote_count": {
+                    "type": "integer"
                 }
             }
         },
-        "models.CreatePollRequest": {
+        "models.PaginatedCommentsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CommentAPIResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ParseLinkRequest": {
             "type": "object",
             "required": [
-                "poll_type",
-                "question"
+                "url"
             ],
             "properties": {
-                "categories": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ParsedActivityData": {
+            "type": "object",
+            "properties": {
+                "category_suggestions": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "deadline": {
+                "description": {
                     "type": "string"
                 },
-                "is_anonymous": {
-                    "type": "boolean"
+                "media_url": {
+                    "type": "string"
                 },
-                "options": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.CreatePollOptionRequest"
-                    }
+                "name": {
+                    "type": "string"
                 },
-                "poll_type": {
-                    "enum": [
-                        "single",
-                        "multi",
-                        "rank"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.PollType"
-                        }
-                    ]
+                "source_type": {
+                    "$ref": "#/definitions/models.LinkType"
                 },
-                "question": {
+                "source_url": {
                     "type": "string"
                 },
-                "should_notify_members": {
-                    "type": "boolean"
+                "thumbnail_url": {
+                    "type": "string"
                 }
             }
         },
-        "models.CreateTripInviteRequest": {
+        "models.Period": {
             "type": "object",
             "properties": {
-                "expires_at": {
-                    "type": "string"
+                "close": {
+                    "$ref": "#/definitions/models.DayTime"
+                },
+                "open": {
+                    "$ref": "#/definitions/models.DayTime"
                 }
             }
         },
-        "models.CreateTripRequest": {
+        "models.PitchAPIResponse": {
             "type": "object",
-            "required": [
-                "budget_max",
-                "budget_min",
-                "name"
-            ],
             "properties": {
-                "budget_max": {
-                    "type": "integer",
-                    "minimum": 0
+                "audio_url": {
+                    "type": "string"
                 },
-                "budget_min": {
-                    "type": "integer",
-                    "minimum": 0
+                "comment_count": {
+                    "type": "integer"
+                },
+                "comment_previews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CommenterPreview"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PitchImageInfo"
+                    }
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PitchLink"
+                    }
                 },
-                "cover_image_id": {
+                "name": {
                     "type": "string"
                 },
-                "currency": {
+                "profile_picture_url": {
                     "type": "string"
                 },
-                "end_date": {
-                    "type": "string",
-                    "format": "date-time"
-                },
-                "name": {
-                    "type": "string",
-                    "minLength": 1
+                "title": {
+                    "type": "string"
                 },
-                "pitch_deadline": {
+                "trip_id": {
                     "type": "string"
                 },
-                "start_date": {
-                    "type": "string",
-                    "format": "date-time"
-                }
-            }
-        },
-        "models.CreateUserRequest": {
-            "type": "object",
-            "required": [
-                "name",
-                "phone_number",
-                "username"
-            ],
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "minLength": 1
+                "updated_at": {
+                    "type": "string"
                 },
-                "phone_number": {
+                "user_id": {
                     "type": "string"
                 },
                 "username": {
@@ -7044,234 +12175,307 @@ const docTemplate = `{
                 }
             }
         },
-        "models.DateRange": {
+        "models.PitchCursorPageResult": {
             "type": "object",
             "properties": {
-                "end": {
-                    "description": "ISO 8601 date format (YYYY-MM-DD)",
-                    "type": "string",
-                    "format": "date",
-                    "example": "2024-01-05"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PitchAPIResponse"
+                    }
                 },
-                "start": {
-                    "description": "ISO 8601 date format (YYYY-MM-DD)",
-                    "type": "string",
-                    "format": "date",
-                    "example": "2024-01-01"
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
                 }
             }
         },
-        "models.DayTime": {
+        "models.PitchImageInfo": {
             "type": "object",
             "properties": {
-                "day": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
-                "time": {
+                "medium_url": {
                     "type": "string"
                 }
             }
         },
-        "models.DeleteCommentReactionRequest": {
+        "models.PitchLink": {
             "type": "object",
-            "required": [
-                "emoji"
-            ],
             "properties": {
-                "emoji": {
-                    "type": "string",
-                    "minLength": 1
+                "added_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pitch_id": {
+                    "type": "string"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "models.EntityType": {
-            "type": "string",
-            "enum": [
-                "activity",
-                "pitch"
-            ],
-            "x-enum-varnames": [
-                "ActivityEntity",
-                "PitchEntity"
-            ]
-        },
-        "models.GetFileAllSizesResponse": {
+        "models.PlaceDetailsRequest": {
             "type": "object",
             "properties": {
-                "files": {
+                "fields": {
+                    "description": "Fields specifies which fields to return (optional)\nIf not specified, returns all available fields",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.GetFileResponse"
+                        "type": "string"
                     }
                 },
-                "imageId": {
+                "input": {
+                    "description": "Input is the text to search for if PlaceID is not provided\nExample: \"Eiffel Tower\", \"Paris, France\"",
+                    "type": "string"
+                },
+                "language": {
+                    "description": "Language is the language code for the results (optional)",
+                    "type": "string"
+                },
+                "place_id": {
+                    "description": "PlaceID is the unique identifier for the place (from typeahead results)",
                     "type": "string"
                 }
             }
         },
-        "models.GetFileResponse": {
+        "models.PlaceDetailsResponse": {
             "type": "object",
             "properties": {
-                "contentType": {
+                "address_components": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AddressComponent"
+                    }
+                },
+                "formatted_address": {
+                    "description": "Address information",
                     "type": "string"
                 },
-                "imageId": {
+                "formatted_phone_number": {
+                    "description": "Contact information",
                     "type": "string"
                 },
-                "size": {
-                    "$ref": "#/definitions/models.ImageSize"
+                "geometry": {
+                    "description": "Location coordinates",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PlaceGeometry"
+                        }
+                    ]
                 },
-                "url": {
+                "icon": {
+                    "type": "string"
+                },
+                "international_phone_number": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening_hours": {
+                    "description": "Business information",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.OpeningHours"
+                        }
+                    ]
+                },
+                "photos": {
+                    "description": "Photos",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlacePhoto"
+                    }
+                },
+                "place_id": {
+                    "type": "string"
+                },
+                "price_level": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "description": "Ratings and reviews",
+                    "type": "number"
+                },
+                "types": {
+                    "description": "Place types",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_ratings_total": {
+                    "type": "integer"
+                },
+                "utc_offset": {
+                    "description": "Additional information",
+                    "type": "integer"
+                },
+                "vicinity": {
+                    "type": "string"
+                },
+                "website": {
                     "type": "string"
                 }
             }
         },
-        "models.ImageSize": {
-            "type": "string",
-            "enum": [
-                "large",
-                "medium",
-                "small"
-            ],
-            "x-enum-varnames": [
-                "ImageSizeLarge",
-                "ImageSizeMedium",
-                "ImageSizeSmall"
-            ]
-        },
-        "models.LatLng": {
+        "models.PlaceGeometry": {
             "type": "object",
             "properties": {
-                "lat": {
-                    "type": "number"
+                "location": {
+                    "$ref": "#/definitions/models.LatLng"
                 },
-                "lng": {
-                    "type": "number"
+                "viewport": {
+                    "$ref": "#/definitions/models.Bounds"
                 }
             }
         },
-        "models.LinkType": {
-            "type": "string",
-            "enum": [
-                "airbnb",
-                "booking_com",
-                "tiktok",
-                "instagram",
-                "generic"
-            ],
-            "x-enum-varnames": [
-                "LinkTypeAirbnb",
-                "LinkTypeBookingCom",
-                "LinkTypeTikTok",
-                "LinkTypeInstagram",
-                "LinkTypeGeneric"
-            ]
-        },
-        "models.MatchedSubstring": {
+        "models.PlacePhoto": {
             "type": "object",
             "properties": {
-                "length": {
+                "height": {
                     "type": "integer"
                 },
-                "offset": {
+                "html_attributions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "photo_reference": {
+                    "type": "string"
+                },
+                "photo_url": {
+                    "description": "PhotoURL is a generated URL to fetch the photo (optional, generated by backend)",
+                    "type": "string"
+                },
+                "width": {
                     "type": "integer"
                 }
             }
         },
-        "models.Membership": {
+        "models.PlacePrediction": {
             "type": "object",
             "properties": {
-                "availability": {
-                    "type": "object",
-                    "additionalProperties": true
-                },
-                "budget_max": {
-                    "type": "integer"
-                },
-                "budget_min": {
-                    "type": "integer"
-                },
-                "created_at": {
+                "description": {
+                    "description": "Description is the human-readable name for this place",
                     "type": "string"
                 },
-                "is_admin": {
-                    "type": "boolean"
-                },
-                "notify_new_comments": {
-                    "type": "boolean"
-                },
-                "notify_new_pitches": {
-                    "type": "boolean"
+                "main_text": {
+                    "description": "StructuredFormatting contains the main text and secondary text",
+                    "type": "string"
                 },
-                "notify_new_polls": {
-                    "type": "boolean"
+                "matched_substrings": {
+                    "description": "MatchedSubstrings indicates which parts of the description match the input",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MatchedSubstring"
+                    }
                 },
-                "trip_id": {
+                "place_id": {
+                    "description": "PlaceID is the unique identifier for this place",
                     "type": "string"
                 },
-                "updated_at": {
+                "secondary_text": {
                     "type": "string"
                 },
-                "user_id": {
-                    "type": "string"
+                "types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "models.MembershipAPIResponse": {
+        "models.PlacesSearchResponse": {
             "type": "object",
             "properties": {
-                "availability": {
-                    "type": "object",
-                    "additionalProperties": true
-                },
-                "budget_max": {
-                    "type": "integer"
+                "predictions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlacePrediction"
+                    }
                 },
-                "budget_min": {
-                    "type": "integer"
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PollAPIResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "is_admin": {
-                    "type": "boolean"
+                "created_by": {
+                    "type": "string"
                 },
-                "name": {
+                "deadline": {
                     "type": "string"
                 },
-                "notify_new_comments": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
                 },
-                "notify_new_pitches": {
+                "is_anonymous": {
                     "type": "boolean"
                 },
-                "notify_new_polls": {
-                    "type": "boolean"
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PollOptionAPIResponse"
+                    }
                 },
-                "profile_picture_url": {
-                    "type": "string"
+                "poll_type": {
+                    "$ref": "#/definitions/models.PollType"
                 },
-                "trip_id": {
+                "question": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string"
+                "should_notify_members": {
+                    "type": "boolean"
                 },
-                "user_id": {
+                "trip_id": {
                     "type": "string"
                 },
-                "username": {
-                    "type": "string"
+                "voting_locked": {
+                    "type": "boolean"
                 }
             }
         },
-        "models.MembershipCursorPageResult": {
+        "models.PollCursorPageResult": {
             "type": "object",
             "properties": {
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.MembershipAPIResponse"
+                        "$ref": "#/definitions/models.PollAPIResponse"
                     }
                 },
                 "limit": {
@@ -7282,543 +12486,670 @@ const docTemplate = `{
                 }
             }
         },
-        "models.NotificationError": {
+        "models.PollOptionAPIResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "entity_id": {
                     "type": "string"
                 },
-                "token": {
+                "entity_type": {
                     "type": "string"
                 },
-                "user_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.NotificationPreferences": {
-            "type": "object",
-            "properties": {
-                "created_at": {
+                "id": {
                     "type": "string"
                 },
-                "deadline_reminders": {
-                    "type": "boolean"
-                },
-                "finalized_decisions": {
-                    "type": "boolean"
-                },
-                "push_enabled": {
-                    "type": "boolean"
-                },
-                "trip_activity": {
-                    "type": "boolean"
-                },
-                "upcoming_trip": {
-                    "type": "boolean"
-                },
-                "updated_at": {
+                "name": {
                     "type": "string"
                 },
-                "user_id": {
-                    "type": "string"
+                "option_type": {
+                    "$ref": "#/definitions/models.OptionType"
                 },
-                "voting_reminders": {
+                "vote_count": {
+                    "type": "integer"
+                },
+                "voted": {
                     "type": "boolean"
                 }
             }
         },
-        "models.NotificationResponse": {
+        "models.PollType": {
+            "type": "string",
+            "enum": [
+                "single",
+                "multi",
+                "rank"
+            ],
+            "x-enum-varnames": [
+                "PollTypeSingle",
+                "PollTypeMulti",
+                "PollTypeRank"
+            ]
+        },
+        "models.PollVotersResponse": {
             "type": "object",
             "properties": {
-                "errors": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.NotificationError"
-                    }
+                "poll_id": {
+                    "type": "string"
                 },
-                "failure_count": {
+                "total_members": {
                     "type": "integer"
                 },
-                "success_count": {
+                "total_voters": {
                     "type": "integer"
-                }
-            }
-        },
-        "models.OpeningHours": {
-            "type": "object",
-            "properties": {
-                "open_now": {
-                    "type": "boolean"
-                },
-                "periods": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Period"
-                    }
                 },
-                "weekday_text": {
+                "voters": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.VoterInfo"
                     }
                 }
             }
         },
-        "models.OptionType": {
+        "models.RSVPStatus": {
             "type": "string",
             "enum": [
-                "entity",
-                "custom"
+                "yes",
+                "maybe",
+                "no"
             ],
             "x-enum-varnames": [
-                "OptionTypeEntity",
-                "OptionTypeCustom"
+                "RSVPStatusGoing",
+                "RSVPStatusMaybe",
+                "RSVPStatusNotGoing"
             ]
         },
-        "models.OptionVotersResponse": {
+        "models.RankChoiceVotersResponse": {
             "type": "object",
             "properties": {
                 "option_id": {
                     "type": "string"
                 },
-                "option_name": {
+                "poll_id": {
+                    "type": "string"
+                },
+                "rank_position": {
+                    "type": "integer"
+                },
+                "total_voters": {
+                    "type": "integer"
+                },
+                "voters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.VoterInfo"
+                    }
+                }
+            }
+        },
+        "models.RankPollAPIResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "deadline": {
                     "type": "string"
                 },
-                "poll_id": {
+                "id": {
                     "type": "string"
                 },
-                "total_voters": {
-                    "type": "integer"
+                "is_anonymous": {
+                    "type": "boolean"
                 },
-                "voters": {
+                "options": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.VoterInfo"
+                        "$ref": "#/definitions/models.PollOptionAPIResponse"
                     }
+                },
+                "poll_type": {
+                    "$ref": "#/definitions/models.PollType"
+                },
+                "question": {
+                    "type": "string"
+                },
+                "should_notify_members": {
+                    "type": "boolean"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "voting_locked": {
+                    "type": "boolean"
                 }
             }
         },
-        "models.OptionWithScore": {
+        "models.RankPollResultsResponse": {
             "type": "object",
             "properties": {
-                "average_rank": {
-                    "type": "number"
-                },
-                "borda_score": {
-                    "type": "integer"
+                "all_options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OptionWithScore"
+                    }
                 },
-                "entity_id": {
+                "created_at": {
                     "type": "string"
                 },
-                "entity_type": {
+                "created_by": {
                     "type": "string"
                 },
-                "name": {
+                "deadline": {
                     "type": "string"
                 },
-                "option_id": {
+                "poll_id": {
                     "type": "string"
                 },
-                "option_type": {
-                    "$ref": "#/definitions/models.OptionType"
+                "poll_type": {
+                    "$ref": "#/definitions/models.PollType"
                 },
-                "rank_breakdown": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer"
-                    }
+                "question": {
+                    "type": "string"
                 },
-                "vote_count": {
-                    "type": "integer"
-                }
-            }
-        },
-        "models.PaginatedCommentsResponse": {
-            "type": "object",
-            "properties": {
-                "items": {
+                "top_3": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CommentAPIResponse"
+                        "$ref": "#/definitions/models.OptionWithScore"
                     }
                 },
-                "limit": {
+                "total_members": {
                     "type": "integer"
                 },
-                "next_cursor": {
-                    "type": "string"
+                "total_voters": {
+                    "type": "integer"
+                },
+                "user_has_voted": {
+                    "type": "boolean"
+                },
+                "user_ranking": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UserRankingItem"
+                    }
+                },
+                "voting_locked": {
+                    "type": "boolean"
                 }
             }
         },
-        "models.ParseLinkRequest": {
+        "models.RankingItem": {
             "type": "object",
             "required": [
-                "url"
+                "option_id",
+                "rank"
             ],
             "properties": {
-                "url": {
+                "option_id": {
                     "type": "string"
+                },
+                "rank": {
+                    "type": "integer"
                 }
             }
         },
-        "models.ParsedActivityData": {
+        "models.RegisterDeviceRequest": {
             "type": "object",
+            "required": [
+                "token"
+            ],
             "properties": {
-                "category_suggestions": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "app_version": {
+                    "type": "string",
+                    "maxLength": 50
                 },
-                "description": {
-                    "type": "string"
+                "platform": {
+                    "enum": [
+                        "ios",
+                        "android",
+                        "web"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DevicePlatform"
+                        }
+                    ]
                 },
-                "media_url": {
+                "token": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                }
+            }
+        },
+        "models.S3HealthCheckResponse": {
+            "type": "object",
+            "properties": {
+                "bucketName": {
                     "type": "string"
                 },
-                "name": {
+                "error": {
+                    "description": "Error contains the underlying error message when status is \"unhealthy\"",
                     "type": "string"
                 },
-                "source_type": {
-                    "$ref": "#/definitions/models.LinkType"
-                },
-                "source_url": {
+                "region": {
                     "type": "string"
                 },
-                "thumbnail_url": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "models.Period": {
+        "models.SearchActivitiesResult": {
             "type": "object",
             "properties": {
-                "close": {
-                    "$ref": "#/definitions/models.DayTime"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActivityAPIResponse"
+                    }
                 },
-                "open": {
-                    "$ref": "#/definitions/models.DayTime"
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "models.PitchAPIResponse": {
+        "models.SearchMembersResult": {
             "type": "object",
             "properties": {
-                "audio_url": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MembershipAPIResponse"
+                    }
                 },
-                "comment_count": {
+                "limit": {
                     "type": "integer"
                 },
-                "comment_previews": {
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SearchTripsResult": {
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CommenterPreview"
+                        "$ref": "#/definitions/models.TripAPIResponse"
                     }
                 },
-                "created_at": {
-                    "type": "string"
+                "limit": {
+                    "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "offset": {
+                    "type": "integer"
                 },
-                "duration": {
+                "total": {
                     "type": "integer"
+                }
+            }
+        },
+        "models.SendBulkNotificationRequest": {
+            "type": "object",
+            "required": [
+                "body",
+                "title",
+                "user_ids"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 1
                 },
-                "id": {
-                    "type": "string"
+                "data": {
+                    "type": "object",
+                    "additionalProperties": true
                 },
-                "images": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.PitchImageInfo"
-                    }
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
                 },
-                "links": {
+                "user_ids": {
                     "type": "array",
+                    "maxItems": 1000,
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/models.PitchLink"
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "models.SendNotificationRequest": {
+            "type": "object",
+            "required": [
+                "body",
+                "title",
+                "user_id"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 1
                 },
-                "name": {
-                    "type": "string"
-                },
-                "profile_picture_url": {
-                    "type": "string"
+                "data": {
+                    "type": "object",
+                    "additionalProperties": true
                 },
                 "title": {
-                    "type": "string"
-                },
-                "trip_id": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
                 },
                 "user_id": {
                     "type": "string"
+                }
+            }
+        },
+        "models.SizedUploadURL": {
+            "type": "object",
+            "properties": {
+                "size": {
+                    "$ref": "#/definitions/models.ImageSize"
                 },
-                "username": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "models.PitchCursorPageResult": {
+        "models.SubmitRankingRequest": {
             "type": "object",
+            "required": [
+                "rankings"
+            ],
             "properties": {
-                "items": {
+                "rankings": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/models.PitchAPIResponse"
+                        "$ref": "#/definitions/models.RankingItem"
                     }
-                },
-                "limit": {
-                    "type": "integer"
-                },
-                "next_cursor": {
-                    "type": "string"
                 }
             }
         },
-        "models.PitchImageInfo": {
+        "models.TabListResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "medium_url": {
+                "tabs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CategoryAPIResponse"
+                    }
+                }
+            }
+        },
+        "models.TransferOwnershipRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "models.PitchLink": {
+        "models.Trip": {
             "type": "object",
             "properties": {
-                "added_by": {
+                "archived_at": {
+                    "description": "ArchivedAt is set while the trip is archived and read-only.",
+                    "type": "string"
+                },
+                "budget_max": {
+                    "type": "integer"
+                },
+                "budget_min": {
+                    "type": "integer"
+                },
+                "cover_image_id": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "description": {
+                "currency": {
                     "type": "string"
                 },
-                "domain": {
+                "deleted_at": {
+                    "description": "DeletedAt is set when the trip is deleted; it is purged after TripRestoreWindow.",
+                    "type": "string"
+                },
+                "departed_content_policy": {
+                    "description": "DepartedContentPolicy defaults to reassign.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DepartedContentPolicy"
+                        }
+                    ]
+                },
+                "end_date": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "pitch_id": {
+                "location": {
                     "type": "string"
                 },
-                "thumbnail_url": {
+                "name": {
                     "type": "string"
                 },
-                "title": {
+                "pitch_deadline": {
                     "type": "string"
                 },
-                "url": {
+                "pitching_closed_at": {
+                    "description": "PitchingClosedAt is set when the pitch deadline passes; no new pitches are accepted.",
                     "type": "string"
-                }
-            }
-        },
-        "models.PlaceDetailsRequest": {
-            "type": "object",
-            "properties": {
-                "fields": {
-                    "description": "Fields specifies which fields to return (optional)\nIf not specified, returns all available fields",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
                 },
-                "input": {
-                    "description": "Input is the text to search for if PlaceID is not provided\nExample: \"Eiffel Tower\", \"Paris, France\"",
+                "rank_poll_id": {
                     "type": "string"
                 },
-                "language": {
-                    "description": "Language is the language code for the results (optional)",
+                "start_date": {
                     "type": "string"
                 },
-                "place_id": {
-                    "description": "PlaceID is the unique identifier for the place (from typeahead results)",
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.PlaceDetailsResponse": {
+        "models.TripAPIResponse": {
             "type": "object",
             "properties": {
-                "address_components": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.AddressComponent"
-                    }
-                },
-                "formatted_address": {
-                    "description": "Address information",
+                "archived_at": {
                     "type": "string"
                 },
-                "formatted_phone_number": {
-                    "description": "Contact information",
-                    "type": "string"
+                "budget_max": {
+                    "type": "integer"
                 },
-                "geometry": {
-                    "description": "Location coordinates",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.PlaceGeometry"
-                        }
-                    ]
+                "budget_min": {
+                    "type": "integer"
                 },
-                "icon": {
+                "cover_image_url": {
                     "type": "string"
                 },
-                "international_phone_number": {
+                "created_at": {
                     "type": "string"
                 },
-                "name": {
+                "currency": {
                     "type": "string"
                 },
-                "opening_hours": {
-                    "description": "Business information",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.OpeningHours"
-                        }
-                    ]
+                "departed_content_policy": {
+                    "$ref": "#/definitions/models.DepartedContentPolicy"
                 },
-                "photos": {
-                    "description": "Photos",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.PlacePhoto"
-                    }
+                "end_date": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "place_id": {
+                "id": {
                     "type": "string"
                 },
-                "price_level": {
-                    "type": "integer"
+                "location": {
+                    "type": "string"
                 },
-                "rating": {
-                    "description": "Ratings and reviews",
-                    "type": "number"
+                "member_count": {
+                    "type": "integer"
                 },
-                "types": {
-                    "description": "Place types",
+                "member_previews": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.CommenterPreview"
                     }
                 },
-                "user_ratings_total": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "utc_offset": {
-                    "description": "Additional information",
-                    "type": "integer"
+                "pitch_deadline": {
+                    "type": "string"
                 },
-                "vicinity": {
+                "pitching_closed_at": {
                     "type": "string"
                 },
-                "website": {
+                "rank_poll_id": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.PlaceGeometry": {
+        "models.TripCursorPageResult": {
             "type": "object",
             "properties": {
-                "location": {
-                    "$ref": "#/definitions/models.LatLng"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TripAPIResponse"
+                    }
                 },
-                "viewport": {
-                    "$ref": "#/definitions/models.Bounds"
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
                 }
             }
         },
-        "models.PlacePhoto": {
+        "models.TripDirectInvite": {
             "type": "object",
             "properties": {
-                "height": {
-                    "type": "integer"
+                "created_at": {
+                    "type": "string"
                 },
-                "html_attributions": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "photo_reference": {
+                "invited_by": {
                     "type": "string"
                 },
-                "photo_url": {
-                    "description": "PhotoURL is a generated URL to fetch the photo (optional, generated by backend)",
+                "invitee_id": {
                     "type": "string"
                 },
-                "width": {
-                    "type": "integer"
+                "responded_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.TripDirectInviteStatus"
+                },
+                "trip_id": {
+                    "type": "string"
                 }
             }
         },
-        "models.PlacePrediction": {
+        "models.TripDirectInviteAPIResponse": {
             "type": "object",
             "properties": {
-                "description": {
-                    "description": "Description is the human-readable name for this place",
+                "created_at": {
                     "type": "string"
                 },
-                "main_text": {
-                    "description": "StructuredFormatting contains the main text and secondary text",
+                "id": {
                     "type": "string"
                 },
-                "matched_substrings": {
-                    "description": "MatchedSubstrings indicates which parts of the description match the input",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.MatchedSubstring"
-                    }
+                "invited_by": {
+                    "type": "string"
                 },
-                "place_id": {
-                    "description": "PlaceID is the unique identifier for this place",
+                "invitee_id": {
                     "type": "string"
                 },
-                "secondary_text": {
+                "invitee_name": {
                     "type": "string"
                 },
-                "types": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "invitee_username": {
+                    "type": "string"
+                },
+                "inviter_name": {
+                    "type": "string"
+                },
+                "responded_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.TripDirectInviteStatus"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "trip_name": {
+                    "type": "string"
                 }
             }
         },
-        "models.PlacesSearchResponse": {
+        "models.TripDirectInviteStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "accepted",
+                "declined",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "TripDirectInvitePending",
+                "TripDirectInviteAccepted",
+                "TripDirectInviteDeclined",
+                "TripDirectInviteCancelled"
+            ]
+        },
+        "models.TripDirectInvitesResponse": {
             "type": "object",
             "properties": {
-                "predictions": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.PlacePrediction"
+                        "$ref": "#/definitions/models.TripDirectInviteAPIResponse"
                     }
-                },
-                "status": {
-                    "type": "string"
                 }
             }
         },
-        "models.PollAPIResponse": {
+        "models.TripInviteAPIResponse": {
             "type": "object",
             "properties": {
-                "categories": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "code": {
+                    "type": "string"
                 },
                 "created_at": {
                     "type": "string"
@@ -7826,575 +13157,627 @@ const docTemplate = `{
                 "created_by": {
                     "type": "string"
                 },
-                "deadline": {
+                "expires_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "is_anonymous": {
+                "is_revoked": {
                     "type": "boolean"
                 },
-                "options": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.PollOptionAPIResponse"
-                    }
-                },
-                "poll_type": {
-                    "$ref": "#/definitions/models.PollType"
-                },
-                "question": {
+                "join_url": {
                     "type": "string"
                 },
-                "should_notify_members": {
+                "max_uses": {
+                    "type": "integer"
+                },
+                "requires_approval": {
                     "type": "boolean"
                 },
+                "stats": {
+                    "$ref": "#/definitions/models.TripInviteJoinStats"
+                },
                 "trip_id": {
                     "type": "string"
+                },
+                "use_count": {
+                    "type": "integer"
                 }
             }
         },
-        "models.PollCursorPageResult": {
+        "models.TripInviteJoin": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.PollAPIResponse"
-                    }
+                "created_at": {
+                    "type": "string"
                 },
-                "limit": {
-                    "type": "integer"
+                "decided_at": {
+                    "type": "string"
                 },
-                "next_cursor": {
+                "decided_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invite_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.TripInviteJoinStatus"
+                },
+                "trip_id": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "models.PollOptionAPIResponse": {
+        "models.TripInviteJoinAPIResponse": {
             "type": "object",
             "properties": {
-                "entity_id": {
+                "created_at": {
                     "type": "string"
                 },
-                "entity_type": {
+                "decided_at": {
+                    "type": "string"
+                },
+                "decided_by": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "invite_id": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
-                "option_type": {
-                    "$ref": "#/definitions/models.OptionType"
+                "status": {
+                    "$ref": "#/definitions/models.TripInviteJoinStatus"
                 },
-                "vote_count": {
-                    "type": "integer"
+                "trip_id": {
+                    "type": "string"
                 },
-                "voted": {
-                    "type": "boolean"
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "models.PollType": {
-            "type": "string",
-            "enum": [
-                "single",
-                "multi",
-                "rank"
-            ],
-            "x-enum-varnames": [
-                "PollTypeSingle",
-                "PollTypeMulti",
-                "PollTypeRank"
-            ]
-        },
-        "models.PollVotersResponse": {
+        "models.TripInviteJoinStats": {
             "type": "object",
             "properties": {
-                "poll_id": {
-                    "type": "string"
-                },
-                "total_members": {
+                "joined": {
                     "type": "integer"
                 },
-                "total_voters": {
+                "pending": {
                     "type": "integer"
                 },
-                "voters": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.VoterInfo"
-                    }
+                "rejected": {
+                    "type": "integer"
                 }
             }
         },
-        "models.RSVPStatus": {
+        "models.TripInviteJoinStatus": {
             "type": "string",
             "enum": [
-                "yes",
-                "maybe",
-                "no"
+                "joined",
+                "pending",
+                "rejected"
             ],
             "x-enum-varnames": [
-                "RSVPStatusGoing",
-                "RSVPStatusMaybe",
-                "RSVPStatusNotGoing"
+                "TripInviteJoinJoined",
+                "TripInviteJoinPending",
+                "TripInviteJoinRejected"
             ]
         },
-        "models.RankChoiceVotersResponse": {
+        "models.TripInviteJoinsResponse": {
             "type": "object",
             "properties": {
-                "option_id": {
-                    "type": "string"
-                },
-                "poll_id": {
-                    "type": "string"
-                },
-                "rank_position": {
-                    "type": "integer"
-                },
-                "total_voters": {
-                    "type": "integer"
-                },
-                "voters": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.VoterInfo"
+                        "$ref": "#/definitions/models.TripInviteJoinAPIResponse"
                     }
                 }
             }
         },
-        "models.RankPollAPIResponse": {
+        "models.TripInvitesResponse": {
             "type": "object",
             "properties": {
-                "categories": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.TripInviteAPIResponse"
                     }
-                },
+                }
+            }
+        },
+        "models.TripRole": {
+            "type": "string",
+            "enum": [
+                "owner",
+                "organiser",
+                "member",
+                "viewer"
+            ],
+            "x-enum-varnames": [
+                "TripRoleOwner",
+                "TripRoleOrganiser",
+                "TripRoleMember",
+                "TripRoleViewer"
+            ]
+        },
+        "models.TripShareLinkAPIResponse": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "type": "string"
                 },
                 "created_by": {
                     "type": "string"
                 },
-                "deadline": {
+                "token": {
                     "type": "string"
                 },
-                "id": {
+                "trip_id": {
                     "type": "string"
                 },
-                "is_anonymous": {
-                    "type": "boolean"
+                "updated_at": {
+                    "type": "string"
                 },
-                "options": {
+                "url": {
+                    "type": "string"
+                },
+                "visible_tabs": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.PollOptionAPIResponse"
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "models.TripSlackChannel": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "string"
                 },
-                "poll_type": {
-                    "$ref": "#/definitions/models.PollType"
-                },
-                "question": {
+                "created_at": {
                     "type": "string"
                 },
-                "should_notify_members": {
-                    "type": "boolean"
+                "linked_by": {
+                    "type": "string"
                 },
                 "trip_id": {
                     "type": "string"
                 }
             }
         },
-        "models.RankPollResultsResponse": {
+        "models.TripTask": {
             "type": "object",
             "properties": {
-                "all_options": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "assignee_ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.OptionWithScore"
+                        "type": "string"
                     }
                 },
+                "completed_at": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "created_by": {
                     "type": "string"
                 },
-                "deadline": {
+                "description": {
                     "type": "string"
                 },
-                "poll_id": {
+                "due_at": {
                     "type": "string"
                 },
-                "poll_type": {
-                    "$ref": "#/definitions/models.PollType"
-                },
-                "question": {
+                "id": {
                     "type": "string"
                 },
-                "top_3": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.OptionWithScore"
-                    }
-                },
-                "total_members": {
-                    "type": "integer"
+                "status": {
+                    "$ref": "#/definitions/models.TripTaskStatus"
                 },
-                "total_voters": {
-                    "type": "integer"
+                "title": {
+                    "type": "string"
                 },
-                "user_has_voted": {
-                    "type": "boolean"
+                "trip_id": {
+                    "type": "string"
                 },
-                "user_ranking": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.UserRankingItem"
-                    }
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "models.RankingItem": {
-            "type": "object",
-            "required": [
-                "option_id",
-                "rank"
+        "models.TripTaskStatus": {
+            "type": "string",
+            "enum": [
+                "todo",
+                "in_progress",
+                "done"
             ],
+            "x-enum-varnames": [
+                "TripTaskTodo",
+                "TripTaskInProgress",
+                "TripTaskDone"
+            ]
+        },
+        "models.TripTasksResponse": {
+            "type": "object",
             "properties": {
-                "option_id": {
-                    "type": "string"
-                },
-                "rank": {
-                    "type": "integer"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TripTask"
+                    }
                 }
             }
         },
-        "models.S3HealthCheckResponse": {
+        "models.TripTemplate": {
             "type": "object",
             "properties": {
-                "bucketName": {
+                "created_at": {
                     "type": "string"
                 },
-                "error": {
-                    "description": "Error contains the underlying error message when status is \"unhealthy\"",
+                "created_by": {
+                    "type": "string"
+                },
+                "data": {
+                    "$ref": "#/definitions/models.TripTemplateData"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_public": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
-                "region": {
+                "source_trip_id": {
                     "type": "string"
                 },
-                "status": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.SearchActivitiesResult": {
+        "models.TripTemplateActivity": {
             "type": "object",
             "properties": {
-                "items": {
+                "category_names": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.ActivityAPIResponse"
+                        "type": "string"
                     }
                 },
-                "limit": {
-                    "type": "integer"
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DateRange"
+                    }
                 },
-                "offset": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "total": {
-                    "type": "integer"
+                "estimated_price": {
+                    "type": "number"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "location_lat": {
+                    "type": "number"
+                },
+                "location_lng": {
+                    "type": "number"
+                },
+                "location_name": {
+                    "type": "string"
+                },
+                "media_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "time_of_day": {
+                    "$ref": "#/definitions/models.ActivityTimeOfDay"
                 }
             }
         },
-        "models.SearchMembersResult": {
+        "models.TripTemplateCategory": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.MembershipAPIResponse"
-                    }
+                "icon": {
+                    "type": "string"
                 },
-                "limit": {
-                    "type": "integer"
+                "is_default": {
+                    "type": "boolean"
                 },
-                "offset": {
-                    "type": "integer"
+                "is_hidden": {
+                    "type": "boolean"
                 },
-                "total": {
+                "label": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
                     "type": "integer"
+                },
+                "view_type": {
+                    "$ref": "#/definitions/models.CategoryViewType"
                 }
             }
         },
-        "models.SearchTripsResult": {
+        "models.TripTemplateData": {
             "type": "object",
             "properties": {
-                "items": {
+                "activities": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.TripAPIResponse"
+                        "$ref": "#/definitions/models.TripTemplateActivity"
                     }
                 },
-                "limit": {
+                "budget_max": {
                     "type": "integer"
                 },
-                "offset": {
+                "budget_min": {
                     "type": "integer"
                 },
-                "total": {
-                    "type": "integer"
-                }
-            }
-        },
-        "models.SendBulkNotificationRequest": {
-            "type": "object",
-            "required": [
-                "body",
-                "title",
-                "user_ids"
-            ],
-            "properties": {
-                "body": {
-                    "type": "string",
-                    "maxLength": 500,
-                    "minLength": 1
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TripTemplateCategory"
+                    }
                 },
-                "data": {
-                    "type": "object",
-                    "additionalProperties": true
+                "currency": {
+                    "type": "string"
                 },
-                "title": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1
+                "departed_content_policy": {
+                    "$ref": "#/definitions/models.DepartedContentPolicy"
                 },
-                "user_ids": {
+                "end_date": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "polls": {
                     "type": "array",
-                    "maxItems": 1000,
-                    "minItems": 1,
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.TripTemplatePoll"
                     }
-                }
-            }
-        },
-        "models.SendNotificationRequest": {
-            "type": "object",
-            "required": [
-                "body",
-                "title",
-                "user_id"
-            ],
-            "properties": {
-                "body": {
-                    "type": "string",
-                    "maxLength": 500,
-                    "minLength": 1
-                },
-                "data": {
-                    "type": "object",
-                    "additionalProperties": true
-                },
-                "title": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1
                 },
-                "user_id": {
+                "start_date": {
                     "type": "string"
                 }
             }
         },
-        "models.SizedUploadURL": {
+        "models.TripTemplateListResponse": {
             "type": "object",
             "properties": {
-                "size": {
-                    "$ref": "#/definitions/models.ImageSize"
-                },
-                "url": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TripTemplateSummary"
+                    }
                 }
             }
         },
-        "models.SubmitRankingRequest": {
+        "models.TripTemplatePoll": {
             "type": "object",
-            "required": [
-                "rankings"
-            ],
             "properties": {
-                "rankings": {
+                "categories": {
                     "type": "array",
-                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/models.RankingItem"
+                        "type": "string"
+                    }
+                },
+                "is_anonymous": {
+                    "type": "boolean"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TripTemplatePollOption"
                     }
+                },
+                "poll_type": {
+                    "$ref": "#/definitions/models.PollType"
+                },
+                "question": {
+                    "type": "string"
+                },
+                "should_notify_members": {
+                    "type": "boolean"
                 }
             }
         },
-        "models.TabListResponse": {
+        "models.TripTemplatePollOption": {
             "type": "object",
             "properties": {
-                "tabs": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.CategoryAPIResponse"
-                    }
+                "activity_key": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "models.Trip": {
+        "models.TripTemplateSummary": {
             "type": "object",
             "properties": {
-                "budget_max": {
+                "activity_count": {
                     "type": "integer"
                 },
-                "budget_min": {
+                "category_count": {
                     "type": "integer"
                 },
-                "cover_image_id": {
-                    "type": "string"
-                },
                 "created_at": {
                     "type": "string"
                 },
-                "currency": {
+                "created_by": {
                     "type": "string"
                 },
-                "end_date": {
+                "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "location": {
-                    "type": "string"
+                "is_public": {
+                    "type": "boolean"
                 },
                 "name": {
                     "type": "string"
                 },
-                "pitch_deadline": {
-                    "type": "string"
-                },
-                "rank_poll_id": {
-                    "type": "string"
-                },
-                "start_date": {
-                    "type": "string"
+                "poll_count": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string"
+                "trip_length_days": {
+                    "type": "integer"
                 }
             }
         },
-        "models.TripAPIResponse": {
+        "models.TripWebhook": {
             "type": "object",
             "properties": {
-                "budget_max": {
-                    "type": "integer"
-                },
-                "budget_min": {
-                    "type": "integer"
-                },
-                "cover_image_url": {
-                    "type": "string"
-                },
                 "created_at": {
                     "type": "string"
                 },
-                "currency": {
+                "created_by": {
                     "type": "string"
                 },
-                "end_date": {
-                    "type": "string",
-                    "format": "date-time"
+                "enabled": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "string"
                 },
-                "location": {
-                    "type": "string"
-                },
-                "member_count": {
-                    "type": "integer"
-                },
-                "member_previews": {
+                "topics": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CommenterPreview"
+                        "type": "string"
                     }
                 },
-                "name": {
-                    "type": "string"
-                },
-                "pitch_deadline": {
-                    "type": "string"
-                },
-                "rank_poll_id": {
-                    "type": "string"
-                },
-                "start_date": {
-                    "type": "string",
-                    "format": "date-time"
-                },
+                "trip_id": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "models.TripCursorPageResult": {
+        "models.TripWebhookDeliveriesResponse": {
             "type": "object",
             "properties": {
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.TripAPIResponse"
+                        "$ref": "#/definitions/models.TripWebhookDelivery"
                     }
-                },
-                "limit": {
-                    "type": "integer"
-                },
-                "next_cursor": {
-                    "type": "string"
                 }
             }
         },
-        "models.TripInviteAPIResponse": {
+        "models.TripWebhookDelivery": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "string"
+                "attempts": {
+                    "type": "integer"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "created_by": {
+                "error_message": {
                     "type": "string"
                 },
-                "expires_at": {
+                "event_id": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "is_revoked": {
-                    "type": "boolean"
+                "last_attempt_at": {
+                    "type": "string"
                 },
-                "join_url": {
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.TripWebhookDeliveryStatus"
+                },
+                "topic": {
                     "type": "string"
                 },
-                "trip_id": {
+                "webhook_id": {
                     "type": "string"
                 }
             }
         },
+        "models.TripWebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "TripWebhookDeliveryPending",
+                "TripWebhookDeliverySucceeded",
+                "TripWebhookDeliveryFailed"
+            ]
+        },
+        "models.TripWebhooksResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TripWebhook"
+                    }
+                }
+            }
+        },
+        "models.UnregisterDeviceRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                }
+            }
+        },
         "models.UpdateActivityRequest": {
             "type": "object",
             "properties": {
@@ -8487,6 +13870,26 @@ const docTemplate = `{
                 }
             }
         },
+        "models.UpdateMemberRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "enum": [
+                        "organiser",
+                        "member",
+                        "viewer"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TripRole"
+                        }
+                    ]
+                }
+            }
+        },
         "models.UpdateMembershipRequest": {
             "type": "object",
             "properties": {
@@ -8497,9 +13900,6 @@ const docTemplate = `{
                 "budget_min": {
                     "type": "integer",
                     "minimum": 0
-                },
-                "is_admin": {
-                    "type": "boolean"
                 }
             }
         },
@@ -8576,6 +13976,18 @@ const docTemplate = `{
                 "currency": {
                     "type": "string"
                 },
+                "departed_content_policy": {
+                    "description": "DepartedContentPolicy is editable by trip admins only.",
+                    "enum": [
+                        "reassign",
+                        "anonymise"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DepartedContentPolicy"
+                        }
+                    ]
+                },
                 "end_date": {
                     "type": "string",
                     "format": "date-time"
@@ -8595,18 +14007,118 @@ const docTemplate = `{
                 }
             }
         },
+        "models.UpdateTripShareLinkRequest": {
+            "type": "object",
+            "properties": {
+                "visible_tabs": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.UpdateTripTaskRequest": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "assignee_ids": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "clear_activity": {
+                    "type": "boolean"
+                },
+                "clear_due_at": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "todo",
+                        "in_progress",
+                        "done"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TripTaskStatus"
+                        }
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                }
+            }
+        },
+        "models.UpdateTripWebhookRequest": {
+            "type": "object",
+            "required": [
+                "topics"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "topics": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048
+                }
+            }
+        },
         "models.UpdateUserNotificationPreferencesRequest": {
             "type": "object",
             "properties": {
                 "deadline_reminders": {
                     "type": "boolean"
                 },
+                "digest_enabled": {
+                    "type": "boolean"
+                },
+                "digest_time": {
+                    "type": "string"
+                },
+                "email_enabled": {
+                    "type": "boolean"
+                },
                 "finalized_decisions": {
                     "type": "boolean"
                 },
                 "push_enabled": {
                     "type": "boolean"
                 },
+                "quiet_hours_enabled": {
+                    "type": "boolean"
+                },
+                "quiet_hours_end": {
+                    "type": "string"
+                },
+                "quiet_hours_start": {
+                    "type": "string"
+                },
+                "sms_enabled": {
+                    "type": "boolean"
+                },
                 "trip_activity": {
                     "type": "boolean"
                 },
@@ -8628,13 +14140,24 @@ const docTemplate = `{
                     "type": "string",
                     "maxLength": 200
                 },
+                "email": {
+                    "type": "string",
+                    "maxLength": 254
+                },
                 "google_maps_enabled": {
                     "type": "boolean"
                 },
+                "locale": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string",
                     "minLength": 1
                 },
+                "phone_discovery_disabled": {
+                    "description": "PhoneDiscoveryDisabled stops friends finding the user from their address book.",
+                    "type": "boolean"
+                },
                 "phone_number": {
                     "type": "string"
                 },
@@ -8710,15 +14233,29 @@ const docTemplate = `{
                 "device_token_updated_at": {
                     "type": "string"
                 },
+                "email": {
+                    "type": "string"
+                },
+                "email_verified_at": {
+                    "description": "EmailVerifiedAt is set once the user confirms Email with a code sent to it. Email\nis neither notified nor matched by lookups until then.",
+                    "type": "string"
+                },
                 "google_maps_enabled": {
                     "type": "boolean"
                 },
                 "id": {
                     "type": "string"
                 },
+                "locale": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
+                "phone_discovery_disabled": {
+                    "description": "PhoneDiscoveryDisabled hides the user from contact discovery.",
+                    "type": "boolean"
+                },
                 "phone_number": {
                     "type": "string"
                 },
@@ -8736,6 +14273,82 @@ const docTemplate = `{
                 }
             }
         },
+        "models.UserDataExport": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Comment"
+                    }
+                },
+                "exported_at": {
+                    "type": "string"
+                },
+                "memberships": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ExportedMembership"
+                    }
+                },
+                "pitches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ExportedPitch"
+                    }
+                },
+                "poll_rankings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ExportedPollRanking"
+                    }
+                },
+                "poll_votes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ExportedPollVote"
+                    }
+                },
+                "profile": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "rsvps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ExportedRSVP"
+                    }
+                }
+            }
+        },
+        "models.UserDevice": {
+            "type": "object",
+            "properties": {
+                "app_version": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "platform": {
+                    "$ref": "#/definitions/models.DevicePlatform"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
         "models.UserRankingItem": {
             "type": "object",
             "properties": {
@@ -8750,6 +14363,17 @@ const docTemplate = `{
                 }
             }
         },
+        "models.VerifyEmailRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
         "models.VoterInfo": {
             "type": "object",
             "properties": {
@@ -8770,32 +14394,46 @@ const docTemplate = `{
                 }
             }
         },
-        "realtime.Event": {
+        "realtime.EventCatalogue": {
             "type": "object",
             "properties": {
-                "actor_id": {
-                    "type": "string"
+                "$defs": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {}
+                    }
                 },
-                "actor_name": {
+                "$schema": {
                     "type": "string"
                 },
-                "data": {
-                    "type": "object"
-                },
-                "entity_id": {
-                    "type": "string"
+                "envelope": {
+                    "type": "object",
+                    "additionalProperties": {}
                 },
-                "id": {
+                "topics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/realtime.EventCatalogueTopic"
+                    }
+                }
+            }
+        },
+        "realtime.EventCatalogueTopic": {
+            "type": "object",
+            "properties": {
+                "description": {
                     "type": "string"
                 },
-                "timestamp": {
-                    "type": "string"
+                "schema": {
+                    "type": "object",
+                    "additionalProperties": {}
                 },
                 "topic": {
                     "type": "string"
                 },
-                "trip_id": {
-                    "type": "string"
+                "version": {
+                    "type": "integer"
                 }
             }
         },
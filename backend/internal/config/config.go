@@ -3,15 +3,17 @@ package config
 import "os"
 
 type Configuration struct {
-	App              AppConfig
-	Database         DatabaseConfig
-	Auth             AuthConfig
-	AWS              AWSConfig
-	Temporal         TemporalConfig
-	Redis            RedisConfig
-	GoogleMaps       GoogleMapsConfig
-	ExpoNotification ExpoNotificationConfig
-	Environment      string
+	App                  AppConfig
+	Database             DatabaseConfig
+	Auth                 AuthConfig
+	AWS                  AWSConfig
+	Temporal             TemporalConfig
+	Redis                RedisConfig
+	GoogleMaps           GoogleMapsConfig
+	ExpoNotification     ExpoNotificationConfig
+	NotificationChannels NotificationChannelsConfig
+	Slack                SlackConfig
+	Environment          string
 }
 
 func LoadConfiguration() (*Configuration, error) {
@@ -55,15 +57,27 @@ func LoadConfiguration() (*Configuration, error) {
 		return nil, err
 	}
 
+	notificationChannelsConfig, err := LoadNotificationChannelsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	slackConfig, err := LoadSlackConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Configuration{
-		App:              *appConfig,
-		Database:         *databaseConfig,
-		Auth:             *authConfig,
-		AWS:              *awsConfig,
-		Temporal:         *temporalConfig,
-		Redis:            *redisConfig,
-		GoogleMaps:       *googleMapsConfig,
-		ExpoNotification: *expoNotificationConfig,
-		Environment:      os.Getenv("APP_ENVIRONMENT"),
+		App:                  *appConfig,
+		Database:             *databaseConfig,
+		Auth:                 *authConfig,
+		AWS:                  *awsConfig,
+		Temporal:             *temporalConfig,
+		Redis:                *redisConfig,
+		GoogleMaps:           *googleMapsConfig,
+		ExpoNotification:     *expoNotificationConfig,
+		NotificationChannels: *notificationChannelsConfig,
+		Slack:                *slackConfig,
+		Environment:          os.Getenv("APP_ENVIRONMENT"),
 	}, nil
 }
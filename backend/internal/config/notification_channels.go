@@ -0,0 +1,60 @@
+package config
+
+import (
+	"os"
+)
+
+// SMTPConfig configures outgoing notification email. Email is disabled when Host is empty.
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string `json:"-"`
+	From     string
+}
+
+// SMSConfig configures a Twilio-compatible SMS API. SMS is disabled when AccountSID is empty.
+type SMSConfig struct {
+	AccountSID string
+	AuthToken  string `json:"-"`
+	FromNumber string
+	// BaseURL overrides the API host, e.g. for a Twilio-compatible provider.
+	BaseURL string
+}
+
+type NotificationChannelsConfig struct {
+	SMTP SMTPConfig
+	SMS  SMSConfig
+}
+
+func LoadNotificationChannelsConfig() (*NotificationChannelsConfig, error) {
+	port := os.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "587"
+	}
+	return &NotificationChannelsConfig{
+		SMTP: SMTPConfig{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     port,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("SMTP_FROM"),
+		},
+		SMS: SMSConfig{
+			AccountSID: os.Getenv("TWILIO_ACCOUNT_SID"),
+			AuthToken:  os.Getenv("TWILIO_AUTH_TOKEN"),
+			FromNumber: os.Getenv("TWILIO_FROM_NUMBER"),
+			BaseURL:    os.Getenv("TWILIO_BASE_URL"),
+		},
+	}, nil
+}
+
+// Enabled reports whether SMTP delivery is configured.
+func (c SMTPConfig) Enabled() bool {
+	return c.Host != ""
+}
+
+// Enabled reports whether SMS delivery is configured.
+func (c SMSConfig) Enabled() bool {
+	return c.AccountSID != ""
+}
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"os"
+)
+
+// SlackConfig configures the trip Slack integration. It shares the bot token with the
+// Expo deploy notices; interactions are rejected when SigningSecret is empty.
+type SlackConfig struct {
+	BotToken      string `json:"-"`
+	SigningSecret string `json:"-"`
+}
+
+func LoadSlackConfig() (*SlackConfig, error) {
+	return &SlackConfig{
+		BotToken:      os.Getenv("SLACK_BOT_TOKEN"),
+		SigningSecret: os.Getenv("SLACK_SIGNING_SECRET"),
+	}, nil
+}
@@ -1,30 +1,39 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 	"toggo/internal/errs"
+	"toggo/internal/models"
 	"toggo/internal/realtime"
 	"toggo/internal/services"
+	"toggo/internal/utilities"
 	"toggo/internal/validators"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
 type ActivityFeedController struct {
 	feedService services.ActivityFeedServiceInterface
+	validator   *validator.Validate
 }
 
-func NewActivityFeedController(feedService services.ActivityFeedServiceInterface) *ActivityFeedController {
-	return &ActivityFeedController{feedService: feedService}
+func NewActivityFeedController(feedService services.ActivityFeedServiceInterface, validator *validator.Validate) *ActivityFeedController {
+	return &ActivityFeedController{feedService: feedService, validator: validator}
 }
 
 // @Summary      Get trip activity feed
-// @Description  Returns all unread events in the caller's trip feed. Events persist until explicitly dismissed via the mark-read endpoint.
+// @Description  Returns the caller's trip feed newest first. Repeated actions on the same subject are aggregated into one item (e.g. "Ana and 3 others voted on Where to eat"). Reading the feed does not mark items as read.
 // @Tags         activity-feed
 // @Produce      json
-// @Param        tripID path string true "Trip ID (UUID)"
-// @Success      200 {array}  realtime.Event
-// @Failure      400 {object} errs.APIError "Invalid trip ID"
+// @Param        tripID      path  string true  "Trip ID (UUID)"
+// @Param        limit       query int    false "Max items to return (default 20, max 100)"
+// @Param        cursor      query string false "Opaque cursor returned in next_cursor"
+// @Param        unread_only query bool   false "Only return items with unread events"
+// @Success      200 {object} models.ActivityFeedPageResult
+// @Failure      400 {object} errs.APIError "Invalid trip ID or cursor"
 // @Failure      401 {object} errs.APIError
 // @Failure      500 {object} errs.APIError
 // @Router       /api/v1/trips/{tripID}/activity [get]
@@ -35,16 +44,25 @@ func (ctrl *ActivityFeedController) GetFeed(c *fiber.Ctx) error {
 		return err
 	}
 
-	events, err := ctrl.feedService.GetFeed(c.Context(), userID, tripID)
+	var params models.GetActivityFeedQueryParams
+	if err := utilities.ParseAndValidateQueryParams(c, ctrl.validator, &params); err != nil {
+		return err
+	}
+	limit, cursorToken := utilities.ExtractLimitAndCursor(&params.CursorPaginationParams)
+
+	result, err := ctrl.feedService.GetFeed(c.Context(), userID, tripID, params.UnreadOnly, limit, cursorToken)
 	if err != nil {
+		if errors.Is(err, errs.ErrInvalidCursor) {
+			return errs.BadRequest(err)
+		}
 		return errs.InternalServerError()
 	}
 
-	return c.Status(http.StatusOK).JSON(events)
+	return c.Status(http.StatusOK).JSON(result)
 }
 
 // @Summary      Get unread activity count
-// @Description  Returns the number of events in the caller's trip feed that have not yet been marked as read.
+// @Description  Returns the number of items in the caller's trip feed that have unread events.
 // @Tags         activity-feed
 // @Produce      json
 // @Param        tripID path string true "Trip ID (UUID)"
@@ -68,45 +86,96 @@ func (ctrl *ActivityFeedController) GetUnreadCount(c *fiber.Ctx) error {
 	return c.Status(http.StatusOK).JSON(realtime.UnreadCountResponse{UnreadCount: count})
 }
 
-// @Summary      Mark activity event as read
-// @Description  Removes a specific event from the caller's trip activity feed.
+// @Summary      Mark all activity as read
+// @Description  Marks every item in the caller's trip activity feed as read.
+// @Tags         activity-feed
+// @Param        tripID path string true "Trip ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/activity/read [post]
+// @ID           markAllActivityRead
+func (ctrl *ActivityFeedController) MarkAllRead(c *fiber.Ctx) error {
+	userID, tripID, err := ctrl.extractIDs(c)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.feedService.MarkAllRead(c.Context(), userID, tripID); err != nil {
+		return errs.InternalServerError()
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      Mark activity item as read
+// @Description  Marks every event in an activity feed item as read.
 // @Tags         activity-feed
-// @Param        tripID  path string true "Trip ID"
-// @Param        eventID path string true "Event ID"
+// @Param        tripID path string true "Trip ID"
+// @Param        itemID path string true "Feed item ID"
 // @Success      204
 // @Failure      400 {object} errs.APIError
 // @Failure      401 {object} errs.APIError
 // @Failure      500 {object} errs.APIError
-// @Router       /api/v1/trips/{tripID}/activity/{eventID} [delete]
-// @ID           markActivityEventRead
+// @Router       /api/v1/trips/{tripID}/activity/{itemID}/read [post]
+// @ID           markActivityItemRead
 func (ctrl *ActivityFeedController) MarkRead(c *fiber.Ctx) error {
+	return ctrl.setRead(c, true)
+}
+
+// @Summary      Mark activity item as unread
+// @Description  Restores an activity feed item to unread.
+// @Tags         activity-feed
+// @Param        tripID path string true "Trip ID"
+// @Param        itemID path string true "Feed item ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/activity/{itemID}/unread [post]
+// @ID           markActivityItemUnread
+func (ctrl *ActivityFeedController) MarkUnread(c *fiber.Ctx) error {
+	return ctrl.setRead(c, false)
+}
+
+func (ctrl *ActivityFeedController) setRead(c *fiber.Ctx, read bool) error {
 	userID, tripID, err := ctrl.extractIDs(c)
 	if err != nil {
 		return err
 	}
 
-	eventID := c.Params("eventID")
-	if eventID == "" {
-		return errs.BadRequest(fiber.NewError(http.StatusBadRequest, "eventID is required"))
+	itemID, err := validators.ValidateID(c.Params("itemID"))
+	if err != nil {
+		return errs.InvalidUUID()
 	}
 
-	if err := ctrl.feedService.MarkRead(c.Context(), userID, tripID, eventID); err != nil {
+	if read {
+		err = ctrl.feedService.MarkRead(c.Context(), userID, tripID, itemID)
+	} else {
+		err = ctrl.feedService.MarkUnread(c.Context(), userID, tripID, itemID)
+	}
+	if err != nil {
 		return errs.InternalServerError()
 	}
 
 	return c.SendStatus(http.StatusNoContent)
 }
 
-func (ctrl *ActivityFeedController) extractIDs(c *fiber.Ctx) (userID, tripID string, err error) {
+func (ctrl *ActivityFeedController) extractIDs(c *fiber.Ctx) (userID, tripID uuid.UUID, err error) {
 	userIDVal, ok := c.Locals("userID").(string)
 	if !ok || userIDVal == "" {
-		return "", "", errs.Unauthorized()
+		return uuid.Nil, uuid.Nil, errs.Unauthorized()
+	}
+	userID, err = validators.ValidateID(userIDVal)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errs.Unauthorized()
 	}
 
-	tripIDParam := c.Params("tripID")
-	if _, err := validators.ValidateID(tripIDParam); err != nil {
-		return "", "", errs.BadRequest(err)
+	tripID, err = validators.ValidateID(c.Params("tripID"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errs.BadRequest(err)
 	}
 
-	return userIDVal, tripIDParam, nil
+	return userID, tripID, nil
 }
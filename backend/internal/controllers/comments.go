@@ -150,11 +150,11 @@ func (cmt *CommentController) DeleteComment(c *fiber.Ctx) error {
 }
 
 // @Summary      Get comments
-// @Description  Retrieves paginated comments for a trip entity
+// @Description  Retrieves paginated comments for a trip entity, leaving out hidden comments and comments from blocked users
 // @Tags         comments
 // @Produce      json
 // @Param        tripID path string true "Trip ID"
-// @Param        entityType path string true "Entity type (activity, pitch)"
+// @Param        entityType path string true "Entity type (activity, pitch, task)"
 // @Param        entityID path string true "Entity ID"
 // @Param        limit query int false "Max items per page (default 20, max 100)"
 // @Param        cursor query string false "Opaque cursor returned in next_cursor"
@@ -177,7 +177,7 @@ func (cmt *CommentController) GetPaginatedComments(c *fiber.Ctx) error {
 		return errs.InvalidUUID()
 	}
 
-	entityType, err := utilities.ParseEntityTypeParam(c, "entityType", "entity_type", models.ActivityEntity, models.PitchEntity)
+	entityType, err := utilities.ParseEntityTypeParam(c, "entityType", "entity_type", models.ActivityEntity, models.PitchEntity, models.TaskEntity)
 	if err != nil {
 		return err
 	}
@@ -187,9 +187,14 @@ func (cmt *CommentController) GetPaginatedComments(c *fiber.Ctx) error {
 		return err
 	}
 
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
 	limit, cursorToken := utilities.ExtractLimitAndCursor(&params.CursorPaginationParams)
 
-	response, err := cmt.commentService.GetPaginatedComments(c.Context(), tripID, entityType, entityID, limit, cursorToken)
+	response, err := cmt.commentService.GetPaginatedComments(c.Context(), tripID, entityType, entityID, userID, limit, cursorToken)
 	if err != nil {
 		if errors.Is(err, errs.ErrInvalidCursor) {
 			return errs.BadRequest(err)
@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"net/http"
+	"toggo/internal/realtime"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EventCatalogueController struct {
+	catalogue *realtime.EventCatalogue
+}
+
+func NewEventCatalogueController(registry realtime.EventRegistry) *EventCatalogueController {
+	return &EventCatalogueController{catalogue: realtime.BuildEventCatalogue(registry.GetAllSchemas())}
+}
+
+// @Summary      Get realtime event catalogue
+// @Description  Returns the JSON Schema for the realtime event envelope and the versioned payload schema of every topic
+// @Tags         realtime
+// @Produce      json
+// @Success      200 {object} realtime.EventCatalogue
+// @Router       /api/realtime/events/catalogue [get]
+// @ID           getRealtimeEventCatalogue
+func (ctrl *EventCatalogueController) GetCatalogue(c *fiber.Ctx) error {
+	return c.Status(http.StatusOK).JSON(ctrl.catalogue)
+}
@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/services"
+	"toggo/internal/utilities"
+	"toggo/internal/validators"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type InboxController struct {
+	inboxService services.InboxServiceInterface
+	validator    *validator.Validate
+}
+
+func NewInboxController(inboxService services.InboxServiceInterface, validator *validator.Validate) *InboxController {
+	return &InboxController{inboxService: inboxService, validator: validator}
+}
+
+// @Summary      Get notification inbox
+// @Description  Returns the caller's activity feed merged across all of their trips, newest first. Pass trip_id one or more times to limit the inbox to specific trips.
+// @Tags         inbox
+// @Produce      json
+// @Param        limit       query int      false "Max items to return (default 20, max 100)"
+// @Param        cursor      query string   false "Opaque cursor returned in next_cursor"
+// @Param        unread_only query bool     false "Only return items with unread events"
+// @Param        trip_id     query []string false "Only include these trips" collectionFormat(multi)
+// @Success      200 {object} models.ActivityFeedPageResult
+// @Failure      400 {object} errs.APIError "Invalid trip ID or cursor"
+// @Failure      401 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/inbox [get]
+// @ID           getInbox
+func (ctrl *InboxController) GetInbox(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var params models.GetInboxQueryParams
+	if err := utilities.ParseAndValidateQueryParams(c, ctrl.validator, &params); err != nil {
+		return err
+	}
+	limit, cursorToken := utilities.ExtractLimitAndCursor(&params.CursorPaginationParams)
+
+	tripIDs := make([]uuid.UUID, 0, len(params.TripIDs))
+	for _, raw := range params.TripIDs {
+		tripID, err := validators.ValidateID(raw)
+		if err != nil {
+			return errs.InvalidUUID()
+		}
+		tripIDs = append(tripIDs, tripID)
+	}
+
+	result, err := ctrl.inboxService.GetInbox(c.Context(), userID, tripIDs, params.UnreadOnly, limit, cursorToken)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidCursor) {
+			return errs.BadRequest(err)
+		}
+		return errs.InternalServerError()
+	}
+
+	return c.Status(http.StatusOK).JSON(result)
+}
+
+// @Summary      Get inbox unread count
+// @Description  Returns the total unread badge count across all of the caller's trips, with a per-trip breakdown.
+// @Tags         inbox
+// @Produce      json
+// @Success      200 {object} models.InboxUnreadCountResponse
+// @Failure      401 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/inbox/unread-count [get]
+// @ID           getInboxUnreadCount
+func (ctrl *InboxController) GetUnreadCount(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := ctrl.inboxService.GetUnreadCount(c.Context(), userID)
+	if err != nil {
+		return errs.InternalServerError()
+	}
+
+	return c.Status(http.StatusOK).JSON(result)
+}
+
+// @Summary      Mark inbox as read
+// @Description  Marks every item in the caller's inbox as read. Provide trip_ids to only mark those trips.
+// @Tags         inbox
+// @Accept       json
+// @Param        request body models.MarkInboxReadRequest false "Optional trip filter"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/inbox/read [post]
+// @ID           markInboxRead
+func (ctrl *InboxController) MarkAllRead(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.MarkInboxReadRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errs.InvalidJSON()
+		}
+	}
+
+	if err := ctrl.inboxService.MarkAllRead(c.Context(), userID, req.TripIDs); err != nil {
+		return errs.InternalServerError()
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -10,11 +10,13 @@ import (
 
 type InvitePageController struct {
 	invitePageService services.InvitePageServiceInterface
+	shareService      services.TripShareServiceInterface
 }
 
-func NewInvitePageController(invitePageService services.InvitePageServiceInterface) *InvitePageController {
+func NewInvitePageController(invitePageService services.InvitePageServiceInterface, shareService services.TripShareServiceInterface) *InvitePageController {
 	return &InvitePageController{
 		invitePageService: invitePageService,
+		shareService:      shareService,
 	}
 }
 
@@ -95,3 +97,45 @@ func (ctrl *InvitePageController) renderTripInvitePage(c *fiber.Ctx, code string
 	c.Set("Content-Type", "text/html; charset=utf-8")
 	return c.Status(http.StatusOK).Send(html)
 }
+
+// SharePage handles GET /share/:token — the public, read-only view of a trip for
+// people without the app. Pages are kept out of search indexes.
+func (ctrl *InvitePageController) SharePage(c *fiber.Ctx) error {
+	data, err := ctrl.shareService.GetTripSharePageData(c.Context(), c.Params("token"))
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString("Something went wrong")
+	}
+
+	pageTitle := "Shared trip — Toggo"
+	metaDesc := "See the trip plan on Toggo."
+	metaImage := ""
+	if data.TripName != "" {
+		pageTitle = data.TripName + " — Toggo"
+		metaDesc = "See the plan for " + data.TripName + " on Toggo."
+	}
+	if data.CoverImageURL != nil {
+		metaImage = *data.CoverImageURL
+	}
+
+	view := templates.TripShareView{
+		AppName:         "Toggo",
+		PageTitle:       pageTitle,
+		MetaDescription: metaDesc,
+		MetaImage:       metaImage,
+		CanonicalURL:    data.CanonicalURL,
+		Data:            data,
+	}
+
+	html, err := templates.RenderTripSharePage(view)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString("Failed to render page")
+	}
+
+	status := http.StatusOK
+	if data.ErrorMessage != "" {
+		status = http.StatusNotFound
+	}
+	c.Set("Content-Type", "text/html; charset=utf-8")
+	c.Set("X-Robots-Tag", "noindex, nofollow")
+	return c.Status(status).Send(html)
+}
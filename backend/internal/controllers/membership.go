@@ -31,7 +31,8 @@ func NewMembershipController(membershipService services.MembershipServiceInterfa
 // @Produce      json
 // @Param        code path string true "Invite code"
 // @Success      201 {object} models.Membership
-// @Failure      400 {object} errs.APIError "Invalid or expired invite code"
+// @Success      202 {object} models.TripInviteJoin "Join request awaiting admin approval"
+// @Failure      400 {object} errs.APIError "Invalid, expired or used-up invite code"
 // @Failure      401 {object} errs.APIError
 // @Failure      500 {object} errs.APIError
 // @Router       /api/v1/trip-invites/{code}/join [post]
@@ -57,7 +58,69 @@ func (ctrl *MembershipController) JoinTripByInvite(c *fiber.Ctx) error {
 		return errs.BadRequest(errors.New("invite code is required"))
 	}
 
-	membership, err := ctrl.membershipService.JoinTripByInviteCode(c.Context(), userID, code)
+	result, err := ctrl.membershipService.JoinTripByInviteCode(c.Context(), userID, code)
+	if err != nil {
+		return err
+	}
+
+	if result.Request != nil {
+		return c.Status(http.StatusAccepted).JSON(result.Request)
+	}
+	return c.Status(http.StatusCreated).JSON(result.Membership)
+}
+
+// @Summary      List join requests
+// @Description  Returns pending requests to join the trip through invites that require approval, oldest first (trip admins only)
+// @Tags         memberships
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.TripInviteJoinsResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/join-requests [get]
+// @ID           listJoinRequests
+func (ctrl *MembershipController) ListJoinRequests(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	requests, err := ctrl.membershipService.ListJoinRequests(c.Context(), tripID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.TripInviteJoinsResponse{Items: requests})
+}
+
+// @Summary      Approve join request
+// @Description  Adds the requester to the trip (trip admins only)
+// @Tags         memberships
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        requestID path string true "Join request ID"
+// @Success      201 {object} models.Membership
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/join-requests/{requestID}/approve [post]
+// @ID           approveJoinRequest
+func (ctrl *MembershipController) ApproveJoinRequest(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	requestID, err := validators.ValidateID(c.Params("requestID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	membership, err := ctrl.membershipService.ApproveJoinRequest(c.Context(), tripID, requestID, userID)
 	if err != nil {
 		return err
 	}
@@ -65,6 +128,37 @@ func (ctrl *MembershipController) JoinTripByInvite(c *fiber.Ctx) error {
 	return c.Status(http.StatusCreated).JSON(membership)
 }
 
+// @Summary      Reject join request
+// @Description  Declines the request and frees its use of the invite (trip admins only)
+// @Tags         memberships
+// @Param        tripID path string true "Trip ID"
+// @Param        requestID path string true "Join request ID"
+// @Success      204 "No Content"
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/join-requests/{requestID}/reject [post]
+// @ID           rejectJoinRequest
+func (ctrl *MembershipController) RejectJoinRequest(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	requestID, err := validators.ValidateID(c.Params("requestID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	if err := ctrl.membershipService.RejectJoinRequest(c.Context(), tripID, requestID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
 // @Summary      Add member to trip
 // @Description  Adds a user as a member of a trip
 // @Tags         memberships
@@ -211,7 +305,7 @@ func (ctrl *MembershipController) UpdateMembership(c *fiber.Ctx) error {
 }
 
 // @Summary      Remove member from trip
-// @Description  Removes a user from a trip. Only admins can remove members.
+// @Description  Removes a user from a trip. Members can remove themselves; owners and organisers can remove members and viewers, and only the owner can remove organisers. If the owner leaves, the longest-standing organiser becomes owner. The member's pitches and activities are reassigned to the owner or anonymised per the trip's departed_content_policy.
 // @Tags         memberships
 // @Param        tripID path string true "Trip ID"
 // @Param        userID path string true "User ID"
@@ -234,7 +328,7 @@ func (ctrl *MembershipController) RemoveMember(c *fiber.Ctx) error {
 		return errs.InvalidUUID()
 	}
 
-	// Admins can remove anyone; members can only remove themselves
+	// Members can remove themselves; removing others is checked against roles
 	authUserID, ok := c.Locals("userID").(string)
 	if !ok {
 		return errs.Unauthorized()
@@ -243,15 +337,8 @@ func (ctrl *MembershipController) RemoveMember(c *fiber.Ctx) error {
 	if err != nil {
 		return errs.Unauthorized()
 	}
-	isAdmin, err := ctrl.membershipService.IsAdmin(c.Context(), tripID, authUserUUID)
-	if err != nil {
-		return err
-	}
-	if !isAdmin && authUserUUID != userID {
-		return errs.Forbidden()
-	}
 
-	if err := ctrl.membershipService.RemoveMember(c.Context(), tripID, userID); err != nil {
+	if err := ctrl.membershipService.RemoveMember(c.Context(), tripID, userID, authUserUUID); err != nil {
 		return err
 	}
 
@@ -259,7 +346,7 @@ func (ctrl *MembershipController) RemoveMember(c *fiber.Ctx) error {
 }
 
 // @Summary      Promote member to admin
-// @Description  Promotes a member to admin role
+// @Description  Makes a member an organiser. Kept for older clients; use the role endpoint instead.
 // @Tags         memberships
 // @Param        tripID path string true "Trip ID"
 // @Param        userID path string true "User ID"
@@ -281,7 +368,6 @@ func (ctrl *MembershipController) PromoteToAdmin(c *fiber.Ctx) error {
 		return errs.InvalidUUID()
 	}
 
-	// Check if authenticated user is an admin
 	authUserID, ok := c.Locals("userID").(string)
 	if !ok {
 		return errs.Unauthorized()
@@ -290,21 +376,59 @@ func (ctrl *MembershipController) PromoteToAdmin(c *fiber.Ctx) error {
 	if err != nil {
 		return errs.InvalidUUID()
 	}
-	isAdmin, err := ctrl.membershipService.IsAdmin(c.Context(), tripID, authUserUUID)
+
+	if err := ctrl.membershipService.PromoteToAdmin(c.Context(), tripID, userID, authUserUUID); err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "Member promoted to admin successfully",
+	})
+}
+
+// @Summary      Update member role
+// @Description  Sets a member's role to organiser, member or viewer. Owners can change anyone else's role; organisers can change members' and viewers' roles and step down themselves. Ownership cannot be assigned here.
+// @Tags         memberships
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        userID path string true "User ID"
+// @Param        request body models.UpdateMemberRoleRequest true "New role"
+// @Success      200 {object} models.Membership
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/memberships/{userID}/role [put]
+// @ID           updateMemberRole
+func (ctrl *MembershipController) UpdateMemberRole(c *fiber.Ctx) error {
+	tripID, actorID, err := tripAndUserIDs(c)
 	if err != nil {
 		return err
 	}
-	if !isAdmin {
-		return errs.Forbidden()
+
+	userID, err := validators.ValidateID(c.Params("userID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	var req models.UpdateMemberRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
 	}
 
-	if err := ctrl.membershipService.PromoteToAdmin(c.Context(), tripID, userID); err != nil {
+	if err := validators.Validate(ctrl.validator, req); err != nil {
 		return err
 	}
 
-	return c.Status(http.StatusOK).JSON(fiber.Map{
-		"message": "Member promoted to admin successfully",
-	})
+	membership, err := ctrl.membershipService.UpdateMemberRole(c.Context(), tripID, userID, actorID, req.Role)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(membership)
 }
 
 // @Summary      Update notification preferences
@@ -365,7 +489,7 @@ func (ctrl *MembershipController) UpdateNotificationPreferences(c *fiber.Ctx) er
 }
 
 // @Summary      Demote admin to member
-// @Description  Demotes an admin to regular member role
+// @Description  Makes an organiser a member. Kept for older clients; use the role endpoint instead.
 // @Tags         memberships
 // @Param        tripID path string true "Trip ID"
 // @Param        userID path string true "User ID"
@@ -387,7 +511,6 @@ func (ctrl *MembershipController) DemoteFromAdmin(c *fiber.Ctx) error {
 		return errs.InvalidUUID()
 	}
 
-	// Check if authenticated user is an admin
 	authUserID, ok := c.Locals("userID").(string)
 	if !ok {
 		return errs.Unauthorized()
@@ -396,19 +519,87 @@ func (ctrl *MembershipController) DemoteFromAdmin(c *fiber.Ctx) error {
 	if err != nil {
 		return errs.InvalidUUID()
 	}
-	isAdmin, err := ctrl.membershipService.IsAdmin(c.Context(), tripID, authUserUUID)
+
+	if err := ctrl.membershipService.DemoteFromAdmin(c.Context(), tripID, userID, authUserUUID); err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "Admin demoted to member successfully",
+	})
+}
+
+// @Summary      Leave trip
+// @Description  Removes the current user from the trip. The last admin must name a successor, who becomes an organiser; a leaving owner hands ownership to the successor, or to the longest-standing organiser when none is named. The user's pitches and activities are reassigned to the owner or anonymised per the trip's departed_content_policy.
+// @Tags         memberships
+// @Accept       json
+// @Param        tripID path string true "Trip ID"
+// @Param        request body models.LeaveTripRequest false "Successor"
+// @Success      204 "No Content"
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/leave [post]
+// @ID           leaveTrip
+func (ctrl *MembershipController) LeaveTrip(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.LeaveTripRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errs.InvalidJSON()
+		}
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	if err := ctrl.membershipService.LeaveTrip(c.Context(), tripID, userID, req); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      Transfer trip ownership
+// @Description  Makes another member the trip's owner. The current owner stays on as an organiser (owner only).
+// @Tags         memberships
+// @Accept       json
+// @Param        tripID path string true "Trip ID"
+// @Param        request body models.TransferOwnershipRequest true "New owner"
+// @Success      204 "No Content"
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/ownership [post]
+// @ID           transferTripOwnership
+func (ctrl *MembershipController) TransferOwnership(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
 	if err != nil {
 		return err
 	}
-	if !isAdmin {
-		return errs.Forbidden()
+
+	var req models.TransferOwnershipRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
 	}
 
-	if err := ctrl.membershipService.DemoteFromAdmin(c.Context(), tripID, userID); err != nil {
+	if err := validators.Validate(ctrl.validator, req); err != nil {
 		return err
 	}
 
-	return c.Status(http.StatusOK).JSON(fiber.Map{
-		"message": "Admin demoted to member successfully",
-	})
+	if err := ctrl.membershipService.TransferOwnership(c.Context(), tripID, userID, req.UserID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
 }
@@ -0,0 +1,276 @@
+package controllers
+
+import (
+	"net/http"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/services"
+	"toggo/internal/utilities"
+	"toggo/internal/validators"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ModerationController struct {
+	moderationService services.ModerationServiceInterface
+	validator         *validator.Validate
+}
+
+func NewModerationController(moderationService services.ModerationServiceInterface, validator *validator.Validate) *ModerationController {
+	return &ModerationController{
+		moderationService: moderationService,
+		validator:         validator,
+	}
+}
+
+// @Summary      Block a user
+// @Description  Hides the user's comments and reactions from the authenticated user and stops them from sending direct invites. Blocking someone already blocked succeeds.
+// @Tags         users
+// @Accept       json
+// @Param        request body models.BlockUserRequest true "User to block"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/blocks [post]
+// @ID           blockUser
+func (ctrl *ModerationController) BlockUser(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.BlockUserRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	if err := ctrl.moderationService.BlockUser(c.Context(), userID, req.UserID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      Unblock a user
+// @Description  Removes a block the authenticated user placed on another user
+// @Tags         users
+// @Param        userID path string true "Blocked user ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/blocks/{userID} [delete]
+// @ID           unblockUser
+func (ctrl *ModerationController) UnblockUser(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	blockedID, err := validators.ValidateID(c.Params("userID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	if err := ctrl.moderationService.UnblockUser(c.Context(), userID, blockedID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      List blocked users
+// @Description  Returns the users the authenticated user has blocked, most recent first
+// @Tags         users
+// @Produce      json
+// @Success      200 {object} models.BlockedUsersResponse
+// @Failure      401 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/blocks [get]
+// @ID           listBlockedUsers
+func (ctrl *ModerationController) ListBlockedUsers(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	users, err := ctrl.moderationService.ListBlockedUsers(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.BlockedUsersResponse{Items: users})
+}
+
+// @Summary      Report content
+// @Description  Reports a comment, pitch or activity in the trip to its moderators. Reporting the same content again returns the pending report.
+// @Tags         moderation
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        request body models.CreateContentReportRequest true "Reported content and reason"
+// @Success      201 {object} models.ContentReport
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/reports [post]
+// @ID           reportContent
+func (ctrl *ModerationController) ReportContent(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.CreateContentReportRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	report, err := ctrl.moderationService.ReportContent(c.Context(), tripID, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(report)
+}
+
+// @Summary      Get the moderation queue
+// @Description  Returns the trip's pending reports, oldest first (requires moderate_content)
+// @Tags         moderation
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.ModerationQueueResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/moderation/reports [get]
+// @ID           getModerationQueue
+func (ctrl *ModerationController) ListReports(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	items, err := ctrl.moderationService.ListReports(c.Context(), tripID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.ModerationQueueResponse{Items: items})
+}
+
+// @Summary      Hide reported content
+// @Description  Hides the reported comment, pitch or activity from the trip and resolves every pending report on it (requires moderate_content)
+// @Tags         moderation
+// @Param        tripID path string true "Trip ID"
+// @Param        reportID path string true "Report ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/moderation/reports/{reportID}/hide [post]
+// @ID           hideReportedContent
+func (ctrl *ModerationController) HideReportedContent(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	reportID, err := validators.ValidateID(c.Params("reportID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	if err := ctrl.moderationService.HideReportedContent(c.Context(), tripID, reportID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      Dismiss a report
+// @Description  Closes a pending report without hiding the content (requires moderate_content)
+// @Tags         moderation
+// @Param        tripID path string true "Trip ID"
+// @Param        reportID path string true "Report ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/moderation/reports/{reportID}/dismiss [post]
+// @ID           dismissContentReport
+func (ctrl *ModerationController) DismissReport(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	reportID, err := validators.ValidateID(c.Params("reportID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	if err := ctrl.moderationService.DismissReport(c.Context(), tripID, reportID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      Unhide content
+// @Description  Shows a hidden comment, pitch or activity in the trip again (requires moderate_content)
+// @Tags         moderation
+// @Param        tripID path string true "Trip ID"
+// @Param        entityType path string true "Entity type (comment, pitch, activity)"
+// @Param        entityID path string true "Entity ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/moderation/hidden/{entityType}/{entityID} [delete]
+// @ID           unhideContent
+func (ctrl *ModerationController) UnhideContent(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	entityType, err := utilities.ParseEntityTypeParam(c, "entityType", "entity_type", models.CommentEntity, models.PitchEntity, models.ActivityEntity)
+	if err != nil {
+		return err
+	}
+
+	entityID, err := validators.ValidateID(c.Params("entityID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	if err := ctrl.moderationService.UnhideContent(c.Context(), tripID, entityType, entityID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
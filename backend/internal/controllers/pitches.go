@@ -228,6 +228,11 @@ func (ctrl *PitchController) UpdatePitch(c *fiber.Ctx) error {
 // @Router       /api/v1/trips/{tripID}/pitches/{pitchID} [delete]
 // @ID           deletePitch
 func (ctrl *PitchController) DeletePitch(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
 	tripID, err := validators.ValidateID(c.Params("tripID"))
 	if err != nil {
 		return errs.InvalidUUID()
@@ -237,7 +242,7 @@ func (ctrl *PitchController) DeletePitch(c *fiber.Ctx) error {
 		return errs.InvalidUUID()
 	}
 
-	if err := ctrl.pitchService.Delete(c.Context(), tripID, pitchID); err != nil {
+	if err := ctrl.pitchService.Delete(c.Context(), tripID, pitchID, userID); err != nil {
 		if errs.IsNotFound(err) {
 			return errs.NewAPIError(http.StatusNotFound, err)
 		}
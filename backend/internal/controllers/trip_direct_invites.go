@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"net/http"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/services"
+	"toggo/internal/validators"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TripDirectInviteController struct {
+	inviteService services.TripDirectInviteServiceInterface
+	validator     *validator.Validate
+}
+
+func NewTripDirectInviteController(inviteService services.TripDirectInviteServiceInterface, validator *validator.Validate) *TripDirectInviteController {
+	return &TripDirectInviteController{
+		inviteService: inviteService,
+		validator:     validator,
+	}
+}
+
+// @Summary      Invite a user to a trip
+// @Description  Invites a user, found by username or phone number, to join the trip. The invitee is notified and accepts or declines. Inviting someone with a pending invite returns that invite. Invites by phone number are answered with 202 and no body whether or not the number belongs to a user who can be invited. Rate limited per user.
+// @Tags         trips
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        request body models.CreateTripDirectInviteRequest true "Username or phone number"
+// @Success      201 {object} models.TripDirectInvite
+// @Success      202
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      429 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/direct-invites [post]
+// @ID           createTripDirectInvite
+func (ctrl *TripDirectInviteController) InviteUser(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.CreateTripDirectInviteRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	invite, err := ctrl.inviteService.InviteUser(c.Context(), tripID, userID, req)
+	if err != nil {
+		return err
+	}
+	if invite == nil {
+		return c.SendStatus(http.StatusAccepted)
+	}
+
+	return c.Status(http.StatusCreated).JSON(invite)
+}
+
+// @Summary      List trip direct invites
+// @Description  Returns the trip's pending direct invites (requires manage_invites)
+// @Tags         trips
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.TripDirectInvitesResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/direct-invites [get]
+// @ID           listTripDirectInvites
+func (ctrl *TripDirectInviteController) ListTripInvites(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	invites, err := ctrl.inviteService.ListTripInvites(c.Context(), tripID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.TripDirectInvitesResponse{Items: invites})
+}
+
+// @Summary      Cancel a direct invite
+// @Description  Withdraws a pending direct invite. Inviters can cancel their own; members with manage_invites can cancel any.
+// @Tags         trips
+// @Param        tripID path string true "Trip ID"
+// @Param        inviteID path string true "Invite ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/direct-invites/{inviteID} [delete]
+// @ID           cancelTripDirectInvite
+func (ctrl *TripDirectInviteController) CancelInvite(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	inviteID, err := validators.ValidateID(c.Params("inviteID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	if err := ctrl.inviteService.CancelInvite(c.Context(), tripID, inviteID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      List received trip invites
+// @Description  Returns the direct invites waiting for the authenticated user, newest first
+// @Tags         users
+// @Produce      json
+// @Success      200 {object} models.TripDirectInvitesResponse
+// @Failure      401 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/trip-invites [get]
+// @ID           listReceivedTripInvites
+func (ctrl *TripDirectInviteController) ListReceivedInvites(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	invites, err := ctrl.inviteService.ListReceivedInvites(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.TripDirectInvitesResponse{Items: invites})
+}
+
+// @Summary      Accept a trip invite
+// @Description  Accepts a direct invite sent to the authenticated user and joins the trip as a member
+// @Tags         users
+// @Produce      json
+// @Param        inviteID path string true "Invite ID"
+// @Success      200 {object} models.Membership
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/trip-invites/{inviteID}/accept [post]
+// @ID           acceptTripInvite
+func (ctrl *TripDirectInviteController) AcceptInvite(c *fiber.Ctx) error {
+	inviteID, userID, err := inviteAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	membership, err := ctrl.inviteService.AcceptInvite(c.Context(), inviteID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(membership)
+}
+
+// @Summary      Decline a trip invite
+// @Description  Declines a direct invite sent to the authenticated user
+// @Tags         users
+// @Param        inviteID path string true "Invite ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/trip-invites/{inviteID}/decline [post]
+// @ID           declineTripInvite
+func (ctrl *TripDirectInviteController) DeclineInvite(c *fiber.Ctx) error {
+	inviteID, userID, err := inviteAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.inviteService.DeclineInvite(c.Context(), inviteID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func inviteAndUserIDs(c *fiber.Ctx) (uuid.UUID, uuid.UUID, error) {
+	inviteID, err := validators.ValidateID(c.Params("inviteID"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errs.InvalidUUID()
+	}
+
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return inviteID, userID, nil
+}
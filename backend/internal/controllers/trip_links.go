@@ -71,6 +71,11 @@ func (ctrl *PitchLinkController) GetLinks(c *fiber.Ctx) error {
 }
 
 func (ctrl *PitchLinkController) DeleteLink(c *fiber.Ctx) error {
+	tripID, err := validators.ValidateID(c.Params("tripID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
 	pitchID, err := validators.ValidateID(c.Params("pitchID"))
 	if err != nil {
 		return errs.InvalidUUID()
@@ -81,7 +86,12 @@ func (ctrl *PitchLinkController) DeleteLink(c *fiber.Ctx) error {
 		return errs.InvalidUUID()
 	}
 
-	if err := ctrl.linkService.DeleteLink(c.Context(), pitchID, linkID); err != nil {
+	userID, err := validators.ValidateID(c.Locals("userID").(string))
+	if err != nil {
+		return errs.Unauthorized()
+	}
+
+	if err := ctrl.linkService.DeleteLink(c.Context(), tripID, pitchID, linkID, userID); err != nil {
 		return err
 	}
 
@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"net/http"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/services"
+	"toggo/internal/validators"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TripShareController struct {
+	shareService services.TripShareServiceInterface
+	validator    *validator.Validate
+}
+
+func NewTripShareController(shareService services.TripShareServiceInterface, validator *validator.Validate) *TripShareController {
+	return &TripShareController{
+		shareService: shareService,
+		validator:    validator,
+	}
+}
+
+// @Summary      Get trip share link
+// @Description  Returns the trip's public, read-only share link and the tabs it shows (trip admins only)
+// @Tags         trips
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.TripShareLinkAPIResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/share-link [get]
+// @ID           getTripShareLink
+func (ctrl *TripShareController) GetShareLink(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	link, err := ctrl.shareService.GetShareLink(c.Context(), tripID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(link)
+}
+
+// @Summary      Enable trip share link
+// @Description  Turns on the trip's public, read-only web view, or changes which tabs it shows (trip admins only). A new link shows every tab unless visible_tabs is set.
+// @Tags         trips
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        request body models.UpdateTripShareLinkRequest true "Visible tabs"
+// @Success      200 {object} models.TripShareLinkAPIResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/share-link [put]
+// @ID           enableTripShareLink
+func (ctrl *TripShareController) EnableShareLink(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.UpdateTripShareLinkRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	link, err := ctrl.shareService.EnableShareLink(c.Context(), tripID, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(link)
+}
+
+// @Summary      Rotate trip share link
+// @Description  Replaces the share link's token so the previous link stops working (trip admins only)
+// @Tags         trips
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.TripShareLinkAPIResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/share-link/rotate [post]
+// @ID           rotateTripShareLink
+func (ctrl *TripShareController) RotateShareLink(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	link, err := ctrl.shareService.RotateShareLink(c.Context(), tripID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(link)
+}
+
+// @Summary      Disable trip share link
+// @Description  Turns off the trip's public web view (trip admins only)
+// @Tags         trips
+// @Param        tripID path string true "Trip ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/share-link [delete]
+// @ID           disableTripShareLink
+func (ctrl *TripShareController) DisableShareLink(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.shareService.DisableShareLink(c.Context(), tripID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/services"
+	"toggo/internal/validators"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/slack-go/slack"
+)
+
+type TripSlackController struct {
+	slackService       services.SlackTripServiceInterface
+	interactionService services.SlackInteractionServiceInterface
+	validator          *validator.Validate
+}
+
+func NewTripSlackController(
+	slackService services.SlackTripServiceInterface,
+	interactionService services.SlackInteractionServiceInterface,
+	validator *validator.Validate,
+) *TripSlackController {
+	return &TripSlackController{
+		slackService:       slackService,
+		interactionService: interactionService,
+		validator:          validator,
+	}
+}
+
+// @Summary      Get trip Slack channel
+// @Description  Returns the Slack channel the trip posts to
+// @Tags         slack
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.TripSlackChannel
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/slack [get]
+// @ID           getTripSlackChannel
+func (ctrl *TripSlackController) GetChannel(c *fiber.Ctx) error {
+	tripID, err := validators.ValidateID(c.Params("tripID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	link, err := ctrl.slackService.GetChannel(c.Context(), tripID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(link)
+}
+
+// @Summary      Link trip to Slack channel
+// @Description  Posts the trip's new polls (with vote buttons), pitches and finalised decisions to a Slack channel, replacing any previous channel (trip admins only). The Slack app must already be in the channel.
+// @Tags         slack
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        request body models.LinkSlackChannelRequest true "Slack channel"
+// @Success      200 {object} models.TripSlackChannel
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/slack [put]
+// @ID           linkTripSlackChannel
+func (ctrl *TripSlackController) LinkChannel(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.LinkSlackChannelRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	link, err := ctrl.slackService.LinkChannel(c.Context(), tripID, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(link)
+}
+
+// @Summary      Unlink trip from Slack
+// @Description  Stops posting the trip's updates to Slack (trip admins only)
+// @Tags         slack
+// @Param        tripID path string true "Trip ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/slack [delete]
+// @ID           unlinkTripSlackChannel
+func (ctrl *TripSlackController) UnlinkChannel(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.slackService.UnlinkChannel(c.Context(), tripID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      Handle Slack interactions
+// @Description  Receives Slack interaction callbacks, signed with the app's signing secret. Poll vote buttons cast the vote for the trip member whose email matches the Slack user.
+// @Tags         slack
+// @Accept       x-www-form-urlencoded
+// @Param        payload formData string true "Slack interaction payload (JSON)"
+// @Success      200
+// @Failure      400 {string} string
+// @Failure      401 {string} string
+// @Router       /slack/interactions [post]
+// @ID           handleSlackInteraction
+func (ctrl *TripSlackController) HandleInteraction(c *fiber.Ctx) error {
+	var payload slack.InteractionCallback
+	if err := json.Unmarshal([]byte(c.FormValue("payload")), &payload); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("invalid interaction payload")
+	}
+
+	if payload.Type != slack.InteractionTypeBlockActions {
+		return c.SendStatus(fiber.StatusOK)
+	}
+
+	for _, action := range payload.ActionCallback.BlockActions {
+		if !strings.HasPrefix(action.ActionID, models.SlackPollVoteActionID+":") {
+			continue
+		}
+		pollID, optionID, err := services.ParseSlackPollVoteValue(action.Value)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).SendString("invalid poll vote")
+		}
+
+		err = ctrl.interactionService.HandlePollVote(c.Context(), models.SlackPollVote{
+			SlackUserID: payload.User.ID,
+			ChannelID:   payload.Channel.ID,
+			MessageTS:   payload.Container.MessageTs,
+			PollID:      pollID,
+			OptionID:    optionID,
+		})
+		if err != nil {
+			log.Printf("slack: failed to handle vote on poll %s: %v", pollID, err)
+			return err
+		}
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
@@ -0,0 +1,205 @@
+package controllers
+
+import (
+	"net/http"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/services"
+	"toggo/internal/utilities"
+	"toggo/internal/validators"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TripTaskController struct {
+	taskService services.TripTaskServiceInterface
+	validator   *validator.Validate
+}
+
+func NewTripTaskController(taskService services.TripTaskServiceInterface, validator *validator.Validate) *TripTaskController {
+	return &TripTaskController{
+		taskService: taskService,
+		validator:   validator,
+	}
+}
+
+// @Summary      Create a task
+// @Description  Adds a task to the trip, optionally assigned to members and linked to an activity (requires manage_tasks). Assignees are notified.
+// @Tags         tasks
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        request body models.CreateTripTaskRequest true "Task"
+// @Success      201 {object} models.TripTask
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/tasks [post]
+// @ID           createTripTask
+func (ctrl *TripTaskController) CreateTask(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.CreateTripTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	task, err := ctrl.taskService.CreateTask(c.Context(), tripID, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(task)
+}
+
+// @Summary      List tasks
+// @Description  Returns the trip's tasks, open ones first and soonest due first
+// @Tags         tasks
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        status query string false "Only tasks with this status (todo, in_progress, done)"
+// @Param        assignee_id query string false "Only tasks assigned to this user"
+// @Success      200 {object} models.TripTasksResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/tasks [get]
+// @ID           listTripTasks
+func (ctrl *TripTaskController) ListTasks(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var params models.TripTaskQueryParams
+	if err := utilities.ParseAndValidateQueryParams(c, ctrl.validator, &params); err != nil {
+		return err
+	}
+
+	tasks, err := ctrl.taskService.ListTasks(c.Context(), tripID, userID, params)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.TripTasksResponse{Items: tasks})
+}
+
+// @Summary      Get a task
+// @Description  Returns a single task in the trip
+// @Tags         tasks
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        taskID path string true "Task ID"
+// @Success      200 {object} models.TripTask
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/tasks/{taskID} [get]
+// @ID           getTripTask
+func (ctrl *TripTaskController) GetTask(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	taskID, err := validators.ValidateID(c.Params("taskID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	task, err := ctrl.taskService.GetTask(c.Context(), tripID, taskID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(task)
+}
+
+// @Summary      Update a task
+// @Description  Changes a task's details, status, due date, activity or assignees (requires manage_tasks). Assignees can change the status of their own task. Newly assigned members are notified.
+// @Tags         tasks
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        taskID path string true "Task ID"
+// @Param        request body models.UpdateTripTaskRequest true "Fields to change"
+// @Success      200 {object} models.TripTask
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/tasks/{taskID} [patch]
+// @ID           updateTripTask
+func (ctrl *TripTaskController) UpdateTask(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	taskID, err := validators.ValidateID(c.Params("taskID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	var req models.UpdateTripTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	task, err := ctrl.taskService.UpdateTask(c.Context(), tripID, taskID, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(task)
+}
+
+// @Summary      Delete a task
+// @Description  Removes a task and its comments (requires manage_tasks)
+// @Tags         tasks
+// @Param        tripID path string true "Trip ID"
+// @Param        taskID path string true "Task ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/tasks/{taskID} [delete]
+// @ID           deleteTripTask
+func (ctrl *TripTaskController) DeleteTask(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	taskID, err := validators.ValidateID(c.Params("taskID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	if err := ctrl.taskService.DeleteTask(c.Context(), tripID, taskID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
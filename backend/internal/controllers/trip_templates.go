@@ -0,0 +1,224 @@
+package controllers
+
+import (
+	"net/http"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/services"
+	"toggo/internal/validators"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TripTemplateController struct {
+	templateService services.TripTemplateServiceInterface
+	validator       *validator.Validate
+}
+
+func NewTripTemplateController(templateService services.TripTemplateServiceInterface, validator *validator.Validate) *TripTemplateController {
+	return &TripTemplateController{
+		templateService: templateService,
+		validator:       validator,
+	}
+}
+
+// @Summary      Duplicate a trip
+// @Description  Copies the trip's categories, activities, polls and budget into a new trip owned by the caller. Members, pitches, comments, RSVPs, votes and images are not copied. Dates are shifted so the copy starts on start_date; without it the copy is undated.
+// @Tags         trips
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        request body models.DuplicateTripRequest false "Optional name and start date"
+// @Success      201 {object} models.Trip
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/duplicate [post]
+// @ID           duplicateTrip
+func (ctrl *TripTemplateController) DuplicateTrip(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.DuplicateTripRequest
+	_ = c.BodyParser(&req) // optional body; empty or {} copies the trip undated
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	trip, err := ctrl.templateService.DuplicateTrip(c.Context(), userID, tripID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(trip)
+}
+
+// @Summary      Save a trip as a template
+// @Description  Saves the trip's categories, activities, polls and budget as a reusable template (trip admins only). Public templates can be used by anyone.
+// @Tags         trip-templates
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        request body models.CreateTripTemplateRequest true "Template details"
+// @Success      201 {object} models.TripTemplate
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/template [post]
+// @ID           saveTripAsTemplate
+func (ctrl *TripTemplateController) SaveTripAsTemplate(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.CreateTripTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	template, err := ctrl.templateService.SaveTripAsTemplate(c.Context(), userID, tripID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(template)
+}
+
+// @Summary      List trip templates
+// @Description  Returns the caller's templates and every public template, newest first
+// @Tags         trip-templates
+// @Produce      json
+// @Success      200 {object} models.TripTemplateListResponse
+// @Failure      401 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trip-templates [get]
+// @ID           listTripTemplates
+func (ctrl *TripTemplateController) ListTemplates(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	templates, err := ctrl.templateService.ListTemplates(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(templates)
+}
+
+// @Summary      Get a trip template
+// @Description  Returns a public template or one of the caller's own, including its contents
+// @Tags         trip-templates
+// @Produce      json
+// @Param        templateID path string true "Template ID"
+// @Success      200 {object} models.TripTemplate
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trip-templates/{templateID} [get]
+// @ID           getTripTemplate
+func (ctrl *TripTemplateController) GetTemplate(c *fiber.Ctx) error {
+	templateID, userID, err := templateAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	template, err := ctrl.templateService.GetTemplate(c.Context(), userID, templateID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(template)
+}
+
+// @Summary      Delete a trip template
+// @Description  Deletes one of the caller's templates. Trips started from it are kept.
+// @Tags         trip-templates
+// @Param        templateID path string true "Template ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trip-templates/{templateID} [delete]
+// @ID           deleteTripTemplate
+func (ctrl *TripTemplateController) DeleteTemplate(c *fiber.Ctx) error {
+	templateID, userID, err := templateAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.templateService.DeleteTemplate(c.Context(), userID, templateID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      Create a trip from a template
+// @Description  Starts a new trip owned by the caller from a template. Dates are shifted so the trip starts on start_date; without it the trip is undated.
+// @Tags         trip-templates
+// @Accept       json
+// @Produce      json
+// @Param        templateID path string true "Template ID"
+// @Param        request body models.CreateTripFromTemplateRequest false "Optional name and start date"
+// @Success      201 {object} models.Trip
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trip-templates/{templateID}/trips [post]
+// @ID           createTripFromTemplate
+func (ctrl *TripTemplateController) CreateTripFromTemplate(c *fiber.Ctx) error {
+	templateID, userID, err := templateAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.CreateTripFromTemplateRequest
+	_ = c.BodyParser(&req) // optional body; empty or {} uses the template's name
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	trip, err := ctrl.templateService.CreateTripFromTemplate(c.Context(), userID, templateID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(trip)
+}
+
+func templateAndUserIDs(c *fiber.Ctx) (uuid.UUID, uuid.UUID, error) {
+	templateID, err := validators.ValidateID(c.Params("templateID"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errs.InvalidUUID()
+	}
+
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return templateID, userID, nil
+}
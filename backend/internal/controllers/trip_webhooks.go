@@ -0,0 +1,244 @@
+package controllers
+
+import (
+	"net/http"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/services"
+	"toggo/internal/validators"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TripWebhookController struct {
+	webhookService services.TripWebhookServiceInterface
+	validator      *validator.Validate
+}
+
+func NewTripWebhookController(webhookService services.TripWebhookServiceInterface, validator *validator.Validate) *TripWebhookController {
+	return &TripWebhookController{
+		webhookService: webhookService,
+		validator:      validator,
+	}
+}
+
+// @Summary      Create trip webhook
+// @Description  Subscribes a URL to the trip's realtime events (trip admins only). Deliveries are JSON events signed in the X-Toggo-Signature header as "sha256=" plus the hex HMAC-SHA256 of the body. The URL must not point at a local or private address, and redirects are not followed. The secret is only returned here.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        request body models.CreateTripWebhookRequest true "Webhook details"
+// @Success      201 {object} models.CreatedTripWebhookResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/webhooks [post]
+// @ID           createTripWebhook
+func (ctrl *TripWebhookController) CreateWebhook(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.CreateTripWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	webhook, err := ctrl.webhookService.CreateWebhook(c.Context(), tripID, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(webhook)
+}
+
+// @Summary      List trip webhooks
+// @Description  Lists the trip's webhook subscriptions (trip admins only)
+// @Tags         webhooks
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.TripWebhooksResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/webhooks [get]
+// @ID           listTripWebhooks
+func (ctrl *TripWebhookController) ListWebhooks(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := ctrl.webhookService.ListWebhooks(c.Context(), tripID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.TripWebhooksResponse{Items: webhooks})
+}
+
+// @Summary      Update trip webhook
+// @Description  Changes a webhook's URL, topics or enabled state (trip admins only)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        webhookID path string true "Webhook ID"
+// @Param        request body models.UpdateTripWebhookRequest true "Fields to update"
+// @Success      200 {object} models.TripWebhook
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/webhooks/{webhookID} [patch]
+// @ID           updateTripWebhook
+func (ctrl *TripWebhookController) UpdateWebhook(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	webhookID, err := validators.ValidateID(c.Params("webhookID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	var req models.UpdateTripWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(ctrl.validator, req); err != nil {
+		return err
+	}
+
+	webhook, err := ctrl.webhookService.UpdateWebhook(c.Context(), tripID, webhookID, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(webhook)
+}
+
+// @Summary      Delete trip webhook
+// @Description  Removes a webhook subscription and its delivery log (trip admins only)
+// @Tags         webhooks
+// @Param        tripID path string true "Trip ID"
+// @Param        webhookID path string true "Webhook ID"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/webhooks/{webhookID} [delete]
+// @ID           deleteTripWebhook
+func (ctrl *TripWebhookController) DeleteWebhook(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	webhookID, err := validators.ValidateID(c.Params("webhookID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	if err := ctrl.webhookService.DeleteWebhook(c.Context(), tripID, webhookID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      List webhook deliveries
+// @Description  Returns the webhook's most recent deliveries, newest first (trip admins only)
+// @Tags         webhooks
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        webhookID path string true "Webhook ID"
+// @Success      200 {object} models.TripWebhookDeliveriesResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/webhooks/{webhookID}/deliveries [get]
+// @ID           listTripWebhookDeliveries
+func (ctrl *TripWebhookController) ListDeliveries(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	webhookID, err := validators.ValidateID(c.Params("webhookID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	deliveries, err := ctrl.webhookService.ListDeliveries(c.Context(), tripID, webhookID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.TripWebhookDeliveriesResponse{Items: deliveries})
+}
+
+// @Summary      Ping trip webhook
+// @Description  Sends a signed webhook.ping event immediately, without retries, and returns the logged delivery (trip admins only)
+// @Tags         webhooks
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        webhookID path string true "Webhook ID"
+// @Success      200 {object} models.TripWebhookDelivery
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/webhooks/{webhookID}/ping [post]
+// @ID           pingTripWebhook
+func (ctrl *TripWebhookController) PingWebhook(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	webhookID, err := validators.ValidateID(c.Params("webhookID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	delivery, err := ctrl.webhookService.PingWebhook(c.Context(), tripID, webhookID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(delivery)
+}
+
+func tripAndUserIDs(c *fiber.Ctx) (uuid.UUID, uuid.UUID, error) {
+	tripID, err := validators.ValidateID(c.Params("tripID"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errs.InvalidUUID()
+	}
+
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return tripID, userID, nil
+}
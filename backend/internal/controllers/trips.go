@@ -105,6 +105,7 @@ func (ctrl *TripController) GetTrip(c *fiber.Ctx) error {
 // @Param        limit  query int false "Max items per page (default 20, max 100)"
 // @Param        cursor query string false "Opaque cursor from previous response next_cursor for next page"
 // @Param        end_date_before query string false "Only include trips where end_date is before this RFC3339 timestamp"
+// @Param        include_archived query bool false "Include archived trips (default false)"
 // @Success      200 {object} models.TripCursorPageResult
 // @Failure      400 {object} errs.APIError "Invalid cursor"
 // @Failure      401 {object} errs.APIError
@@ -145,7 +146,7 @@ func (ctrl *TripController) GetAllTrips(c *fiber.Ctx) error {
 		endDateBefore = &parsed
 	}
 
-	result, err := ctrl.tripService.GetTripsWithCursor(c.Context(), userID, limit, cursorToken, endDateBefore)
+	result, err := ctrl.tripService.GetTripsWithCursor(c.Context(), userID, limit, cursorToken, endDateBefore, params.IncludeArchived)
 	if err != nil {
 		if errors.Is(err, errs.ErrInvalidCursor) {
 			return errs.BadRequest(err)
@@ -205,7 +206,7 @@ func (ctrl *TripController) UpdateTrip(c *fiber.Ctx) error {
 // @Accept       json
 // @Produce      json
 // @Param        tripID path string true "Trip ID"
-// @Param        request body models.CreateTripInviteRequest true "Optional expires_at; default 7 days"
+// @Param        request body models.CreateTripInviteRequest true "Optional expires_at (default 7 days), max_uses or single_use, and requires_approval"
 // @Success      201 {object} models.TripInviteAPIResponse
 // @Failure      400 {object} errs.APIError
 // @Failure      401 {object} errs.APIError
@@ -250,13 +251,104 @@ func (ctrl *TripController) CreateTripInvite(c *fiber.Ctx) error {
 	return c.Status(http.StatusCreated).JSON(invite)
 }
 
+// @Summary      List trip invites
+// @Description  Returns the trip's invites, newest first, with how many people joined through each (trip admins only)
+// @Tags         trips
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.TripInvitesResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/invites [get]
+// @ID           listTripInvites
+func (ctrl *TripController) ListTripInvites(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	invites, err := ctrl.tripService.ListTripInvites(c.Context(), tripID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.TripInvitesResponse{Items: invites})
+}
+
+// @Summary      Revoke a trip invite
+// @Description  Stops the invite from being used (trip admins and the invite's creator only). Pending join requests can still be decided.
+// @Tags         trips
+// @Param        tripID path string true "Trip ID"
+// @Param        inviteID path string true "Invite ID"
+// @Success      204 "No Content"
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/invites/{inviteID} [delete]
+// @ID           revokeTripInvite
+func (ctrl *TripController) RevokeTripInvite(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	inviteID, err := validators.ValidateID(c.Params("inviteID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	if err := ctrl.tripService.RevokeTripInvite(c.Context(), tripID, inviteID, userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      List invite joins
+// @Description  Returns who joined, or asked to join, through the invite, newest first (trip admins only)
+// @Tags         trips
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Param        inviteID path string true "Invite ID"
+// @Success      200 {object} models.TripInviteJoinsResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/invites/{inviteID}/joins [get]
+// @ID           listTripInviteJoins
+func (ctrl *TripController) ListInviteJoins(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	inviteID, err := validators.ValidateID(c.Params("inviteID"))
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+
+	joins, err := ctrl.tripService.ListInviteJoins(c.Context(), tripID, inviteID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.TripInviteJoinsResponse{Items: joins})
+}
+
 // @Summary      Delete a trip
-// @Description  Deletes a trip by ID
+// @Description  Deletes a trip by ID. It can be restored for 30 days, after which it and its media are permanently removed.
 // @Tags         trips
 // @Param        tripID path string true "Trip ID"
 // @Success      204 "No Content"
 // @Failure      400 {object} errs.APIError
 // @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
 // @Failure      404 {object} errs.APIError
 // @Failure      500 {object} errs.APIError
 // @Router       /api/v1/trips/{tripID} [delete]
@@ -288,3 +380,107 @@ func (ctrl *TripController) DeleteTrip(c *fiber.Ctx) error {
 
 	return c.SendStatus(http.StatusNoContent)
 }
+
+// @Summary      Archive a trip
+// @Description  Makes the trip read-only and hides it from the trip list unless include_archived is set (trip admins only)
+// @Tags         trips
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.Trip
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/archive [post]
+// @ID           archiveTrip
+func (ctrl *TripController) ArchiveTrip(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	trip, err := ctrl.tripService.ArchiveTrip(c.Context(), userID, tripID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(trip)
+}
+
+// @Summary      Unarchive a trip
+// @Description  Makes an archived trip editable again (trip admins only)
+// @Tags         trips
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.Trip
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/{tripID}/unarchive [post]
+// @ID           unarchiveTrip
+func (ctrl *TripController) UnarchiveTrip(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	trip, err := ctrl.tripService.UnarchiveTrip(c.Context(), userID, tripID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(trip)
+}
+
+// @Summary      List deleted trips
+// @Description  Returns the caller's deleted trips that can still be restored, most recently deleted first
+// @Tags         trips
+// @Produce      json
+// @Success      200 {object} models.DeletedTripsResponse
+// @Failure      401 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/deleted [get]
+// @ID           listDeletedTrips
+func (ctrl *TripController) ListDeletedTrips(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	trips, err := ctrl.tripService.ListDeletedTrips(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(models.DeletedTripsResponse{Items: trips})
+}
+
+// @Summary      Restore a deleted trip
+// @Description  Restores a trip deleted in the last 30 days (members who could delete it only)
+// @Tags         trips
+// @Produce      json
+// @Param        tripID path string true "Trip ID"
+// @Success      200 {object} models.Trip
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      403 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/trips/deleted/{tripID}/restore [post]
+// @ID           restoreTrip
+func (ctrl *TripController) RestoreTrip(c *fiber.Ctx) error {
+	tripID, userID, err := tripAndUserIDs(c)
+	if err != nil {
+		return err
+	}
+
+	trip, err := ctrl.tripService.RestoreTrip(c.Context(), userID, tripID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(trip)
+}
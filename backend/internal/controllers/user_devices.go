@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"net/http"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/services"
+	"toggo/internal/validators"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type UserDeviceController struct {
+	deviceService services.UserDeviceServiceInterface
+	validator     *validator.Validate
+}
+
+func NewUserDeviceController(deviceService services.UserDeviceServiceInterface, validator *validator.Validate) *UserDeviceController {
+	return &UserDeviceController{
+		deviceService: deviceService,
+		validator:     validator,
+	}
+}
+
+// @Summary      List my devices
+// @Description  Lists the push notification devices registered by the authenticated user
+// @Tags         devices
+// @Produce      json
+// @Success      200 {array}  models.UserDevice
+// @Failure      401 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/devices [get]
+// @ID           listDevices
+func (d *UserDeviceController) ListDevices(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	devices, err := d.deviceService.ListDevices(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(devices)
+}
+
+// @Summary      Register a device
+// @Description  Registers an Expo push token for the authenticated user. Re-registering an existing token refreshes its last seen time.
+// @Tags         devices
+// @Accept       json
+// @Produce      json
+// @Param        request body models.RegisterDeviceRequest true "Register device request"
+// @Success      200 {object} models.UserDevice
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/devices [post]
+// @ID           registerDevice
+func (d *UserDeviceController) RegisterDevice(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.RegisterDeviceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(d.validator, req); err != nil {
+		return err
+	}
+
+	device, err := d.deviceService.RegisterDevice(c.Context(), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(device)
+}
+
+// @Summary      Unregister a device
+// @Description  Removes an Expo push token from the authenticated user's devices, e.g. on sign-out
+// @Tags         devices
+// @Accept       json
+// @Param        request body models.UnregisterDeviceRequest true "Unregister device request"
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/devices [delete]
+// @ID           unregisterDevice
+func (d *UserDeviceController) UnregisterDevice(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.UnregisterDeviceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(d.validator, req); err != nil {
+		return err
+	}
+
+	if err := d.deviceService.UnregisterDevice(c.Context(), userID, req.Token); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -157,10 +157,10 @@ func (u *UserController) GetUser(c *fiber.Ctx) error {
 }
 
 // @Summary      Delete a user
-// @Description  Deletes a user by ID
+// @Description  Deletes the authenticated user's account. Trips they administer pass to another member and trips they were alone on are deleted. Their comments, pitches, activities and polls stay on their trips without an author; their votes, profile picture and pitch recordings are removed. Deleting an account that no longer exists succeeds.
 // @Tags         users
 // @Param        userID path string true "User ID"
-// @Success      204 "No Content"
+// @Success      204
 // @Failure      400 {object} errs.APIError
 // @Failure      404 {object} errs.APIError
 // @Failure      500 {object} errs.APIError
@@ -172,9 +172,140 @@ func (u *UserController) DeleteUser(c *fiber.Ctx) error {
 		return errs.InvalidUUID()
 	}
 
+	authUserID, ok := c.Locals("userID").(string)
+	if !ok || authUserID != id.String() {
+		return errs.ErrNotFound
+	}
+
 	if err := u.userService.DeleteUser(c.Context(), id); err != nil {
 		return err
 	}
 
 	return c.SendStatus(http.StatusNoContent)
 }
+
+// @Summary      Export my data
+// @Description  Returns everything stored about the authenticated user as a JSON archive: profile, trip memberships, comments, poll votes and rankings, RSVPs, and pitches with links to their audio. Audio links expire like other download links. Rate limited per user.
+// @Tags         users
+// @Produce      json
+// @Success      200 {object} models.UserDataExport
+// @Failure      401 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      429 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/export [get]
+// @ID           exportUserData
+func (u *UserController) ExportUserData(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	export, err := u.userService.ExportUserData(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderContentDisposition, `attachment; filename="toggo-data-export.json"`)
+	return c.Status(http.StatusOK).JSON(export)
+}
+
+// @Summary      Discover contacts
+// @Description  Matches SHA-256 hashes of the device address book's E.164 phone numbers against registered users. The hashes are unsalted, so they identify numbers rather than hide them; users who opted out of discovery are left out. Rate limited per user.
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        request body models.DiscoverContactsRequest true "Up to 500 lowercase hex SHA-256 phone number hashes"
+// @Success      200 {object} models.DiscoverContactsResponse
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      429 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/contacts/discover [post]
+// @ID           discoverContacts
+func (u *UserController) DiscoverContacts(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.DiscoverContactsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(u.validator, req); err != nil {
+		return err
+	}
+
+	matches, err := u.userService.DiscoverContacts(c.Context(), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(matches)
+}
+
+// @Summary      Send email verification code
+// @Description  Emails a six digit code to the authenticated user's address. The address is not used for notifications or lookups until it is verified. Codes expire after 30 minutes; sending again replaces the previous code. Rate limited per user.
+// @Tags         users
+// @Produce      json
+// @Success      204
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      404 {object} errs.APIError
+// @Failure      429 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Failure      503 {object} errs.APIError
+// @Router       /api/v1/users/me/email/verification [post]
+// @ID           sendEmailVerification
+func (u *UserController) SendEmailVerification(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	if err := u.userService.SendEmailVerification(c.Context(), userID); err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary      Verify email
+// @Description  Confirms the authenticated user's email address with the code sent to it. Rate limited per user.
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        request body models.VerifyEmailRequest true "Verification code"
+// @Success      200 {object} models.User
+// @Failure      400 {object} errs.APIError
+// @Failure      401 {object} errs.APIError
+// @Failure      422 {object} errs.APIError
+// @Failure      429 {object} errs.APIError
+// @Failure      500 {object} errs.APIError
+// @Router       /api/v1/users/me/email/verify [post]
+// @ID           verifyEmail
+func (u *UserController) VerifyEmail(c *fiber.Ctx) error {
+	userID, err := validators.ExtractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.VerifyEmailRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errs.InvalidJSON()
+	}
+
+	if err := validators.Validate(u.validator, req); err != nil {
+		return err
+	}
+
+	user, err := u.userService.VerifyEmail(c.Context(), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(user)
+}
@@ -14,6 +14,7 @@ var (
 	ErrNotFound      = errors.New("not found")
 	ErrDuplicate     = errors.New("already exists")
 	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrTripArchived  = errors.New("trip is archived")
 )
 
 func IsNotFound(err error) bool {
@@ -126,6 +127,10 @@ func Conflict() APIError {
 	return NewAPIError(http.StatusConflict, errors.New("conflict"))
 }
 
+func TooManyRequests() APIError {
+	return NewAPIError(http.StatusTooManyRequests, errors.New("too many requests"))
+}
+
 func InternalServerError() APIError {
 	return NewAPIError(http.StatusInternalServerError, errors.New("internal server error"))
 }
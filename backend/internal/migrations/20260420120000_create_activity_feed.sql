@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE activity_feed_events (
+    id UUID PRIMARY KEY,
+    trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    topic TEXT NOT NULL,
+    entity_id TEXT,
+    actor_id UUID,
+    actor_name TEXT,
+    version INT NOT NULL DEFAULT 0,
+    data JSONB NOT NULL DEFAULT '{}'::jsonb,
+    group_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE TABLE activity_feed_entries (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES activity_feed_events(id) ON DELETE CASCADE,
+    trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    group_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    read_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (user_id, event_id)
+);
+
+CREATE INDEX idx_activity_feed_entries_user_trip ON activity_feed_entries(user_id, trip_id, created_at DESC);
+CREATE INDEX idx_activity_feed_entries_group ON activity_feed_entries(user_id, group_id);
+CREATE INDEX idx_activity_feed_entries_unread ON activity_feed_entries(user_id, trip_id) WHERE read_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_activity_feed_entries_unread;
+DROP INDEX IF EXISTS idx_activity_feed_entries_group;
+DROP INDEX IF EXISTS idx_activity_feed_entries_user_trip;
+DROP TABLE activity_feed_entries;
+DROP TABLE activity_feed_events;
+-- +goose StatementEnd
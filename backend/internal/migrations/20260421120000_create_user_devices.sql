@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_devices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token TEXT NOT NULL UNIQUE,
+    platform TEXT NOT NULL DEFAULT 'unknown',
+    app_version TEXT,
+    last_seen_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE INDEX idx_user_devices_user_id ON user_devices(user_id);
+
+-- Carry over the single token previously stored on users.
+INSERT INTO user_devices (user_id, token, last_seen_at)
+SELECT id, device_token, COALESCE(device_token_updated_at, now())
+FROM users
+WHERE device_token IS NOT NULL AND device_token != ''
+ON CONFLICT (token) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_devices;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE notification_preferences
+    ADD COLUMN quiet_hours_enabled BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN quiet_hours_start TEXT NOT NULL DEFAULT '22:00',
+    ADD COLUMN quiet_hours_end TEXT NOT NULL DEFAULT '08:00',
+    ADD COLUMN digest_enabled BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN digest_time TEXT NOT NULL DEFAULT '18:00';
+
+CREATE TABLE notification_digest_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    title TEXT NOT NULL,
+    body TEXT NOT NULL,
+    deliver_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    delivered_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_notification_digest_items_pending ON notification_digest_items(user_id, deliver_at) WHERE delivered_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_digest_items;
+
+ALTER TABLE notification_preferences
+    DROP COLUMN IF EXISTS quiet_hours_enabled,
+    DROP COLUMN IF EXISTS quiet_hours_start,
+    DROP COLUMN IF EXISTS quiet_hours_end,
+    DROP COLUMN IF EXISTS digest_enabled,
+    DROP COLUMN IF EXISTS digest_time;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE trips ADD COLUMN pitching_closed_at TIMESTAMP WITH TIME ZONE;
+
+-- Trip rank polls stay locked until the pitch deadline workflow opens voting. Existing
+-- polls are already open.
+ALTER TABLE polls ADD COLUMN voting_locked BOOLEAN NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE polls DROP COLUMN IF EXISTS voting_locked;
+ALTER TABLE trips DROP COLUMN IF EXISTS pitching_closed_at;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN email TEXT;
+
+-- Email and SMS are opt-in; push stays the default channel.
+ALTER TABLE notification_preferences
+    ADD COLUMN email_enabled BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN sms_enabled BOOLEAN NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE notification_preferences
+    DROP COLUMN IF EXISTS sms_enabled,
+    DROP COLUMN IF EXISTS email_enabled;
+
+ALTER TABLE users DROP COLUMN IF EXISTS email;
+-- +goose StatementEnd
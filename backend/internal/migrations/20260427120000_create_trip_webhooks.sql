@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE trip_webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    topics TEXT[] NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE INDEX idx_trip_webhooks_trip_id ON trip_webhooks(trip_id);
+
+-- One row per webhook and event; retries update the same row.
+CREATE TABLE trip_webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id UUID NOT NULL REFERENCES trip_webhooks(id) ON DELETE CASCADE,
+    event_id TEXT NOT NULL,
+    topic TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    response_status INT,
+    error_message TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    last_attempt_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX idx_trip_webhook_deliveries_webhook_id ON trip_webhook_deliveries(webhook_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS trip_webhook_deliveries;
+DROP TABLE IF EXISTS trip_webhooks;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A trip posts to at most one Slack channel; a channel may follow several trips.
+CREATE TABLE trip_slack_channels (
+    trip_id UUID PRIMARY KEY REFERENCES trips(id) ON DELETE CASCADE,
+    channel_id TEXT NOT NULL,
+    linked_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE INDEX idx_users_lower_email ON users(LOWER(email));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_lower_email;
+DROP TABLE IF EXISTS trip_slack_channels;
+-- +goose StatementEnd
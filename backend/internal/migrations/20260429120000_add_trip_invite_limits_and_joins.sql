@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE trip_invites
+    ADD COLUMN max_uses INT CHECK (max_uses > 0),
+    ADD COLUMN use_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN requires_approval BOOLEAN NOT NULL DEFAULT false;
+
+-- Who used which invite. Invites that require approval create a pending row that an
+-- admin approves (joined) or rejects.
+CREATE TABLE trip_invite_joins (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    invite_id UUID NOT NULL REFERENCES trip_invites(id) ON DELETE CASCADE,
+    trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('joined', 'pending', 'rejected')),
+    decided_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE INDEX idx_trip_invite_joins_invite_id ON trip_invite_joins(invite_id, created_at DESC);
+CREATE UNIQUE INDEX idx_trip_invite_joins_pending ON trip_invite_joins(trip_id, user_id) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS trip_invite_joins;
+ALTER TABLE trip_invites
+    DROP COLUMN IF EXISTS requires_approval,
+    DROP COLUMN IF EXISTS use_count,
+    DROP COLUMN IF EXISTS max_uses;
+-- +goose StatementEnd
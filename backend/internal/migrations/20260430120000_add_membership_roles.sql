@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE memberships
+    ADD COLUMN role TEXT NOT NULL DEFAULT 'member'
+        CHECK (role IN ('owner', 'organiser', 'member', 'viewer'));
+
+UPDATE memberships SET role = 'organiser' WHERE is_admin;
+
+-- Each trip's longest-standing admin becomes its owner.
+UPDATE memberships m
+SET role = 'owner'
+FROM (
+    SELECT DISTINCT ON (trip_id) trip_id, user_id
+    FROM memberships
+    WHERE is_admin
+    ORDER BY trip_id, created_at, user_id
+) first_admin
+WHERE m.trip_id = first_admin.trip_id AND m.user_id = first_admin.user_id;
+
+-- is_admin is now derived from the role so existing readers keep working.
+ALTER TABLE memberships DROP COLUMN is_admin;
+ALTER TABLE memberships
+    ADD COLUMN is_admin BOOLEAN GENERATED ALWAYS AS (role IN ('owner', 'organiser')) STORED;
+
+CREATE INDEX idx_memberships_is_admin ON memberships(is_admin) WHERE is_admin = true;
+CREATE UNIQUE INDEX idx_memberships_trip_owner ON memberships(trip_id) WHERE role = 'owner';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_memberships_trip_owner;
+ALTER TABLE memberships DROP COLUMN is_admin;
+ALTER TABLE memberships ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT false;
+UPDATE memberships SET is_admin = role IN ('owner', 'organiser');
+CREATE INDEX idx_memberships_is_admin ON memberships(is_admin) WHERE is_admin = true;
+ALTER TABLE memberships DROP COLUMN IF EXISTS role;
+-- +goose StatementEnd
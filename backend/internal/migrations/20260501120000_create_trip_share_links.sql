@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A trip has at most one public, read-only share link. Rotating replaces the token;
+-- disabling deletes the row.
+CREATE TABLE trip_share_links (
+    trip_id UUID PRIMARY KEY REFERENCES trips(id) ON DELETE CASCADE,
+    token TEXT NOT NULL UNIQUE,
+    visible_tabs TEXT[] NOT NULL DEFAULT '{}',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS trip_share_links;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- What happens to a member's pitches and activities when they leave: they pass to the
+-- trip owner, or stay on the trip without an author.
+ALTER TABLE trips
+    ADD COLUMN departed_content_policy TEXT NOT NULL DEFAULT 'reassign'
+        CHECK (departed_content_policy IN ('reassign', 'anonymise'));
+
+ALTER TABLE trip_pitches ALTER COLUMN user_id DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM trip_pitches WHERE user_id IS NULL;
+ALTER TABLE trip_pitches ALTER COLUMN user_id SET NOT NULL;
+
+ALTER TABLE trips DROP COLUMN IF EXISTS departed_content_policy;
+-- +goose StatementEnd
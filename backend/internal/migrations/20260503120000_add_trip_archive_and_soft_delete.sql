@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Archived trips are read-only and hidden from the trip list by default. Deleted trips
+-- are hidden everywhere and can be restored until the purge workflow removes them.
+ALTER TABLE trips
+    ADD COLUMN archived_at TIMESTAMPTZ,
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_trips_deleted_at ON trips(deleted_at) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM trips WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_trips_deleted_at;
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS archived_at;
+-- +goose StatementEnd
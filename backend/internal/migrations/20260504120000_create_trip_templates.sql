@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A trip template is a snapshot of a trip's tabs, activities, polls and budget that new
+-- trips can be started from. Public templates can be used by anyone; private ones only
+-- by their creator.
+CREATE TABLE trip_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    description TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    source_trip_id UUID REFERENCES trips(id) ON DELETE SET NULL,
+    is_public BOOLEAN NOT NULL DEFAULT false,
+    data JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE INDEX idx_trip_templates_created_by ON trip_templates(created_by);
+CREATE INDEX idx_trip_templates_public ON trip_templates(created_at DESC) WHERE is_public;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS trip_templates;
+-- +goose StatementEnd
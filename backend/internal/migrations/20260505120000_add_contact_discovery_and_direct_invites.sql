@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Contact discovery matches unsalted SHA-256 hashes of E.164 phone numbers from the
+-- device address book. Phone numbers are few enough to hash exhaustively, so the hashes
+-- do not hide the numbers; discovery is rate limited and users can opt out of being found.
+ALTER TABLE users
+    ADD COLUMN phone_number_hash TEXT GENERATED ALWAYS AS (encode(sha256(convert_to(phone_number, 'UTF8')), 'hex')) STORED,
+    ADD COLUMN phone_discovery_disabled BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX idx_users_phone_number_hash ON users(phone_number_hash);
+
+-- A direct invite asks a specific user to join a trip. It stays pending until the
+-- invitee accepts or declines, or the inviter cancels it.
+CREATE TABLE trip_direct_invites (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    invited_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    invitee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'declined', 'cancelled')),
+    responded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_trip_direct_invites_pending ON trip_direct_invites(trip_id, invitee_id) WHERE status = 'pending';
+CREATE INDEX idx_trip_direct_invites_invitee ON trip_direct_invites(invitee_id, created_at DESC) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS trip_direct_invites;
+DROP INDEX IF EXISTS idx_users_phone_number_hash;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS phone_discovery_disabled,
+    DROP COLUMN IF EXISTS phone_number_hash;
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A block hides the blocked user's comments and reactions from the blocker and stops
+-- the blocked user from sending them direct invites.
+CREATE TABLE user_blocks (
+    blocker_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    blocked_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX idx_user_blocks_blocked ON user_blocks(blocked_id);
+
+-- Members report comments, pitches and activities to the trip's moderators. A report
+-- stays pending until a moderator hides the content or dismisses the report.
+CREATE TABLE content_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    entity_type TEXT NOT NULL CHECK (entity_type IN ('comment', 'pitch', 'activity')),
+    entity_id UUID NOT NULL,
+    reported_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL CHECK (reason IN ('spam', 'harassment', 'inappropriate', 'other')),
+    details TEXT,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'hidden', 'dismissed')),
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_content_reports_pending ON content_reports(entity_type, entity_id, reported_by) WHERE status = 'pending';
+CREATE INDEX idx_content_reports_trip ON content_reports(trip_id, created_at DESC) WHERE status = 'pending';
+
+-- Content a moderator has hidden is left out of the trip's comment, pitch and activity
+-- lists until it is unhidden.
+CREATE TABLE hidden_content (
+    entity_type TEXT NOT NULL CHECK (entity_type IN ('comment', 'pitch', 'activity')),
+    entity_id UUID NOT NULL,
+    trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    hidden_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    hidden_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    PRIMARY KEY (entity_type, entity_id)
+);
+
+CREATE INDEX idx_hidden_content_trip ON hidden_content(trip_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS hidden_content;
+DROP TABLE IF EXISTS content_reports;
+DROP TABLE IF EXISTS user_blocks;
+-- +goose StatementEnd
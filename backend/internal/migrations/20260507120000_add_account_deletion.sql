@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deleting an account keeps what the user wrote for the rest of the trip but removes
+-- the author, so these columns can now be empty.
+ALTER TABLE comments ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE polls ALTER COLUMN created_by DROP NOT NULL;
+ALTER TABLE pitch_links ALTER COLUMN added_by DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM pitch_links WHERE added_by IS NULL;
+ALTER TABLE pitch_links ALTER COLUMN added_by SET NOT NULL;
+
+DELETE FROM polls WHERE created_by IS NULL;
+ALTER TABLE polls ALTER COLUMN created_by SET NOT NULL;
+
+DELETE FROM comments WHERE user_id IS NULL;
+ALTER TABLE comments ALTER COLUMN user_id SET NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Organisers' to-do list for a trip, e.g. booking the accommodation. A task can point
+-- at the activity it is for and is discussed through the usual comments.
+CREATE TABLE trip_tasks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    description TEXT,
+    status TEXT NOT NULL DEFAULT 'todo' CHECK (status IN ('todo', 'in_progress', 'done')),
+    due_at TIMESTAMP WITH TIME ZONE,
+    activity_id UUID REFERENCES activities(id) ON DELETE SET NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE INDEX idx_trip_tasks_trip ON trip_tasks(trip_id, created_at);
+
+-- Assignees are trip members; leaving the trip drops their assignments.
+CREATE TABLE trip_task_assignees (
+    task_id UUID NOT NULL REFERENCES trip_tasks(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    PRIMARY KEY (task_id, user_id)
+);
+
+CREATE INDEX idx_trip_task_assignees_user ON trip_task_assignees(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM comments WHERE entity_type = 'task';
+DROP TABLE IF EXISTS trip_task_assignees;
+DROP TABLE IF EXISTS trip_tasks;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Addresses were never unique. Rather than pick which account keeps a shared address,
+-- refuse to migrate until the duplicates have been resolved by hand.
+DO $$
+DECLARE
+    duplicates INT;
+BEGIN
+    SELECT COUNT(*) INTO duplicates FROM (
+        SELECT LOWER(email)
+        FROM users
+        WHERE email IS NOT NULL AND email <> ''
+        GROUP BY LOWER(email)
+        HAVING COUNT(*) > 1
+    ) AS shared;
+    IF duplicates > 0 THEN
+        RAISE EXCEPTION '% email address(es) belong to more than one user; resolve them before adding idx_users_email_lower', duplicates;
+    END IF;
+END $$;
+
+-- Email is only used once the owner has proved they can read it.
+ALTER TABLE users
+    ADD COLUMN email_verified_at TIMESTAMPTZ,
+    ADD COLUMN email_verification_code_hash TEXT,
+    ADD COLUMN email_verification_expires_at TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX idx_users_email_lower ON users (LOWER(email)) WHERE email IS NOT NULL AND email <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_email_lower;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS email_verification_expires_at,
+    DROP COLUMN IF EXISTS email_verification_code_hash,
+    DROP COLUMN IF EXISTS email_verified_at;
+-- +goose StatementEnd
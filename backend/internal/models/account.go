@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserDataExport is everything the app holds about a user, downloaded before they
+// delete their account or whenever they ask for a copy.
+type UserDataExport struct {
+	ExportedAt   time.Time              `json:"exported_at"`
+	Profile      *User                  `json:"profile"`
+	Memberships  []*ExportedMembership  `json:"memberships"`
+	Comments     []*Comment             `json:"comments"`
+	PollVotes    []*ExportedPollVote    `json:"poll_votes"`
+	PollRankings []*ExportedPollRanking `json:"poll_rankings"`
+	RSVPs        []*ExportedRSVP        `json:"rsvps"`
+	Pitches      []*ExportedPitch       `json:"pitches"`
+}
+
+type ExportedMembership struct {
+	TripID       uuid.UUID               `bun:"trip_id" json:"trip_id"`
+	TripName     string                  `bun:"trip_name" json:"trip_name"`
+	Role         TripRole                `bun:"role" json:"role"`
+	BudgetMin    int                     `bun:"budget_min" json:"budget_min"`
+	BudgetMax    int                     `bun:"budget_max" json:"budget_max"`
+	Availability *map[string]interface{} `bun:"availability,type:jsonb" json:"availability,omitempty"`
+	JoinedAt     time.Time               `bun:"joined_at" json:"joined_at"`
+}
+
+type ExportedPollVote struct {
+	TripID     uuid.UUID `bun:"trip_id" json:"trip_id"`
+	PollID     uuid.UUID `bun:"poll_id" json:"poll_id"`
+	Question   string    `bun:"question" json:"question"`
+	OptionID   uuid.UUID `bun:"option_id" json:"option_id"`
+	OptionName string    `bun:"option_name" json:"option_name"`
+	CreatedAt  time.Time `bun:"created_at" json:"created_at"`
+}
+
+type ExportedPollRanking struct {
+	TripID       uuid.UUID `bun:"trip_id" json:"trip_id"`
+	PollID       uuid.UUID `bun:"poll_id" json:"poll_id"`
+	Question     string    `bun:"question" json:"question"`
+	OptionID     uuid.UUID `bun:"option_id" json:"option_id"`
+	OptionName   string    `bun:"option_name" json:"option_name"`
+	RankPosition int       `bun:"rank_position" json:"rank_position"`
+}
+
+type ExportedRSVP struct {
+	TripID       uuid.UUID  `bun:"trip_id" json:"trip_id"`
+	ActivityID   uuid.UUID  `bun:"activity_id" json:"activity_id"`
+	ActivityName string     `bun:"activity_name" json:"activity_name"`
+	Status       RSVPStatus `bun:"status" json:"status"`
+	UpdatedAt    time.Time  `bun:"updated_at" json:"updated_at"`
+}
+
+// ExportedPitch links to the pitch audio with a presigned URL that expires like any
+// other download link.
+type ExportedPitch struct {
+	ID          uuid.UUID `bun:"id" json:"id"`
+	TripID      uuid.UUID `bun:"trip_id" json:"trip_id"`
+	Title       string    `bun:"title" json:"title"`
+	Description string    `bun:"description" json:"description"`
+	Duration    *int      `bun:"duration" json:"duration,omitempty"`
+	AudioS3Key  string    `bun:"audio_s3_key" json:"-"`
+	AudioURL    string    `bun:"-" json:"audio_url,omitempty"`
+	CreatedAt   time.Time `bun:"created_at" json:"created_at"`
+}
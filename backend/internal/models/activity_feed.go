@@ -0,0 +1,123 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ActivityFeedEvent is a realtime event persisted once for every feed it appears in.
+type ActivityFeedEvent struct {
+	bun.BaseModel `bun:"table:activity_feed_events"`
+
+	ID        uuid.UUID       `bun:"id,pk,type:uuid" json:"id"`
+	TripID    uuid.UUID       `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
+	Topic     string          `bun:"topic,notnull" json:"topic"`
+	EntityID  string          `bun:"entity_id" json:"entity_id,omitempty"`
+	ActorID   *uuid.UUID      `bun:"actor_id,type:uuid" json:"actor_id,omitempty"`
+	ActorName string          `bun:"actor_name" json:"actor_name,omitempty"`
+	Version   int             `bun:"version,notnull" json:"version"`
+	Data      json.RawMessage `bun:"data,type:jsonb,notnull" json:"data" swaggertype:"object"`
+	GroupID   uuid.UUID       `bun:"group_id,type:uuid,notnull" json:"group_id"`
+	CreatedAt time.Time       `bun:"created_at,notnull" json:"created_at"`
+}
+
+// ActivityFeedEntry places an event in one recipient's feed and tracks whether they have read it.
+type ActivityFeedEntry struct {
+	bun.BaseModel `bun:"table:activity_feed_entries"`
+
+	UserID    uuid.UUID  `bun:"user_id,pk,type:uuid" json:"user_id"`
+	EventID   uuid.UUID  `bun:"event_id,pk,type:uuid" json:"event_id"`
+	TripID    uuid.UUID  `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
+	GroupID   uuid.UUID  `bun:"group_id,type:uuid,notnull" json:"group_id"`
+	CreatedAt time.Time  `bun:"created_at,notnull" json:"created_at"`
+	ReadAt    *time.Time `bun:"read_at" json:"read_at,omitempty"`
+}
+
+// ActivityFeedGroupRow is one aggregated feed item as read from the database.
+type ActivityFeedGroupRow struct {
+	GroupID       uuid.UUID       `bun:"group_id"`
+	TripID        uuid.UUID       `bun:"trip_id"`
+	TripName      string          `bun:"trip_name"`
+	Topic         string          `bun:"topic"`
+	EntityID      string          `bun:"entity_id"`
+	LatestEventID uuid.UUID       `bun:"latest_event_id"`
+	LatestAt      time.Time       `bun:"latest_at"`
+	EventCount    int             `bun:"event_count"`
+	ActorCount    int             `bun:"actor_count"`
+	HasUnread     bool            `bun:"has_unread"`
+	Version       int             `bun:"version"`
+	Data          json.RawMessage `bun:"data"`
+}
+
+// ActivityFeedActorRow is a distinct actor within a feed group, most recent first.
+type ActivityFeedActorRow struct {
+	GroupID  uuid.UUID `bun:"group_id"`
+	UserID   uuid.UUID `bun:"user_id"`
+	Name     string    `bun:"name"`
+	Username string    `bun:"username"`
+	ActedAt  time.Time `bun:"acted_at"`
+}
+
+type ActivityFeedActor struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Name     string    `json:"name"`
+	Username string    `json:"username"`
+}
+
+// ActivityFeedItem is an aggregated feed entry, e.g. "Ana and 3 others voted on Where to eat".
+// Data and Version are taken from the most recent event in the group.
+type ActivityFeedItem struct {
+	ID         uuid.UUID           `json:"id"`
+	TripID     uuid.UUID           `json:"trip_id"`
+	TripName   string              `json:"trip_name"`
+	Topic      string              `json:"topic"`
+	EntityID   string              `json:"entity_id,omitempty"`
+	Summary    string              `json:"summary"`
+	Actors     []ActivityFeedActor `json:"actors"`
+	ActorCount int                 `json:"actor_count"`
+	EventCount int                 `json:"event_count"`
+	IsRead     bool                `json:"is_read"`
+	LatestAt   time.Time           `json:"latest_at"`
+	Version    int                 `json:"version"`
+	Data       json.RawMessage     `json:"data" swaggertype:"object"`
+}
+
+// ActivityFeedCursor is the sort key for feed pagination (latest_at DESC, group_id DESC).
+type ActivityFeedCursor = TimeUUIDCursor
+
+type ActivityFeedPageResult struct {
+	Items      []*ActivityFeedItem `json:"items"`
+	NextCursor *string             `json:"next_cursor,omitempty"`
+	Limit      int                 `json:"limit"`
+}
+
+type GetActivityFeedQueryParams struct {
+	CursorPaginationParams
+	UnreadOnly bool `query:"unread_only"`
+}
+
+// GetInboxQueryParams filters the cross-trip inbox. TripIDs narrows it to a subset of
+// the user's trips; trips the user is not a member of are ignored.
+type GetInboxQueryParams struct {
+	CursorPaginationParams
+	UnreadOnly bool     `query:"unread_only"`
+	TripIDs    []string `query:"trip_id" validate:"omitempty,dive,uuid"`
+}
+
+type MarkInboxReadRequest struct {
+	TripIDs []uuid.UUID `json:"trip_ids"`
+}
+
+type InboxTripUnreadCount struct {
+	TripID      uuid.UUID `json:"trip_id"`
+	UnreadCount int64     `json:"unread_count"`
+}
+
+// InboxUnreadCountResponse is the badge count across every trip, with a per-trip breakdown.
+type InboxUnreadCountResponse struct {
+	UnreadCount int64                  `json:"unread_count"`
+	Trips       []InboxTripUnreadCount `json:"trips"`
+}
@@ -19,7 +19,7 @@ type Comment struct {
 
 type CreateCommentRequest struct {
 	TripID     uuid.UUID  `validate:"required" json:"trip_id"`
-	EntityType EntityType `validate:"required,oneof=activity pitch" json:"entity_type"`
+	EntityType EntityType `validate:"required,oneof=activity pitch task" json:"entity_type"`
 	EntityID   uuid.UUID  `validate:"required" json:"entity_id"`
 	Content    string     `validate:"required,min=1" json:"content"`
 }
@@ -0,0 +1,28 @@
+package models
+
+import "github.com/google/uuid"
+
+// DiscoverContactsRequest holds hex SHA-256 hashes of E.164 phone numbers from the
+// device address book, e.g. sha256("+13141592658"). A request checks at most 500.
+type DiscoverContactsRequest struct {
+	PhoneHashes []string `validate:"required,min=1,max=500,unique,dive,len=64,hexadecimal" json:"phone_hashes"`
+}
+
+// ContactUser is the public profile shown for a discovered contact. It never includes
+// the phone number.
+type ContactUser struct {
+	ID             uuid.UUID  `json:"id"`
+	Name           string     `json:"name"`
+	Username       string     `json:"username"`
+	ProfilePicture *uuid.UUID `json:"profile_picture,omitempty"`
+}
+
+// ContactMatch pairs a submitted hash with the user it belongs to.
+type ContactMatch struct {
+	PhoneHash string      `json:"phone_hash"`
+	User      ContactUser `json:"user"`
+}
+
+type DiscoverContactsResponse struct {
+	Matches []*ContactMatch `json:"matches"`
+}
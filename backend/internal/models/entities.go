@@ -5,4 +5,6 @@ type EntityType string
 const (
 	ActivityEntity EntityType = "activity"
 	PitchEntity    EntityType = "pitch"
+	CommentEntity  EntityType = "comment"
+	TaskEntity     EntityType = "task"
 )
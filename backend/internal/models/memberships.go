@@ -14,10 +14,34 @@ const (
 	NotificationPreferenceNewComment NotificationPreference = "new_comment"
 )
 
+// Category returns the user-level notification category a trip preference falls under.
+func (p NotificationPreference) Category() NotificationCategory {
+	return NotificationCategoryTripActivity
+}
+
+// AllowedBy reports whether the membership has this per-trip toggle enabled. An empty
+// preference is not gated at the trip level.
+func (p NotificationPreference) AllowedBy(membership *MembershipDatabaseResponse) bool {
+	switch p {
+	case "":
+		return true
+	case NotificationPreferenceNewPitch:
+		return membership.NotifyNewPitches
+	case NotificationPreferenceNewPoll:
+		return membership.NotifyNewPolls
+	case NotificationPreferenceNewComment:
+		return membership.NotifyNewComments
+	default:
+		return false
+	}
+}
+
 type Membership struct {
-	UserID            uuid.UUID               `bun:"user_id,pk,type:uuid" json:"user_id"`
-	TripID            uuid.UUID               `bun:"trip_id,pk,type:uuid" json:"trip_id"`
-	IsAdmin           bool                    `bun:"is_admin" json:"is_admin"`
+	UserID uuid.UUID `bun:"user_id,pk,type:uuid" json:"user_id"`
+	TripID uuid.UUID `bun:"trip_id,pk,type:uuid" json:"trip_id"`
+	Role   TripRole  `bun:"role,notnull" json:"role"`
+	// IsAdmin is generated from Role by the database.
+	IsAdmin           bool                    `bun:"is_admin,scanonly" json:"is_admin"`
 	CreatedAt         time.Time               `bun:"created_at,nullzero" json:"created_at"`
 	UpdatedAt         time.Time               `bun:"updated_at,nullzero" json:"updated_at"`
 	BudgetMin         int                     `bun:"budget_min" json:"budget_min"`
@@ -29,17 +53,31 @@ type Membership struct {
 }
 
 type CreateMembershipRequest struct {
-	UserID    uuid.UUID `validate:"required,uuid" json:"user_id"`
-	TripID    uuid.UUID `validate:"required,uuid" json:"trip_id"`
-	IsAdmin   bool      `json:"is_admin"`
-	BudgetMin int       `validate:"required,gte=0" json:"budget_min"`
-	BudgetMax int       `validate:"required,gte=0,gtefield=BudgetMin" json:"budget_max"`
+	UserID  uuid.UUID `validate:"required,uuid" json:"user_id"`
+	TripID  uuid.UUID `validate:"required,uuid" json:"trip_id"`
+	IsAdmin bool      `json:"is_admin"`
+	// Role defaults to organiser when IsAdmin is set and member otherwise.
+	Role      TripRole `validate:"omitempty,oneof=organiser member viewer" json:"role,omitempty"`
+	BudgetMin int      `validate:"required,gte=0" json:"budget_min"`
+	BudgetMax int      `validate:"required,gte=0,gtefield=BudgetMin" json:"budget_max"`
 }
 
+// UpdateMembershipRequest updates a member's own details; roles change through
+// UpdateMemberRoleRequest.
 type UpdateMembershipRequest struct {
-	IsAdmin   *bool `validate:"omitempty" json:"is_admin"`
-	BudgetMin *int  `validate:"omitempty,gte=0" json:"budget_min"`
-	BudgetMax *int  `validate:"omitempty,gte=0,gtefield=BudgetMin" json:"budget_max"`
+	BudgetMin *int `validate:"omitempty,gte=0" json:"budget_min"`
+	BudgetMax *int `validate:"omitempty,gte=0,gtefield=BudgetMin" json:"budget_max"`
+}
+
+// LeaveTripRequest names who takes over from a leaving member. It is required when the
+// member is the trip's last admin; a leaving owner without one is succeeded by the
+// longest-standing organiser.
+type LeaveTripRequest struct {
+	SuccessorID *uuid.UUID `validate:"omitempty" json:"successor_id,omitempty"`
+}
+
+type TransferOwnershipRequest struct {
+	UserID uuid.UUID `validate:"required" json:"user_id"`
 }
 
 type UpdateNotificationPreferencesRequest struct {
@@ -60,6 +98,7 @@ type MembershipCursorPageResult struct {
 type MembershipDatabaseResponse struct {
 	UserID            uuid.UUID               `json:"user_id"`
 	TripID            uuid.UUID               `json:"trip_id"`
+	Role              TripRole                `json:"role"`
 	IsAdmin           bool                    `json:"is_admin"`
 	CreatedAt         time.Time               `json:"created_at"`
 	UpdatedAt         time.Time               `json:"updated_at"`
@@ -78,6 +117,7 @@ type MembershipDatabaseResponse struct {
 type MembershipAPIResponse struct {
 	UserID            uuid.UUID               `json:"user_id"`
 	TripID            uuid.UUID               `json:"trip_id"`
+	Role              TripRole                `json:"role"`
 	IsAdmin           bool                    `json:"is_admin"`
 	CreatedAt         time.Time               `json:"created_at"`
 	UpdatedAt         time.Time               `json:"updated_at"`
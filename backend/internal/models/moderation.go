@@ -0,0 +1,88 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// UserBlock records that BlockerID no longer wants to see BlockedID's comments and
+// reactions or receive their direct invites.
+type UserBlock struct {
+	BlockerID uuid.UUID `bun:"blocker_id,pk,type:uuid" json:"blocker_id"`
+	BlockedID uuid.UUID `bun:"blocked_id,pk,type:uuid" json:"blocked_id"`
+	CreatedAt time.Time `bun:"created_at,nullzero,default:now()" json:"created_at"`
+}
+
+type BlockUserRequest struct {
+	UserID uuid.UUID `validate:"required" json:"user_id"`
+}
+
+type BlockedUsersResponse struct {
+	Items []*ContactUser `json:"items"`
+}
+
+type ContentReportReason string
+
+const (
+	ContentReportSpam          ContentReportReason = "spam"
+	ContentReportHarassment    ContentReportReason = "harassment"
+	ContentReportInappropriate ContentReportReason = "inappropriate"
+	ContentReportOther         ContentReportReason = "other"
+)
+
+type ContentReportStatus string
+
+const (
+	ContentReportPending   ContentReportStatus = "pending"
+	ContentReportHidden    ContentReportStatus = "hidden"
+	ContentReportDismissed ContentReportStatus = "dismissed"
+)
+
+// ContentReport flags a comment, pitch or activity for the trip's moderators.
+type ContentReport struct {
+	ID         uuid.UUID           `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	TripID     uuid.UUID           `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
+	EntityType EntityType          `bun:"entity_type,notnull" json:"entity_type"`
+	EntityID   uuid.UUID           `bun:"entity_id,type:uuid,notnull" json:"entity_id"`
+	ReportedBy *uuid.UUID          `bun:"reported_by,type:uuid" json:"reported_by,omitempty"`
+	Reason     ContentReportReason `bun:"reason,notnull" json:"reason"`
+	Details    *string             `bun:"details" json:"details,omitempty"`
+	Status     ContentReportStatus `bun:"status,notnull" json:"status"`
+	ResolvedBy *uuid.UUID          `bun:"resolved_by,type:uuid" json:"resolved_by,omitempty"`
+	ResolvedAt *time.Time          `bun:"resolved_at" json:"resolved_at,omitempty"`
+	CreatedAt  time.Time           `bun:"created_at,nullzero,default:now()" json:"created_at"`
+}
+
+type CreateContentReportRequest struct {
+	EntityType EntityType          `validate:"required,oneof=comment pitch activity" json:"entity_type"`
+	EntityID   uuid.UUID           `validate:"required" json:"entity_id"`
+	Reason     ContentReportReason `validate:"required,oneof=spam harassment inappropriate other" json:"reason"`
+	Details    *string             `validate:"omitempty,max=1000" json:"details,omitempty"`
+}
+
+// ModerationQueueItem is a pending report with enough of the reported content for a
+// moderator to decide on it without opening the thread.
+type ModerationQueueItem struct {
+	bun.BaseModel `bun:"table:content_reports,alias:cr" swaggerignore:"true"`
+	ContentReport
+	ReporterName *string    `bun:"reporter_name" json:"reporter_name,omitempty"`
+	AuthorID     *uuid.UUID `bun:"author_id" json:"author_id,omitempty"`
+	AuthorName   *string    `bun:"author_name" json:"author_name,omitempty"`
+	Preview      *string    `bun:"preview" json:"preview,omitempty"`
+}
+
+type ModerationQueueResponse struct {
+	Items []*ModerationQueueItem `json:"items"`
+}
+
+// HiddenContent is a comment, pitch or activity a moderator has hidden from the trip.
+type HiddenContent struct {
+	bun.BaseModel `bun:"table:hidden_content,alias:hc" swaggerignore:"true"`
+	EntityType    EntityType `bun:"entity_type,pk" json:"entity_type"`
+	EntityID      uuid.UUID  `bun:"entity_id,pk,type:uuid" json:"entity_id"`
+	TripID        uuid.UUID  `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
+	HiddenBy      *uuid.UUID `bun:"hidden_by,type:uuid" json:"hidden_by,omitempty"`
+	HiddenAt      time.Time  `bun:"hidden_at,nullzero,default:now()" json:"hidden_at"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryPending   NotificationDeliveryStatus = "pending"
+	NotificationDeliveryDelivered NotificationDeliveryStatus = "delivered"
+	NotificationDeliveryFailed    NotificationDeliveryStatus = "failed"
+)
+
+// NotificationDelivery tracks a single push message to one device. TicketID is the Expo
+// push ticket used to fetch the delivery receipt; it is nil when Expo rejected the
+// message outright.
+type NotificationDelivery struct {
+	bun.BaseModel `bun:"table:notification_deliveries"`
+
+	ID           uuid.UUID                  `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	TicketID     *string                    `bun:"ticket_id" json:"ticket_id,omitempty"`
+	UserID       uuid.UUID                  `bun:"user_id,type:uuid,notnull" json:"user_id"`
+	Token        string                     `bun:"token,notnull" json:"token"`
+	Title        string                     `bun:"title,notnull" json:"title"`
+	Status       NotificationDeliveryStatus `bun:"status,notnull" json:"status"`
+	ErrorCode    *string                    `bun:"error_code" json:"error_code,omitempty"`
+	ErrorMessage *string                    `bun:"error_message" json:"error_message,omitempty"`
+	CreatedAt    time.Time                  `bun:"created_at,nullzero" json:"created_at"`
+	CheckedAt    *time.Time                 `bun:"checked_at" json:"checked_at,omitempty"`
+}
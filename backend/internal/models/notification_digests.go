@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// NotificationDigestItem is a non-urgent notification held back by quiet hours or digest
+// mode. Items due at the same time are delivered together as one summary push.
+type NotificationDigestItem struct {
+	bun.BaseModel `bun:"table:notification_digest_items"`
+
+	ID          uuid.UUID              `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	UserID      uuid.UUID              `bun:"user_id,type:uuid,notnull" json:"user_id"`
+	TripID      uuid.UUID              `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
+	Kind        NotificationPreference `bun:"kind,notnull" json:"kind"`
+	Title       string                 `bun:"title,notnull" json:"title"`
+	Body        string                 `bun:"body,notnull" json:"body"`
+	DeliverAt   time.Time              `bun:"deliver_at,notnull" json:"deliver_at"`
+	CreatedAt   time.Time              `bun:"created_at,nullzero" json:"created_at"`
+	DeliveredAt *time.Time             `bun:"delivered_at" json:"delivered_at,omitempty"`
+}
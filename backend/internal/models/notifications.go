@@ -6,34 +6,146 @@ import (
 	"github.com/google/uuid"
 )
 
+// NotificationCategory maps a notification to the user-level toggle that gates it.
+type NotificationCategory string
+
+const (
+	NotificationCategoryTripActivity       NotificationCategory = "trip_activity"
+	NotificationCategoryVotingReminders    NotificationCategory = "voting_reminders"
+	NotificationCategoryDeadlineReminders  NotificationCategory = "deadline_reminders"
+	NotificationCategoryUpcomingTrip       NotificationCategory = "upcoming_trip"
+	NotificationCategoryFinalizedDecisions NotificationCategory = "finalized_decisions"
+)
+
+// NotificationChannel is a way of reaching a user.
+type NotificationChannel string
+
+const (
+	NotificationChannelPush  NotificationChannel = "push"
+	NotificationChannelEmail NotificationChannel = "email"
+	NotificationChannelSMS   NotificationChannel = "sms"
+)
+
+// NotificationAudience describes who a notification is for and which preferences gate it.
+type NotificationAudience struct {
+	TripID uuid.UUID
+	// UserIDs limits the audience to these users; nil means every member of TripID.
+	UserIDs       []uuid.UUID
+	ExcludeUserID uuid.UUID
+	Category      NotificationCategory
+	// TripPreference is the optional per-trip membership toggle, e.g. new_poll.
+	TripPreference NotificationPreference
+}
+
 type NotificationPreferences struct {
 	UserID             uuid.UUID `bun:"user_id,pk,type:uuid" json:"user_id"`
 	PushEnabled        bool      `bun:"push_enabled" json:"push_enabled"`
+	EmailEnabled       bool      `bun:"email_enabled" json:"email_enabled"`
+	SMSEnabled         bool      `bun:"sms_enabled" json:"sms_enabled"`
 	UpcomingTrip       bool      `bun:"upcoming_trip" json:"upcoming_trip"`
 	VotingReminders    bool      `bun:"voting_reminders" json:"voting_reminders"`
 	FinalizedDecisions bool      `bun:"finalized_decisions" json:"finalized_decisions"`
 	TripActivity       bool      `bun:"trip_activity" json:"trip_activity"`
 	DeadlineReminders  bool      `bun:"deadline_reminders" json:"deadline_reminders"`
-	CreatedAt          time.Time `bun:"created_at,nullzero" json:"created_at"`
-	UpdatedAt          time.Time `bun:"updated_at,nullzero" json:"updated_at"`
+	// Quiet hours and digest times are "HH:MM" in the user's timezone.
+	QuietHoursEnabled bool      `bun:"quiet_hours_enabled" json:"quiet_hours_enabled"`
+	QuietHoursStart   string    `bun:"quiet_hours_start" json:"quiet_hours_start"`
+	QuietHoursEnd     string    `bun:"quiet_hours_end" json:"quiet_hours_end"`
+	DigestEnabled     bool      `bun:"digest_enabled" json:"digest_enabled"`
+	DigestTime        string    `bun:"digest_time" json:"digest_time"`
+	CreatedAt         time.Time `bun:"created_at,nullzero" json:"created_at"`
+	UpdatedAt         time.Time `bun:"updated_at,nullzero" json:"updated_at"`
+}
+
+// DefaultNotificationPreferences returns the preferences used for users who have never
+// saved any: every category enabled, delivered immediately by push only.
+func DefaultNotificationPreferences(userID uuid.UUID) *NotificationPreferences {
+	return &NotificationPreferences{
+		UserID:             userID,
+		PushEnabled:        true,
+		UpcomingTrip:       true,
+		VotingReminders:    true,
+		FinalizedDecisions: true,
+		TripActivity:       true,
+		DeadlineReminders:  true,
+		QuietHoursStart:    "22:00",
+		QuietHoursEnd:      "08:00",
+		DigestTime:         "18:00",
+	}
+}
+
+// Deferrable reports whether notifications of this category may be held back by quiet
+// hours or digest mode. Reminders tied to a deadline are always delivered immediately.
+func (c NotificationCategory) Deferrable() bool {
+	return c == NotificationCategoryTripActivity
+}
+
+// Allows reports whether notifications of the given category are enabled on at least
+// one channel.
+func (p *NotificationPreferences) Allows(category NotificationCategory) bool {
+	if !p.PushEnabled && !p.EmailEnabled && !p.SMSEnabled {
+		return false
+	}
+	switch category {
+	case NotificationCategoryTripActivity:
+		return p.TripActivity
+	case NotificationCategoryVotingReminders:
+		return p.VotingReminders
+	case NotificationCategoryDeadlineReminders:
+		return p.DeadlineReminders
+	case NotificationCategoryUpcomingTrip:
+		return p.UpcomingTrip
+	case NotificationCategoryFinalizedDecisions:
+		return p.FinalizedDecisions
+	default:
+		return false
+	}
+}
+
+// ChannelEnabled reports whether the user wants notifications delivered on the channel.
+func (p *NotificationPreferences) ChannelEnabled(channel NotificationChannel) bool {
+	switch channel {
+	case NotificationChannelPush:
+		return p.PushEnabled
+	case NotificationChannelEmail:
+		return p.EmailEnabled
+	case NotificationChannelSMS:
+		return p.SMSEnabled
+	default:
+		return false
+	}
 }
 
 type CreateNotificationPreferencesRequest struct {
-	PushEnabled        *bool `json:"push_enabled"`
-	UpcomingTrip       *bool `json:"upcoming_trip"`
-	VotingReminders    *bool `json:"voting_reminders"`
-	FinalizedDecisions *bool `json:"finalized_decisions"`
-	TripActivity       *bool `json:"trip_activity"`
-	DeadlineReminders  *bool `json:"deadline_reminders"`
+	PushEnabled        *bool   `json:"push_enabled"`
+	EmailEnabled       *bool   `json:"email_enabled"`
+	SMSEnabled         *bool   `json:"sms_enabled"`
+	UpcomingTrip       *bool   `json:"upcoming_trip"`
+	VotingReminders    *bool   `json:"voting_reminders"`
+	FinalizedDecisions *bool   `json:"finalized_decisions"`
+	TripActivity       *bool   `json:"trip_activity"`
+	DeadlineReminders  *bool   `json:"deadline_reminders"`
+	QuietHoursEnabled  *bool   `json:"quiet_hours_enabled"`
+	QuietHoursStart    *string `validate:"omitempty,datetime=15:04" json:"quiet_hours_start"`
+	QuietHoursEnd      *string `validate:"omitempty,datetime=15:04" json:"quiet_hours_end"`
+	DigestEnabled      *bool   `json:"digest_enabled"`
+	DigestTime         *string `validate:"omitempty,datetime=15:04" json:"digest_time"`
 }
 
 type UpdateUserNotificationPreferencesRequest struct {
-	PushEnabled        *bool `validate:"omitempty" json:"push_enabled"`
-	UpcomingTrip       *bool `validate:"omitempty" json:"upcoming_trip"`
-	VotingReminders    *bool `validate:"omitempty" json:"voting_reminders"`
-	FinalizedDecisions *bool `validate:"omitempty" json:"finalized_decisions"`
-	TripActivity       *bool `validate:"omitempty" json:"trip_activity"`
-	DeadlineReminders  *bool `validate:"omitempty" json:"deadline_reminders"`
+	PushEnabled        *bool   `validate:"omitempty" json:"push_enabled"`
+	EmailEnabled       *bool   `validate:"omitempty" json:"email_enabled"`
+	SMSEnabled         *bool   `validate:"omitempty" json:"sms_enabled"`
+	UpcomingTrip       *bool   `validate:"omitempty" json:"upcoming_trip"`
+	VotingReminders    *bool   `validate:"omitempty" json:"voting_reminders"`
+	FinalizedDecisions *bool   `validate:"omitempty" json:"finalized_decisions"`
+	TripActivity       *bool   `validate:"omitempty" json:"trip_activity"`
+	DeadlineReminders  *bool   `validate:"omitempty" json:"deadline_reminders"`
+	QuietHoursEnabled  *bool   `validate:"omitempty" json:"quiet_hours_enabled"`
+	QuietHoursStart    *string `validate:"omitempty,datetime=15:04" json:"quiet_hours_start"`
+	QuietHoursEnd      *string `validate:"omitempty,datetime=15:04" json:"quiet_hours_end"`
+	DigestEnabled      *bool   `validate:"omitempty" json:"digest_enabled"`
+	DigestTime         *string `validate:"omitempty,datetime=15:04" json:"digest_time"`
 }
 
 type SendNotificationRequest struct {
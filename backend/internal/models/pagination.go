@@ -13,7 +13,8 @@ type CursorPaginationParams struct {
 
 type TripsCursorPaginationParams struct {
 	CursorPaginationParams
-	EndDateBefore string `query:"end_date_before" validate:"omitempty"`
+	EndDateBefore   string `query:"end_date_before" validate:"omitempty"`
+	IncludeArchived bool   `query:"include_archived"`
 }
 
 func (p *CursorPaginationParams) GetLimit() *int {
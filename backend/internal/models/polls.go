@@ -33,6 +33,8 @@ type Poll struct {
 	Deadline            *time.Time `bun:"deadline,nullzero" json:"deadline,omitempty"`
 	ShouldNotifyMembers bool       `bun:"should_notify_members,notnull,default:false" json:"should_notify_members"`
 	IsAnonymous         bool       `bun:"is_anonymous,notnull,default:false" json:"is_anonymous"`
+	// VotingLocked keeps a trip's rank poll closed to votes until pitching ends.
+	VotingLocked bool `bun:"voting_locked,notnull,default:false" json:"voting_locked"`
 
 	// Relations
 	Options []PollOption `bun:"rel:has-many,join:id=poll_id" json:"options,omitempty"`
@@ -111,6 +113,7 @@ type PollAPIResponse struct {
 	Deadline            *time.Time              `json:"deadline,omitempty"`
 	IsAnonymous         bool                    `json:"is_anonymous"`
 	ShouldNotifyMembers bool                    `json:"should_notify_members"`
+	VotingLocked        bool                    `json:"voting_locked"`
 	Options             []PollOptionAPIResponse `json:"options"`
 	Categories          []string                `json:"categories,omitempty"`
 }
@@ -175,6 +178,7 @@ type RankPollResultsResponse struct {
 	Question     string            `json:"question"`
 	PollType     PollType          `json:"poll_type"`
 	Deadline     *time.Time        `json:"deadline,omitempty"`
+	VotingLocked bool              `json:"voting_locked"`
 	CreatedBy    uuid.UUID         `json:"created_by"`
 	CreatedAt    time.Time         `json:"created_at"`
 	TotalVoters  int               `json:"total_voters"`
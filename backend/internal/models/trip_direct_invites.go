@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TripDirectInviteStatus string
+
+const (
+	TripDirectInvitePending   TripDirectInviteStatus = "pending"
+	TripDirectInviteAccepted  TripDirectInviteStatus = "accepted"
+	TripDirectInviteDeclined  TripDirectInviteStatus = "declined"
+	TripDirectInviteCancelled TripDirectInviteStatus = "cancelled"
+)
+
+// TripDirectInvite asks a specific user to join a trip. The invitee joins as a member by
+// accepting it.
+type TripDirectInvite struct {
+	ID          uuid.UUID              `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	TripID      uuid.UUID              `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
+	InvitedBy   *uuid.UUID             `bun:"invited_by,type:uuid" json:"invited_by,omitempty"`
+	InviteeID   uuid.UUID              `bun:"invitee_id,type:uuid,notnull" json:"invitee_id"`
+	Status      TripDirectInviteStatus `bun:"status,notnull" json:"status"`
+	RespondedAt *time.Time             `bun:"responded_at" json:"responded_at,omitempty"`
+	CreatedAt   time.Time              `bun:"created_at,nullzero,default:now()" json:"created_at"`
+}
+
+// CreateTripDirectInviteRequest names the invitee by username or phone number; exactly
+// one must be set.
+type CreateTripDirectInviteRequest struct {
+	Username    *string `validate:"required_without=PhoneNumber,excluded_with=PhoneNumber,omitempty,username" json:"username,omitempty"`
+	PhoneNumber *string `validate:"required_without=Username,omitempty,phone" json:"phone_number,omitempty"`
+}
+
+// TripDirectInviteAPIResponse is a direct invite with the trip, inviter and invitee
+// display details.
+type TripDirectInviteAPIResponse struct {
+	bun.BaseModel `bun:"table:trip_direct_invites,alias:tdi" swaggerignore:"true"`
+	TripDirectInvite
+	TripName        string  `bun:"trip_name" json:"trip_name"`
+	InviterName     *string `bun:"inviter_name" json:"inviter_name,omitempty"`
+	InviteeName     string  `bun:"invitee_name" json:"invitee_name"`
+	InviteeUsername string  `bun:"invitee_username" json:"invitee_username"`
+}
+
+type TripDirectInvitesResponse struct {
+	Items []*TripDirectInviteAPIResponse `json:"items"`
+}
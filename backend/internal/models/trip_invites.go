@@ -4,33 +4,103 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/uptrace/bun"
 )
 
-// TripInvite represents a shareable invite for a trip.
+// TripInvite represents a shareable invite for a trip. UseCount counts joins and
+// pending join requests; a nil MaxUses allows unlimited uses.
 type TripInvite struct {
-	ID        uuid.UUID `bun:"id,pk,type:uuid" json:"id"`
-	TripID    uuid.UUID `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
-	CreatedBy uuid.UUID `bun:"created_by,type:uuid,notnull" json:"created_by"`
-	Code      string    `bun:"code,notnull" json:"code"`
-	ExpiresAt time.Time `bun:"expires_at,nullzero,notnull" json:"expires_at"`
-	IsRevoked bool      `bun:"is_revoked,notnull" json:"is_revoked"`
-	CreatedAt time.Time `bun:"created_at,nullzero" json:"created_at"`
+	ID               uuid.UUID `bun:"id,pk,type:uuid" json:"id"`
+	TripID           uuid.UUID `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
+	CreatedBy        uuid.UUID `bun:"created_by,type:uuid,notnull" json:"created_by"`
+	Code             string    `bun:"code,notnull" json:"code"`
+	ExpiresAt        time.Time `bun:"expires_at,nullzero,notnull" json:"expires_at"`
+	IsRevoked        bool      `bun:"is_revoked,notnull" json:"is_revoked"`
+	MaxUses          *int      `bun:"max_uses" json:"max_uses,omitempty"`
+	UseCount         int       `bun:"use_count,notnull" json:"use_count"`
+	RequiresApproval bool      `bun:"requires_approval,notnull" json:"requires_approval"`
+	CreatedAt        time.Time `bun:"created_at,nullzero" json:"created_at"`
+}
+
+// IsUsedUp reports whether the invite has reached its usage limit.
+func (i *TripInvite) IsUsedUp() bool {
+	return i.MaxUses != nil && i.UseCount >= *i.MaxUses
 }
 
 // CreateTripInviteRequest is the request body for creating a trip invite.
 // If ExpiresAt is nil, a default (e.g. 7 days) is applied in the service.
 type CreateTripInviteRequest struct {
 	ExpiresAt *time.Time `json:"expires_at" validate:"omitempty"`
+	// MaxUses limits how many people can use the invite; omit for unlimited.
+	MaxUses *int `json:"max_uses" validate:"omitempty,min=1,max=1000"`
+	// SingleUse is shorthand for max_uses 1.
+	SingleUse bool `json:"single_use"`
+	// RequiresApproval turns joins into requests that a trip admin approves.
+	RequiresApproval bool `json:"requires_approval"`
 }
 
 // TripInviteAPIResponse is the API response for a trip invite.
 type TripInviteAPIResponse struct {
-	ID        uuid.UUID `json:"id"`
-	TripID    uuid.UUID `json:"trip_id"`
-	CreatedBy uuid.UUID `json:"created_by"`
-	Code      string    `json:"code"`
-	ExpiresAt time.Time `json:"expires_at"`
-	IsRevoked bool      `json:"is_revoked"`
-	CreatedAt time.Time `json:"created_at"`
-	JoinURL   *string   `json:"join_url,omitempty"`
+	ID               uuid.UUID            `json:"id"`
+	TripID           uuid.UUID            `json:"trip_id"`
+	CreatedBy        uuid.UUID            `json:"created_by"`
+	Code             string               `json:"code"`
+	ExpiresAt        time.Time            `json:"expires_at"`
+	IsRevoked        bool                 `json:"is_revoked"`
+	MaxUses          *int                 `json:"max_uses,omitempty"`
+	UseCount         int                  `json:"use_count"`
+	RequiresApproval bool                 `json:"requires_approval"`
+	CreatedAt        time.Time            `json:"created_at"`
+	JoinURL          *string              `json:"join_url,omitempty"`
+	Stats            *TripInviteJoinStats `json:"stats,omitempty"`
+}
+
+type TripInvitesResponse struct {
+	Items []*TripInviteAPIResponse `json:"items"`
+}
+
+type TripInviteJoinStatus string
+
+const (
+	TripInviteJoinJoined   TripInviteJoinStatus = "joined"
+	TripInviteJoinPending  TripInviteJoinStatus = "pending"
+	TripInviteJoinRejected TripInviteJoinStatus = "rejected"
+)
+
+// TripInviteJoin records a user joining, or asking to join, through an invite.
+type TripInviteJoin struct {
+	ID        uuid.UUID            `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	InviteID  uuid.UUID            `bun:"invite_id,type:uuid,notnull" json:"invite_id"`
+	TripID    uuid.UUID            `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
+	UserID    uuid.UUID            `bun:"user_id,type:uuid,notnull" json:"user_id"`
+	Status    TripInviteJoinStatus `bun:"status,notnull" json:"status"`
+	DecidedBy *uuid.UUID           `bun:"decided_by,type:uuid" json:"decided_by,omitempty"`
+	DecidedAt *time.Time           `bun:"decided_at" json:"decided_at,omitempty"`
+	CreatedAt time.Time            `bun:"created_at,nullzero,default:now()" json:"created_at"`
+}
+
+// TripInviteJoinAPIResponse is a join with the user's display details.
+type TripInviteJoinAPIResponse struct {
+	bun.BaseModel `bun:"table:trip_invite_joins,alias:tij" swaggerignore:"true"`
+	TripInviteJoin
+	Name     string `bun:"name" json:"name"`
+	Username string `bun:"username" json:"username"`
+}
+
+type TripInviteJoinsResponse struct {
+	Items []*TripInviteJoinAPIResponse `json:"items"`
+}
+
+// TripInviteJoinStats counts an invite's joins by outcome.
+type TripInviteJoinStats struct {
+	Joined   int `json:"joined"`
+	Pending  int `json:"pending"`
+	Rejected int `json:"rejected"`
+}
+
+// JoinTripResult holds the new membership, or the pending request when the invite
+// requires approval.
+type JoinTripResult struct {
+	Membership *Membership
+	Request    *TripInviteJoin
 }
@@ -0,0 +1,119 @@
+package models
+
+// TripRole is a member's role within a trip. Owners and organisers are the trip's
+// admins; viewers can read the trip but not change it.
+type TripRole string
+
+const (
+	TripRoleOwner     TripRole = "owner"
+	TripRoleOrganiser TripRole = "organiser"
+	TripRoleMember    TripRole = "member"
+	TripRoleViewer    TripRole = "viewer"
+)
+
+// TripPermission is an action gated by role.
+type TripPermission string
+
+const (
+	// TripPermissionEditTrip covers the trip's details, categories and integrations.
+	TripPermissionEditTrip TripPermission = "edit_trip"
+	// TripPermissionDeleteTrip deletes the trip for everyone and restores it.
+	TripPermissionDeleteTrip TripPermission = "delete_trip"
+	// TripPermissionArchiveTrip archives and unarchives the trip.
+	TripPermissionArchiveTrip TripPermission = "archive_trip"
+	// TripPermissionContribute covers adding and editing your own pitches, activities,
+	// comments, RSVPs and votes.
+	TripPermissionContribute TripPermission = "contribute"
+	// TripPermissionCreatePolls creates vote and rank polls.
+	TripPermissionCreatePolls TripPermission = "create_polls"
+	// TripPermissionModerateContent edits or deletes other members' activities and polls.
+	TripPermissionModerateContent TripPermission = "moderate_content"
+	// TripPermissionInviteMembers creates invite links.
+	TripPermissionInviteMembers TripPermission = "invite_members"
+	// TripPermissionManageInvites lists and revokes everyone's invites and decides join requests.
+	TripPermissionManageInvites TripPermission = "manage_invites"
+	// TripPermissionRemoveMembers removes other members from the trip.
+	TripPermissionRemoveMembers TripPermission = "remove_members"
+	// TripPermissionManageRoles changes other members' roles. Only owners can change an
+	// organiser's role.
+	TripPermissionManageRoles TripPermission = "manage_roles"
+	// TripPermissionManageTasks creates, edits, assigns and deletes the trip's tasks.
+	// Assignees can update the status of their own tasks with Contribute.
+	TripPermissionManageTasks TripPermission = "manage_tasks"
+)
+
+var tripRolePermissions = map[TripRole][]TripPermission{
+	TripRoleOwner: {
+		TripPermissionEditTrip,
+		TripPermissionDeleteTrip,
+		TripPermissionArchiveTrip,
+		TripPermissionContribute,
+		TripPermissionCreatePolls,
+		TripPermissionModerateContent,
+		TripPermissionInviteMembers,
+		TripPermissionManageInvites,
+		TripPermissionRemoveMembers,
+		TripPermissionManageRoles,
+		TripPermissionManageTasks,
+	},
+	TripRoleOrganiser: {
+		TripPermissionEditTrip,
+		TripPermissionArchiveTrip,
+		TripPermissionContribute,
+		TripPermissionCreatePolls,
+		TripPermissionModerateContent,
+		TripPermissionInviteMembers,
+		TripPermissionManageInvites,
+		TripPermissionRemoveMembers,
+		TripPermissionManageRoles,
+		TripPermissionManageTasks,
+	},
+	TripRoleMember: {
+		TripPermissionContribute,
+		TripPermissionCreatePolls,
+		TripPermissionInviteMembers,
+	},
+	TripRoleViewer: {},
+}
+
+// Can reports whether the role grants the permission. Unknown roles grant nothing.
+func (r TripRole) Can(permission TripPermission) bool {
+	for _, p := range tripRolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// archivedTripPermissions are the only permissions an archived trip still grants; it is
+// otherwise read-only.
+var archivedTripPermissions = map[TripPermission]bool{
+	TripPermissionDeleteTrip:  true,
+	TripPermissionArchiveTrip: true,
+}
+
+// TripAccess is a member's role in a trip and whether the trip is archived.
+type TripAccess struct {
+	Role     TripRole `bun:"role"`
+	Archived bool     `bun:"archived"`
+}
+
+// Can reports whether the member may perform the action on the trip as it stands, so
+// it is false for most permissions while the trip is archived.
+func (a TripAccess) Can(permission TripPermission) bool {
+	if a.Archived && !archivedTripPermissions[permission] {
+		return false
+	}
+	return a.Role.Can(permission)
+}
+
+// IsAdmin reports whether the role counts as a trip admin.
+func (r TripRole) IsAdmin() bool {
+	return r == TripRoleOwner || r == TripRoleOrganiser
+}
+
+// UpdateMemberRoleRequest changes a member's role. Ownership cannot be assigned here.
+type UpdateMemberRoleRequest struct {
+	Role TripRole `validate:"required,oneof=organiser member viewer" json:"role"`
+}
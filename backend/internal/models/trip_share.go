@@ -0,0 +1,96 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tabs of the public share page an admin can choose to show. The trip's name, dates,
+// location and cover are always visible.
+const (
+	TripShareTabItinerary  = "itinerary"
+	TripShareTabActivities = "activities"
+	TripShareTabPolls      = "polls"
+)
+
+// TripShareTabs lists every share page tab in display order.
+var TripShareTabs = []string{TripShareTabItinerary, TripShareTabActivities, TripShareTabPolls}
+
+// TripShareLink is a trip's public, read-only share link. Anyone with the token can
+// view the tabs in VisibleTabs without signing in.
+type TripShareLink struct {
+	TripID      uuid.UUID  `bun:"trip_id,pk,type:uuid" json:"trip_id"`
+	Token       string     `bun:"token,notnull" json:"token"`
+	VisibleTabs []string   `bun:"visible_tabs,array" json:"visible_tabs"`
+	CreatedBy   *uuid.UUID `bun:"created_by,type:uuid" json:"created_by,omitempty"`
+	CreatedAt   time.Time  `bun:"created_at,nullzero" json:"created_at"`
+	UpdatedAt   time.Time  `bun:"updated_at,nullzero" json:"updated_at"`
+}
+
+// ShowsTab reports whether the share page includes the tab.
+func (l *TripShareLink) ShowsTab(tab string) bool {
+	for _, t := range l.VisibleTabs {
+		if t == tab {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateTripShareLinkRequest enables the share link or changes its visible tabs. A new
+// link shows every tab when VisibleTabs is omitted.
+type UpdateTripShareLinkRequest struct {
+	VisibleTabs *[]string `validate:"omitempty,unique,dive,oneof=itinerary activities polls" json:"visible_tabs"`
+}
+
+type TripShareLinkAPIResponse struct {
+	*TripShareLink
+	URL string `json:"url,omitempty"`
+}
+
+// TripSharePageData contains data rendered by the public share page.
+type TripSharePageData struct {
+	TripName       string
+	Location       string
+	Dates          string
+	CoverImageURL  *string
+	CanonicalURL   string
+	MemberCount    int
+	Itinerary      []TripShareDay
+	Activities     []TripShareActivity
+	Polls          []TripSharePollResult
+	ShowItinerary  bool
+	ShowActivities bool
+	ShowPolls      bool
+	ErrorMessage   string
+}
+
+// TripShareDay is one dated day of the shared itinerary.
+type TripShareDay struct {
+	Label      string
+	Activities []TripShareActivity
+}
+
+type TripShareActivity struct {
+	Name         string
+	Description  string
+	LocationName string
+	TimeOfDay    string
+	ThumbnailURL string
+}
+
+// TripSharePollResult is a closed poll's outcome. Winners holds every option tied for
+// the lead.
+type TripSharePollResult struct {
+	Question string
+	Winners  []string
+	Options  []TripSharePollOption
+}
+
+// TripSharePollOption is an option's vote count, or Borda score for rank polls.
+type TripSharePollOption struct {
+	Name     string
+	Score    int
+	IsWinner bool
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SlackPollVoteActionID identifies the vote buttons on polls posted to Slack. Each
+// button's value is "<poll_id>:<option_id>".
+const SlackPollVoteActionID = "poll_vote"
+
+// TripSlackChannel links a trip to the Slack channel that receives its new polls,
+// pitches and finalised decisions.
+type TripSlackChannel struct {
+	TripID    uuid.UUID  `bun:"trip_id,pk,type:uuid" json:"trip_id"`
+	ChannelID string     `bun:"channel_id,notnull" json:"channel_id"`
+	LinkedBy  *uuid.UUID `bun:"linked_by,type:uuid" json:"linked_by,omitempty"`
+	CreatedAt time.Time  `bun:"created_at,nullzero,default:now()" json:"created_at"`
+}
+
+type LinkSlackChannelRequest struct {
+	ChannelID string `validate:"required,max=64" json:"channel_id"`
+}
+
+// SlackPollVote is a vote button pressed in Slack, after the request signature has
+// been verified.
+type SlackPollVote struct {
+	SlackUserID string
+	ChannelID   string
+	MessageTS   string
+	PollID      uuid.UUID
+	OptionID    uuid.UUID
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TripTaskStatus string
+
+const (
+	TripTaskTodo       TripTaskStatus = "todo"
+	TripTaskInProgress TripTaskStatus = "in_progress"
+	TripTaskDone       TripTaskStatus = "done"
+)
+
+// TripTaskReminderLead is how long before a task's due date its assignees are reminded.
+const TripTaskReminderLead = 24 * time.Hour
+
+// TripTask is an item on the organisers' to-do list for a trip, such as booking the
+// accommodation. It is discussed through comments like activities and pitches.
+type TripTask struct {
+	bun.BaseModel `bun:"table:trip_tasks,alias:tt" swaggerignore:"true"`
+	ID            uuid.UUID      `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	TripID        uuid.UUID      `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
+	Title         string         `bun:"title,notnull" json:"title"`
+	Description   *string        `bun:"description" json:"description,omitempty"`
+	Status        TripTaskStatus `bun:"status,notnull" json:"status"`
+	DueAt         *time.Time     `bun:"due_at" json:"due_at,omitempty"`
+	ActivityID    *uuid.UUID     `bun:"activity_id,type:uuid" json:"activity_id,omitempty"`
+	CreatedBy     *uuid.UUID     `bun:"created_by,type:uuid" json:"created_by,omitempty"`
+	CompletedAt   *time.Time     `bun:"completed_at" json:"completed_at,omitempty"`
+	CreatedAt     time.Time      `bun:"created_at,nullzero,default:now()" json:"created_at"`
+	UpdatedAt     time.Time      `bun:"updated_at,nullzero,default:now()" json:"updated_at"`
+	AssigneeIDs   []uuid.UUID    `bun:"-" json:"assignee_ids"`
+}
+
+// IsAssignee reports whether the user is assigned to the task.
+func (t *TripTask) IsAssignee(userID uuid.UUID) bool {
+	for _, id := range t.AssigneeIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+type TripTaskAssignee struct {
+	bun.BaseModel `bun:"table:trip_task_assignees,alias:tta" swaggerignore:"true"`
+	TaskID        uuid.UUID `bun:"task_id,pk,type:uuid" json:"task_id"`
+	UserID        uuid.UUID `bun:"user_id,pk,type:uuid" json:"user_id"`
+	CreatedAt     time.Time `bun:"created_at,nullzero,default:now()" json:"created_at"`
+}
+
+type CreateTripTaskRequest struct {
+	Title       string      `validate:"required,min=1,max=200" json:"title"`
+	Description *string     `validate:"omitempty,max=2000" json:"description,omitempty"`
+	DueAt       *time.Time  `json:"due_at,omitempty"`
+	ActivityID  *uuid.UUID  `json:"activity_id,omitempty"`
+	AssigneeIDs []uuid.UUID `validate:"omitempty,max=20" json:"assignee_ids,omitempty"`
+}
+
+// UpdateTripTaskRequest changes the fields that are set. ClearDueAt and ClearActivity
+// remove the due date and the linked activity; AssigneeIDs replaces the assignees.
+type UpdateTripTaskRequest struct {
+	Title         *string         `validate:"omitempty,min=1,max=200" json:"title,omitempty"`
+	Description   *string         `validate:"omitempty,max=2000" json:"description,omitempty"`
+	Status        *TripTaskStatus `validate:"omitempty,oneof=todo in_progress done" json:"status,omitempty"`
+	DueAt         *time.Time      `json:"due_at,omitempty"`
+	ClearDueAt    bool            `json:"clear_due_at,omitempty"`
+	ActivityID    *uuid.UUID      `json:"activity_id,omitempty"`
+	ClearActivity bool            `json:"clear_activity,omitempty"`
+	AssigneeIDs   *[]uuid.UUID    `validate:"omitempty,max=20" json:"assignee_ids,omitempty"`
+}
+
+// OnlyChangesStatus reports whether the request changes nothing but the status, which
+// assignees may do on their own tasks.
+func (r UpdateTripTaskRequest) OnlyChangesStatus() bool {
+	return r.Title == nil && r.Description == nil && r.DueAt == nil && !r.ClearDueAt &&
+		r.ActivityID == nil && !r.ClearActivity && r.AssigneeIDs == nil
+}
+
+// TripTaskQueryParams filters a trip's task list.
+type TripTaskQueryParams struct {
+	Status     TripTaskStatus `query:"status" validate:"omitempty,oneof=todo in_progress done"`
+	AssigneeID string         `query:"assignee_id" validate:"omitempty,uuid"`
+}
+
+type TripTasksResponse struct {
+	Items []*TripTask `json:"items"`
+}
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TripTemplate is a reusable snapshot of a trip's plan. Public templates can be used by
+// anyone; private ones only by their creator.
+type TripTemplate struct {
+	ID           uuid.UUID        `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	Name         string           `bun:"name,notnull" json:"name"`
+	Description  *string          `bun:"description" json:"description,omitempty"`
+	CreatedBy    *uuid.UUID       `bun:"created_by,type:uuid" json:"created_by,omitempty"`
+	SourceTripID *uuid.UUID       `bun:"source_trip_id,type:uuid" json:"source_trip_id,omitempty"`
+	IsPublic     bool             `bun:"is_public" json:"is_public"`
+	Data         TripTemplateData `bun:"data,type:jsonb" json:"data"`
+	CreatedAt    time.Time        `bun:"created_at,nullzero" json:"created_at"`
+	UpdatedAt    time.Time        `bun:"updated_at,nullzero" json:"updated_at"`
+}
+
+// CanUse reports whether the user may view the template and start trips from it.
+func (t *TripTemplate) CanUse(userID uuid.UUID) bool {
+	return t.IsPublic || (t.CreatedBy != nil && *t.CreatedBy == userID)
+}
+
+// TripTemplateData is everything copied into a trip started from a template or
+// duplicated from another trip. Members, pitches, comments, RSVPs, votes and images are
+// never copied. StartDate and EndDate are the source trip's dates, used to shift
+// activity dates to the new trip's start.
+type TripTemplateData struct {
+	BudgetMin             int                    `json:"budget_min"`
+	BudgetMax             int                    `json:"budget_max"`
+	Currency              string                 `json:"currency"`
+	Location              *string                `json:"location,omitempty"`
+	DepartedContentPolicy DepartedContentPolicy  `json:"departed_content_policy,omitempty"`
+	StartDate             *time.Time             `json:"start_date,omitempty"`
+	EndDate               *time.Time             `json:"end_date,omitempty"`
+	Categories            []TripTemplateCategory `json:"categories"`
+	Activities            []TripTemplateActivity `json:"activities"`
+	Polls                 []TripTemplatePoll     `json:"polls"`
+}
+
+// ShiftedTo returns the trip dates and activities for a trip starting on startDate.
+// Activity dates keep their distance from the source trip's start and the trip keeps its
+// length. Without both dates nothing can be lined up, so the trip and its activities are
+// left undated.
+func (d *TripTemplateData) ShiftedTo(startDate *time.Time) (start, end *time.Time, activities []TripTemplateActivity) {
+	activities = make([]TripTemplateActivity, len(d.Activities))
+	copy(activities, d.Activities)
+	if startDate == nil || d.StartDate == nil {
+		for i := range activities {
+			activities[i].Dates = nil
+		}
+		return nil, nil, activities
+	}
+
+	newStart := startDate.UTC()
+	start = &newStart
+	if d.EndDate != nil {
+		newEnd := newStart.Add(d.EndDate.Sub(*d.StartDate))
+		end = &newEnd
+	}
+
+	days := int(civilDate(newStart).Sub(civilDate(d.StartDate.UTC())).Hours() / 24)
+	for i, a := range activities {
+		if a.Dates == nil {
+			continue
+		}
+		dates := make([]DateRange, 0, len(*a.Dates))
+		for _, r := range *a.Dates {
+			rangeStart, errStart := time.Parse(time.DateOnly, r.Start)
+			rangeEnd, errEnd := time.Parse(time.DateOnly, r.End)
+			if errStart != nil || errEnd != nil {
+				continue
+			}
+			dates = append(dates, DateRange{
+				Start: rangeStart.AddDate(0, 0, days).Format(time.DateOnly),
+				End:   rangeEnd.AddDate(0, 0, days).Format(time.DateOnly),
+			})
+		}
+		activities[i].Dates = &dates
+	}
+	return start, end, activities
+}
+
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+type TripTemplateCategory struct {
+	Name      string           `json:"name"`
+	Label     string           `json:"label"`
+	Icon      *string          `json:"icon,omitempty"`
+	IsHidden  bool             `json:"is_hidden"`
+	IsDefault bool             `json:"is_default"`
+	ViewType  CategoryViewType `json:"view_type"`
+	Position  int              `json:"position"`
+}
+
+// TripTemplateActivity is an activity without its proposer, images or RSVPs. Key links
+// it to poll options that name it.
+type TripTemplateActivity struct {
+	Key            uuid.UUID          `json:"key"`
+	Name           string             `json:"name"`
+	TimeOfDay      *ActivityTimeOfDay `json:"time_of_day,omitempty"`
+	ThumbnailURL   *string            `json:"thumbnail_url,omitempty"`
+	MediaURL       *string            `json:"media_url,omitempty"`
+	Description    *string            `json:"description,omitempty"`
+	Dates          *[]DateRange       `json:"dates,omitempty"`
+	LocationName   *string            `json:"location_name,omitempty"`
+	LocationLat    *float64           `json:"location_lat,omitempty"`
+	LocationLng    *float64           `json:"location_lng,omitempty"`
+	EstimatedPrice *float64           `json:"estimated_price,omitempty"`
+	CategoryNames  []string           `json:"category_names"`
+}
+
+// TripTemplatePoll is a poll's question and options without votes or a deadline.
+type TripTemplatePoll struct {
+	Question            string                   `json:"question"`
+	PollType            PollType                 `json:"poll_type"`
+	IsAnonymous         bool                     `json:"is_anonymous"`
+	ShouldNotifyMembers bool                     `json:"should_notify_members"`
+	Options             []TripTemplatePollOption `json:"options"`
+	Categories          []string                 `json:"categories"`
+}
+
+// TripTemplatePollOption is a custom option, or one naming the template activity with
+// ActivityKey. Options for other entities, such as pitches, are copied as custom ones.
+type TripTemplatePollOption struct {
+	Name        string     `json:"name"`
+	ActivityKey *uuid.UUID `json:"activity_key,omitempty"`
+}
+
+// DuplicateTripRequest copies a trip into a new one owned by the caller. Dates are
+// shifted so the new trip starts on StartDate; without it the copy is undated.
+type DuplicateTripRequest struct {
+	Name      *string    `validate:"omitempty,min=1" json:"name,omitempty"`
+	StartDate *time.Time `json:"start_date,omitempty" swaggertype:"string" format:"date-time"`
+}
+
+// CreateTripTemplateRequest saves a trip as a template.
+type CreateTripTemplateRequest struct {
+	Name        string  `validate:"required,min=1,max=255" json:"name"`
+	Description *string `validate:"omitempty,max=2000" json:"description,omitempty"`
+	IsPublic    bool    `json:"is_public"`
+}
+
+// CreateTripFromTemplateRequest starts a trip from a template. Name defaults to the
+// template's name; dates work as in DuplicateTripRequest.
+type CreateTripFromTemplateRequest struct {
+	Name      *string    `validate:"omitempty,min=1" json:"name,omitempty"`
+	StartDate *time.Time `json:"start_date,omitempty" swaggertype:"string" format:"date-time"`
+}
+
+// TripTemplateSummary describes a template in listings without its contents.
+type TripTemplateSummary struct {
+	ID             uuid.UUID  `json:"id"`
+	Name           string     `json:"name"`
+	Description    *string    `json:"description,omitempty"`
+	CreatedBy      *uuid.UUID `json:"created_by,omitempty"`
+	IsPublic       bool       `json:"is_public"`
+	TripLengthDays *int       `json:"trip_length_days,omitempty"`
+	CategoryCount  int        `json:"category_count"`
+	ActivityCount  int        `json:"activity_count"`
+	PollCount      int        `json:"poll_count"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type TripTemplateListResponse struct {
+	Items []*TripTemplateSummary `json:"items"`
+}
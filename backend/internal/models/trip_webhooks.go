@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookPingTopic is the topic of the test delivery sent by the ping endpoint.
+const WebhookPingTopic = "webhook.ping"
+
+// TripWebhook subscribes an external URL to a trip's realtime events. Each delivery is
+// signed with Secret, which is only returned when the webhook is created.
+type TripWebhook struct {
+	ID        uuid.UUID  `bun:"id,pk,type:uuid" json:"id"`
+	TripID    uuid.UUID  `bun:"trip_id,type:uuid,notnull" json:"trip_id"`
+	CreatedBy *uuid.UUID `bun:"created_by,type:uuid" json:"created_by,omitempty"`
+	URL       string     `bun:"url,notnull" json:"url"`
+	Secret    string     `bun:"secret,notnull" json:"-"`
+	Topics    []string   `bun:"topics,array" json:"topics"`
+	Enabled   bool       `bun:"enabled" json:"enabled"`
+	CreatedAt time.Time  `bun:"created_at,nullzero" json:"created_at"`
+	UpdatedAt time.Time  `bun:"updated_at,nullzero" json:"updated_at"`
+}
+
+// Subscribes reports whether the webhook wants events of the topic.
+func (w *TripWebhook) Subscribes(topic string) bool {
+	for _, t := range w.Topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+type CreateTripWebhookRequest struct {
+	URL    string   `validate:"required,url,max=2048" json:"url"`
+	Topics []string `validate:"required,min=1,dive,required" json:"topics"`
+	// Secret is generated when omitted.
+	Secret *string `validate:"omitempty,min=16,max=256" json:"secret,omitempty"`
+}
+
+type UpdateTripWebhookRequest struct {
+	URL     *string   `validate:"omitempty,url,max=2048" json:"url"`
+	Topics  *[]string `validate:"omitempty,min=1,dive,required" json:"topics"`
+	Enabled *bool     `validate:"omitempty" json:"enabled"`
+}
+
+// CreatedTripWebhookResponse includes the signing secret, which is not shown again.
+type CreatedTripWebhookResponse struct {
+	*TripWebhook
+	Secret string `json:"secret"`
+}
+
+type TripWebhooksResponse struct {
+	Items []*TripWebhook `json:"items"`
+}
+
+type TripWebhookDeliveryStatus string
+
+const (
+	TripWebhookDeliveryPending   TripWebhookDeliveryStatus = "pending"
+	TripWebhookDeliverySucceeded TripWebhookDeliveryStatus = "succeeded"
+	TripWebhookDeliveryFailed    TripWebhookDeliveryStatus = "failed"
+)
+
+// TripWebhookDelivery logs one event sent to a webhook. Retries update the same row.
+type TripWebhookDelivery struct {
+	ID             uuid.UUID                 `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	WebhookID      uuid.UUID                 `bun:"webhook_id,type:uuid,notnull" json:"webhook_id"`
+	EventID        string                    `bun:"event_id,notnull" json:"event_id"`
+	Topic          string                    `bun:"topic,notnull" json:"topic"`
+	Status         TripWebhookDeliveryStatus `bun:"status,notnull" json:"status"`
+	Attempts       int                       `bun:"attempts,notnull" json:"attempts"`
+	ResponseStatus *int                      `bun:"response_status" json:"response_status,omitempty"`
+	ErrorMessage   *string                   `bun:"error_message" json:"error_message,omitempty"`
+	CreatedAt      time.Time                 `bun:"created_at,nullzero" json:"created_at"`
+	LastAttemptAt  *time.Time                `bun:"last_attempt_at" json:"last_attempt_at,omitempty"`
+}
+
+type TripWebhookDeliveriesResponse struct {
+	Items []*TripWebhookDelivery `json:"items"`
+}
@@ -6,6 +6,17 @@ import (
 	"github.com/google/uuid"
 )
 
+// DepartedContentPolicy decides what happens to a member's pitches and activities when
+// they leave or are removed from a trip.
+type DepartedContentPolicy string
+
+const (
+	// DepartedContentReassign hands the content to the trip owner.
+	DepartedContentReassign DepartedContentPolicy = "reassign"
+	// DepartedContentAnonymise keeps the content on the trip without an author.
+	DepartedContentAnonymise DepartedContentPolicy = "anonymise"
+)
+
 type Trip struct {
 	ID            uuid.UUID  `bun:"id,pk,type:uuid" json:"id"`
 	Name          string     `bun:"name" json:"name"`
@@ -15,11 +26,43 @@ type Trip struct {
 	Currency      string     `bun:"currency" json:"currency"`
 	PitchDeadline *time.Time `bun:"pitch_deadline" json:"pitch_deadline,omitempty"`
 	RankPollID    *uuid.UUID `bun:"rank_poll_id,type:uuid" json:"rank_poll_id,omitempty"`
-	StartDate     *time.Time `bun:"start_date" json:"start_date,omitempty"`
-	EndDate       *time.Time `bun:"end_date" json:"end_date,omitempty"`
-	Location      *string    `bun:"location" json:"location,omitempty"`
-	CreatedAt     time.Time  `bun:"created_at,nullzero" json:"created_at"`
-	UpdatedAt     time.Time  `bun:"updated_at,nullzero" json:"updated_at"`
+	// PitchingClosedAt is set when the pitch deadline passes; no new pitches are accepted.
+	PitchingClosedAt *time.Time `bun:"pitching_closed_at" json:"pitching_closed_at,omitempty"`
+	StartDate        *time.Time `bun:"start_date" json:"start_date,omitempty"`
+	EndDate          *time.Time `bun:"end_date" json:"end_date,omitempty"`
+	Location         *string    `bun:"location" json:"location,omitempty"`
+	// DepartedContentPolicy defaults to reassign.
+	DepartedContentPolicy DepartedContentPolicy `bun:"departed_content_policy,nullzero" json:"departed_content_policy"`
+	// ArchivedAt is set while the trip is archived and read-only.
+	ArchivedAt *time.Time `bun:"archived_at" json:"archived_at,omitempty"`
+	// DeletedAt is set when the trip is deleted; it is purged after TripRestoreWindow.
+	DeletedAt *time.Time `bun:"deleted_at" json:"deleted_at,omitempty"`
+	CreatedAt time.Time  `bun:"created_at,nullzero" json:"created_at"`
+	UpdatedAt time.Time  `bun:"updated_at,nullzero" json:"updated_at"`
+}
+
+// TripRestoreWindow is how long a deleted trip can be restored before it is purged.
+const TripRestoreWindow = 30 * 24 * time.Hour
+
+// PurgeAt is when the deleted trip and its media are permanently removed.
+func (t *Trip) PurgeAt() *time.Time {
+	if t.DeletedAt == nil {
+		return nil
+	}
+	purgeAt := t.DeletedAt.Add(TripRestoreWindow)
+	return &purgeAt
+}
+
+// DeletedTripAPIResponse is a deleted trip that can still be restored.
+type DeletedTripAPIResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
+
+type DeletedTripsResponse struct {
+	Items []*DeletedTripAPIResponse `json:"items"`
 }
 
 type UpdateTripRequest struct {
@@ -32,6 +75,8 @@ type UpdateTripRequest struct {
 	EndDate       *time.Time `json:"end_date,omitempty" swaggertype:"string" format:"date-time"`
 	PitchDeadline *time.Time `json:"pitch_deadline,omitempty"`
 	Location      *string    `json:"location,omitempty"`
+	// DepartedContentPolicy is editable by trip admins only.
+	DepartedContentPolicy *DepartedContentPolicy `validate:"omitempty,oneof=reassign anonymise" json:"departed_content_policy,omitempty"`
 }
 
 type CreateTripRequest struct {
@@ -80,36 +125,42 @@ type TripMemberStats struct {
 }
 
 type TripDatabaseResponse struct {
-	TripID        uuid.UUID  `bun:"trip_id"`
-	Name          string     `bun:"name"`
-	CoverImageID  *uuid.UUID `bun:"cover_image"`
-	CoverImageKey *string    `bun:"cover_image_key"`
-	BudgetMin     int        `bun:"budget_min"`
-	BudgetMax     int        `bun:"budget_max"`
-	Currency      string     `bun:"currency"`
-	PitchDeadline *time.Time `bun:"pitch_deadline"`
-	RankPollID    *uuid.UUID `bun:"rank_poll_id"`
-	StartDate     *time.Time `bun:"start_date"`
-	EndDate       *time.Time `bun:"end_date"`
-	Location      *string    `bun:"location"`
-	CreatedAt     time.Time  `bun:"created_at"`
-	UpdatedAt     time.Time  `bun:"updated_at"`
+	TripID                uuid.UUID             `bun:"trip_id"`
+	Name                  string                `bun:"name"`
+	CoverImageID          *uuid.UUID            `bun:"cover_image"`
+	CoverImageKey         *string               `bun:"cover_image_key"`
+	BudgetMin             int                   `bun:"budget_min"`
+	BudgetMax             int                   `bun:"budget_max"`
+	Currency              string                `bun:"currency"`
+	PitchDeadline         *time.Time            `bun:"pitch_deadline"`
+	RankPollID            *uuid.UUID            `bun:"rank_poll_id"`
+	PitchingClosedAt      *time.Time            `bun:"pitching_closed_at"`
+	StartDate             *time.Time            `bun:"start_date"`
+	EndDate               *time.Time            `bun:"end_date"`
+	Location              *string               `bun:"location"`
+	DepartedContentPolicy DepartedContentPolicy `bun:"departed_content_policy"`
+	ArchivedAt            *time.Time            `bun:"archived_at"`
+	CreatedAt             time.Time             `bun:"created_at"`
+	UpdatedAt             time.Time             `bun:"updated_at"`
 }
 
 type TripAPIResponse struct {
-	ID             uuid.UUID          `json:"id"`
-	Name           string             `json:"name"`
-	CoverImageURL  *string            `json:"cover_image_url"`
-	BudgetMin      int                `json:"budget_min"`
-	BudgetMax      int                `json:"budget_max"`
-	Currency       string             `json:"currency"`
-	PitchDeadline  *time.Time         `json:"pitch_deadline,omitempty"`
-	RankPollID     *uuid.UUID         `json:"rank_poll_id,omitempty"`
-	StartDate      *time.Time         `json:"start_date,omitempty" swaggertype:"string" format:"date-time"`
-	EndDate        *time.Time         `json:"end_date,omitempty" swaggertype:"string" format:"date-time"`
-	Location       *string            `json:"location,omitempty"`
-	MemberCount    int                `json:"member_count"`
-	MemberPreviews []CommenterPreview `json:"member_previews"`
-	CreatedAt      time.Time          `json:"created_at"`
-	UpdatedAt      time.Time          `json:"updated_at"`
+	ID                    uuid.UUID             `json:"id"`
+	Name                  string                `json:"name"`
+	CoverImageURL         *string               `json:"cover_image_url"`
+	BudgetMin             int                   `json:"budget_min"`
+	BudgetMax             int                   `json:"budget_max"`
+	Currency              string                `json:"currency"`
+	PitchDeadline         *time.Time            `json:"pitch_deadline,omitempty"`
+	RankPollID            *uuid.UUID            `json:"rank_poll_id,omitempty"`
+	PitchingClosedAt      *time.Time            `json:"pitching_closed_at,omitempty"`
+	StartDate             *time.Time            `json:"start_date,omitempty" swaggertype:"string" format:"date-time"`
+	EndDate               *time.Time            `json:"end_date,omitempty" swaggertype:"string" format:"date-time"`
+	Location              *string               `json:"location,omitempty"`
+	MemberCount           int                   `json:"member_count"`
+	MemberPreviews        []CommenterPreview    `json:"member_previews"`
+	DepartedContentPolicy DepartedContentPolicy `json:"departed_content_policy"`
+	ArchivedAt            *time.Time            `json:"archived_at,omitempty"`
+	CreatedAt             time.Time             `json:"created_at"`
+	UpdatedAt             time.Time             `json:"updated_at"`
 }
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type DevicePlatform string
+
+const (
+	DevicePlatformIOS     DevicePlatform = "ios"
+	DevicePlatformAndroid DevicePlatform = "android"
+	DevicePlatformWeb     DevicePlatform = "web"
+	DevicePlatformUnknown DevicePlatform = "unknown"
+)
+
+// UserDevice is an Expo push token registered by one of the user's devices.
+type UserDevice struct {
+	bun.BaseModel `bun:"table:user_devices" swaggerignore:"true"`
+
+	ID         uuid.UUID      `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	UserID     uuid.UUID      `bun:"user_id,type:uuid,notnull" json:"user_id"`
+	Token      string         `bun:"token,notnull" json:"token"`
+	Platform   DevicePlatform `bun:"platform,notnull" json:"platform"`
+	AppVersion *string        `bun:"app_version" json:"app_version,omitempty"`
+	LastSeenAt time.Time      `bun:"last_seen_at,nullzero" json:"last_seen_at"`
+	CreatedAt  time.Time      `bun:"created_at,nullzero" json:"created_at"`
+	UpdatedAt  time.Time      `bun:"updated_at,nullzero" json:"updated_at"`
+}
+
+type RegisterDeviceRequest struct {
+	Token      string          `validate:"required,min=1,max=200" json:"token"`
+	Platform   *DevicePlatform `validate:"omitempty,oneof=ios android web" json:"platform"`
+	AppVersion *string         `validate:"omitempty,max=50" json:"app_version"`
+}
+
+type UnregisterDeviceRequest struct {
+	Token string `validate:"required,min=1,max=200" json:"token"`
+}
@@ -6,19 +6,37 @@ import (
 	"github.com/google/uuid"
 )
 
+// DefaultLocale is used for users without a locale and for missing translations.
+const DefaultLocale = "en"
+
+// SupportedLocales lists the locales notifications and the activity feed are translated into.
+var SupportedLocales = []string{"en", "es"}
+
 type User struct {
 	ID                   uuid.UUID  `bun:"id,pk,type:uuid" json:"id"`
 	Name                 string     `bun:"name" json:"name"`
 	Username             string     `bun:"username" json:"username"`
 	PhoneNumber          string     `bun:"phone_number" json:"phone_number"`
+	Email                *string    `bun:"email" json:"email,omitempty"`
 	ProfilePicture       *uuid.UUID `bun:"profile_picture,unique,type:uuid" json:"profile_picture,omitempty"`
 	DeviceToken          *string    `bun:"device_token" json:"device_token"`
 	DeviceTokenUpdatedAt *time.Time `bun:"device_token_updated_at" json:"device_token_updated_at"`
 	Timezone             string     `bun:"timezone" json:"timezone"`
+	Locale               string     `bun:"locale" json:"locale"`
 	AppleMapsEnabled     bool       `bun:"apple_maps_enabled" json:"apple_maps_enabled"`
 	GoogleMapsEnabled    bool       `bun:"google_maps_enabled" json:"google_maps_enabled"`
-	CreatedAt            time.Time  `bun:"created_at,nullzero" json:"created_at"`
-	UpdatedAt            time.Time  `bun:"updated_at,nullzero" json:"updated_at"`
+	// EmailVerifiedAt is set once the user confirms Email with a code sent to it. Email
+	// is neither notified nor matched by lookups until then.
+	EmailVerifiedAt *time.Time `bun:"email_verified_at" json:"email_verified_at,omitempty"`
+	// The pending verification code, stored hashed, and when it stops being accepted.
+	EmailVerificationCodeHash  *string    `bun:"email_verification_code_hash" json:"-"`
+	EmailVerificationExpiresAt *time.Time `bun:"email_verification_expires_at" json:"-"`
+	// PhoneNumberHash is the hex SHA-256 of PhoneNumber, matched by contact discovery.
+	PhoneNumberHash string `bun:"phone_number_hash,scanonly" json:"-"`
+	// PhoneDiscoveryDisabled hides the user from contact discovery.
+	PhoneDiscoveryDisabled bool      `bun:"phone_discovery_disabled" json:"phone_discovery_disabled"`
+	CreatedAt              time.Time `bun:"created_at,nullzero" json:"created_at"`
+	UpdatedAt              time.Time `bun:"updated_at,nullzero" json:"updated_at"`
 }
 
 type CreateUserRequest struct {
@@ -31,9 +49,18 @@ type UpdateUserRequest struct {
 	Name           *string    `validate:"omitempty,min=1" json:"name"`
 	Username       *string    `validate:"omitempty,username" json:"username"`
 	PhoneNumber    *string    `validate:"omitempty,phone" json:"phone_number"`
+	Email          *string    `validate:"omitempty,email,max=254" json:"email"`
 	ProfilePicture *uuid.UUID `validate:"omitempty" json:"profile_picture,omitempty"`
 	DeviceToken    *string    `validate:"omitempty,max=200" json:"device_token"`
 	Timezone       *string    `validate:"omitempty,timezone" json:"timezone"`
+	Locale         *string    `validate:"omitempty,locale" json:"locale"`
 	AppleMaps      *bool      `json:"apple_maps_enabled"`
 	GoogleMaps     *bool      `json:"google_maps_enabled"`
+	// PhoneDiscoveryDisabled stops friends finding the user from their address book.
+	PhoneDiscoveryDisabled *bool `json:"phone_discovery_disabled"`
+}
+
+// VerifyEmailRequest confirms the user's email with the code sent to it.
+type VerifyEmailRequest struct {
+	Code string `validate:"required,len=6,numeric" json:"code"`
 }
@@ -20,7 +20,8 @@ const (
 	maxMessageSize = 64 * 1024
 )
 
-// Client represents a WebSocket connection.
+// Client represents a WebSocket or SSE connection. Conn is nil for SSE clients,
+// whose messages are written by SSEHandler instead of WritePump.
 type Client struct {
 	ID             string
 	UserID         string
@@ -31,7 +32,7 @@ type Client struct {
 	subscriptionMu sync.RWMutex
 }
 
-// NewClient creates a new WebSocket client instance.
+// NewClient creates a new client instance. Pass a nil conn for SSE clients.
 func NewClient(id string, userID string, hub Hub, conn *websocket.Conn) *Client {
 	return &Client{
 		ID:            id,
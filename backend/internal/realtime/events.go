@@ -1,4 +1,4 @@
-// Package realtime provides WebSocket-based real-time event broadcasting with Redis pub/sub.
+// Package realtime provides WebSocket and Server-Sent Events real-time event broadcasting with Redis pub/sub.
 package realtime
 
 import (
@@ -14,9 +14,11 @@ type UnreadCountResponse struct {
 }
 
 // Event represents a real-time event with topic-based routing and trip scoping.
+// Version is the schema version of Data for the topic; see EventSchema.
 type Event struct {
 	ID        string          `json:"id"`
 	Topic     string          `json:"topic"`
+	Version   int             `json:"version"`
 	TripID    string          `json:"trip_id"`
 	EntityID  string          `json:"entity_id,omitempty"`
 	ActorID   string          `json:"actor_id,omitempty"`
@@ -35,6 +37,7 @@ func NewEvent(topic EventTopic, tripID string, data any) (*Event, error) {
 	return &Event{
 		ID:        uuid.New().String(),
 		Topic:     string(topic),
+		Version:   SchemaVersion(topic),
 		TripID:    tripID,
 		Data:      dataBytes,
 		Timestamp: time.Now().UTC(),
@@ -52,6 +55,7 @@ func NewEventWithActor(topic EventTopic, tripID, entityID, actorID, actorName st
 	return &Event{
 		ID:        uuid.New().String(),
 		Topic:     string(topic),
+		Version:   SchemaVersion(topic),
 		TripID:    tripID,
 		EntityID:  entityID,
 		ActorID:   actorID,
@@ -79,9 +83,10 @@ const (
 	MessageTypePing        = "ping"
 )
 
-// ServerMessage represents messages sent from the server to WebSocket clients.
+// ServerMessage represents messages sent from the server to WebSocket and SSE clients.
 type ServerMessage struct {
 	Type      string    `json:"type"`
+	ClientID  string    `json:"client_id,omitempty"`
 	Events    []Event   `json:"events,omitempty"`
 	Error     string    `json:"error,omitempty"`
 	Timestamp time.Time `json:"timestamp"`
@@ -89,7 +94,9 @@ type ServerMessage struct {
 
 // Server message types.
 const (
-	ServerMessageTypeEvents = "events"
-	ServerMessageTypePong   = "pong"
-	ServerMessageTypeError  = "error"
+	ServerMessageTypeEvents    = "events"
+	ServerMessageTypePong      = "pong"
+	ServerMessageTypeError     = "error"
+	ServerMessageTypeConnected = "connected"
+	ServerMessageTypeHeartbeat = "heartbeat"
 )
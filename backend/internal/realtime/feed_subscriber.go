@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"time"
 	"toggo/internal/models"
 	"toggo/internal/repository"
 
@@ -14,30 +15,70 @@ import (
 // feedworthyTopics is the set of event topics that generate activity feed entries.
 // Only actions by other members that are meaningful to display in a trip feed are included.
 var feedworthyTopics = map[EventTopic]bool{
-	EventTopicActivityCreated: true,
-	EventTopicCommentCreated:  true,
-	EventTopicPollCreated:     true,
-	EventTopicPollVoteAdded:   true,
-	EventTopicMembershipAdded: true,
-	EventTopicTripUpdated:     true,
-	EventTopicCategoryCreated: true,
+	EventTopicActivityCreated:           true,
+	EventTopicActivityUpdated:           true,
+	EventTopicActivityRSVPUpdated:       true,
+	EventTopicCommentCreated:            true,
+	EventTopicCommentReactionAdded:      true,
+	EventTopicPollCreated:               true,
+	EventTopicPollVoteAdded:             true,
+	EventTopicMembershipAdded:           true,
+	EventTopicTripUpdated:               true,
+	EventTopicCategoryCreated:           true,
+	EventTopicCategoryVisibilityChanged: true,
+	EventTopicCategoryReordered:         true,
+	EventTopicPitchCreated:              true,
+	EventTopicPitchUpdated:              true,
+	EventTopicPitchLinkAdded:            true,
 }
 
-// ActivityFeedSubscriber listens to the Redis trip pub/sub channel and fans out
-// feedworthy events to each trip member's personal activity feed sorted set.
+// aggregatedTopics are repeated actions on the same subject that collapse into a
+// single feed item per day, e.g. "Ana and 3 others voted on Where to eat".
+var aggregatedTopics = map[EventTopic]bool{
+	EventTopicPollVoteAdded:        true,
+	EventTopicCommentCreated:       true,
+	EventTopicCommentReactionAdded: true,
+	EventTopicActivityRSVPUpdated:  true,
+	EventTopicMembershipAdded:      true,
+}
+
+var feedGroupNamespace = uuid.MustParse("6f1c2a54-3a1e-4d8e-9a57-0c5b6e2f9d41")
+
+// FeedGroupID returns the feed item an event belongs to. Aggregated topics share a
+// deterministic ID per topic, subject and UTC day; every other event is its own item.
+func FeedGroupID(event *Event) uuid.UUID {
+	topic := EventTopic(event.Topic)
+	if !aggregatedTopics[topic] {
+		if id, err := uuid.Parse(event.ID); err == nil {
+			return id
+		}
+		return uuid.NewSHA1(feedGroupNamespace, []byte(event.ID))
+	}
+
+	// membership.added is keyed by the new member, so group joins by trip instead.
+	subject := event.EntityID
+	if topic == EventTopicMembershipAdded || subject == "" {
+		subject = event.TripID
+	}
+	day := event.Timestamp.UTC().Format(time.DateOnly)
+	return uuid.NewSHA1(feedGroupNamespace, []byte(event.TripID+"|"+event.Topic+"|"+subject+"|"+day))
+}
+
+// ActivityFeedSubscriber listens to the Redis trip pub/sub channel and persists
+// feedworthy events to each trip member's activity feed.
 type ActivityFeedSubscriber struct {
-	store          *ActivityFeedStore
+	feedRepo       repository.ActivityFeedRepository
 	membershipRepo repository.MembershipRepository
 	redisClient    *redis.Client
 }
 
 func NewActivityFeedSubscriber(
-	store *ActivityFeedStore,
+	feedRepo repository.ActivityFeedRepository,
 	membershipRepo repository.MembershipRepository,
 	redisClient *redis.Client,
 ) *ActivityFeedSubscriber {
 	return &ActivityFeedSubscriber{
-		store:          store,
+		feedRepo:       feedRepo,
 		membershipRepo: membershipRepo,
 		redisClient:    redisClient,
 	}
@@ -82,11 +123,12 @@ func (s *ActivityFeedSubscriber) handleEvent(ctx context.Context, event *Event)
 		return
 	}
 
-	tripID, err := uuid.Parse(event.TripID)
+	feedEvent, err := toFeedEvent(event)
 	if err != nil {
-		log.Printf("activity feed subscriber: invalid trip ID %s: %v", event.TripID, err)
+		log.Printf("activity feed subscriber: invalid event %s: %v", event.ID, err)
 		return
 	}
+	tripID := feedEvent.TripID
 
 	const memberPageSize = 500
 
@@ -98,17 +140,15 @@ func (s *ActivityFeedSubscriber) handleEvent(ctx context.Context, event *Event)
 			return
 		}
 
-		recipientIDs := make([]string, 0, len(members))
+		recipientIDs := make([]uuid.UUID, 0, len(members))
 		for _, m := range members {
 			if m.UserID.String() != event.ActorID {
-				recipientIDs = append(recipientIDs, m.UserID.String())
+				recipientIDs = append(recipientIDs, m.UserID)
 			}
 		}
 
-		if len(recipientIDs) > 0 {
-			if err := s.store.FanOutEvent(ctx, event, recipientIDs); err != nil {
-				log.Printf("activity feed subscriber: failed to fan out event %s: %v", event.ID, err)
-			}
+		if err := s.feedRepo.CreateEventWithEntries(ctx, feedEvent, recipientIDs); err != nil {
+			log.Printf("activity feed subscriber: failed to fan out event %s: %v", event.ID, err)
 		}
 
 		if nextCursor == nil {
@@ -117,3 +157,37 @@ func (s *ActivityFeedSubscriber) handleEvent(ctx context.Context, event *Event)
 		cursor = nextCursor
 	}
 }
+
+func toFeedEvent(event *Event) (*models.ActivityFeedEvent, error) {
+	eventID, err := uuid.Parse(event.ID)
+	if err != nil {
+		return nil, err
+	}
+	tripID, err := uuid.Parse(event.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	var actorID *uuid.UUID
+	if parsed, err := uuid.Parse(event.ActorID); err == nil {
+		actorID = &parsed
+	}
+
+	data := event.Data
+	if len(data) == 0 {
+		data = json.RawMessage("null")
+	}
+
+	return &models.ActivityFeedEvent{
+		ID:        eventID,
+		TripID:    tripID,
+		Topic:     event.Topic,
+		EntityID:  event.EntityID,
+		ActorID:   actorID,
+		ActorName: event.ActorName,
+		Version:   event.Version,
+		Data:      data,
+		GroupID:   FeedGroupID(event),
+		CreatedAt: event.Timestamp,
+	}, nil
+}
@@ -212,6 +212,9 @@ func (h *WebSocketHub) Shutdown() {
 
 	for client := range h.clients {
 		close(client.Send)
+		if client.Conn == nil {
+			continue
+		}
 		if err := client.Conn.Close(); err != nil {
 			log.Printf("Error closing client connection: %v", err)
 		}
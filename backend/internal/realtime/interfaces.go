@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -32,6 +33,12 @@ type Auth interface {
 	ValidateConnection(r *http.Request) (string, error)
 }
 
+// TripMembership reports whether a user belongs to a trip, so clients can only
+// subscribe to trips they are members of.
+type TripMembership interface {
+	IsMember(ctx context.Context, tripID, userID uuid.UUID) (bool, error)
+}
+
 // EventBatcher defines the contract for batching and collapsing events.
 type EventBatcher interface {
 	Run(ctx context.Context)
@@ -43,6 +50,8 @@ type EventRegistry interface {
 	IsAllowed(topic string) bool
 	Register(topic string)
 	GetAllTopics() []string
+	GetSchema(topic string) (EventSchema, bool)
+	GetAllSchemas() []EventSchema
 }
 
 // Compile-time interface verification
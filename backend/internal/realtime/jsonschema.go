@@ -0,0 +1,173 @@
+package realtime
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JSONSchemaDialect is the JSON Schema draft used by the event catalogue.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// EventCatalogue is the published, versioned description of every realtime topic.
+// Clients can generate types from it or validate incoming events against it.
+type EventCatalogue struct {
+	Schema   string                    `json:"$schema"`
+	Envelope map[string]any            `json:"envelope"`
+	Topics   []EventCatalogueTopic     `json:"topics"`
+	Defs     map[string]map[string]any `json:"$defs"`
+}
+
+// EventCatalogueTopic documents the payload carried in Event.Data for one topic.
+type EventCatalogueTopic struct {
+	Topic       string         `json:"topic"`
+	Version     int            `json:"version"`
+	Description string         `json:"description"`
+	Schema      map[string]any `json:"schema"`
+}
+
+// BuildEventCatalogue renders the given schemas as JSON Schema. Named structs are
+// emitted once under $defs and referenced from each topic.
+func BuildEventCatalogue(schemas []EventSchema) *EventCatalogue {
+	gen := &schemaGenerator{defs: make(map[string]map[string]any)}
+
+	topics := make([]EventCatalogueTopic, 0, len(schemas))
+	for _, schema := range schemas {
+		topics = append(topics, EventCatalogueTopic{
+			Topic:       string(schema.Topic),
+			Version:     schema.Version,
+			Description: schema.Description,
+			Schema:      gen.schemaFor(reflect.TypeOf(schema.Payload)),
+		})
+	}
+
+	return &EventCatalogue{
+		Schema:   JSONSchemaDialect,
+		Envelope: gen.schemaFor(reflect.TypeOf(Event{})),
+		Topics:   topics,
+		Defs:     gen.defs,
+	}
+}
+
+type schemaGenerator struct {
+	defs map[string]map[string]any
+}
+
+var (
+	uuidType       = reflect.TypeOf(uuid.UUID{})
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+func (g *schemaGenerator) schemaFor(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case uuidType:
+		return map[string]any{"type": "string", "format": "uuid"}
+	case timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case rawMessageType:
+		return map[string]any{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.schemaFor(t.Elem())}
+	case reflect.Struct:
+		return g.structRef(t)
+	default:
+		return map[string]any{}
+	}
+}
+
+func (g *schemaGenerator) structRef(t reflect.Type) map[string]any {
+	if t.Name() == "" {
+		return g.structSchema(t)
+	}
+
+	name := t.Name()
+	if _, ok := g.defs[name]; !ok {
+		// Reserve the name first so self-referencing types terminate.
+		g.defs[name] = map[string]any{}
+		g.defs[name] = g.structSchema(t)
+	}
+	return map[string]any{"$ref": "#/$defs/" + name}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	required := []string{}
+	g.collectFields(t, properties, &required)
+
+	// Unknown properties stay allowed: adding optional fields does not bump the
+	// topic version, so consumers must tolerate them.
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func (g *schemaGenerator) collectFields(t reflect.Type, properties map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				g.collectFields(embedded, properties, required)
+				continue
+			}
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		property := g.schemaFor(field.Type)
+		switch field.Type.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map:
+			if field.Type != rawMessageType {
+				property = map[string]any{"anyOf": []any{property, map[string]any{"type": "null"}}}
+			}
+		}
+		properties[name] = property
+
+		optional := strings.Contains(opts, "omitempty") || field.Type.Kind() == reflect.Pointer
+		if !optional {
+			*required = append(*required, name)
+		}
+	}
+}
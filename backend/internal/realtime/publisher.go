@@ -27,12 +27,21 @@ func NewRedisEventPublisher(client RedisClient) *RedisEventPublisher {
 	}
 }
 
-// Publish validates the event topic and publishes it to the trip's Redis channel.
+// Publish validates the event topic and payload, then publishes it to the trip's Redis channel.
 func (p *RedisEventPublisher) Publish(ctx context.Context, event *Event) error {
 	if !p.registry.IsAllowed(event.Topic) {
 		return fmt.Errorf("%w: %s", ErrInvalidTopic, event.Topic)
 	}
 
+	if schema, ok := p.registry.GetSchema(event.Topic); ok {
+		if err := schema.Validate(event.Data); err != nil {
+			return err
+		}
+		if event.Version == 0 {
+			event.Version = schema.Version
+		}
+	}
+
 	eventData, err := json.Marshal(event)
 	if err != nil {
 		return err
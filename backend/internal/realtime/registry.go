@@ -2,6 +2,7 @@ package realtime
 
 import (
 	"errors"
+	"sort"
 	"sync"
 )
 
@@ -12,31 +13,51 @@ var (
 type EventTopic string
 
 const (
-	EventTopicPollCreated          EventTopic = "poll.created"
-	EventTopicPollUpdated          EventTopic = "poll.updated"
-	EventTopicPollDeleted          EventTopic = "poll.deleted"
-	EventTopicPollVoteAdded        EventTopic = "poll.vote_added"
-	EventTopicPollVoteRemoved      EventTopic = "poll.vote_removed"
-	EventTopicPollRankingSubmitted EventTopic = "poll.ranking_submitted"
-	EventTopicTripCreated          EventTopic = "trip.created"
-	EventTopicTripUpdated          EventTopic = "trip.updated"
-	EventTopicTripDeleted          EventTopic = "trip.deleted"
-	EventTopicMembershipAdded      EventTopic = "membership.added"
-	EventTopicMembershipRemoved    EventTopic = "membership.removed"
-	EventTopicMembershipUpdated    EventTopic = "membership.updated"
-	EventTopicCommentCreated       EventTopic = "comment.created"
-	EventTopicCommentUpdated       EventTopic = "comment.updated"
-	EventTopicCommentDeleted       EventTopic = "comment.deleted"
-	EventTopicFileUploaded         EventTopic = "file.uploaded"
-	EventTopicFileDeleted          EventTopic = "file.deleted"
-	EventTopicNotificationSent     EventTopic = "notification.sent"
-	EventTopicActivityCreated      EventTopic = "activity.created"
-	EventTopicCategoryCreated      EventTopic = "category.created"
+	EventTopicPollCreated               EventTopic = "poll.created"
+	EventTopicPollUpdated               EventTopic = "poll.updated"
+	EventTopicPollDeleted               EventTopic = "poll.deleted"
+	EventTopicPollVoteAdded             EventTopic = "poll.vote_added"
+	EventTopicPollVoteRemoved           EventTopic = "poll.vote_removed"
+	EventTopicPollRankingSubmitted      EventTopic = "poll.ranking_submitted"
+	EventTopicTripCreated               EventTopic = "trip.created"
+	EventTopicTripUpdated               EventTopic = "trip.updated"
+	EventTopicTripDeleted               EventTopic = "trip.deleted"
+	EventTopicMembershipAdded           EventTopic = "membership.added"
+	EventTopicMembershipRemoved         EventTopic = "membership.removed"
+	EventTopicMembershipUpdated         EventTopic = "membership.updated"
+	EventTopicCommentCreated            EventTopic = "comment.created"
+	EventTopicCommentUpdated            EventTopic = "comment.updated"
+	EventTopicCommentDeleted            EventTopic = "comment.deleted"
+	EventTopicFileUploaded              EventTopic = "file.uploaded"
+	EventTopicFileDeleted               EventTopic = "file.deleted"
+	EventTopicNotificationSent          EventTopic = "notification.sent"
+	EventTopicCommentReactionAdded      EventTopic = "comment.reaction_added"
+	EventTopicCommentReactionRemoved    EventTopic = "comment.reaction_removed"
+	EventTopicActivityCreated           EventTopic = "activity.created"
+	EventTopicActivityUpdated           EventTopic = "activity.updated"
+	EventTopicActivityDeleted           EventTopic = "activity.deleted"
+	EventTopicActivityRSVPUpdated       EventTopic = "activity.rsvp_updated"
+	EventTopicActivityRSVPRemoved       EventTopic = "activity.rsvp_removed"
+	EventTopicCategoryCreated           EventTopic = "category.created"
+	EventTopicCategoryDeleted           EventTopic = "category.deleted"
+	EventTopicCategoryVisibilityChanged EventTopic = "category.visibility_changed"
+	EventTopicCategoryReordered         EventTopic = "category.reordered"
+	EventTopicPitchCreated              EventTopic = "pitch.created"
+	EventTopicPitchUpdated              EventTopic = "pitch.updated"
+	EventTopicPitchDeleted              EventTopic = "pitch.deleted"
+	EventTopicPitchLinkAdded            EventTopic = "pitch.link_added"
+	EventTopicPitchLinkRemoved          EventTopic = "pitch.link_removed"
+	EventTopicContentVisibilityChanged  EventTopic = "content.visibility_changed"
+	EventTopicTaskCreated               EventTopic = "task.created"
+	EventTopicTaskUpdated               EventTopic = "task.updated"
+	EventTopicTaskDeleted               EventTopic = "task.deleted"
 )
 
-// TopicRegistry validates event topics against a whitelist of allowed event names.
+// TopicRegistry validates event topics against a whitelist of allowed event names
+// and holds the payload schema for each topic.
 type TopicRegistry struct {
 	allowedTopics map[string]bool
+	schemas       map[string]EventSchema
 	mu            sync.RWMutex
 }
 
@@ -44,6 +65,7 @@ type TopicRegistry struct {
 func NewEventRegistry() *TopicRegistry {
 	registry := &TopicRegistry{
 		allowedTopics: make(map[string]bool),
+		schemas:       make(map[string]EventSchema),
 	}
 
 	registry.registerDefaultTopics()
@@ -51,31 +73,9 @@ func NewEventRegistry() *TopicRegistry {
 }
 
 func (r *TopicRegistry) registerDefaultTopics() {
-	topics := []EventTopic{
-		EventTopicPollCreated,
-		EventTopicPollUpdated,
-		EventTopicPollDeleted,
-		EventTopicPollVoteAdded,
-		EventTopicPollVoteRemoved,
-		EventTopicPollRankingSubmitted,
-		EventTopicTripCreated,
-		EventTopicTripUpdated,
-		EventTopicTripDeleted,
-		EventTopicMembershipAdded,
-		EventTopicMembershipRemoved,
-		EventTopicMembershipUpdated,
-		EventTopicCommentCreated,
-		EventTopicCommentUpdated,
-		EventTopicCommentDeleted,
-		EventTopicFileUploaded,
-		EventTopicFileDeleted,
-		EventTopicNotificationSent,
-		EventTopicActivityCreated,
-		EventTopicCategoryCreated,
-	}
-
-	for _, topic := range topics {
-		r.allowedTopics[string(topic)] = true
+	for _, schema := range defaultEventSchemas {
+		r.allowedTopics[string(schema.Topic)] = true
+		r.schemas[string(schema.Topic)] = schema
 	}
 }
 
@@ -86,7 +86,8 @@ func (r *TopicRegistry) IsAllowed(topic string) bool {
 	return r.allowedTopics[topic]
 }
 
-// Register adds a new topic to the whitelist.
+// Register adds a new topic to the whitelist. Topics registered without a schema
+// are published without payload validation.
 func (r *TopicRegistry) Register(topic string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -98,6 +99,36 @@ func (r *TopicRegistry) Unregister(topic string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	delete(r.allowedTopics, topic)
+	delete(r.schemas, topic)
+}
+
+// RegisterSchema adds a topic to the whitelist along with its payload schema.
+func (r *TopicRegistry) RegisterSchema(schema EventSchema) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.allowedTopics[string(schema.Topic)] = true
+	r.schemas[string(schema.Topic)] = schema
+}
+
+// GetSchema returns the payload schema registered for a topic.
+func (r *TopicRegistry) GetSchema(topic string) (EventSchema, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	schema, ok := r.schemas[topic]
+	return schema, ok
+}
+
+// GetAllSchemas returns every registered schema ordered by topic.
+func (r *TopicRegistry) GetAllSchemas() []EventSchema {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schemas := make([]EventSchema, 0, len(r.schemas))
+	for _, schema := range r.schemas {
+		schemas = append(schemas, schema)
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Topic < schemas[j].Topic })
+	return schemas
 }
 
 // GetAllTopics returns all registered topics.
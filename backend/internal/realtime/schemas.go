@@ -0,0 +1,216 @@
+package realtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"toggo/internal/models"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidPayload = errors.New("event payload does not match topic schema")
+)
+
+// EventSchema describes the versioned payload carried in Event.Data for a topic.
+// Adding optional fields is backwards compatible and keeps the same Version;
+// renaming, removing or retyping a field requires bumping Version so older app
+// builds can ignore payloads they do not understand.
+type EventSchema struct {
+	Topic       EventTopic
+	Version     int
+	Description string
+	// Payload is a zero value of the Go struct that Event.Data must decode into.
+	Payload any
+}
+
+// PollRankingSubmittedPayload is published when a member submits a ranking on a rank poll.
+type PollRankingSubmittedPayload struct {
+	PollID      uuid.UUID                `json:"poll_id" validate:"required"`
+	UserID      uuid.UUID                `json:"user_id" validate:"required"`
+	TotalVoters int                      `json:"total_voters"`
+	Top3        []models.OptionWithScore `json:"top_3"`
+}
+
+// TripDeletedPayload is published when a trip is removed.
+type TripDeletedPayload struct {
+	TripID uuid.UUID `json:"trip_id" validate:"required"`
+}
+
+// MembershipPayload identifies the member whose membership changed and their role
+// afterwards. IsAdmin is kept for clients that predate roles.
+type MembershipPayload struct {
+	UserID  uuid.UUID       `json:"user_id" validate:"required"`
+	TripID  uuid.UUID       `json:"trip_id" validate:"required"`
+	Role    models.TripRole `json:"role"`
+	IsAdmin bool            `json:"is_admin"`
+}
+
+// CommentDeletedPayload identifies a removed comment and the entity it belonged to.
+type CommentDeletedPayload struct {
+	ID         uuid.UUID         `json:"id" validate:"required"`
+	EntityType models.EntityType `json:"entity_type" validate:"required"`
+	EntityID   uuid.UUID         `json:"entity_id" validate:"required"`
+}
+
+// FilePayload identifies an uploaded or deleted file.
+type FilePayload struct {
+	ImageID uuid.UUID `json:"image_id" validate:"required"`
+}
+
+// NotificationSentPayload summarises a push notification fan-out for a trip.
+type NotificationSentPayload struct {
+	Title          string `json:"title" validate:"required"`
+	Body           string `json:"body"`
+	RecipientCount int    `json:"recipient_count"`
+}
+
+// CommentReactionPayload is published when a member adds or removes an emoji reaction.
+type CommentReactionPayload struct {
+	CommentID  uuid.UUID         `json:"comment_id" validate:"required"`
+	EntityType models.EntityType `json:"entity_type" validate:"required"`
+	EntityID   uuid.UUID         `json:"entity_id" validate:"required"`
+	UserID     uuid.UUID         `json:"user_id" validate:"required"`
+	Emoji      string            `json:"emoji" validate:"required"`
+}
+
+// ActivityDeletedPayload identifies a removed activity.
+type ActivityDeletedPayload struct {
+	ID     uuid.UUID `json:"id" validate:"required"`
+	TripID uuid.UUID `json:"trip_id" validate:"required"`
+}
+
+// ActivityRSVPRemovedPayload identifies an RSVP that was cleared.
+type ActivityRSVPRemovedPayload struct {
+	ActivityID uuid.UUID `json:"activity_id" validate:"required"`
+	TripID     uuid.UUID `json:"trip_id" validate:"required"`
+	UserID     uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// CategoryDeletedPayload identifies a removed category tab.
+type CategoryDeletedPayload struct {
+	TripID uuid.UUID `json:"trip_id" validate:"required"`
+	Name   string    `json:"name" validate:"required"`
+}
+
+// CategoryVisibilityPayload is published when a category tab is hidden or shown.
+type CategoryVisibilityPayload struct {
+	TripID   uuid.UUID `json:"trip_id" validate:"required"`
+	Name     string    `json:"name" validate:"required"`
+	IsHidden bool      `json:"is_hidden"`
+}
+
+// CategoryReorderedPayload carries the full tab order after a reorder.
+type CategoryReorderedPayload struct {
+	TripID uuid.UUID                 `json:"trip_id" validate:"required"`
+	Tabs   []models.CategoryTabOrder `json:"tabs" validate:"required"`
+}
+
+// PitchDeletedPayload identifies a removed pitch.
+type PitchDeletedPayload struct {
+	ID     uuid.UUID `json:"id" validate:"required"`
+	TripID uuid.UUID `json:"trip_id" validate:"required"`
+}
+
+// PitchLinkRemovedPayload identifies a link removed from a pitch.
+type PitchLinkRemovedPayload struct {
+	ID      uuid.UUID `json:"id" validate:"required"`
+	PitchID uuid.UUID `json:"pitch_id" validate:"required"`
+}
+
+// ContentVisibilityPayload is published when a moderator hides or unhides a comment,
+// pitch or activity.
+type ContentVisibilityPayload struct {
+	TripID     uuid.UUID         `json:"trip_id" validate:"required"`
+	EntityType models.EntityType `json:"entity_type" validate:"required"`
+	EntityID   uuid.UUID         `json:"entity_id" validate:"required"`
+	IsHidden   bool              `json:"is_hidden"`
+}
+
+// TaskDeletedPayload identifies a removed trip task.
+type TaskDeletedPayload struct {
+	ID     uuid.UUID `json:"id" validate:"required"`
+	TripID uuid.UUID `json:"trip_id" validate:"required"`
+}
+
+var defaultEventSchemas = []EventSchema{
+	{Topic: EventTopicPollCreated, Version: 1, Description: "A poll was created", Payload: models.PollAPIResponse{}},
+	{Topic: EventTopicPollUpdated, Version: 1, Description: "A poll's question, deadline or categories changed", Payload: models.PollAPIResponse{}},
+	{Topic: EventTopicPollDeleted, Version: 1, Description: "A poll was deleted", Payload: models.PollAPIResponse{}},
+	{Topic: EventTopicPollVoteAdded, Version: 1, Description: "A member voted on a poll", Payload: models.PollAPIResponse{}},
+	{Topic: EventTopicPollVoteRemoved, Version: 1, Description: "A member cleared their votes on a poll", Payload: models.PollAPIResponse{}},
+	{Topic: EventTopicPollRankingSubmitted, Version: 1, Description: "A member submitted a ranking on a rank poll", Payload: PollRankingSubmittedPayload{}},
+	{Topic: EventTopicTripCreated, Version: 1, Description: "A trip was created", Payload: models.Trip{}},
+	{Topic: EventTopicTripUpdated, Version: 1, Description: "A trip's details changed", Payload: models.Trip{}},
+	{Topic: EventTopicTripDeleted, Version: 1, Description: "A trip was deleted", Payload: TripDeletedPayload{}},
+	{Topic: EventTopicMembershipAdded, Version: 1, Description: "A user joined the trip", Payload: MembershipPayload{}},
+	{Topic: EventTopicMembershipRemoved, Version: 1, Description: "A user left or was removed from the trip", Payload: MembershipPayload{}},
+	{Topic: EventTopicMembershipUpdated, Version: 2, Description: "A member's role changed", Payload: MembershipPayload{}},
+	{Topic: EventTopicCommentCreated, Version: 1, Description: "A comment was posted", Payload: models.Comment{}},
+	{Topic: EventTopicCommentUpdated, Version: 1, Description: "A comment was edited", Payload: models.Comment{}},
+	{Topic: EventTopicCommentDeleted, Version: 1, Description: "A comment was removed", Payload: CommentDeletedPayload{}},
+	{Topic: EventTopicFileUploaded, Version: 1, Description: "A file was added to the trip", Payload: FilePayload{}},
+	{Topic: EventTopicFileDeleted, Version: 1, Description: "A file was removed from the trip", Payload: FilePayload{}},
+	{Topic: EventTopicNotificationSent, Version: 1, Description: "A push notification was sent to trip members", Payload: NotificationSentPayload{}},
+	{Topic: EventTopicCommentReactionAdded, Version: 1, Description: "A member reacted to a comment", Payload: CommentReactionPayload{}},
+	{Topic: EventTopicCommentReactionRemoved, Version: 1, Description: "A member removed a comment reaction", Payload: CommentReactionPayload{}},
+	{Topic: EventTopicActivityCreated, Version: 1, Description: "An activity was proposed", Payload: models.ActivityAPIResponse{}},
+	{Topic: EventTopicActivityUpdated, Version: 1, Description: "An activity's details or categories changed", Payload: models.ActivityAPIResponse{}},
+	{Topic: EventTopicActivityDeleted, Version: 1, Description: "An activity was removed", Payload: ActivityDeletedPayload{}},
+	{Topic: EventTopicActivityRSVPUpdated, Version: 1, Description: "A member RSVP'd to an activity", Payload: models.ActivityRSVP{}},
+	{Topic: EventTopicActivityRSVPRemoved, Version: 1, Description: "A member's RSVP was cleared", Payload: ActivityRSVPRemovedPayload{}},
+	{Topic: EventTopicCategoryCreated, Version: 1, Description: "A category tab was created", Payload: models.Category{}},
+	{Topic: EventTopicCategoryDeleted, Version: 1, Description: "A category tab was deleted", Payload: CategoryDeletedPayload{}},
+	{Topic: EventTopicCategoryVisibilityChanged, Version: 1, Description: "A category tab was hidden or shown", Payload: CategoryVisibilityPayload{}},
+	{Topic: EventTopicCategoryReordered, Version: 1, Description: "The category tabs were reordered", Payload: CategoryReorderedPayload{}},
+	{Topic: EventTopicPitchCreated, Version: 1, Description: "A pitch was created", Payload: models.PitchAPIResponse{}},
+	{Topic: EventTopicPitchUpdated, Version: 1, Description: "A pitch's details or images changed", Payload: models.PitchAPIResponse{}},
+	{Topic: EventTopicPitchDeleted, Version: 1, Description: "A pitch was removed", Payload: PitchDeletedPayload{}},
+	{Topic: EventTopicPitchLinkAdded, Version: 1, Description: "A link was attached to a pitch", Payload: models.PitchLink{}},
+	{Topic: EventTopicPitchLinkRemoved, Version: 1, Description: "A link was removed from a pitch", Payload: PitchLinkRemovedPayload{}},
+	{Topic: EventTopicContentVisibilityChanged, Version: 1, Description: "A moderator hid or unhid a comment, pitch or activity", Payload: ContentVisibilityPayload{}},
+	{Topic: EventTopicTaskCreated, Version: 1, Description: "A task was added to the trip", Payload: models.TripTask{}},
+	{Topic: EventTopicTaskUpdated, Version: 1, Description: "A task's details, status or assignees changed", Payload: models.TripTask{}},
+	{Topic: EventTopicTaskDeleted, Version: 1, Description: "A task was removed", Payload: TaskDeletedPayload{}},
+}
+
+var schemaIndex = func() map[EventTopic]EventSchema {
+	index := make(map[EventTopic]EventSchema, len(defaultEventSchemas))
+	for _, schema := range defaultEventSchemas {
+		index[schema.Topic] = schema
+	}
+	return index
+}()
+
+var payloadValidator = validator.New()
+
+// SchemaVersion returns the current payload version for a built-in topic, or 0 if
+// the topic has no registered schema.
+func SchemaVersion(topic EventTopic) int {
+	return schemaIndex[topic].Version
+}
+
+// Validate checks that data decodes into the schema's payload struct without
+// unknown fields and satisfies its validate tags.
+func (s EventSchema) Validate(data json.RawMessage) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return fmt.Errorf("%w: %s payload is empty", ErrInvalidPayload, s.Topic)
+	}
+
+	payload := reflect.New(reflect.TypeOf(s.Payload)).Interface()
+	decoder := json.NewDecoder(bytes.NewReader(trimmed))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(payload); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidPayload, s.Topic, err)
+	}
+
+	if err := payloadValidator.Struct(payload); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidPayload, s.Topic, err)
+	}
+	return nil
+}
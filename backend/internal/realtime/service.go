@@ -17,10 +17,12 @@ type RealtimeService struct {
 	hub           Hub
 	auth          Auth
 	handler       *WSHandler
+	sseHandler    *SSEHandler
 }
 
 // NewRealtimeService initializes all realtime components from configuration.
-func NewRealtimeService(cfg *config.Configuration) (*RealtimeService, error) {
+// members is used to restrict trip subscriptions to the trip's members.
+func NewRealtimeService(cfg *config.Configuration, members TripMembership) (*RealtimeService, error) {
 	goRedisClient, err := NewRedisClient(
 		cfg.Redis.Address,
 		cfg.Redis.Password,
@@ -35,6 +37,7 @@ func NewRealtimeService(cfg *config.Configuration) (*RealtimeService, error) {
 	hub := NewHub(goRedisClient)
 	auth := NewAuthMiddleware(cfg.Auth.JWTSecretKey)
 	handler := NewWSHandler(hub, auth)
+	sseHandler := NewSSEHandler(hub, auth, members)
 
 	return &RealtimeService{
 		goRedisClient: goRedisClient,
@@ -43,6 +46,7 @@ func NewRealtimeService(cfg *config.Configuration) (*RealtimeService, error) {
 		hub:           hub,
 		auth:          auth,
 		handler:       handler,
+		sseHandler:    sseHandler,
 	}, nil
 }
 
@@ -73,6 +77,11 @@ func (s *RealtimeService) GetHandler() *WSHandler {
 	return s.handler
 }
 
+// GetSSEHandler returns the Server-Sent Events fallback handler for routing.
+func (s *RealtimeService) GetSSEHandler() *SSEHandler {
+	return s.sseHandler
+}
+
 // GetPublisher returns the event publisher interface for dependency injection.
 func (s *RealtimeService) GetPublisher() EventPublisher {
 	return s.publisher
@@ -0,0 +1,220 @@
+package realtime
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+	"toggo/internal/errs"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/google/uuid"
+)
+
+// SSEHandler serves the Server-Sent Events fallback transport for clients whose
+// networks block WebSocket upgrades. It streams the same ServerMessage payloads as
+// WSHandler and registers its clients with the same hub.
+type SSEHandler struct {
+	hub     Hub
+	auth    Auth
+	members TripMembership
+	clients map[string]*Client
+	mu      sync.RWMutex
+}
+
+// NewSSEHandler creates a new Server-Sent Events handler.
+func NewSSEHandler(hub Hub, auth Auth, members TripMembership) *SSEHandler {
+	return &SSEHandler{
+		hub:     hub,
+		auth:    auth,
+		members: members,
+		clients: make(map[string]*Client),
+	}
+}
+
+// Handler opens an event stream for the authenticated user. Trips passed as
+// repeated trip_id query parameters are subscribed immediately; the stream is
+// refused if the user is not a member of any of them. Further subscriptions are
+// managed through MessageHandler using the client ID sent in the initial
+// "connected" message.
+func (h *SSEHandler) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := h.authenticate(c)
+		if err != nil {
+			return err
+		}
+
+		var tripIDs []string
+		for _, tripID := range c.Context().QueryArgs().PeekMulti("trip_id") {
+			if _, err := uuid.Parse(string(tripID)); err != nil {
+				continue
+			}
+			if err := h.requireMember(c.Context(), string(tripID), userID); err != nil {
+				return err
+			}
+			tripIDs = append(tripIDs, string(tripID))
+		}
+
+		clientID := uuid.New().String()
+		client := NewClient(clientID, userID, h.hub, nil)
+		h.hub.RegisterClient(client)
+		h.addClient(client)
+
+		for _, tripID := range tripIDs {
+			h.hub.SubscribeClientToTrip(client, tripID)
+		}
+
+		c.Set(fiber.HeaderContentType, "text/event-stream")
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		c.Set(fiber.HeaderConnection, "keep-alive")
+		c.Set("X-Accel-Buffering", "no")
+
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			defer func() {
+				h.removeClient(client)
+				h.hub.UnregisterClient(client)
+			}()
+			h.stream(w, client)
+		})
+
+		return nil
+	}
+}
+
+// MessageHandler accepts the same ClientMessage payloads a WebSocket client would
+// send (subscribe, unsubscribe, ping) and applies them to an open SSE stream.
+func (h *SSEHandler) MessageHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := h.authenticate(c)
+		if err != nil {
+			return err
+		}
+
+		client, ok := h.getClient(c.Params("clientID"))
+		if !ok || client.UserID != userID {
+			return errs.ErrNotFound
+		}
+
+		var msg ClientMessage
+		if err := c.BodyParser(&msg); err != nil {
+			return errs.InvalidJSON()
+		}
+
+		if msg.Type == MessageTypeSubscribe && msg.TripID != "" {
+			if err := h.requireMember(c.Context(), msg.TripID, userID); err != nil {
+				return err
+			}
+		}
+
+		h.hub.HandleClientMessage(client, &msg)
+		return c.SendStatus(fiber.StatusAccepted)
+	}
+}
+
+func (h *SSEHandler) authenticate(c *fiber.Ctx) (string, error) {
+	req, err := adaptor.ConvertRequest(c, false)
+	if err != nil {
+		return "", errs.Unauthorized()
+	}
+
+	userID, err := h.auth.ValidateConnection(req)
+	if err != nil {
+		return "", errs.Unauthorized()
+	}
+	return userID, nil
+}
+
+// requireMember rejects subscriptions to trips the user does not belong to.
+func (h *SSEHandler) requireMember(ctx context.Context, tripID, userID string) error {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return errs.InvalidUUID()
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return errs.Unauthorized()
+	}
+
+	isMember, err := h.members.IsMember(ctx, tripUUID, userUUID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return errs.Forbidden()
+	}
+	return nil
+}
+
+// stream writes hub messages to the response until the client disconnects or
+// the hub closes the client's send channel. Heartbeats keep intermediaries from
+// closing idle connections and let clients detect a stale stream.
+func (h *SSEHandler) stream(w *bufio.Writer, client *Client) {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+
+	connected := ServerMessage{
+		Type:      ServerMessageTypeConnected,
+		ClientID:  client.ID,
+		Timestamp: time.Now().UTC(),
+	}
+	if err := writeSSEMessage(w, connected); err != nil {
+		return
+	}
+
+	for {
+		select {
+		case message, ok := <-client.Send:
+			if !ok {
+				return
+			}
+			if err := writeSSEMessage(w, message); err != nil {
+				log.Printf("SSE client %s disconnected: %v", client.ID, err)
+				return
+			}
+
+		case <-ticker.C:
+			heartbeat := ServerMessage{
+				Type:      ServerMessageTypeHeartbeat,
+				Timestamp: time.Now().UTC(),
+			}
+			if err := writeSSEMessage(w, heartbeat); err != nil {
+				log.Printf("SSE client %s disconnected: %v", client.ID, err)
+				return
+			}
+		}
+	}
+}
+
+func writeSSEMessage(w *bufio.Writer, message ServerMessage) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", message.Type, payload); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func (h *SSEHandler) addClient(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.clients[client.ID] = client
+}
+
+func (h *SSEHandler) removeClient(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.clients, client.ID)
+}
+
+func (h *SSEHandler) getClient(clientID string) (*Client, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	client, ok := h.clients[clientID]
+	return client, ok
+}
@@ -0,0 +1,92 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// WebhookDispatcher queues an event for delivery to a webhook. Implemented by the
+// Temporal webhooks package, which retries failed deliveries.
+type WebhookDispatcher interface {
+	DispatchWebhook(ctx context.Context, webhookID uuid.UUID, event *Event) error
+}
+
+// WebhookSubscriber listens to the Redis trip pub/sub channel and hands each event to
+// the trip's webhooks that subscribed to its topic.
+type WebhookSubscriber struct {
+	webhookRepo repository.TripWebhookRepository
+	dispatcher  WebhookDispatcher
+	redisClient *redis.Client
+}
+
+func NewWebhookSubscriber(
+	webhookRepo repository.TripWebhookRepository,
+	dispatcher WebhookDispatcher,
+	redisClient *redis.Client,
+) *WebhookSubscriber {
+	return &WebhookSubscriber{
+		webhookRepo: webhookRepo,
+		dispatcher:  dispatcher,
+		redisClient: redisClient,
+	}
+}
+
+func (s *WebhookSubscriber) Start(ctx context.Context) {
+	pubsub := s.redisClient.PSubscribe(ctx, "trip:*")
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			log.Printf("webhook subscriber: error closing pubsub: %v", err)
+		}
+	}()
+
+	log.Println("Webhook subscriber started")
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				log.Println("Webhook subscriber: channel closed")
+				return
+			}
+			if msg == nil {
+				continue
+			}
+			var event Event
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				log.Printf("webhook subscriber: failed to unmarshal event: %v", err)
+				continue
+			}
+			s.HandleEvent(ctx, &event)
+		case <-ctx.Done():
+			log.Println("Webhook subscriber stopped")
+			return
+		}
+	}
+}
+
+// HandleEvent dispatches the event to every enabled webhook of its trip subscribed to
+// the topic. Failures are logged; one webhook never blocks another.
+func (s *WebhookSubscriber) HandleEvent(ctx context.Context, event *Event) {
+	tripID, err := uuid.Parse(event.TripID)
+	if err != nil {
+		return
+	}
+
+	webhooks, err := s.webhookRepo.FindSubscribed(ctx, tripID, event.Topic)
+	if err != nil {
+		log.Printf("webhook subscriber: failed to get webhooks for trip %s: %v", event.TripID, err)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if err := s.dispatcher.DispatchWebhook(ctx, webhook.ID, event); err != nil {
+			log.Printf("webhook subscriber: failed to dispatch event %s to webhook %s: %v", event.ID, webhook.ID, err)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// AccountRepository reads everything a user has created for their data export and
+// removes it when they delete their account.
+type AccountRepository interface {
+	FindMemberships(ctx context.Context, userID uuid.UUID) ([]*models.ExportedMembership, error)
+	FindComments(ctx context.Context, userID uuid.UUID) ([]*models.Comment, error)
+	FindPollVotes(ctx context.Context, userID uuid.UUID) ([]*models.ExportedPollVote, error)
+	FindPollRankings(ctx context.Context, userID uuid.UUID) ([]*models.ExportedPollRanking, error)
+	FindRSVPs(ctx context.Context, userID uuid.UUID) ([]*models.ExportedRSVP, error)
+	FindPitches(ctx context.Context, userID uuid.UUID) ([]*models.ExportedPitch, error)
+	FindMediaKeys(ctx context.Context, userID uuid.UUID) ([]string, error)
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+var _ AccountRepository = (*accountRepository)(nil)
+
+type accountRepository struct {
+	db *bun.DB
+}
+
+func NewAccountRepository(db *bun.DB) AccountRepository {
+	return &accountRepository{db: db}
+}
+
+func (r *accountRepository) FindMemberships(ctx context.Context, userID uuid.UUID) ([]*models.ExportedMembership, error) {
+	memberships := []*models.ExportedMembership{}
+	err := r.db.NewSelect().
+		TableExpr("memberships AS m").
+		ColumnExpr("m.trip_id, t.name AS trip_name, m.role, m.budget_min, m.budget_max, m.availability").
+		ColumnExpr("m.created_at AS joined_at").
+		Join("JOIN trips AS t ON t.id = m.trip_id").
+		Where("m.user_id = ?", userID).
+		OrderExpr("m.created_at ASC").
+		Scan(ctx, &memberships)
+	if err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+func (r *accountRepository) FindComments(ctx context.Context, userID uuid.UUID) ([]*models.Comment, error) {
+	comments := []*models.Comment{}
+	err := r.db.NewSelect().
+		Model(&comments).
+		Where("user_id = ?", userID).
+		OrderExpr("created_at ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (r *accountRepository) FindPollVotes(ctx context.Context, userID uuid.UUID) ([]*models.ExportedPollVote, error) {
+	votes := []*models.ExportedPollVote{}
+	err := r.db.NewSelect().
+		TableExpr("poll_votes AS pv").
+		ColumnExpr("p.trip_id, pv.poll_id, p.question, pv.option_id, po.name AS option_name, pv.created_at").
+		Join("JOIN polls AS p ON p.id = pv.poll_id").
+		Join("JOIN poll_options AS po ON po.id = pv.option_id").
+		Where("pv.user_id = ?", userID).
+		OrderExpr("pv.created_at ASC").
+		Scan(ctx, &votes)
+	if err != nil {
+		return nil, err
+	}
+	return votes, nil
+}
+
+func (r *accountRepository) FindPollRankings(ctx context.Context, userID uuid.UUID) ([]*models.ExportedPollRanking, error) {
+	rankings := []*models.ExportedPollRanking{}
+	err := r.db.NewSelect().
+		TableExpr("poll_rankings AS pr").
+		ColumnExpr("p.trip_id, pr.poll_id, p.question, pr.option_id, po.name AS option_name, pr.rank_position").
+		Join("JOIN polls AS p ON p.id = pr.poll_id").
+		Join("JOIN poll_options AS po ON po.id = pr.option_id").
+		Where("pr.user_id = ?", userID).
+		OrderExpr("pr.poll_id, pr.rank_position").
+		Scan(ctx, &rankings)
+	if err != nil {
+		return nil, err
+	}
+	return rankings, nil
+}
+
+func (r *accountRepository) FindRSVPs(ctx context.Context, userID uuid.UUID) ([]*models.ExportedRSVP, error) {
+	rsvps := []*models.ExportedRSVP{}
+	err := r.db.NewSelect().
+		TableExpr("activity_rsvps AS r").
+		ColumnExpr("r.trip_id, r.activity_id, a.name AS activity_name, r.status, r.updated_at").
+		Join("JOIN activities AS a ON a.id = r.activity_id").
+		Where("r.user_id = ?", userID).
+		OrderExpr("r.updated_at ASC").
+		Scan(ctx, &rsvps)
+	if err != nil {
+		return nil, err
+	}
+	return rsvps, nil
+}
+
+func (r *accountRepository) FindPitches(ctx context.Context, userID uuid.UUID) ([]*models.ExportedPitch, error) {
+	pitches := []*models.ExportedPitch{}
+	err := r.db.NewSelect().
+		TableExpr("trip_pitches AS tp").
+		ColumnExpr("tp.id, tp.trip_id, tp.title, tp.description, tp.duration, tp.audio_s3_key, tp.created_at").
+		Where("tp.user_id = ?", userID).
+		OrderExpr("tp.created_at ASC").
+		Scan(ctx, &pitches)
+	if err != nil {
+		return nil, err
+	}
+	return pitches, nil
+}
+
+// FindMediaKeys returns the S3 keys of the user's own files: every size of their
+// profile picture and their pitch recordings. Images attached to pitches stay with the
+// trip.
+func (r *accountRepository) FindMediaKeys(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	var keys []string
+	err := r.db.NewRaw(`
+		SELECT i.file_key FROM images AS i JOIN users AS u ON u.profile_picture = i.image_id WHERE u.id = ?
+		UNION
+		SELECT p.audio_s3_key FROM trip_pitches AS p WHERE p.user_id = ? AND p.audio_s3_key <> ''
+	`, userID, userID).Scan(ctx, &keys)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Delete removes the user. Their comments, pitches, activities, polls and links stay
+// on their trips without an author, pitch recordings are detached, and votes, rankings
+// and the feed events they caused are removed; everything else of theirs goes with the
+// user row.
+func (r *accountRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// The recordings themselves are deleted from S3 by the caller.
+		if _, err := tx.NewUpdate().
+			Model((*models.TripPitch)(nil)).
+			Set("audio_s3_key = ''").
+			Set("updated_at = now()").
+			Where("user_id = ?", userID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		anonymise := []struct {
+			model  any
+			column string
+		}{
+			{(*models.Comment)(nil), "user_id"},
+			{(*models.TripPitch)(nil), "user_id"},
+			{(*models.Activity)(nil), "proposed_by"},
+			{(*models.Poll)(nil), "created_by"},
+			{(*models.PitchLink)(nil), "added_by"},
+		}
+		for _, a := range anonymise {
+			if _, err := tx.NewUpdate().
+				Model(a.model).
+				Set("? = NULL", bun.Ident(a.column)).
+				Where("? = ?", bun.Ident(a.column), userID).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.NewDelete().
+			Model((*models.PollVote)(nil)).
+			Where("user_id = ?", userID).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewDelete().
+			Model((*models.PollRanking)(nil)).
+			Where("user_id = ?", userID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Feed events keep the actor's name and a copy of what they wrote, so they are
+		// removed rather than anonymised. Their feed entries cascade.
+		if _, err := tx.NewDelete().
+			Model((*models.ActivityFeedEvent)(nil)).
+			Where("actor_id = ?", userID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.NewDelete().
+			TableExpr("images").
+			Where("image_id = (SELECT profile_picture FROM users WHERE id = ?)", userID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		result, err := tx.NewDelete().
+			Model((*models.User)(nil)).
+			Where("id = ?", userID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return errs.ErrNotFound
+		}
+		return nil
+	})
+}
@@ -20,6 +20,7 @@ type ActivityRepository interface {
 	FindByActivityQueryParams(ctx context.Context, tripID uuid.UUID, params models.ActivityQueryParams, cursor *models.ActivityCursor, limit int) ([]*models.ActivityDatabaseResponse, *models.ActivityCursor, error)
 	Exists(ctx context.Context, activityID uuid.UUID) (bool, error)
 	CountByTripID(ctx context.Context, tripID uuid.UUID) (int, error)
+	FindByTripIDOnDate(ctx context.Context, tripID uuid.UUID, date string) ([]*models.Activity, error)
 	Update(ctx context.Context, activityID uuid.UUID, req *models.UpdateActivityRequest) (*models.Activity, error)
 	UpdateTx(ctx context.Context, tx bun.Tx, activityID uuid.UUID, req *models.UpdateActivityRequest) (*models.Activity, error)
 	Delete(ctx context.Context, activityID uuid.UUID) error
@@ -72,7 +73,8 @@ func (r *activityRepository) CreateActivityTx(ctx context.Context, tx bun.Tx, ac
 	return activity, nil
 }
 
-// Find retrieves a specific activity by ID with proposer details (categories fetched separately)
+// Find retrieves a specific activity by ID with proposer details (categories fetched separately).
+// An activity hidden by a moderator is not found.
 func (r *activityRepository) Find(ctx context.Context, activityID uuid.UUID) (*models.ActivityDatabaseResponse, error) {
 	activity := &models.ActivityDatabaseResponse{}
 	err := r.db.NewSelect().
@@ -83,9 +85,10 @@ func (r *activityRepository) Find(ctx context.Context, activityID uuid.UUID) (*m
 		ColumnExpr("img.file_key AS proposer_picture_key").
 		ColumnExpr("COALESCE((SELECT json_agg(ai.image_id) FROM activity_images ai WHERE ai.activity_id = a.id), '[]') AS image_keys").
 		ColumnExpr(goingUsersSubquery).
-		Join("JOIN users AS u ON u.id = a.proposed_by").
+		Join("LEFT JOIN users AS u ON u.id = a.proposed_by").
 		Join("LEFT JOIN images AS img ON u.profile_picture IS NOT NULL AND img.image_id = u.profile_picture AND img.size = ? AND img.status = ?", models.ImageSizeSmall, models.UploadStatusConfirmed).
 		Where("a.id = ?", activityID).
+		Where(notHidden("a.id"), models.ActivityEntity).
 		Scan(ctx, activity)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -119,6 +122,7 @@ func (r *activityRepository) FindByCategoryName(
 }
 
 // FindByActivityQueryParams lists activities with optional category, time-of-day, and date filters.
+// Activities hidden by a moderator are left out.
 func (r *activityRepository) FindByActivityQueryParams(
 	ctx context.Context,
 	tripID uuid.UUID,
@@ -134,10 +138,11 @@ func (r *activityRepository) FindByActivityQueryParams(
 		ColumnExpr("img.file_key AS proposer_picture_key").
 		ColumnExpr("COALESCE(json_agg(ai.image_id) FILTER (WHERE ai.image_id IS NOT NULL), '[]') AS image_keys").
 		ColumnExpr(goingUsersSubquery).
-		Join("JOIN users AS u ON u.id = a.proposed_by").
+		Join("LEFT JOIN users AS u ON u.id = a.proposed_by").
 		Join("LEFT JOIN images AS img ON u.profile_picture IS NOT NULL AND img.image_id = u.profile_picture AND img.size = ? AND img.status = ?", models.ImageSizeSmall, models.UploadStatusConfirmed).
 		Join("LEFT JOIN activity_images AS ai ON ai.activity_id = a.id").
-		Where("a.trip_id = ?", tripID)
+		Where("a.trip_id = ?", tripID).
+		Where(notHidden("a.id"), models.ActivityEntity)
 
 	if params.Category != nil && *params.Category != "" {
 		query = query.
@@ -186,6 +191,25 @@ func (r *activityRepository) CountByTripID(ctx context.Context, tripID uuid.UUID
 	return count, err
 }
 
+// FindByTripIDOnDate returns the trip's activities scheduled on the given YYYY-MM-DD date,
+// ordered by time of day with unscheduled times last.
+func (r *activityRepository) FindByTripIDOnDate(ctx context.Context, tripID uuid.UUID, date string) ([]*models.Activity, error) {
+	var activities []*models.Activity
+	err := r.db.NewSelect().
+		Model(&activities).
+		Where("trip_id = ?", tripID).
+		Where(`EXISTS (
+			SELECT 1 FROM jsonb_array_elements(COALESCE(dates, '[]'::jsonb)) AS elem
+			WHERE (elem->>'start')::date <= ?::date AND (elem->>'end')::date >= ?::date
+		)`, date, date).
+		OrderExpr("time_of_day ASC NULLS LAST, name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
 // Update modifies an activity (categories managed separately)
 func (r *activityRepository) Update(ctx context.Context, activityID uuid.UUID, req *models.UpdateActivityRequest) (*models.Activity, error) {
 	updateQuery := r.db.NewUpdate().
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ActivityFeedRepository interface {
+	CreateEventWithEntries(ctx context.Context, event *models.ActivityFeedEvent, recipientIDs []uuid.UUID) error
+	FindGroupsWithCursor(ctx context.Context, userID uuid.UUID, tripIDs []uuid.UUID, unreadOnly bool, limit int, cursor *models.ActivityFeedCursor) ([]*models.ActivityFeedGroupRow, *models.ActivityFeedCursor, error)
+	FindGroupActors(ctx context.Context, userID uuid.UUID, groupIDs []uuid.UUID) ([]*models.ActivityFeedActorRow, error)
+	SetGroupRead(ctx context.Context, userID, tripID, groupID uuid.UUID, read bool) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID, tripIDs []uuid.UUID) error
+	CountUnreadGroupsByTrip(ctx context.Context, userID uuid.UUID, tripIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+}
+
+var _ ActivityFeedRepository = (*activityFeedRepository)(nil)
+
+type activityFeedRepository struct {
+	db *bun.DB
+}
+
+func NewActivityFeedRepository(db *bun.DB) ActivityFeedRepository {
+	return &activityFeedRepository{db: db}
+}
+
+// feedEventNotHidden leaves out events about content a moderator has hidden. Events keep
+// a copy of the content, so one is left out when its subject, the comment it carries or
+// the activity or pitch that comment belongs to is hidden.
+const feedEventNotHidden = "NOT EXISTS (SELECT 1 FROM hidden_content AS hc WHERE hc.entity_id::text IN (ev.entity_id, ev.data->>'id', ev.data->>'entity_id'))"
+
+// CreateEventWithEntries stores the event and one unread entry per recipient in a single
+// transaction. Both inserts ignore conflicts so the same event delivered to several
+// subscribers (one per server instance) is only recorded once.
+func (r *activityFeedRepository) CreateEventWithEntries(ctx context.Context, event *models.ActivityFeedEvent, recipientIDs []uuid.UUID) error {
+	if len(recipientIDs) == 0 {
+		return nil
+	}
+
+	entries := make([]*models.ActivityFeedEntry, 0, len(recipientIDs))
+	for _, userID := range recipientIDs {
+		entries = append(entries, &models.ActivityFeedEntry{
+			UserID:    userID,
+			EventID:   event.ID,
+			TripID:    event.TripID,
+			GroupID:   event.GroupID,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().
+			Model(event).
+			On("CONFLICT (id) DO NOTHING").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewInsert().
+			Model(&entries).
+			On("CONFLICT (user_id, event_id) DO NOTHING").
+			Exec(ctx)
+		return err
+	})
+}
+
+// FindGroupsWithCursor returns the user's feed across the given trips aggregated by
+// group, ordered by the group's most recent event (latest_at DESC, group_id DESC).
+// Events about hidden content are left out.
+func (r *activityFeedRepository) FindGroupsWithCursor(ctx context.Context, userID uuid.UUID, tripIDs []uuid.UUID, unreadOnly bool, limit int, cursor *models.ActivityFeedCursor) ([]*models.ActivityFeedGroupRow, *models.ActivityFeedCursor, error) {
+	if len(tripIDs) == 0 {
+		return []*models.ActivityFeedGroupRow{}, nil, nil
+	}
+
+	groups := r.db.NewSelect().
+		TableExpr("activity_feed_entries AS afe").
+		Join("JOIN activity_feed_events AS ev ON ev.id = afe.event_id").
+		ColumnExpr("afe.group_id").
+		ColumnExpr("MAX(afe.created_at) AS latest_at").
+		ColumnExpr("COUNT(*) AS event_count").
+		ColumnExpr("COUNT(DISTINCT ev.actor_id) AS actor_count").
+		ColumnExpr("BOOL_OR(afe.read_at IS NULL) AS has_unread").
+		ColumnExpr("(ARRAY_AGG(afe.event_id ORDER BY afe.created_at DESC))[1] AS latest_event_id").
+		Where("afe.user_id = ?", userID).
+		Where("afe.trip_id IN (?)", bun.In(tripIDs)).
+		Where(feedEventNotHidden).
+		GroupExpr("afe.group_id")
+
+	if unreadOnly {
+		groups = groups.Having("BOOL_OR(afe.read_at IS NULL)")
+	}
+	if cursor != nil {
+		groups = groups.Having("(MAX(afe.created_at), afe.group_id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	query := r.db.NewSelect().
+		With("groups", groups).
+		TableExpr("groups AS g").
+		Join("JOIN activity_feed_events AS ev ON ev.id = g.latest_event_id").
+		Join("JOIN trips AS t ON t.id = ev.trip_id").
+		ColumnExpr("g.group_id, g.latest_at, g.event_count, g.actor_count, g.has_unread, g.latest_event_id").
+		ColumnExpr("ev.trip_id, t.name AS trip_name, ev.topic, ev.entity_id, ev.version, ev.data").
+		OrderExpr("g.latest_at DESC, g.group_id DESC").
+		Limit(limit + 1)
+
+	var rows []*models.ActivityFeedGroupRow
+	if err := query.Scan(ctx, &rows); err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *models.ActivityFeedCursor
+	if len(rows) > limit {
+		last := rows[limit-1]
+		nextCursor = &models.ActivityFeedCursor{CreatedAt: last.LatestAt, ID: last.GroupID}
+		rows = rows[:limit]
+	}
+	return rows, nextCursor, nil
+}
+
+// FindGroupActors returns each distinct actor per group with the time of their most
+// recent action, newest first.
+func (r *activityFeedRepository) FindGroupActors(ctx context.Context, userID uuid.UUID, groupIDs []uuid.UUID) ([]*models.ActivityFeedActorRow, error) {
+	if len(groupIDs) == 0 {
+		return []*models.ActivityFeedActorRow{}, nil
+	}
+
+	var rows []*models.ActivityFeedActorRow
+	err := r.db.NewSelect().
+		TableExpr("activity_feed_entries AS afe").
+		Join("JOIN activity_feed_events AS ev ON ev.id = afe.event_id").
+		Join("JOIN users AS u ON u.id = ev.actor_id").
+		ColumnExpr("afe.group_id, ev.actor_id AS user_id, u.name, u.username").
+		ColumnExpr("MAX(ev.created_at) AS acted_at").
+		Where("afe.user_id = ?", userID).
+		Where("afe.group_id IN (?)", bun.In(groupIDs)).
+		GroupExpr("afe.group_id, ev.actor_id, u.name, u.username").
+		OrderExpr("afe.group_id, acted_at DESC").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SetGroupRead marks every entry in a feed group as read or unread.
+func (r *activityFeedRepository) SetGroupRead(ctx context.Context, userID, tripID, groupID uuid.UUID, read bool) error {
+	query := r.db.NewUpdate().
+		Model((*models.ActivityFeedEntry)(nil)).
+		Where("user_id = ?", userID).
+		Where("trip_id = ?", tripID).
+		Where("group_id = ?", groupID)
+
+	if read {
+		query = query.Set("read_at = ?", time.Now().UTC()).Where("read_at IS NULL")
+	} else {
+		query = query.Set("read_at = NULL")
+	}
+
+	_, err := query.Exec(ctx)
+	return err
+}
+
+// MarkAllRead marks every unread entry in the user's feed for the given trips as read.
+func (r *activityFeedRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, tripIDs []uuid.UUID) error {
+	if len(tripIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*models.ActivityFeedEntry)(nil)).
+		Set("read_at = ?", time.Now().UTC()).
+		Where("user_id = ?", userID).
+		Where("trip_id IN (?)", bun.In(tripIDs)).
+		Where("read_at IS NULL").
+		Exec(ctx)
+	return err
+}
+
+// CountUnreadGroupsByTrip returns, per trip, the number of feed items with at least
+// one unread event, not counting events about hidden content. Trips without unread
+// items are omitted.
+func (r *activityFeedRepository) CountUnreadGroupsByTrip(ctx context.Context, userID uuid.UUID, tripIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	counts := make(map[uuid.UUID]int64)
+	if len(tripIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		TripID uuid.UUID `bun:"trip_id"`
+		Count  int64     `bun:"count"`
+	}
+	err := r.db.NewSelect().
+		TableExpr("activity_feed_entries AS afe").
+		Join("JOIN activity_feed_events AS ev ON ev.id = afe.event_id").
+		ColumnExpr("afe.trip_id").
+		ColumnExpr("COUNT(DISTINCT afe.group_id) AS count").
+		Where("afe.user_id = ?", userID).
+		Where("afe.trip_id IN (?)", bun.In(tripIDs)).
+		Where("afe.read_at IS NULL").
+		Where(feedEventNotHidden).
+		GroupExpr("afe.trip_id").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.TripID] = row.Count
+	}
+	return counts, nil
+}
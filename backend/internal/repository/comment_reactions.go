@@ -15,7 +15,7 @@ type CommentReactionRepository interface {
 	Create(ctx context.Context, reaction *models.CommentReaction) (*models.CommentReaction, error)
 	DeleteByUserEmoji(ctx context.Context, commentID uuid.UUID, userID uuid.UUID, emoji string) error
 	GetSummary(ctx context.Context, commentID uuid.UUID, currentUserID uuid.UUID) ([]models.CommentReactionSummary, error)
-	ListUsersForEmoji(ctx context.Context, commentID uuid.UUID, emoji string) ([]CommentReactionUserDBRow, error)
+	ListUsersForEmoji(ctx context.Context, commentID uuid.UUID, viewerID uuid.UUID, emoji string) ([]CommentReactionUserDBRow, error)
 }
 
 var _ CommentReactionRepository = (*commentReactionRepository)(nil)
@@ -70,6 +70,8 @@ func (r *commentReactionRepository) DeleteByUserEmoji(ctx context.Context, comme
 	return err
 }
 
+// GetSummary aggregates the comment's reactions by emoji, leaving out reactions from
+// users the current user has blocked.
 func (r *commentReactionRepository) GetSummary(ctx context.Context, commentID uuid.UUID, currentUserID uuid.UUID) ([]models.CommentReactionSummary, error) {
 	type row struct {
 		Emoji       string `bun:"emoji"`
@@ -84,6 +86,7 @@ func (r *commentReactionRepository) GetSummary(ctx context.Context, commentID uu
 		ColumnExpr("COUNT(*)::int AS count").
 		ColumnExpr("BOOL_OR(cr.user_id = ?) AS reacted_by_me", currentUserID).
 		Where("cr.comment_id = ?", commentID).
+		Where(notBlockedBy("cr.user_id"), currentUserID).
 		GroupExpr("cr.emoji").
 		OrderExpr("count DESC, cr.emoji ASC").
 		Scan(ctx, &rows)
@@ -110,7 +113,9 @@ type CommentReactionUserDBRow struct {
 	ProfilePictureKey *string    `bun:"profile_picture_key" json:"-"`
 }
 
-func (r *commentReactionRepository) ListUsersForEmoji(ctx context.Context, commentID uuid.UUID, emoji string) ([]CommentReactionUserDBRow, error) {
+// ListUsersForEmoji returns who reacted with the emoji, leaving out users the viewer has
+// blocked.
+func (r *commentReactionRepository) ListUsersForEmoji(ctx context.Context, commentID uuid.UUID, viewerID uuid.UUID, emoji string) ([]CommentReactionUserDBRow, error) {
 	var users []CommentReactionUserDBRow
 
 	err := r.db.NewSelect().
@@ -123,6 +128,7 @@ func (r *commentReactionRepository) ListUsersForEmoji(ctx context.Context, comme
 		Join("LEFT JOIN images AS img ON u.profile_picture IS NOT NULL AND img.image_id = u.profile_picture AND img.size = ? AND img.status = ?", models.ImageSizeSmall, models.UploadStatusConfirmed).
 		Where("cr.comment_id = ?", commentID).
 		Where("cr.emoji = ?", emoji).
+		Where(notBlockedBy("cr.user_id"), viewerID).
 		OrderExpr("u.username ASC, u.id ASC").
 		Scan(ctx, &users)
 	if err != nil {
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	"toggo/internal/errs"
 	"toggo/internal/models"
@@ -15,9 +17,10 @@ const maxCommentPreviewCount = 3
 
 type CommentRepository interface {
 	Create(ctx context.Context, comment *models.Comment) (*models.Comment, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Comment, error)
 	Update(ctx context.Context, id uuid.UUID, userID uuid.UUID, content string) (*models.Comment, error)
 	Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
-	FindPaginatedComments(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID uuid.UUID, limit int, cursor *models.CommentCursor) ([]*models.CommentDatabaseResponse, error)
+	FindPaginatedComments(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID uuid.UUID, viewerID uuid.UUID, limit int, cursor *models.CommentCursor) ([]*models.CommentDatabaseResponse, error)
 	GetCommentStatsForPitches(ctx context.Context, pitchIDs []uuid.UUID) (map[uuid.UUID]*models.PitchCommentStats, error)
 	GetCommentStatsForActivities(ctx context.Context, activityIDs []uuid.UUID) (map[uuid.UUID]*models.PitchCommentStats, error)
 }
@@ -43,7 +46,24 @@ func (r *commentRepository) Create(ctx context.Context, comment *models.Comment)
 	return comment, nil
 }
 
-func (r *commentRepository) FindPaginatedComments(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID uuid.UUID, limit int, cursor *models.CommentCursor) ([]*models.CommentDatabaseResponse, error) {
+func (r *commentRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Comment, error) {
+	comment := &models.Comment{}
+	err := r.db.NewSelect().
+		Model(comment).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return comment, nil
+}
+
+// FindPaginatedComments returns a page of the entity's comments, newest first. Hidden
+// comments and comments by users the viewer has blocked are left out.
+func (r *commentRepository) FindPaginatedComments(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID uuid.UUID, viewerID uuid.UUID, limit int, cursor *models.CommentCursor) ([]*models.CommentDatabaseResponse, error) {
 	var comments []*models.CommentDatabaseResponse
 
 	query := r.db.NewSelect().
@@ -52,11 +72,13 @@ func (r *commentRepository) FindPaginatedComments(ctx context.Context, tripID uu
 		ColumnExpr("u.name, u.username").
 		ColumnExpr("u.profile_picture AS profile_picture_id").
 		ColumnExpr("img.file_key AS profile_picture_key").
-		Join("JOIN users AS u ON u.id = c.user_id").
+		Join("LEFT JOIN users AS u ON u.id = c.user_id").
 		Join("LEFT JOIN images AS img ON u.profile_picture IS NOT NULL AND img.image_id = u.profile_picture AND img.size = ? AND img.status = ?", models.ImageSizeSmall, models.UploadStatusConfirmed).
 		Where("c.trip_id = ?", tripID).
 		Where("c.entity_type = ?", entityType).
 		Where("c.entity_id = ?", entityID).
+		Where(notHidden("c.id"), models.CommentEntity).
+		Where(notBlockedBy("c.user_id"), viewerID).
 		OrderExpr("c.created_at DESC, c.id DESC").
 		Limit(limit + 1)
 
@@ -131,7 +153,7 @@ func (r *commentRepository) GetCommentStatsForPitches(ctx context.Context, pitch
 				SUM(COUNT(c.id)) OVER (PARTITION BY c.entity_id)                                         AS total_comment_count,
 				ROW_NUMBER() OVER (PARTITION BY c.entity_id ORDER BY MIN(c.created_at))                  AS rn
 			FROM comments AS c
-			JOIN users AS u ON u.id = c.user_id
+			LEFT JOIN users AS u ON u.id = c.user_id
 			LEFT JOIN images AS pfp
 				ON u.profile_picture IS NOT NULL
 				AND pfp.image_id = u.profile_picture
@@ -139,12 +161,14 @@ func (r *commentRepository) GetCommentStatsForPitches(ctx context.Context, pitch
 				AND pfp.status = ?
 			WHERE c.entity_type = ?
 			  AND c.entity_id IN (?)
+			  AND NOT EXISTS (SELECT 1 FROM hidden_content AS hc WHERE hc.entity_type = ? AND hc.entity_id = c.id)
 			GROUP BY c.entity_id, c.user_id, u.name, u.username, pfp.file_key
 		) AS ranked`,
 			models.ImageSizeSmall,
 			models.UploadStatusConfirmed,
 			models.PitchEntity,
 			bun.In(pitchIDs),
+			models.CommentEntity,
 		).
 		ColumnExpr("pitch_id, user_id, commenter_name, commenter_username, commenter_pfp_key, total_comment_count").
 		Where("rn <= ?", maxCommentPreviewCount).
@@ -159,6 +183,10 @@ func (r *commentRepository) GetCommentStatsForPitches(ctx context.Context, pitch
 			stats = &models.PitchCommentStats{Count: row.TotalCommentCount}
 			result[row.PitchID] = stats
 		}
+		// Comments whose author deleted their account count but have no one to preview.
+		if row.UserID == uuid.Nil {
+			continue
+		}
 		stats.Previews = append(stats.Previews, models.PitchCommenterDB{
 			UserID:            row.UserID,
 			Name:              row.CommenterName,
@@ -196,7 +224,7 @@ func (r *commentRepository) GetCommentStatsForActivities(ctx context.Context, ac
 				SUM(COUNT(c.id)) OVER (PARTITION BY c.entity_id)                                         AS total_comment_count,
 				ROW_NUMBER() OVER (PARTITION BY c.entity_id ORDER BY MIN(c.created_at))                  AS rn
 			FROM comments AS c
-			JOIN users AS u ON u.id = c.user_id
+			LEFT JOIN users AS u ON u.id = c.user_id
 			LEFT JOIN images AS pfp
 				ON u.profile_picture IS NOT NULL
 				AND pfp.image_id = u.profile_picture
@@ -204,12 +232,14 @@ func (r *commentRepository) GetCommentStatsForActivities(ctx context.Context, ac
 				AND pfp.status = ?
 			WHERE c.entity_type = ?
 			  AND c.entity_id IN (?)
+			  AND NOT EXISTS (SELECT 1 FROM hidden_content AS hc WHERE hc.entity_type = ? AND hc.entity_id = c.id)
 			GROUP BY c.entity_id, c.user_id, u.name, u.username, pfp.file_key
 		) AS ranked`,
 			models.ImageSizeSmall,
 			models.UploadStatusConfirmed,
 			models.ActivityEntity,
 			bun.In(activityIDs),
+			models.CommentEntity,
 		).
 		ColumnExpr("activity_id, user_id, commenter_name, commenter_username, commenter_pfp_key, total_comment_count").
 		Where("rn <= ?", maxCommentPreviewCount).
@@ -224,6 +254,9 @@ func (r *commentRepository) GetCommentStatsForActivities(ctx context.Context, ac
 			stats = &models.PitchCommentStats{Count: row.TotalCommentCount}
 			result[row.ActivityID] = stats
 		}
+		if row.UserID == uuid.Nil {
+			continue
+		}
 		stats.Previews = append(stats.Previews, models.PitchCommenterDB{
 			UserID:            row.UserID,
 			Name:              row.CommenterName,
@@ -21,12 +21,17 @@ type MembershipRepository interface {
 	FindUserIDsWithNotificationPreference(ctx context.Context, tripID uuid.UUID, preference models.NotificationPreference, excludeUserID uuid.UUID) ([]uuid.UUID, error)
 	IsMember(ctx context.Context, tripID, userID uuid.UUID) (bool, error)
 	IsAdmin(ctx context.Context, tripID, userID uuid.UUID) (bool, error)
+	FindRole(ctx context.Context, tripID, userID uuid.UUID) (models.TripRole, error)
+	FindAccess(ctx context.Context, tripID, userID uuid.UUID) (models.TripAccess, error)
 	CountMembers(ctx context.Context, tripID uuid.UUID) (int, error)
 	CountAdmins(ctx context.Context, tripID uuid.UUID) (int, error)
 	GetMemberStatsForTrips(ctx context.Context, tripIDs []uuid.UUID) (map[uuid.UUID]*models.TripMemberStats, error)
 	Update(ctx context.Context, userID, tripID uuid.UUID, req *models.UpdateMembershipRequest) (*models.Membership, error)
+	UpdateRole(ctx context.Context, userID, tripID uuid.UUID, role models.TripRole) (*models.Membership, error)
+	TransferOwnership(ctx context.Context, tripID, fromUserID, toUserID uuid.UUID) error
 	UpdateNotificationPreferences(ctx context.Context, userID, tripID uuid.UUID, req *models.UpdateNotificationPreferencesRequest) (*models.Membership, error)
 	Delete(ctx context.Context, userID, tripID uuid.UUID) error
+	DeleteAndHandOverContent(ctx context.Context, userID, tripID uuid.UUID, newAuthorID *uuid.UUID) error
 }
 
 var _ MembershipRepository = (*membershipRepository)(nil)
@@ -56,7 +61,7 @@ func (r *membershipRepository) Find(ctx context.Context, userID, tripID uuid.UUI
 	membership := &models.MembershipDatabaseResponse{}
 	err := r.db.NewSelect().
 		TableExpr("memberships AS m").
-		ColumnExpr("m.user_id, m.trip_id, m.is_admin, m.created_at, m.updated_at, m.budget_min, m.budget_max, m.availability").
+		ColumnExpr("m.user_id, m.trip_id, m.role, m.is_admin, m.created_at, m.updated_at, m.budget_min, m.budget_max, m.availability").
 		ColumnExpr("m.notify_new_pitches, m.notify_new_polls, m.notify_new_comments").
 		ColumnExpr("u.name, u.username").
 		ColumnExpr("u.profile_picture AS profile_picture_id").
@@ -75,16 +80,17 @@ func (r *membershipRepository) Find(ctx context.Context, userID, tripID uuid.UUI
 	return membership, nil
 }
 
-// FindByTripID retrieves all members of a trip
+// FindByTripID retrieves all members of a trip. A deleted trip has no members.
 func (r *membershipRepository) FindByTripID(ctx context.Context, tripID uuid.UUID) ([]*models.MembershipDatabaseResponse, error) {
 	var memberships []*models.MembershipDatabaseResponse
 	err := r.db.NewSelect().
 		TableExpr("memberships AS m").
-		ColumnExpr("m.user_id, m.trip_id, m.is_admin, m.created_at, m.updated_at, m.budget_min, m.budget_max, m.availability").
+		ColumnExpr("m.user_id, m.trip_id, m.role, m.is_admin, m.created_at, m.updated_at, m.budget_min, m.budget_max, m.availability").
 		ColumnExpr("m.notify_new_pitches, m.notify_new_polls, m.notify_new_comments").
 		ColumnExpr("u.name, u.username").
 		ColumnExpr("u.profile_picture AS profile_picture_id").
 		ColumnExpr("img.file_key AS profile_picture_key").
+		Join("JOIN trips AS t ON t.id = m.trip_id AND t.deleted_at IS NULL").
 		Join("JOIN users AS u ON u.id = m.user_id").
 		Join("LEFT JOIN images AS img ON u.profile_picture IS NOT NULL AND img.image_id = u.profile_picture AND img.size = ? AND img.status = ?", models.ImageSizeSmall, models.UploadStatusConfirmed).
 		Where("m.trip_id = ?", tripID).
@@ -105,7 +111,7 @@ func (r *membershipRepository) FindByTripIDWithCursor(ctx context.Context, tripI
 
 	query := r.db.NewSelect().
 		TableExpr("memberships AS m").
-		ColumnExpr("m.user_id, m.trip_id, m.is_admin, m.created_at, m.updated_at, m.budget_min, m.budget_max, m.availability").
+		ColumnExpr("m.user_id, m.trip_id, m.role, m.is_admin, m.created_at, m.updated_at, m.budget_min, m.budget_max, m.availability").
 		ColumnExpr("m.notify_new_pitches, m.notify_new_polls, m.notify_new_comments").
 		ColumnExpr("u.name, u.username").
 		ColumnExpr("u.profile_picture AS profile_picture_id").
@@ -207,13 +213,14 @@ func (r *membershipRepository) GetMemberStatsForTrips(ctx context.Context, tripI
 	return result, nil
 }
 
-// FindByUserID retrieves all trips a user is a member of
+// FindByUserID retrieves all trips a user is a member of, leaving out deleted trips.
 func (r *membershipRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Membership, error) {
 	var memberships []*models.Membership
 	err := r.db.NewSelect().
 		Model(&memberships).
-		Where("user_id = ?", userID).
-		Order("created_at DESC").
+		Join("JOIN trips AS t ON t.id = membership.trip_id AND t.deleted_at IS NULL").
+		Where("membership.user_id = ?", userID).
+		OrderExpr("membership.created_at DESC").
 		Scan(ctx)
 	if err != nil {
 		return nil, err
@@ -223,8 +230,8 @@ func (r *membershipRepository) FindByUserID(ctx context.Context, userID uuid.UUI
 
 // FindUserIDsWithNotificationPreference returns user IDs of trip members who have the given
 // notification preference enabled, excluding the specified user.
-// It also checks the user's global notification preferences: the user must have both
-// push_enabled and the relevant global category enabled. Users with no global preferences
+// It also checks the user's global notification preferences: the user must have at least
+// one delivery channel (push, email or SMS) and the relevant global category enabled. Users with no global preferences
 // row are treated as having all notifications enabled (consistent with defaults).
 func (r *membershipRepository) FindUserIDsWithNotificationPreference(ctx context.Context, tripID uuid.UUID, preference models.NotificationPreference, excludeUserID uuid.UUID) ([]uuid.UUID, error) {
 	tripCol, err := notificationPreferenceColumn(preference)
@@ -243,7 +250,7 @@ func (r *membershipRepository) FindUserIDsWithNotificationPreference(ctx context
 		ColumnExpr("m.user_id").
 		Join("LEFT JOIN notification_preferences AS np ON np.user_id = m.user_id").
 		Where("m.trip_id = ? AND m.user_id != ? AND m."+tripCol+" = TRUE", tripID, excludeUserID).
-		Where("(np.user_id IS NULL OR (np.push_enabled = TRUE AND np."+globalCol+" = TRUE))").
+		Where("(np.user_id IS NULL OR ((np.push_enabled OR np.email_enabled OR np.sms_enabled) AND np."+globalCol+" = TRUE))").
 		Scan(ctx, &userIDs)
 	if scanErr != nil {
 		return nil, scanErr
@@ -255,7 +262,8 @@ func (r *membershipRepository) FindUserIDsWithNotificationPreference(ctx context
 func (r *membershipRepository) IsMember(ctx context.Context, tripID, userID uuid.UUID) (bool, error) {
 	count, err := r.db.NewSelect().
 		Model((*models.Membership)(nil)).
-		Where("trip_id = ? AND user_id = ?", tripID, userID).
+		Join("JOIN trips AS t ON t.id = membership.trip_id AND t.deleted_at IS NULL").
+		Where("membership.trip_id = ? AND membership.user_id = ?", tripID, userID).
 		Count(ctx)
 	if err != nil {
 		return false, err
@@ -263,7 +271,7 @@ func (r *membershipRepository) IsMember(ctx context.Context, tripID, userID uuid
 	return count > 0, nil
 }
 
-// IsAdmin checks if a user is an admin of a trip
+// IsAdmin checks if a user is an admin (owner or organiser) of a trip
 func (r *membershipRepository) IsAdmin(ctx context.Context, tripID, userID uuid.UUID) (bool, error) {
 	membership := &models.Membership{}
 	err := r.db.NewSelect().
@@ -279,6 +287,44 @@ func (r *membershipRepository) IsAdmin(ctx context.Context, tripID, userID uuid.
 	return true, nil
 }
 
+// FindRole returns the user's role in the trip, or ErrNotFound if they are not a member.
+// Unlike IsMember and FindAccess it also finds memberships of deleted trips.
+func (r *membershipRepository) FindRole(ctx context.Context, tripID, userID uuid.UUID) (models.TripRole, error) {
+	var role models.TripRole
+	err := r.db.NewSelect().
+		Model((*models.Membership)(nil)).
+		Column("role").
+		Where("trip_id = ? AND user_id = ?", tripID, userID).
+		Scan(ctx, &role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errs.ErrNotFound
+		}
+		return "", err
+	}
+	return role, nil
+}
+
+// FindAccess returns the user's role in the trip and whether the trip is archived, or
+// ErrNotFound if they are not a member or the trip is deleted.
+func (r *membershipRepository) FindAccess(ctx context.Context, tripID, userID uuid.UUID) (models.TripAccess, error) {
+	var access models.TripAccess
+	err := r.db.NewSelect().
+		TableExpr("memberships AS m").
+		ColumnExpr("m.role").
+		ColumnExpr("t.archived_at IS NOT NULL AS archived").
+		Join("JOIN trips AS t ON t.id = m.trip_id AND t.deleted_at IS NULL").
+		Where("m.trip_id = ? AND m.user_id = ?", tripID, userID).
+		Scan(ctx, &access)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.TripAccess{}, errs.ErrNotFound
+		}
+		return models.TripAccess{}, err
+	}
+	return access, nil
+}
+
 // CountMembers returns the number of members in a trip
 func (r *membershipRepository) CountMembers(ctx context.Context, tripID uuid.UUID) (int, error) {
 	count, err := r.db.NewSelect().
@@ -288,7 +334,7 @@ func (r *membershipRepository) CountMembers(ctx context.Context, tripID uuid.UUI
 	return count, err
 }
 
-// CountAdmins returns the number of admins in a trip
+// CountAdmins returns the number of admins (owners and organisers) in a trip
 func (r *membershipRepository) CountAdmins(ctx context.Context, tripID uuid.UUID) (int, error) {
 	count, err := r.db.NewSelect().
 		Model((*models.Membership)(nil)).
@@ -304,10 +350,6 @@ func (r *membershipRepository) Update(ctx context.Context, userID, tripID uuid.U
 		Where("user_id = ? AND trip_id = ?", userID, tripID)
 
 	// Only update fields that are provided (not nil)
-	if req.IsAdmin != nil {
-		updateQuery = updateQuery.Set("is_admin = ?", *req.IsAdmin)
-	}
-
 	if req.BudgetMin != nil {
 		updateQuery = updateQuery.Set("budget_min = ?", *req.BudgetMin)
 	}
@@ -342,6 +384,67 @@ func (r *membershipRepository) Update(ctx context.Context, userID, tripID uuid.U
 	return updatedMembership, nil
 }
 
+// UpdateRole sets a member's role
+func (r *membershipRepository) UpdateRole(ctx context.Context, userID, tripID uuid.UUID, role models.TripRole) (*models.Membership, error) {
+	membership := &models.Membership{}
+	result, err := r.db.NewUpdate().
+		Model(membership).
+		Set("role = ?", role).
+		Set("updated_at = now()").
+		Where("user_id = ? AND trip_id = ?", userID, tripID).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, errs.ErrNotFound
+	}
+	return membership, nil
+}
+
+// TransferOwnership makes toUserID the trip's owner and the previous owner an organiser.
+func (r *membershipRepository) TransferOwnership(ctx context.Context, tripID, fromUserID, toUserID uuid.UUID) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Demote first: at most one owner per trip is enforced by a unique index.
+		result, err := tx.NewUpdate().
+			Model((*models.Membership)(nil)).
+			Set("role = ?", models.TripRoleOrganiser).
+			Set("updated_at = now()").
+			Where("trip_id = ? AND user_id = ? AND role = ?", tripID, fromUserID, models.TripRoleOwner).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if rowsAffected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if rowsAffected == 0 {
+			return errs.ErrNotFound
+		}
+
+		result, err = tx.NewUpdate().
+			Model((*models.Membership)(nil)).
+			Set("role = ?", models.TripRoleOwner).
+			Set("updated_at = now()").
+			Where("trip_id = ? AND user_id = ?", tripID, toUserID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if rowsAffected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if rowsAffected == 0 {
+			return errs.ErrNotFound
+		}
+		return nil
+	})
+}
+
 // UpdateNotificationPreferences updates the notification preference flags for a membership.
 func (r *membershipRepository) UpdateNotificationPreferences(ctx context.Context, userID, tripID uuid.UUID, req *models.UpdateNotificationPreferencesRequest) (*models.Membership, error) {
 	updateQuery := r.db.NewUpdate().
@@ -396,6 +499,45 @@ func (r *membershipRepository) Delete(ctx context.Context, userID, tripID uuid.U
 	return err
 }
 
+// DeleteAndHandOverContent removes the membership and, in the same transaction, moves
+// the member's pitches and activities in the trip to newAuthorID. A nil newAuthorID
+// leaves the content without an author. The member is unassigned from the trip's tasks.
+func (r *membershipRepository) DeleteAndHandOverContent(ctx context.Context, userID, tripID uuid.UUID, newAuthorID *uuid.UUID) error {
+	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewUpdate().
+			Model((*models.TripPitch)(nil)).
+			Set("user_id = ?", newAuthorID).
+			Set("updated_at = now()").
+			Where("trip_id = ? AND user_id = ?", tripID, userID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.NewUpdate().
+			Model((*models.Activity)(nil)).
+			Set("proposed_by = ?", newAuthorID).
+			Set("updated_at = now()").
+			Where("trip_id = ? AND proposed_by = ?", tripID, userID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.NewDelete().
+			Model((*models.TripTaskAssignee)(nil)).
+			Where("user_id = ?", userID).
+			Where("task_id IN (SELECT id FROM trip_tasks WHERE trip_id = ?)", tripID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewDelete().
+			Model((*models.Membership)(nil)).
+			Where("user_id = ? AND trip_id = ?", userID, tripID).
+			Exec(ctx)
+		return err
+	})
+}
+
 func notificationPreferenceColumn(preference models.NotificationPreference) (string, error) {
 	switch preference {
 	case models.NotificationPreferenceNewPitch:
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// moderatedTable describes where a reportable entity lives and who wrote it.
+type moderatedTable struct {
+	table        string
+	authorColumn string
+}
+
+var moderatedTables = map[models.EntityType]moderatedTable{
+	models.CommentEntity:  {table: "comments", authorColumn: "user_id"},
+	models.PitchEntity:    {table: "trip_pitches", authorColumn: "user_id"},
+	models.ActivityEntity: {table: "activities", authorColumn: "proposed_by"},
+}
+
+type ModerationRepository interface {
+	FindEntityAuthor(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID uuid.UUID) (*uuid.UUID, error)
+	CreateReport(ctx context.Context, report *models.ContentReport) (*models.ContentReport, bool, error)
+	FindReport(ctx context.Context, id uuid.UUID) (*models.ContentReport, error)
+	FindPendingReports(ctx context.Context, tripID uuid.UUID) ([]*models.ModerationQueueItem, error)
+	DismissReport(ctx context.Context, id, resolvedBy uuid.UUID) (bool, error)
+	Hide(ctx context.Context, hidden *models.HiddenContent) error
+	Unhide(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID uuid.UUID) error
+}
+
+var _ ModerationRepository = (*moderationRepository)(nil)
+
+type moderationRepository struct {
+	db *bun.DB
+}
+
+func NewModerationRepository(db *bun.DB) ModerationRepository {
+	return &moderationRepository{db: db}
+}
+
+// FindEntityAuthor returns the author of a comment, pitch or activity in the trip. The
+// author is nil for activities whose proposer deleted their account.
+func (r *moderationRepository) FindEntityAuthor(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID uuid.UUID) (*uuid.UUID, error) {
+	t, ok := moderatedTables[entityType]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+
+	var authorID *uuid.UUID
+	err := r.db.NewSelect().
+		TableExpr("? AS e", bun.Ident(t.table)).
+		ColumnExpr("e.?", bun.Ident(t.authorColumn)).
+		Where("e.id = ? AND e.trip_id = ?", entityID, tripID).
+		Scan(ctx, &authorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return authorID, nil
+}
+
+// CreateReport files the report unless the reporter already has a pending report on the
+// same content, in which case that report is returned instead. It reports whether a new
+// report was created.
+func (r *moderationRepository) CreateReport(ctx context.Context, report *models.ContentReport) (*models.ContentReport, bool, error) {
+	report.Status = models.ContentReportPending
+	result, err := r.db.NewInsert().
+		Model(report).
+		On("CONFLICT (entity_type, entity_id, reported_by) WHERE status = 'pending' DO NOTHING").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+	if rowsAffected > 0 {
+		return report, true, nil
+	}
+
+	existing := &models.ContentReport{}
+	err = r.db.NewSelect().
+		Model(existing).
+		Where("entity_type = ? AND entity_id = ? AND reported_by = ? AND status = ?", report.EntityType, report.EntityID, report.ReportedBy, models.ContentReportPending).
+		Scan(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+func (r *moderationRepository) FindReport(ctx context.Context, id uuid.UUID) (*models.ContentReport, error) {
+	report := &models.ContentReport{}
+	err := r.db.NewSelect().
+		Model(report).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return report, nil
+}
+
+// FindPendingReports returns the trip's moderation queue, oldest report first, with the
+// reported content's author and a preview of its text.
+func (r *moderationRepository) FindPendingReports(ctx context.Context, tripID uuid.UUID) ([]*models.ModerationQueueItem, error) {
+	items := []*models.ModerationQueueItem{}
+	err := r.db.NewSelect().
+		Model(&items).
+		ColumnExpr("cr.*").
+		ColumnExpr("reporter.name AS reporter_name").
+		ColumnExpr("COALESCE(c.user_id, tp.user_id, a.proposed_by) AS author_id").
+		ColumnExpr("author.name AS author_name").
+		ColumnExpr("COALESCE(c.content, tp.title, a.name) AS preview").
+		Join("LEFT JOIN comments AS c ON cr.entity_type = ? AND c.id = cr.entity_id", models.CommentEntity).
+		Join("LEFT JOIN trip_pitches AS tp ON cr.entity_type = ? AND tp.id = cr.entity_id", models.PitchEntity).
+		Join("LEFT JOIN activities AS a ON cr.entity_type = ? AND a.id = cr.entity_id", models.ActivityEntity).
+		Join("LEFT JOIN users AS author ON author.id = COALESCE(c.user_id, tp.user_id, a.proposed_by)").
+		Join("LEFT JOIN users AS reporter ON reporter.id = cr.reported_by").
+		Where("cr.trip_id = ?", tripID).
+		Where("cr.status = ?", models.ContentReportPending).
+		OrderExpr("cr.created_at ASC, cr.id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// DismissReport closes a pending report without hiding anything. It reports false when
+// the report was already resolved.
+func (r *moderationRepository) DismissReport(ctx context.Context, id, resolvedBy uuid.UUID) (bool, error) {
+	result, err := r.db.NewUpdate().
+		Model((*models.ContentReport)(nil)).
+		Set("status = ?", models.ContentReportDismissed).
+		Set("resolved_by = ?", resolvedBy).
+		Set("resolved_at = now()").
+		Where("id = ? AND status = ?", id, models.ContentReportPending).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// Hide hides the content from the trip and resolves every pending report on it. Hiding
+// content that is already hidden only resolves the reports.
+func (r *moderationRepository) Hide(ctx context.Context, hidden *models.HiddenContent) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().
+			Model(hidden).
+			On("CONFLICT (entity_type, entity_id) DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewUpdate().
+			Model((*models.ContentReport)(nil)).
+			Set("status = ?", models.ContentReportHidden).
+			Set("resolved_by = ?", hidden.HiddenBy).
+			Set("resolved_at = now()").
+			Where("entity_type = ? AND entity_id = ? AND status = ?", hidden.EntityType, hidden.EntityID, models.ContentReportPending).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (r *moderationRepository) Unhide(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID uuid.UUID) error {
+	result, err := r.db.NewDelete().
+		Model((*models.HiddenContent)(nil)).
+		Where("trip_id = ? AND entity_type = ? AND entity_id = ?", tripID, entityType, entityID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// notHidden filters out content a moderator has hidden. idColumn is the qualified id
+// column of the entity, e.g. "c.id"; the entity type is the query argument.
+func notHidden(idColumn string) string {
+	return "NOT EXISTS (SELECT 1 FROM hidden_content AS hc WHERE hc.entity_type = ? AND hc.entity_id = " + idColumn + ")"
+}
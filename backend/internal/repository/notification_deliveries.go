@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"toggo/internal/models"
+
+	"github.com/uptrace/bun"
+)
+
+type NotificationDeliveryRepository interface {
+	CreateMany(ctx context.Context, deliveries []*models.NotificationDelivery) error
+	FindByTicketIDs(ctx context.Context, ticketIDs []string) ([]*models.NotificationDelivery, error)
+	UpdateStatus(ctx context.Context, ticketID string, status models.NotificationDeliveryStatus, errorCode, errorMessage *string) error
+}
+
+var _ NotificationDeliveryRepository = (*notificationDeliveryRepository)(nil)
+
+type notificationDeliveryRepository struct {
+	db *bun.DB
+}
+
+func NewNotificationDeliveryRepository(db *bun.DB) NotificationDeliveryRepository {
+	return &notificationDeliveryRepository{db: db}
+}
+
+func (r *notificationDeliveryRepository) CreateMany(ctx context.Context, deliveries []*models.NotificationDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NewInsert().
+		Model(&deliveries).
+		Exec(ctx)
+	return err
+}
+
+func (r *notificationDeliveryRepository) FindByTicketIDs(ctx context.Context, ticketIDs []string) ([]*models.NotificationDelivery, error) {
+	if len(ticketIDs) == 0 {
+		return []*models.NotificationDelivery{}, nil
+	}
+
+	var deliveries []*models.NotificationDelivery
+	err := r.db.NewSelect().
+		Model(&deliveries).
+		Where("ticket_id IN (?)", bun.In(ticketIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// UpdateStatus records the receipt outcome for a ticket.
+func (r *notificationDeliveryRepository) UpdateStatus(ctx context.Context, ticketID string, status models.NotificationDeliveryStatus, errorCode, errorMessage *string) error {
+	_, err := r.db.NewUpdate().
+		Model((*models.NotificationDelivery)(nil)).
+		Set("status = ?", status).
+		Set("error_code = ?", errorCode).
+		Set("error_message = ?", errorMessage).
+		Set("checked_at = ?", time.Now().UTC()).
+		Where("ticket_id = ?", ticketID).
+		Exec(ctx)
+	return err
+}
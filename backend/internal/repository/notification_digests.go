@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type NotificationDigestRepository interface {
+	CreateMany(ctx context.Context, items []*models.NotificationDigestItem) error
+	FindDueByUserID(ctx context.Context, userID uuid.UUID, now time.Time) ([]*models.NotificationDigestItem, error)
+	MarkDelivered(ctx context.Context, ids []uuid.UUID) error
+}
+
+var _ NotificationDigestRepository = (*notificationDigestRepository)(nil)
+
+type notificationDigestRepository struct {
+	db *bun.DB
+}
+
+func NewNotificationDigestRepository(db *bun.DB) NotificationDigestRepository {
+	return &notificationDigestRepository{db: db}
+}
+
+func (r *notificationDigestRepository) CreateMany(ctx context.Context, items []*models.NotificationDigestItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NewInsert().
+		Model(&items).
+		Returning("*").
+		Exec(ctx)
+	return err
+}
+
+// FindDueByUserID returns the user's undelivered items whose delivery time has passed,
+// oldest first.
+func (r *notificationDigestRepository) FindDueByUserID(ctx context.Context, userID uuid.UUID, now time.Time) ([]*models.NotificationDigestItem, error) {
+	var items []*models.NotificationDigestItem
+	err := r.db.NewSelect().
+		Model(&items).
+		Where("user_id = ?", userID).
+		Where("delivered_at IS NULL").
+		Where("deliver_at <= ?", now).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *notificationDigestRepository) MarkDelivered(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*models.NotificationDigestItem)(nil)).
+		Set("delivered_at = ?", time.Now().UTC()).
+		Where("id IN (?)", bun.In(ids)).
+		Exec(ctx)
+	return err
+}
@@ -15,6 +15,7 @@ import (
 type NotificationPreferencesRepository interface {
 	Create(ctx context.Context, prefs *models.NotificationPreferences) (*models.NotificationPreferences, error)
 	Find(ctx context.Context, userID uuid.UUID) (*models.NotificationPreferences, error)
+	FindByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.NotificationPreferences, error)
 	Update(ctx context.Context, userID uuid.UUID, req *models.UpdateUserNotificationPreferencesRequest) (*models.NotificationPreferences, error)
 	Delete(ctx context.Context, userID uuid.UUID) error
 	Upsert(ctx context.Context, prefs *models.NotificationPreferences) (*models.NotificationPreferences, error)
@@ -56,6 +57,24 @@ func (r *notificationPreferencesRepository) Find(ctx context.Context, userID uui
 	return prefs, nil
 }
 
+// FindByUserIDs returns the saved preferences for the given users. Users who have never
+// saved preferences have no row and are omitted.
+func (r *notificationPreferencesRepository) FindByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.NotificationPreferences, error) {
+	if len(userIDs) == 0 {
+		return []*models.NotificationPreferences{}, nil
+	}
+
+	var prefs []*models.NotificationPreferences
+	err := r.db.NewSelect().
+		Model(&prefs).
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
+
 func (r *notificationPreferencesRepository) Update(ctx context.Context, userID uuid.UUID, req *models.UpdateUserNotificationPreferencesRequest) (*models.NotificationPreferences, error) {
 	updateQuery := r.db.NewUpdate().
 		Model(&models.NotificationPreferences{}).
@@ -65,6 +84,14 @@ func (r *notificationPreferencesRepository) Update(ctx context.Context, userID u
 		updateQuery = updateQuery.Set("push_enabled = ?", *req.PushEnabled)
 	}
 
+	if req.EmailEnabled != nil {
+		updateQuery = updateQuery.Set("email_enabled = ?", *req.EmailEnabled)
+	}
+
+	if req.SMSEnabled != nil {
+		updateQuery = updateQuery.Set("sms_enabled = ?", *req.SMSEnabled)
+	}
+
 	if req.UpcomingTrip != nil {
 		updateQuery = updateQuery.Set("upcoming_trip = ?", *req.UpcomingTrip)
 	}
@@ -85,6 +112,26 @@ func (r *notificationPreferencesRepository) Update(ctx context.Context, userID u
 		updateQuery = updateQuery.Set("deadline_reminders = ?", *req.DeadlineReminders)
 	}
 
+	if req.QuietHoursEnabled != nil {
+		updateQuery = updateQuery.Set("quiet_hours_enabled = ?", *req.QuietHoursEnabled)
+	}
+
+	if req.QuietHoursStart != nil {
+		updateQuery = updateQuery.Set("quiet_hours_start = ?", *req.QuietHoursStart)
+	}
+
+	if req.QuietHoursEnd != nil {
+		updateQuery = updateQuery.Set("quiet_hours_end = ?", *req.QuietHoursEnd)
+	}
+
+	if req.DigestEnabled != nil {
+		updateQuery = updateQuery.Set("digest_enabled = ?", *req.DigestEnabled)
+	}
+
+	if req.DigestTime != nil {
+		updateQuery = updateQuery.Set("digest_time = ?", *req.DigestTime)
+	}
+
 	updateQuery = updateQuery.Set("updated_at = NOW()")
 
 	result, err := updateQuery.Exec(ctx)
@@ -128,11 +175,18 @@ func (r *notificationPreferencesRepository) Upsert(ctx context.Context, prefs *m
 		Model(prefs).
 		On("CONFLICT (user_id) DO UPDATE").
 		Set("push_enabled = EXCLUDED.push_enabled").
+		Set("email_enabled = EXCLUDED.email_enabled").
+		Set("sms_enabled = EXCLUDED.sms_enabled").
 		Set("upcoming_trip = EXCLUDED.upcoming_trip").
 		Set("voting_reminders = EXCLUDED.voting_reminders").
 		Set("finalized_decisions = EXCLUDED.finalized_decisions").
 		Set("trip_activity = EXCLUDED.trip_activity").
 		Set("deadline_reminders = EXCLUDED.deadline_reminders").
+		Set("quiet_hours_enabled = EXCLUDED.quiet_hours_enabled").
+		Set("quiet_hours_start = EXCLUDED.quiet_hours_start").
+		Set("quiet_hours_end = EXCLUDED.quiet_hours_end").
+		Set("digest_enabled = EXCLUDED.digest_enabled").
+		Set("digest_time = EXCLUDED.digest_time").
 		Set("updated_at = NOW()").
 		Returning("*").
 		Exec(ctx)
@@ -19,6 +19,7 @@ type PitchRepository interface {
 	Update(ctx context.Context, id, tripID uuid.UUID, req *models.UpdatePitchRequest) (*models.TripPitch, error)
 	UpdateWithImages(ctx context.Context, id, tripID uuid.UUID, req *models.UpdatePitchRequest, imageIDs []uuid.UUID) (*models.TripPitch, error)
 	Delete(ctx context.Context, id, tripID uuid.UUID) error
+	FindPitcherIDs(ctx context.Context, tripID uuid.UUID) ([]uuid.UUID, error)
 
 	GetImageIDsForPitch(ctx context.Context, pitchID uuid.UUID) ([]uuid.UUID, error)
 	GetImageIDsForPitches(ctx context.Context, pitchIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
@@ -71,6 +72,7 @@ func (r *pitchRepository) CreateWithImages(ctx context.Context, pitch *models.Tr
 }
 
 // FindByIDAndTripID fetches a pitch by id and trip_id, joined with the pitcher's username and profile picture key.
+// A pitch hidden by a moderator is not found.
 func (r *pitchRepository) FindByIDAndTripID(ctx context.Context, id, tripID uuid.UUID) (*models.PitchDatabaseResponse, error) {
 	result := &models.PitchDatabaseResponse{}
 	err := r.db.NewSelect().
@@ -78,9 +80,10 @@ func (r *pitchRepository) FindByIDAndTripID(ctx context.Context, id, tripID uuid
 		ColumnExpr("tp.id, tp.trip_id, tp.user_id, tp.title, tp.description, tp.audio_s3_key, tp.duration, tp.created_at, tp.updated_at").
 		ColumnExpr("u.name, u.username").
 		ColumnExpr("pfp.file_key AS profile_picture_key").
-		Join("JOIN users AS u ON u.id = tp.user_id").
+		Join("LEFT JOIN users AS u ON u.id = tp.user_id").
 		Join("LEFT JOIN images AS pfp ON u.profile_picture IS NOT NULL AND pfp.image_id = u.profile_picture AND pfp.size = ? AND pfp.status = ?", models.ImageSizeSmall, models.UploadStatusConfirmed).
 		Where("tp.id = ? AND tp.trip_id = ?", id, tripID).
+		Where(notHidden("tp.id"), models.PitchEntity).
 		Scan(ctx, result)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -92,15 +95,17 @@ func (r *pitchRepository) FindByIDAndTripID(ctx context.Context, id, tripID uuid
 }
 
 // FindByTripIDWithCursor returns paginated pitches for a trip joined with pitcher user info (created_at DESC, id DESC).
+// Pitches hidden by a moderator are left out.
 func (r *pitchRepository) FindByTripIDWithCursor(ctx context.Context, tripID uuid.UUID, limit int, cursor *models.PitchCursor) ([]*models.PitchDatabaseResponse, *models.PitchCursor, error) {
 	query := r.db.NewSelect().
 		TableExpr("trip_pitches AS tp").
 		ColumnExpr("tp.id, tp.trip_id, tp.user_id, tp.title, tp.description, tp.audio_s3_key, tp.duration, tp.created_at, tp.updated_at").
 		ColumnExpr("u.name, u.username").
 		ColumnExpr("pfp.file_key AS profile_picture_key").
-		Join("JOIN users AS u ON u.id = tp.user_id").
+		Join("LEFT JOIN users AS u ON u.id = tp.user_id").
 		Join("LEFT JOIN images AS pfp ON u.profile_picture IS NOT NULL AND pfp.image_id = u.profile_picture AND pfp.size = ? AND pfp.status = ?", models.ImageSizeSmall, models.UploadStatusConfirmed).
 		Where("tp.trip_id = ?", tripID).
+		Where(notHidden("tp.id"), models.PitchEntity).
 		OrderExpr("tp.created_at DESC, tp.id DESC").
 		Limit(limit + 1)
 
@@ -158,6 +163,22 @@ func (r *pitchRepository) Update(ctx context.Context, id, tripID uuid.UUID, req
 	return pitch, nil
 }
 
+// FindPitcherIDs returns the distinct users who have pitched in the trip. Pitches
+// left behind by departed members have no author and are skipped.
+func (r *pitchRepository) FindPitcherIDs(ctx context.Context, tripID uuid.UUID) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.db.NewSelect().
+		Model((*models.TripPitch)(nil)).
+		ColumnExpr("DISTINCT user_id").
+		Where("trip_id = ?", tripID).
+		Where("user_id IS NOT NULL").
+		Scan(ctx, &userIDs)
+	if err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
 // Delete removes a pitch by id and trip_id. Returns ErrNotFound if no row was deleted.
 func (r *pitchRepository) Delete(ctx context.Context, id, tripID uuid.UUID) error {
 	result, err := r.db.NewDelete().
@@ -25,6 +25,19 @@ type Repository struct {
 	Search                  SearchRepository
 	ActivityRSVP            ActivityRSVPRepository
 	NotificationPreferences NotificationPreferencesRepository
+	ActivityFeed            ActivityFeedRepository
+	UserDevice              UserDeviceRepository
+	NotificationDelivery    NotificationDeliveryRepository
+	NotificationDigest      NotificationDigestRepository
+	TripWebhook             TripWebhookRepository
+	TripSlackChannel        TripSlackChannelRepository
+	TripShareLink           TripShareLinkRepository
+	TripTemplate            TripTemplateRepository
+	TripDirectInvite        TripDirectInviteRepository
+	UserBlock               UserBlockRepository
+	Moderation              ModerationRepository
+	Account                 AccountRepository
+	TripTask                TripTaskRepository
 	db                      *bun.DB
 }
 
@@ -50,6 +63,19 @@ func NewRepository(db *bun.DB) *Repository {
 		TripInvite:              NewTripInviteRepository(db),
 		Search:                  NewSearchRepository(db),
 		NotificationPreferences: NewNotificationPreferencesRepository(db),
+		ActivityFeed:            NewActivityFeedRepository(db),
+		UserDevice:              NewUserDeviceRepository(db),
+		NotificationDelivery:    NewNotificationDeliveryRepository(db),
+		NotificationDigest:      NewNotificationDigestRepository(db),
+		TripWebhook:             NewTripWebhookRepository(db),
+		TripSlackChannel:        NewTripSlackChannelRepository(db),
+		TripShareLink:           NewTripShareLinkRepository(db),
+		TripTemplate:            NewTripTemplateRepository(db),
+		TripDirectInvite:        NewTripDirectInviteRepository(db),
+		UserBlock:               NewUserBlockRepository(db),
+		Moderation:              NewModerationRepository(db),
+		Account:                 NewAccountRepository(db),
+		TripTask:                NewTripTaskRepository(db),
 		db:                      db,
 	}
 }
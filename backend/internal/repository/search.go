@@ -67,6 +67,7 @@ func (r *searchRepository) SearchTrips(
 		TableExpr("trips AS t").
 		Join("JOIN memberships AS m ON m.trip_id = t.id").
 		Where("m.user_id = ?", userID).
+		Where("t.deleted_at IS NULL").
 		Where("to_tsvector('english', t.name) @@ to_tsquery('english', ?)", tsQuery)
 
 	total, err := base.Clone().Count(ctx)
@@ -164,7 +165,7 @@ func (r *searchRepository) SearchTripMembers(
 
 	var rows []*models.MembershipDatabaseResponse
 	err = base.Clone().
-		ColumnExpr("m.user_id, m.trip_id, m.is_admin, m.created_at, m.updated_at, m.budget_min, m.budget_max, m.availability").
+		ColumnExpr("m.user_id, m.trip_id, m.role, m.is_admin, m.created_at, m.updated_at, m.budget_min, m.budget_max, m.availability").
 		ColumnExpr("u.name, u.username").
 		ColumnExpr("u.profile_picture AS profile_picture_id").
 		ColumnExpr("img.file_key AS profile_picture_key").
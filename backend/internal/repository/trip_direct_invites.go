@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TripDirectInviteRepository interface {
+	CreatePending(ctx context.Context, invite *models.TripDirectInvite) (*models.TripDirectInvite, bool, error)
+	Find(ctx context.Context, id uuid.UUID) (*models.TripDirectInvite, error)
+	FindPendingByTripID(ctx context.Context, tripID uuid.UUID) ([]*models.TripDirectInviteAPIResponse, error)
+	FindPendingByInviteeID(ctx context.Context, inviteeID uuid.UUID) ([]*models.TripDirectInviteAPIResponse, error)
+	Respond(ctx context.Context, id uuid.UUID, status models.TripDirectInviteStatus) (bool, error)
+}
+
+var _ TripDirectInviteRepository = (*tripDirectInviteRepository)(nil)
+
+type tripDirectInviteRepository struct {
+	db *bun.DB
+}
+
+func NewTripDirectInviteRepository(db *bun.DB) TripDirectInviteRepository {
+	return &tripDirectInviteRepository{db: db}
+}
+
+// CreatePending invites the user unless they already have a pending invite to the trip,
+// in which case that invite is returned instead. It reports whether a new invite was
+// created.
+func (r *tripDirectInviteRepository) CreatePending(ctx context.Context, invite *models.TripDirectInvite) (*models.TripDirectInvite, bool, error) {
+	invite.Status = models.TripDirectInvitePending
+	result, err := r.db.NewInsert().
+		Model(invite).
+		On("CONFLICT (trip_id, invitee_id) WHERE status = 'pending' DO NOTHING").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+	if rowsAffected > 0 {
+		return invite, true, nil
+	}
+
+	existing := &models.TripDirectInvite{}
+	err = r.db.NewSelect().
+		Model(existing).
+		Where("trip_id = ? AND invitee_id = ? AND status = ?", invite.TripID, invite.InviteeID, models.TripDirectInvitePending).
+		Scan(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+func (r *tripDirectInviteRepository) Find(ctx context.Context, id uuid.UUID) (*models.TripDirectInvite, error) {
+	invite := &models.TripDirectInvite{}
+	err := r.db.NewSelect().
+		Model(invite).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return invite, nil
+}
+
+func (r *tripDirectInviteRepository) FindPendingByTripID(ctx context.Context, tripID uuid.UUID) ([]*models.TripDirectInviteAPIResponse, error) {
+	return r.findPending(ctx, "tdi.trip_id = ?", tripID)
+}
+
+// FindPendingByInviteeID returns the user's open invites, leaving out deleted trips.
+func (r *tripDirectInviteRepository) FindPendingByInviteeID(ctx context.Context, inviteeID uuid.UUID) ([]*models.TripDirectInviteAPIResponse, error) {
+	return r.findPending(ctx, "tdi.invitee_id = ?", inviteeID)
+}
+
+func (r *tripDirectInviteRepository) findPending(ctx context.Context, where string, arg any) ([]*models.TripDirectInviteAPIResponse, error) {
+	invites := []*models.TripDirectInviteAPIResponse{}
+	err := r.db.NewSelect().
+		Model(&invites).
+		ColumnExpr("tdi.*").
+		ColumnExpr("t.name AS trip_name").
+		ColumnExpr("inviter.name AS inviter_name").
+		ColumnExpr("invitee.name AS invitee_name, invitee.username AS invitee_username").
+		Join("JOIN trips AS t ON t.id = tdi.trip_id AND t.deleted_at IS NULL").
+		Join("JOIN users AS invitee ON invitee.id = tdi.invitee_id").
+		Join("LEFT JOIN users AS inviter ON inviter.id = tdi.invited_by").
+		Where(where, arg).
+		Where("tdi.status = ?", models.TripDirectInvitePending).
+		OrderExpr("tdi.created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+// Respond settles a pending invite. It reports false when the invite was already
+// accepted, declined or cancelled.
+func (r *tripDirectInviteRepository) Respond(ctx context.Context, id uuid.UUID, status models.TripDirectInviteStatus) (bool, error) {
+	result, err := r.db.NewUpdate().
+		Model((*models.TripDirectInvite)(nil)).
+		Set("status = ?", status).
+		Set("responded_at = now()").
+		Where("id = ? AND status = ?", id, models.TripDirectInvitePending).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
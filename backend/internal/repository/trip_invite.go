@@ -15,6 +15,17 @@ type TripInviteRepository interface {
 	Create(ctx context.Context, invite *models.TripInvite) (*models.TripInvite, error)
 	FindByID(ctx context.Context, id uuid.UUID) (*models.TripInvite, error)
 	FindByCode(ctx context.Context, code string) (*models.TripInvite, error)
+	FindByTripID(ctx context.Context, tripID uuid.UUID) ([]*models.TripInvite, error)
+	Revoke(ctx context.Context, tripID, id uuid.UUID) error
+	ClaimUse(ctx context.Context, id uuid.UUID) (bool, error)
+	ReleaseUse(ctx context.Context, id uuid.UUID) error
+	CreateJoin(ctx context.Context, join *models.TripInviteJoin) (*models.TripInviteJoin, error)
+	FindJoin(ctx context.Context, tripID, id uuid.UUID) (*models.TripInviteJoin, error)
+	FindPendingJoin(ctx context.Context, tripID, userID uuid.UUID) (*models.TripInviteJoin, error)
+	FindJoinsByInviteID(ctx context.Context, inviteID uuid.UUID) ([]*models.TripInviteJoinAPIResponse, error)
+	FindPendingJoinsByTripID(ctx context.Context, tripID uuid.UUID) ([]*models.TripInviteJoinAPIResponse, error)
+	DecideJoin(ctx context.Context, id uuid.UUID, status models.TripInviteJoinStatus, decidedBy uuid.UUID) (bool, error)
+	GetJoinStats(ctx context.Context, inviteIDs []uuid.UUID) (map[uuid.UUID]*models.TripInviteJoinStats, error)
 }
 
 var _ TripInviteRepository = (*tripInviteRepository)(nil)
@@ -70,3 +81,197 @@ func (r *tripInviteRepository) FindByCode(ctx context.Context, code string) (*mo
 	}
 	return invite, nil
 }
+
+// FindByTripID returns the trip's invites, newest first.
+func (r *tripInviteRepository) FindByTripID(ctx context.Context, tripID uuid.UUID) ([]*models.TripInvite, error) {
+	invites := []*models.TripInvite{}
+	err := r.db.NewSelect().
+		Model(&invites).
+		Where("trip_id = ?", tripID).
+		OrderExpr("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+// Revoke marks the invite revoked. Revoking twice is not an error.
+func (r *tripInviteRepository) Revoke(ctx context.Context, tripID, id uuid.UUID) error {
+	result, err := r.db.NewUpdate().
+		Model((*models.TripInvite)(nil)).
+		Set("is_revoked = TRUE").
+		Where("id = ? AND trip_id = ?", id, tripID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// ClaimUse counts one use of the invite unless it is revoked, expired or used up. The
+// check and increment are a single statement so concurrent joins cannot exceed max_uses.
+func (r *tripInviteRepository) ClaimUse(ctx context.Context, id uuid.UUID) (bool, error) {
+	result, err := r.db.NewUpdate().
+		Model((*models.TripInvite)(nil)).
+		Set("use_count = use_count + 1").
+		Where("id = ?", id).
+		Where("is_revoked = FALSE AND expires_at > now()").
+		Where("(max_uses IS NULL OR use_count < max_uses)").
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// ReleaseUse gives back a use claimed by a join that did not go through.
+func (r *tripInviteRepository) ReleaseUse(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewUpdate().
+		Model((*models.TripInvite)(nil)).
+		Set("use_count = GREATEST(use_count - 1, 0)").
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+func (r *tripInviteRepository) CreateJoin(ctx context.Context, join *models.TripInviteJoin) (*models.TripInviteJoin, error) {
+	_, err := r.db.NewInsert().
+		Model(join).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return join, nil
+}
+
+func (r *tripInviteRepository) FindJoin(ctx context.Context, tripID, id uuid.UUID) (*models.TripInviteJoin, error) {
+	join := &models.TripInviteJoin{}
+	err := r.db.NewSelect().
+		Model(join).
+		Where("id = ? AND trip_id = ?", id, tripID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return join, nil
+}
+
+// FindPendingJoin returns the user's open request to join the trip.
+func (r *tripInviteRepository) FindPendingJoin(ctx context.Context, tripID, userID uuid.UUID) (*models.TripInviteJoin, error) {
+	join := &models.TripInviteJoin{}
+	err := r.db.NewSelect().
+		Model(join).
+		Where("trip_id = ? AND user_id = ? AND status = ?", tripID, userID, models.TripInviteJoinPending).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return join, nil
+}
+
+// FindJoinsByInviteID returns everyone who used the invite, newest first.
+func (r *tripInviteRepository) FindJoinsByInviteID(ctx context.Context, inviteID uuid.UUID) ([]*models.TripInviteJoinAPIResponse, error) {
+	return r.findJoins(ctx, "tij.created_at DESC", "tij.invite_id = ?", inviteID)
+}
+
+// FindPendingJoinsByTripID returns the trip's open join requests, oldest first.
+func (r *tripInviteRepository) FindPendingJoinsByTripID(ctx context.Context, tripID uuid.UUID) ([]*models.TripInviteJoinAPIResponse, error) {
+	return r.findJoins(ctx, "tij.created_at ASC", "tij.trip_id = ? AND tij.status = 'pending'", tripID)
+}
+
+func (r *tripInviteRepository) findJoins(ctx context.Context, order, where string, arg any) ([]*models.TripInviteJoinAPIResponse, error) {
+	joins := []*models.TripInviteJoinAPIResponse{}
+	err := r.db.NewSelect().
+		Model(&joins).
+		ColumnExpr("tij.*").
+		ColumnExpr("u.name, u.username").
+		Join("JOIN users AS u ON u.id = tij.user_id").
+		Where(where, arg).
+		OrderExpr(order).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return joins, nil
+}
+
+// DecideJoin settles a pending request. It reports false when the request was already
+// decided, so two admins cannot both act on it.
+func (r *tripInviteRepository) DecideJoin(ctx context.Context, id uuid.UUID, status models.TripInviteJoinStatus, decidedBy uuid.UUID) (bool, error) {
+	result, err := r.db.NewUpdate().
+		Model((*models.TripInviteJoin)(nil)).
+		Set("status = ?", status).
+		Set("decided_by = ?", decidedBy).
+		Set("decided_at = now()").
+		Where("id = ? AND status = ?", id, models.TripInviteJoinPending).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// GetJoinStats counts joins per invite and status. Invites without joins get zeroes.
+func (r *tripInviteRepository) GetJoinStats(ctx context.Context, inviteIDs []uuid.UUID) (map[uuid.UUID]*models.TripInviteJoinStats, error) {
+	stats := make(map[uuid.UUID]*models.TripInviteJoinStats, len(inviteIDs))
+	for _, id := range inviteIDs {
+		stats[id] = &models.TripInviteJoinStats{}
+	}
+	if len(inviteIDs) == 0 {
+		return stats, nil
+	}
+
+	var rows []struct {
+		InviteID uuid.UUID                   `bun:"invite_id"`
+		Status   models.TripInviteJoinStatus `bun:"status"`
+		Count    int                         `bun:"count"`
+	}
+	err := r.db.NewSelect().
+		TableExpr("trip_invite_joins").
+		ColumnExpr("invite_id, status, COUNT(*) AS count").
+		Where("invite_id IN (?)", bun.In(inviteIDs)).
+		GroupExpr("invite_id, status").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		s := stats[row.InviteID]
+		switch row.Status {
+		case models.TripInviteJoinJoined:
+			s.Joined = row.Count
+		case models.TripInviteJoinPending:
+			s.Pending = row.Count
+		case models.TripInviteJoinRejected:
+			s.Rejected = row.Count
+		}
+	}
+	return stats, nil
+}
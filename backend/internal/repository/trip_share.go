@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TripShareLinkRepository interface {
+	Upsert(ctx context.Context, link *models.TripShareLink) (*models.TripShareLink, error)
+	Find(ctx context.Context, tripID uuid.UUID) (*models.TripShareLink, error)
+	FindByToken(ctx context.Context, token string) (*models.TripShareLink, error)
+	Delete(ctx context.Context, tripID uuid.UUID) error
+}
+
+var _ TripShareLinkRepository = (*tripShareLinkRepository)(nil)
+
+type tripShareLinkRepository struct {
+	db *bun.DB
+}
+
+func NewTripShareLinkRepository(db *bun.DB) TripShareLinkRepository {
+	return &tripShareLinkRepository{db: db}
+}
+
+// Upsert creates the trip's share link or replaces its token and visible tabs.
+func (r *tripShareLinkRepository) Upsert(ctx context.Context, link *models.TripShareLink) (*models.TripShareLink, error) {
+	_, err := r.db.NewInsert().
+		Model(link).
+		On("CONFLICT (trip_id) DO UPDATE").
+		Set("token = EXCLUDED.token").
+		Set("visible_tabs = EXCLUDED.visible_tabs").
+		Set("updated_at = now()").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+func (r *tripShareLinkRepository) Find(ctx context.Context, tripID uuid.UUID) (*models.TripShareLink, error) {
+	return r.findWhere(ctx, "trip_id = ?", tripID)
+}
+
+func (r *tripShareLinkRepository) FindByToken(ctx context.Context, token string) (*models.TripShareLink, error) {
+	return r.findWhere(ctx, "token = ?", token)
+}
+
+func (r *tripShareLinkRepository) findWhere(ctx context.Context, query string, arg any) (*models.TripShareLink, error) {
+	link := &models.TripShareLink{}
+	err := r.db.NewSelect().
+		Model(link).
+		Where(query, arg).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return link, nil
+}
+
+func (r *tripShareLinkRepository) Delete(ctx context.Context, tripID uuid.UUID) error {
+	result, err := r.db.NewDelete().
+		Model((*models.TripShareLink)(nil)).
+		Where("trip_id = ?", tripID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TripSlackChannelRepository interface {
+	Upsert(ctx context.Context, link *models.TripSlackChannel) (*models.TripSlackChannel, error)
+	Find(ctx context.Context, tripID uuid.UUID) (*models.TripSlackChannel, error)
+	Delete(ctx context.Context, tripID uuid.UUID) error
+}
+
+var _ TripSlackChannelRepository = (*tripSlackChannelRepository)(nil)
+
+type tripSlackChannelRepository struct {
+	db *bun.DB
+}
+
+func NewTripSlackChannelRepository(db *bun.DB) TripSlackChannelRepository {
+	return &tripSlackChannelRepository{db: db}
+}
+
+// Upsert links the trip to the channel, replacing any previous link.
+func (r *tripSlackChannelRepository) Upsert(ctx context.Context, link *models.TripSlackChannel) (*models.TripSlackChannel, error) {
+	_, err := r.db.NewInsert().
+		Model(link).
+		On("CONFLICT (trip_id) DO UPDATE").
+		Set("channel_id = EXCLUDED.channel_id").
+		Set("linked_by = EXCLUDED.linked_by").
+		Set("created_at = now()").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+func (r *tripSlackChannelRepository) Find(ctx context.Context, tripID uuid.UUID) (*models.TripSlackChannel, error) {
+	link := &models.TripSlackChannel{}
+	err := r.db.NewSelect().
+		Model(link).
+		Where("trip_id = ?", tripID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return link, nil
+}
+
+func (r *tripSlackChannelRepository) Delete(ctx context.Context, tripID uuid.UUID) error {
+	result, err := r.db.NewDelete().
+		Model((*models.TripSlackChannel)(nil)).
+		Where("trip_id = ?", tripID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
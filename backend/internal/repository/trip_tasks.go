@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TripTaskRepository interface {
+	Create(ctx context.Context, task *models.TripTask) (*models.TripTask, error)
+	Find(ctx context.Context, id uuid.UUID) (*models.TripTask, error)
+	FindByTripID(ctx context.Context, tripID uuid.UUID, params models.TripTaskQueryParams) ([]*models.TripTask, error)
+	Update(ctx context.Context, task *models.TripTask, replaceAssignees bool) (*models.TripTask, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+var _ TripTaskRepository = (*tripTaskRepository)(nil)
+
+type tripTaskRepository struct {
+	db *bun.DB
+}
+
+func NewTripTaskRepository(db *bun.DB) TripTaskRepository {
+	return &tripTaskRepository{db: db}
+}
+
+// Create inserts the task and its assignees.
+func (r *tripTaskRepository) Create(ctx context.Context, task *models.TripTask) (*models.TripTask, error) {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().
+			Model(task).
+			Returning("*").
+			Exec(ctx); err != nil {
+			return err
+		}
+		return insertTaskAssignees(ctx, tx, task.ID, task.AssigneeIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if task.AssigneeIDs == nil {
+		task.AssigneeIDs = []uuid.UUID{}
+	}
+	return task, nil
+}
+
+func (r *tripTaskRepository) Find(ctx context.Context, id uuid.UUID) (*models.TripTask, error) {
+	task := &models.TripTask{}
+	err := r.db.NewSelect().
+		Model(task).
+		Where("tt.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	if err := r.loadAssignees(ctx, []*models.TripTask{task}); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// FindByTripID lists the trip's tasks with open tasks first, soonest due first, and
+// undated tasks in the order they were added.
+func (r *tripTaskRepository) FindByTripID(ctx context.Context, tripID uuid.UUID, params models.TripTaskQueryParams) ([]*models.TripTask, error) {
+	tasks := []*models.TripTask{}
+	query := r.db.NewSelect().
+		Model(&tasks).
+		Where("tt.trip_id = ?", tripID)
+	if params.Status != "" {
+		query = query.Where("tt.status = ?", params.Status)
+	}
+	if params.AssigneeID != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM trip_task_assignees AS tta WHERE tta.task_id = tt.id AND tta.user_id = ?)", params.AssigneeID)
+	}
+	err := query.
+		OrderExpr("tt.status = ? ASC, tt.due_at ASC NULLS LAST, tt.created_at ASC, tt.id ASC", models.TripTaskDone).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadAssignees(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// Update saves the task's editable fields and, when replaceAssignees is set, replaces
+// its assignees with task.AssigneeIDs.
+func (r *tripTaskRepository) Update(ctx context.Context, task *models.TripTask, replaceAssignees bool) (*models.TripTask, error) {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewUpdate().
+			Model(task).
+			Column("title", "description", "status", "due_at", "activity_id", "completed_at").
+			Set("updated_at = now()").
+			WherePK().
+			Returning("*").
+			Scan(ctx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errs.ErrNotFound
+			}
+			return err
+		}
+		if !replaceAssignees {
+			return nil
+		}
+
+		if _, err := tx.NewDelete().
+			Model((*models.TripTaskAssignee)(nil)).
+			Where("task_id = ?", task.ID).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return insertTaskAssignees(ctx, tx, task.ID, task.AssigneeIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if task.AssigneeIDs == nil {
+		task.AssigneeIDs = []uuid.UUID{}
+	}
+	return task, nil
+}
+
+// Delete removes the task along with its assignees and comments.
+func (r *tripTaskRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		result, err := tx.NewDelete().
+			Model((*models.TripTask)(nil)).
+			Where("id = ?", id).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return errs.ErrNotFound
+		}
+
+		_, err = tx.NewDelete().
+			Model((*models.Comment)(nil)).
+			Where("entity_type = ? AND entity_id = ?", models.TaskEntity, id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func insertTaskAssignees(ctx context.Context, tx bun.Tx, taskID uuid.UUID, userIDs []uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	assignees := make([]*models.TripTaskAssignee, 0, len(userIDs))
+	for _, userID := range userIDs {
+		assignees = append(assignees, &models.TripTaskAssignee{TaskID: taskID, UserID: userID})
+	}
+	_, err := tx.NewInsert().
+		Model(&assignees).
+		On("CONFLICT DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
+// loadAssignees fills in each task's assignees in the order they were assigned.
+func (r *tripTaskRepository) loadAssignees(ctx context.Context, tasks []*models.TripTask) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	byID := make(map[uuid.UUID]*models.TripTask, len(tasks))
+	taskIDs := make([]uuid.UUID, 0, len(tasks))
+	for _, task := range tasks {
+		task.AssigneeIDs = []uuid.UUID{}
+		byID[task.ID] = task
+		taskIDs = append(taskIDs, task.ID)
+	}
+
+	var assignees []*models.TripTaskAssignee
+	err := r.db.NewSelect().
+		Model(&assignees).
+		Where("task_id IN (?)", bun.In(taskIDs)).
+		Order("created_at ASC", "user_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return err
+	}
+	for _, a := range assignees {
+		task := byID[a.TaskID]
+		task.AssigneeIDs = append(task.AssigneeIDs, a.UserID)
+	}
+	return nil
+}
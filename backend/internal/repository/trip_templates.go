@@ -0,0 +1,343 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TripTemplateRepository interface {
+	Create(ctx context.Context, template *models.TripTemplate) (*models.TripTemplate, error)
+	Find(ctx context.Context, id uuid.UUID) (*models.TripTemplate, error)
+	FindUsable(ctx context.Context, userID uuid.UUID) ([]*models.TripTemplate, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Snapshot(ctx context.Context, trip *models.Trip) (*models.TripTemplateData, error)
+	CopyContentTx(ctx context.Context, tx bun.Tx, tripID, creatorID uuid.UUID, data *models.TripTemplateData) error
+}
+
+var _ TripTemplateRepository = (*tripTemplateRepository)(nil)
+
+type tripTemplateRepository struct {
+	db *bun.DB
+}
+
+func NewTripTemplateRepository(db *bun.DB) TripTemplateRepository {
+	return &tripTemplateRepository{db: db}
+}
+
+func (r *tripTemplateRepository) Create(ctx context.Context, template *models.TripTemplate) (*models.TripTemplate, error) {
+	_, err := r.db.NewInsert().
+		Model(template).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (r *tripTemplateRepository) Find(ctx context.Context, id uuid.UUID) (*models.TripTemplate, error) {
+	template := &models.TripTemplate{}
+	err := r.db.NewSelect().
+		Model(template).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return template, nil
+}
+
+// FindUsable returns the user's own templates and every public one, newest first.
+func (r *tripTemplateRepository) FindUsable(ctx context.Context, userID uuid.UUID) ([]*models.TripTemplate, error) {
+	var templates []*models.TripTemplate
+	err := r.db.NewSelect().
+		Model(&templates).
+		Where("created_by = ? OR is_public", userID).
+		Order("created_at DESC", "id DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+func (r *tripTemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.NewDelete().
+		Model((*models.TripTemplate)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// Snapshot captures the trip's settings, categories (including hidden and custom ones),
+// activities and polls. The trip's pitch rank poll is left out since it only makes sense
+// alongside the trip's pitches.
+func (r *tripTemplateRepository) Snapshot(ctx context.Context, trip *models.Trip) (*models.TripTemplateData, error) {
+	data := &models.TripTemplateData{
+		BudgetMin:             trip.BudgetMin,
+		BudgetMax:             trip.BudgetMax,
+		Currency:              trip.Currency,
+		Location:              trip.Location,
+		DepartedContentPolicy: trip.DepartedContentPolicy,
+		StartDate:             trip.StartDate,
+		EndDate:               trip.EndDate,
+		Categories:            []models.TripTemplateCategory{},
+		Activities:            []models.TripTemplateActivity{},
+		Polls:                 []models.TripTemplatePoll{},
+	}
+
+	var categories []models.Category
+	err := r.db.NewSelect().
+		Model(&categories).
+		Where("trip_id = ?", trip.ID).
+		Order("position ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range categories {
+		data.Categories = append(data.Categories, models.TripTemplateCategory{
+			Name:      c.Name,
+			Label:     c.Label,
+			Icon:      c.Icon,
+			IsHidden:  c.IsHidden,
+			IsDefault: c.IsDefault,
+			ViewType:  c.ViewType,
+			Position:  c.Position,
+		})
+	}
+
+	var activities []models.Activity
+	err = r.db.NewSelect().
+		Model(&activities).
+		Where("trip_id = ?", trip.ID).
+		Order("created_at ASC", "id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var activityCategories []models.ActivityCategory
+	err = r.db.NewSelect().
+		Model(&activityCategories).
+		Where("trip_id = ?", trip.ID).
+		Order("category_name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categoriesByActivity := make(map[uuid.UUID][]string)
+	for _, ac := range activityCategories {
+		categoriesByActivity[ac.ActivityID] = append(categoriesByActivity[ac.ActivityID], ac.CategoryName)
+	}
+	for _, a := range activities {
+		names := categoriesByActivity[a.ID]
+		if names == nil {
+			names = []string{}
+		}
+		data.Activities = append(data.Activities, models.TripTemplateActivity{
+			Key:            a.ID,
+			Name:           a.Name,
+			TimeOfDay:      a.TimeOfDay,
+			ThumbnailURL:   a.ThumbnailURL,
+			MediaURL:       a.MediaURL,
+			Description:    a.Description,
+			Dates:          a.Dates,
+			LocationName:   a.LocationName,
+			LocationLat:    a.LocationLat,
+			LocationLng:    a.LocationLng,
+			EstimatedPrice: a.EstimatedPrice,
+			CategoryNames:  names,
+		})
+	}
+
+	var polls []models.Poll
+	query := r.db.NewSelect().
+		Model(&polls).
+		Relation("Options", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("name ASC")
+		}).
+		Where("poll.trip_id = ?", trip.ID).
+		Order("poll.created_at ASC", "poll.id ASC")
+	if trip.RankPollID != nil {
+		query = query.Where("poll.id <> ?", *trip.RankPollID)
+	}
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+	var pollCategories []models.PollCategory
+	err = r.db.NewSelect().
+		Model(&pollCategories).
+		Where("trip_id = ?", trip.ID).
+		Order("category_name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categoriesByPoll := make(map[uuid.UUID][]string)
+	for _, pc := range pollCategories {
+		categoriesByPoll[pc.PollID] = append(categoriesByPoll[pc.PollID], pc.CategoryName)
+	}
+	for _, p := range polls {
+		poll := models.TripTemplatePoll{
+			Question:            p.Question,
+			PollType:            p.PollType,
+			IsAnonymous:         p.IsAnonymous,
+			ShouldNotifyMembers: p.ShouldNotifyMembers,
+			Options:             make([]models.TripTemplatePollOption, 0, len(p.Options)),
+			Categories:          categoriesByPoll[p.ID],
+		}
+		if poll.Categories == nil {
+			poll.Categories = []string{}
+		}
+		for _, o := range p.Options {
+			option := models.TripTemplatePollOption{Name: o.Name}
+			if o.OptionType == models.OptionTypeEntity && o.EntityType != nil && *o.EntityType == string(models.ActivityEntity) {
+				option.ActivityKey = o.EntityID
+			}
+			poll.Options = append(poll.Options, option)
+		}
+		data.Polls = append(data.Polls, poll)
+	}
+
+	return data, nil
+}
+
+// CopyContentTx inserts the template's categories, activities and polls into a new trip.
+// Categories keep their positions; activities and polls are attributed to creatorID.
+// Poll options naming a template activity point at its copy, and options for activities
+// missing from the template become custom options.
+func (r *tripTemplateRepository) CopyContentTx(ctx context.Context, tx bun.Tx, tripID, creatorID uuid.UUID, data *models.TripTemplateData) error {
+	if len(data.Categories) > 0 {
+		categories := make([]*models.Category, 0, len(data.Categories))
+		for _, c := range data.Categories {
+			categories = append(categories, &models.Category{
+				TripID:    tripID,
+				Name:      c.Name,
+				Label:     c.Label,
+				Icon:      c.Icon,
+				IsHidden:  c.IsHidden,
+				IsDefault: c.IsDefault,
+				ViewType:  c.ViewType,
+				Position:  c.Position,
+			})
+		}
+		if _, err := tx.NewInsert().Model(&categories).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	activityIDs := make(map[uuid.UUID]uuid.UUID, len(data.Activities))
+	if len(data.Activities) > 0 {
+		activities := make([]*models.Activity, 0, len(data.Activities))
+		var activityCategories []*models.ActivityCategory
+		for _, a := range data.Activities {
+			activity := &models.Activity{
+				ID:             uuid.New(),
+				TripID:         tripID,
+				ProposedBy:     &creatorID,
+				Name:           a.Name,
+				TimeOfDay:      a.TimeOfDay,
+				ThumbnailURL:   a.ThumbnailURL,
+				MediaURL:       a.MediaURL,
+				Description:    a.Description,
+				Dates:          a.Dates,
+				LocationName:   a.LocationName,
+				LocationLat:    a.LocationLat,
+				LocationLng:    a.LocationLng,
+				EstimatedPrice: a.EstimatedPrice,
+			}
+			activityIDs[a.Key] = activity.ID
+			activities = append(activities, activity)
+			for _, name := range a.CategoryNames {
+				activityCategories = append(activityCategories, &models.ActivityCategory{
+					ActivityID:   activity.ID,
+					TripID:       tripID,
+					CategoryName: name,
+				})
+			}
+		}
+		if _, err := tx.NewInsert().Model(&activities).Exec(ctx); err != nil {
+			return err
+		}
+		if len(activityCategories) > 0 {
+			if _, err := tx.NewInsert().Model(&activityCategories).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, p := range data.Polls {
+		poll := &models.Poll{
+			ID:                  uuid.New(),
+			TripID:              tripID,
+			CreatedBy:           creatorID,
+			Question:            p.Question,
+			PollType:            p.PollType,
+			ShouldNotifyMembers: p.ShouldNotifyMembers,
+			IsAnonymous:         p.IsAnonymous,
+		}
+		if _, err := tx.NewInsert().Model(poll).Exec(ctx); err != nil {
+			return err
+		}
+
+		if len(p.Options) > 0 {
+			options := make([]*models.PollOption, 0, len(p.Options))
+			for _, o := range p.Options {
+				option := &models.PollOption{
+					ID:         uuid.New(),
+					PollID:     poll.ID,
+					OptionType: models.OptionTypeCustom,
+					Name:       o.Name,
+				}
+				if o.ActivityKey != nil {
+					if activityID, ok := activityIDs[*o.ActivityKey]; ok {
+						entityType := string(models.ActivityEntity)
+						option.OptionType = models.OptionTypeEntity
+						option.EntityType = &entityType
+						option.EntityID = &activityID
+					}
+				}
+				options = append(options, option)
+			}
+			if _, err := tx.NewInsert().Model(&options).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		if len(p.Categories) > 0 {
+			categories := make([]*models.PollCategory, 0, len(p.Categories))
+			for _, name := range p.Categories {
+				categories = append(categories, &models.PollCategory{
+					PollID:       poll.ID,
+					TripID:       tripID,
+					CategoryName: name,
+				})
+			}
+			if _, err := tx.NewInsert().Model(&categories).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TripWebhookRepository interface {
+	Create(ctx context.Context, webhook *models.TripWebhook) (*models.TripWebhook, error)
+	Find(ctx context.Context, id uuid.UUID) (*models.TripWebhook, error)
+	FindByTripID(ctx context.Context, tripID uuid.UUID) ([]*models.TripWebhook, error)
+	FindSubscribed(ctx context.Context, tripID uuid.UUID, topic string) ([]*models.TripWebhook, error)
+	Update(ctx context.Context, webhook *models.TripWebhook) (*models.TripWebhook, error)
+	Delete(ctx context.Context, tripID, id uuid.UUID) error
+	RecordAttempt(ctx context.Context, attempt *models.TripWebhookDelivery) (*models.TripWebhookDelivery, error)
+	MarkFailed(ctx context.Context, webhookID uuid.UUID, eventID string) error
+	FindDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*models.TripWebhookDelivery, error)
+}
+
+var _ TripWebhookRepository = (*tripWebhookRepository)(nil)
+
+type tripWebhookRepository struct {
+	db *bun.DB
+}
+
+func NewTripWebhookRepository(db *bun.DB) TripWebhookRepository {
+	return &tripWebhookRepository{db: db}
+}
+
+func (r *tripWebhookRepository) Create(ctx context.Context, webhook *models.TripWebhook) (*models.TripWebhook, error) {
+	_, err := r.db.NewInsert().
+		Model(webhook).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (r *tripWebhookRepository) Find(ctx context.Context, id uuid.UUID) (*models.TripWebhook, error) {
+	webhook := &models.TripWebhook{}
+	err := r.db.NewSelect().
+		Model(webhook).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (r *tripWebhookRepository) FindByTripID(ctx context.Context, tripID uuid.UUID) ([]*models.TripWebhook, error) {
+	webhooks := []*models.TripWebhook{}
+	err := r.db.NewSelect().
+		Model(&webhooks).
+		Where("trip_id = ?", tripID).
+		OrderExpr("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// FindSubscribed returns the trip's enabled webhooks that selected the topic.
+func (r *tripWebhookRepository) FindSubscribed(ctx context.Context, tripID uuid.UUID, topic string) ([]*models.TripWebhook, error) {
+	var webhooks []*models.TripWebhook
+	err := r.db.NewSelect().
+		Model(&webhooks).
+		Where("trip_id = ? AND enabled = TRUE AND ? = ANY(topics)", tripID, topic).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (r *tripWebhookRepository) Update(ctx context.Context, webhook *models.TripWebhook) (*models.TripWebhook, error) {
+	webhook.UpdatedAt = time.Now().UTC()
+	result, err := r.db.NewUpdate().
+		Model(webhook).
+		Column("url", "topics", "enabled", "updated_at").
+		WherePK().
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, errs.ErrNotFound
+	}
+	return webhook, nil
+}
+
+func (r *tripWebhookRepository) Delete(ctx context.Context, tripID, id uuid.UUID) error {
+	result, err := r.db.NewDelete().
+		Model((*models.TripWebhook)(nil)).
+		Where("id = ? AND trip_id = ?", id, tripID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// RecordAttempt logs the outcome of one attempt at delivering an event, creating the
+// delivery on the first attempt and counting retries on the same row.
+func (r *tripWebhookRepository) RecordAttempt(ctx context.Context, attempt *models.TripWebhookDelivery) (*models.TripWebhookDelivery, error) {
+	now := time.Now().UTC()
+	attempt.Attempts = 1
+	attempt.LastAttemptAt = &now
+
+	_, err := r.db.NewInsert().
+		Model(attempt).
+		On("CONFLICT (webhook_id, event_id) DO UPDATE").
+		Set("attempts = trip_webhook_delivery.attempts + 1").
+		Set("status = EXCLUDED.status").
+		Set("response_status = EXCLUDED.response_status").
+		Set("error_message = EXCLUDED.error_message").
+		Set("last_attempt_at = EXCLUDED.last_attempt_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// MarkFailed records that an event will not be retried.
+func (r *tripWebhookRepository) MarkFailed(ctx context.Context, webhookID uuid.UUID, eventID string) error {
+	_, err := r.db.NewUpdate().
+		Model((*models.TripWebhookDelivery)(nil)).
+		Set("status = ?", models.TripWebhookDeliveryFailed).
+		Where("webhook_id = ? AND event_id = ?", webhookID, eventID).
+		Exec(ctx)
+	return err
+}
+
+// FindDeliveries returns the webhook's most recent deliveries, newest first.
+func (r *tripWebhookRepository) FindDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*models.TripWebhookDelivery, error) {
+	deliveries := []*models.TripWebhookDelivery{}
+	err := r.db.NewSelect().
+		Model(&deliveries).
+		Where("webhook_id = ?", webhookID).
+		OrderExpr("created_at DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
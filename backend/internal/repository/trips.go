@@ -16,12 +16,20 @@ type TripRepository interface {
 	Create(ctx context.Context, trip *models.Trip) (*models.Trip, error)
 	Find(ctx context.Context, id uuid.UUID) (*models.Trip, error)
 	FindWithCoverImage(ctx context.Context, id uuid.UUID) (*models.TripDatabaseResponse, error)
-	FindAllWithCursorAndCoverImage(ctx context.Context, userID uuid.UUID, limit int, cursor *models.TripCursor, endDateBefore *time.Time) ([]*models.TripDatabaseResponse, *models.TripCursor, error)
+	FindAllWithCursorAndCoverImage(ctx context.Context, userID uuid.UUID, limit int, cursor *models.TripCursor, endDateBefore *time.Time, includeArchived bool) ([]*models.TripDatabaseResponse, *models.TripCursor, error)
 	FindAllWithCursor(ctx context.Context, userID uuid.UUID, limit int, cursor *models.TripCursor) ([]*models.Trip, *models.TripCursor, error)
 	Update(ctx context.Context, id uuid.UUID, req *models.UpdateTripRequest) (*models.Trip, error)
 	UpdateTx(ctx context.Context, tx bun.Tx, id uuid.UUID, req *models.UpdateTripRequest) (*models.Trip, error)
 	SetRankPollID(ctx context.Context, tripID, pollID uuid.UUID) error
 	SetRankPollIDTx(ctx context.Context, tx bun.Tx, tripID, pollID uuid.UUID) error
+	ClosePitching(ctx context.Context, tripID uuid.UUID, closedAt time.Time) (bool, error)
+	SetArchived(ctx context.Context, id uuid.UUID, archivedAt *time.Time) (*models.Trip, error)
+	SoftDelete(ctx context.Context, id uuid.UUID, deletedAt time.Time) error
+	Restore(ctx context.Context, id uuid.UUID, deletedAfter time.Time) (*models.Trip, error)
+	FindDeleted(ctx context.Context, id uuid.UUID) (*models.Trip, error)
+	FindDeletedByUserID(ctx context.Context, userID uuid.UUID, deletedAfter time.Time) ([]*models.Trip, error)
+	FindMediaKeys(ctx context.Context, id uuid.UUID) ([]string, error)
+	Purge(ctx context.Context, id uuid.UUID) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
@@ -47,12 +55,13 @@ func (r *tripRepository) Create(ctx context.Context, trip *models.Trip) (*models
 	return trip, nil
 }
 
-// Find retrieves a trip by ID
+// Find retrieves a trip by ID. Deleted trips are not found.
 func (r *tripRepository) Find(ctx context.Context, id uuid.UUID) (*models.Trip, error) {
 	trip := &models.Trip{}
 	err := r.db.NewSelect().
 		Model(trip).
 		Where("id = ?", id).
+		Where("deleted_at IS NULL").
 		Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -68,11 +77,12 @@ func (r *tripRepository) FindWithCoverImage(ctx context.Context, id uuid.UUID) (
 	tripData := &models.TripDatabaseResponse{}
 	err := r.db.NewSelect().
 		TableExpr("trips AS t").
-		ColumnExpr("t.id AS trip_id, t.name, t.budget_min, t.budget_max, t.currency, t.pitch_deadline, t.rank_poll_id, t.start_date, t.end_date, t.location, t.created_at, t.updated_at").
+		ColumnExpr("t.id AS trip_id, t.name, t.budget_min, t.budget_max, t.currency, t.pitch_deadline, t.rank_poll_id, t.pitching_closed_at, t.start_date, t.end_date, t.location, t.departed_content_policy, t.archived_at, t.created_at, t.updated_at").
 		ColumnExpr("t.cover_image").
 		ColumnExpr("img.file_key AS cover_image_key").
 		Join("LEFT JOIN images AS img ON t.cover_image IS NOT NULL AND img.image_id = t.cover_image AND img.size = ? AND img.status = ?", models.ImageSizeMedium, models.UploadStatusConfirmed).
 		Where("t.id = ?", id).
+		Where("t.deleted_at IS NULL").
 		Scan(ctx, tripData)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -91,6 +101,7 @@ func (r *tripRepository) FindAllWithCursor(ctx context.Context, userID uuid.UUID
 		Model((*models.Trip)(nil)).
 		Join("JOIN memberships AS m ON m.trip_id = trip.id").
 		Where("m.user_id = ?", userID).
+		Where("trip.deleted_at IS NULL").
 		OrderExpr("trip.created_at DESC, trip.id DESC").
 		Limit(limit + 1)
 
@@ -113,16 +124,18 @@ func (r *tripRepository) FindAllWithCursor(ctx context.Context, userID uuid.UUID
 	return trips, nextCursor, nil
 }
 
-// FindAllWithCursorAndCoverImage retrieves trips a user belongs to with cursor pagination and cover image IDs
-func (r *tripRepository) FindAllWithCursorAndCoverImage(ctx context.Context, userID uuid.UUID, limit int, cursor *models.TripCursor, endDateBefore *time.Time) ([]*models.TripDatabaseResponse, *models.TripCursor, error) {
+// FindAllWithCursorAndCoverImage retrieves trips a user belongs to with cursor pagination and cover image IDs.
+// Archived trips are left out unless includeArchived is set.
+func (r *tripRepository) FindAllWithCursorAndCoverImage(ctx context.Context, userID uuid.UUID, limit int, cursor *models.TripCursor, endDateBefore *time.Time, includeArchived bool) ([]*models.TripDatabaseResponse, *models.TripCursor, error) {
 	query := r.db.NewSelect().
 		TableExpr("trips AS t").
-		ColumnExpr("t.id AS trip_id, t.name, t.budget_min, t.budget_max, t.currency, t.pitch_deadline, t.rank_poll_id, t.start_date, t.end_date, t.location, t.created_at, t.updated_at").
+		ColumnExpr("t.id AS trip_id, t.name, t.budget_min, t.budget_max, t.currency, t.pitch_deadline, t.rank_poll_id, t.pitching_closed_at, t.start_date, t.end_date, t.location, t.departed_content_policy, t.archived_at, t.created_at, t.updated_at").
 		ColumnExpr("t.cover_image").
 		ColumnExpr("img.file_key AS cover_image_key").
 		Join("JOIN memberships AS m ON m.trip_id = t.id").
 		Join("LEFT JOIN images AS img ON t.cover_image IS NOT NULL AND img.image_id = t.cover_image AND img.size = ? AND img.status = ?", models.ImageSizeMedium, models.UploadStatusConfirmed).
 		Where("m.user_id = ?", userID).
+		Where("t.deleted_at IS NULL").
 		OrderExpr("t.created_at DESC, t.id DESC").
 		Limit(limit + 1)
 
@@ -130,6 +143,10 @@ func (r *tripRepository) FindAllWithCursorAndCoverImage(ctx context.Context, use
 		query = query.Where("(t.created_at < ?) OR (t.created_at = ? AND t.id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
 	}
 
+	if !includeArchived {
+		query = query.Where("t.archived_at IS NULL")
+	}
+
 	if endDateBefore != nil {
 		query = query.Where("t.end_date IS NOT NULL AND t.end_date < ?", *endDateBefore)
 	}
@@ -153,7 +170,8 @@ func (r *tripRepository) FindAllWithCursorAndCoverImage(ctx context.Context, use
 func (r *tripRepository) Update(ctx context.Context, id uuid.UUID, req *models.UpdateTripRequest) (*models.Trip, error) {
 	updateQuery := r.db.NewUpdate().
 		Model(&models.Trip{}).
-		Where("id = ?", id)
+		Where("id = ?", id).
+		Where("deleted_at IS NULL")
 
 	if req.Name != nil {
 		updateQuery = updateQuery.Set("name = ?", *req.Name)
@@ -176,7 +194,8 @@ func (r *tripRepository) Update(ctx context.Context, id uuid.UUID, req *models.U
 	}
 
 	if req.PitchDeadline != nil {
-		updateQuery = updateQuery.Set("pitch_deadline = ?", *req.PitchDeadline)
+		// A new deadline reopens pitching until the close workflow runs again.
+		updateQuery = updateQuery.Set("pitch_deadline = ?", *req.PitchDeadline).Set("pitching_closed_at = NULL")
 	}
 
 	if req.StartDate != nil {
@@ -187,6 +206,10 @@ func (r *tripRepository) Update(ctx context.Context, id uuid.UUID, req *models.U
 		updateQuery = updateQuery.Set("end_date = ?", *req.EndDate)
 	}
 
+	if req.DepartedContentPolicy != nil {
+		updateQuery = updateQuery.Set("departed_content_policy = ?", *req.DepartedContentPolicy)
+	}
+
 	result, err := updateQuery.Exec(ctx)
 	if err != nil {
 		return nil, err
@@ -210,6 +233,12 @@ func (r *tripRepository) Update(ctx context.Context, id uuid.UUID, req *models.U
 		return nil, err
 	}
 
+	if req.PitchDeadline != nil {
+		if err := relockRankPoll(ctx, r.db, updatedTrip); err != nil {
+			return nil, err
+		}
+	}
+
 	return updatedTrip, nil
 }
 
@@ -217,7 +246,8 @@ func (r *tripRepository) Update(ctx context.Context, id uuid.UUID, req *models.U
 func (r *tripRepository) UpdateTx(ctx context.Context, tx bun.Tx, id uuid.UUID, req *models.UpdateTripRequest) (*models.Trip, error) {
 	updateQuery := tx.NewUpdate().
 		Model(&models.Trip{}).
-		Where("id = ?", id)
+		Where("id = ?", id).
+		Where("deleted_at IS NULL")
 
 	if req.Name != nil {
 		updateQuery = updateQuery.Set("name = ?", *req.Name)
@@ -235,7 +265,8 @@ func (r *tripRepository) UpdateTx(ctx context.Context, tx bun.Tx, id uuid.UUID,
 		updateQuery = updateQuery.Set("cover_image = ?", *req.CoverImageID)
 	}
 	if req.PitchDeadline != nil {
-		updateQuery = updateQuery.Set("pitch_deadline = ?", *req.PitchDeadline)
+		// A new deadline reopens pitching until the close workflow runs again.
+		updateQuery = updateQuery.Set("pitch_deadline = ?", *req.PitchDeadline).Set("pitching_closed_at = NULL")
 	}
 	if req.StartDate != nil {
 		updateQuery = updateQuery.Set("start_date = ?", *req.StartDate)
@@ -246,6 +277,9 @@ func (r *tripRepository) UpdateTx(ctx context.Context, tx bun.Tx, id uuid.UUID,
 	if req.Location != nil {
 		updateQuery = updateQuery.Set("location = ?", *req.Location)
 	}
+	if req.DepartedContentPolicy != nil {
+		updateQuery = updateQuery.Set("departed_content_policy = ?", *req.DepartedContentPolicy)
+	}
 
 	result, err := updateQuery.Exec(ctx)
 	if err != nil {
@@ -264,9 +298,28 @@ func (r *tripRepository) UpdateTx(ctx context.Context, tx bun.Tx, id uuid.UUID,
 	if err := tx.NewSelect().Model(updatedTrip).Where("id = ?", id).Scan(ctx); err != nil {
 		return nil, err
 	}
+	if req.PitchDeadline != nil {
+		if err := relockRankPoll(ctx, tx, updatedTrip); err != nil {
+			return nil, err
+		}
+	}
 	return updatedTrip, nil
 }
 
+// relockRankPoll closes the trip's rank poll to votes again after its pitch deadline
+// moved, so the lock matches the reopened pitching until the close job runs again.
+func relockRankPoll(ctx context.Context, db bun.IDB, trip *models.Trip) error {
+	if trip.RankPollID == nil {
+		return nil
+	}
+	_, err := db.NewUpdate().
+		TableExpr("polls").
+		Set("voting_locked = true").
+		Where("id = ?", *trip.RankPollID).
+		Exec(ctx)
+	return err
+}
+
 // SetRankPollID sets the rank_poll_id on a trip.
 func (r *tripRepository) SetRankPollID(ctx context.Context, tripID, pollID uuid.UUID) error {
 	_, err := r.db.NewUpdate().
@@ -287,6 +340,196 @@ func (r *tripRepository) SetRankPollIDTx(ctx context.Context, tx bun.Tx, tripID,
 	return err
 }
 
+// ClosePitching marks the trip's pitching as closed and unlocks voting on its rank poll.
+// It returns false without changes if pitching was already closed.
+func (r *tripRepository) ClosePitching(ctx context.Context, tripID uuid.UUID, closedAt time.Time) (bool, error) {
+	closed := false
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var rankPollID *uuid.UUID
+		err := tx.NewUpdate().
+			TableExpr("trips").
+			Set("pitching_closed_at = ?", closedAt).
+			Where("id = ?", tripID).
+			Where("pitching_closed_at IS NULL").
+			Returning("rank_poll_id").
+			Scan(ctx, &rankPollID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		closed = true
+
+		if rankPollID == nil {
+			return nil
+		}
+		_, err = tx.NewUpdate().
+			TableExpr("polls").
+			Set("voting_locked = false").
+			Where("id = ?", *rankPollID).
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	return closed, nil
+}
+
+// SetArchived archives the trip at archivedAt, or unarchives it when archivedAt is nil.
+func (r *tripRepository) SetArchived(ctx context.Context, id uuid.UUID, archivedAt *time.Time) (*models.Trip, error) {
+	trip := &models.Trip{}
+	err := r.db.NewUpdate().
+		Model(trip).
+		Set("archived_at = ?", archivedAt).
+		Where("id = ?", id).
+		Where("deleted_at IS NULL").
+		Returning("*").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return trip, nil
+}
+
+// SoftDelete hides the trip everywhere until it is restored or purged.
+func (r *tripRepository) SoftDelete(ctx context.Context, id uuid.UUID, deletedAt time.Time) error {
+	result, err := r.db.NewUpdate().
+		Model((*models.Trip)(nil)).
+		Set("deleted_at = ?", deletedAt).
+		Where("id = ?", id).
+		Where("deleted_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// Restore undeletes a trip deleted after deletedAfter. Trips deleted earlier are
+// awaiting purge and are not found.
+func (r *tripRepository) Restore(ctx context.Context, id uuid.UUID, deletedAfter time.Time) (*models.Trip, error) {
+	trip := &models.Trip{}
+	err := r.db.NewUpdate().
+		Model(trip).
+		Set("deleted_at = NULL").
+		Where("id = ?", id).
+		Where("deleted_at > ?", deletedAfter).
+		Returning("*").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return trip, nil
+}
+
+// FindDeleted retrieves a deleted trip by ID, or ErrNotFound if it is not deleted.
+func (r *tripRepository) FindDeleted(ctx context.Context, id uuid.UUID) (*models.Trip, error) {
+	trip := &models.Trip{}
+	err := r.db.NewSelect().
+		Model(trip).
+		Where("id = ?", id).
+		Where("deleted_at IS NOT NULL").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return trip, nil
+}
+
+// FindDeletedByUserID retrieves the trips a user belongs to that were deleted after
+// deletedAfter, most recently deleted first.
+func (r *tripRepository) FindDeletedByUserID(ctx context.Context, userID uuid.UUID, deletedAfter time.Time) ([]*models.Trip, error) {
+	var trips []*models.Trip
+	err := r.db.NewSelect().
+		Model(&trips).
+		Join("JOIN memberships AS m ON m.trip_id = trip.id").
+		Where("m.user_id = ?", userID).
+		Where("trip.deleted_at > ?", deletedAfter).
+		OrderExpr("trip.deleted_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return trips, nil
+}
+
+// FindMediaKeys returns the S3 keys of every file tied to the trip: all sizes of its
+// cover, pitch and activity images, and pitch audio.
+func (r *tripRepository) FindMediaKeys(ctx context.Context, id uuid.UUID) ([]string, error) {
+	var keys []string
+	err := r.db.NewRaw(`
+		SELECT img.file_key FROM images AS img
+		WHERE img.image_id IN (
+			SELECT t.cover_image FROM trips AS t WHERE t.id = ? AND t.cover_image IS NOT NULL
+			UNION
+			SELECT pi.image_id FROM pitch_images AS pi JOIN trip_pitches AS p ON p.id = pi.pitch_id WHERE p.trip_id = ?
+			UNION
+			SELECT ai.image_id FROM activity_images AS ai JOIN activities AS a ON a.id = ai.activity_id WHERE a.trip_id = ?
+		)
+		UNION
+		SELECT p.audio_s3_key FROM trip_pitches AS p WHERE p.trip_id = ? AND p.audio_s3_key <> ''
+	`, id, id, id, id).Scan(ctx, &keys)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Purge permanently removes a deleted trip along with its pitch and activity image
+// records; everything else cascades. Trips that are not deleted are left alone.
+func (r *tripRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var trip models.Trip
+		err := tx.NewSelect().
+			Model(&trip).
+			Where("id = ?", id).
+			Where("deleted_at IS NOT NULL").
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			TableExpr("images").
+			Where("image_id IN (SELECT pi.image_id FROM pitch_images AS pi JOIN trip_pitches AS p ON p.id = pi.pitch_id WHERE p.trip_id = ?)", id).
+			WhereOr("image_id IN (SELECT ai.image_id FROM activity_images AS ai JOIN activities AS a ON a.id = ai.activity_id WHERE a.trip_id = ?)", id).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		// The cover image's records are removed by the trips delete trigger.
+		_, err = tx.NewDelete().
+			Model((*models.Trip)(nil)).
+			Where("id = ?", id).
+			Exec(ctx)
+		return err
+	})
+}
+
 // Delete removes a trip (idempotent)
 func (r *tripRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	_, err := r.db.NewDelete().
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type UserBlockRepository interface {
+	Block(ctx context.Context, blockerID, blockedID uuid.UUID) error
+	Unblock(ctx context.Context, blockerID, blockedID uuid.UUID) error
+	IsBlocked(ctx context.Context, blockerID, blockedID uuid.UUID) (bool, error)
+	FindBlockedUsers(ctx context.Context, blockerID uuid.UUID) ([]*models.ContactUser, error)
+}
+
+var _ UserBlockRepository = (*userBlockRepository)(nil)
+
+type userBlockRepository struct {
+	db *bun.DB
+}
+
+func NewUserBlockRepository(db *bun.DB) UserBlockRepository {
+	return &userBlockRepository{db: db}
+}
+
+// Block is idempotent; blocking someone already blocked is not an error.
+func (r *userBlockRepository) Block(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	_, err := r.db.NewInsert().
+		Model(&models.UserBlock{BlockerID: blockerID, BlockedID: blockedID}).
+		On("CONFLICT (blocker_id, blocked_id) DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
+func (r *userBlockRepository) Unblock(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	result, err := r.db.NewDelete().
+		Model((*models.UserBlock)(nil)).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+func (r *userBlockRepository) IsBlocked(ctx context.Context, blockerID, blockedID uuid.UUID) (bool, error) {
+	return r.db.NewSelect().
+		Model((*models.UserBlock)(nil)).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Exists(ctx)
+}
+
+// FindBlockedUsers returns the public profiles of everyone the user has blocked, most
+// recently blocked first.
+func (r *userBlockRepository) FindBlockedUsers(ctx context.Context, blockerID uuid.UUID) ([]*models.ContactUser, error) {
+	users := []*models.ContactUser{}
+	err := r.db.NewSelect().
+		TableExpr("user_blocks AS ub").
+		ColumnExpr("u.id, u.name, u.username, u.profile_picture").
+		Join("JOIN users AS u ON u.id = ub.blocked_id").
+		Where("ub.blocker_id = ?", blockerID).
+		OrderExpr("ub.created_at DESC").
+		Scan(ctx, &users)
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// notBlockedBy filters out rows authored by users the viewer has blocked. userColumn is
+// the qualified author column, e.g. "c.user_id".
+func notBlockedBy(userColumn string) string {
+	return "NOT EXISTS (SELECT 1 FROM user_blocks AS ub WHERE ub.blocker_id = ? AND ub.blocked_id = " + userColumn + ")"
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type UserDeviceRepository interface {
+	Upsert(ctx context.Context, device *models.UserDevice) (*models.UserDevice, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.UserDevice, error)
+	FindByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.UserDevice, error)
+	Delete(ctx context.Context, userID uuid.UUID, token string) error
+	DeleteByTokens(ctx context.Context, tokens []string) (int, error)
+}
+
+var _ UserDeviceRepository = (*userDeviceRepository)(nil)
+
+type userDeviceRepository struct {
+	db *bun.DB
+}
+
+func NewUserDeviceRepository(db *bun.DB) UserDeviceRepository {
+	return &userDeviceRepository{db: db}
+}
+
+// Upsert registers a token for the user. Tokens are unique per device, so a token
+// still registered to another account (e.g. after a sign-out and sign-in as someone
+// else on the same phone) is first revoked from that account; the conflict update
+// below only ever refreshes the caller's own row.
+func (r *userDeviceRepository) Upsert(ctx context.Context, device *models.UserDevice) (*models.UserDevice, error) {
+	now := time.Now().UTC()
+	device.LastSeenAt = now
+	device.UpdatedAt = now
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Model((*models.UserDevice)(nil)).
+			Where("token = ?", device.Token).
+			Where("user_id <> ?", device.UserID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewInsert().
+			Model(device).
+			On("CONFLICT (token) DO UPDATE").
+			Set("platform = EXCLUDED.platform").
+			Set("app_version = EXCLUDED.app_version").
+			Set("last_seen_at = EXCLUDED.last_seen_at").
+			Set("updated_at = EXCLUDED.updated_at").
+			Where("?TableAlias.user_id = EXCLUDED.user_id").
+			Returning("*").
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+func (r *userDeviceRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.UserDevice, error) {
+	var devices []*models.UserDevice
+	err := r.db.NewSelect().
+		Model(&devices).
+		Where("user_id = ?", userID).
+		OrderExpr("last_seen_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+func (r *userDeviceRepository) FindByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.UserDevice, error) {
+	if len(userIDs) == 0 {
+		return []*models.UserDevice{}, nil
+	}
+
+	var devices []*models.UserDevice
+	err := r.db.NewSelect().
+		Model(&devices).
+		Where("user_id IN (?)", bun.In(userIDs)).
+		OrderExpr("user_id, last_seen_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+// Delete unregisters one of the user's devices. Unknown tokens are ignored.
+func (r *userDeviceRepository) Delete(ctx context.Context, userID uuid.UUID, token string) error {
+	_, err := r.db.NewDelete().
+		Model((*models.UserDevice)(nil)).
+		Where("user_id = ?", userID).
+		Where("token = ?", token).
+		Exec(ctx)
+	return err
+}
+
+// DeleteByTokens removes tokens regardless of owner and returns how many were deleted.
+// Used to prune tokens Expo reports as no longer registered.
+func (r *userDeviceRepository) DeleteByTokens(ctx context.Context, tokens []string) (int, error) {
+	if len(tokens) == 0 {
+		return 0, nil
+	}
+
+	result, err := r.db.NewDelete().
+		Model((*models.UserDevice)(nil)).
+		Where("token IN (?)", bun.In(tokens)).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(deleted), nil
+}
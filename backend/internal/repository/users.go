@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 	"toggo/internal/errs"
 	"toggo/internal/models"
 
@@ -16,9 +17,16 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, user *models.User) (*models.User, error)
 	Find(ctx context.Context, id uuid.UUID) (*models.User, error)
+	FindByEmail(ctx context.Context, email string) (*models.User, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.User, error)
 	Update(ctx context.Context, id uuid.UUID, user *models.UpdateUserRequest) (*models.User, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetUsersWithDeviceTokens(ctx context.Context, userIDs []uuid.UUID) ([]*models.User, error)
+	FindByUsername(ctx context.Context, username string) (*models.User, error)
+	FindByPhoneNumber(ctx context.Context, phoneNumber string) (*models.User, error)
+	FindDiscoverableByPhoneHashes(ctx context.Context, hashes []string, excludeUserID uuid.UUID) ([]*models.User, error)
+	SetEmailVerificationCode(ctx context.Context, id uuid.UUID, codeHash string, expiresAt time.Time) error
+	VerifyEmail(ctx context.Context, id uuid.UUID, codeHash string, now time.Time) (*models.User, error)
 }
 
 var _ UserRepository = (*userRepository)(nil)
@@ -57,6 +65,41 @@ func (r *userRepository) Find(ctx context.Context, id uuid.UUID) (*models.User,
 	return u, nil
 }
 
+// FindByEmail matches the email case-insensitively. Only verified addresses match, so
+// nobody can be identified by an email they merely typed into their profile.
+func (r *userRepository) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	u := &models.User{}
+	err := r.db.NewSelect().
+		Model(u).
+		Where("LOWER(email) = LOWER(?)", email).
+		Where("email_verified_at IS NOT NULL").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+// FindByIDs returns the users with the given IDs. Unknown IDs are omitted.
+func (r *userRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.User, error) {
+	if len(ids) == 0 {
+		return []*models.User{}, nil
+	}
+
+	var users []*models.User
+	err := r.db.NewSelect().
+		Model(&users).
+		Where("id IN (?)", bun.In(ids)).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 func (r *userRepository) Update(ctx context.Context, id uuid.UUID, req *models.UpdateUserRequest) (*models.User, error) {
 	updateQuery := r.db.NewUpdate().
 		Model(&models.User{}).
@@ -79,11 +122,27 @@ func (r *userRepository) Update(ctx context.Context, id uuid.UUID, req *models.U
 		updateQuery = updateQuery.Set("phone_number = ?", *req.PhoneNumber)
 	}
 
+	if req.Email != nil {
+		updates["email"] = *req.Email
+		// A new address has to be verified again; re-saving the current one keeps its
+		// verification. SET expressions see the row as it was before the update.
+		updateQuery = updateQuery.
+			Set("email_verified_at = CASE WHEN LOWER(email) IS NOT DISTINCT FROM LOWER(NULLIF(?, '')) THEN email_verified_at END", *req.Email).
+			Set("email_verification_code_hash = CASE WHEN LOWER(email) IS NOT DISTINCT FROM LOWER(NULLIF(?, '')) THEN email_verification_code_hash END", *req.Email).
+			Set("email_verification_expires_at = CASE WHEN LOWER(email) IS NOT DISTINCT FROM LOWER(NULLIF(?, '')) THEN email_verification_expires_at END", *req.Email).
+			Set("email = NULLIF(?, '')", *req.Email)
+	}
+
 	if req.Timezone != nil {
 		updates["timezone"] = *req.Timezone
 		updateQuery = updateQuery.Set("timezone = ?", *req.Timezone)
 	}
 
+	if req.Locale != nil {
+		updates["locale"] = *req.Locale
+		updateQuery = updateQuery.Set("locale = ?", *req.Locale)
+	}
+
 	if req.AppleMaps != nil {
 		updates["apple_maps_enabled"] = *req.AppleMaps
 		updateQuery = updateQuery.Set("apple_maps_enabled = ?", *req.AppleMaps)
@@ -99,6 +158,11 @@ func (r *userRepository) Update(ctx context.Context, id uuid.UUID, req *models.U
 		updateQuery = updateQuery.Set("profile_picture = ?", *req.ProfilePicture)
 	}
 
+	if req.PhoneDiscoveryDisabled != nil {
+		updates["phone_discovery_disabled"] = *req.PhoneDiscoveryDisabled
+		updateQuery = updateQuery.Set("phone_discovery_disabled = ?", *req.PhoneDiscoveryDisabled)
+	}
+
 	if req.DeviceToken != nil {
 		trimmedToken := strings.TrimSpace(*req.DeviceToken)
 		updateQuery = updateQuery.Set("device_token = ?", trimmedToken)
@@ -132,6 +196,52 @@ func (r *userRepository) Update(ctx context.Context, id uuid.UUID, req *models.U
 	return updatedUser, nil
 }
 
+// SetEmailVerificationCode stores the hash of a newly sent verification code, replacing
+// any earlier one.
+func (r *userRepository) SetEmailVerificationCode(ctx context.Context, id uuid.UUID, codeHash string, expiresAt time.Time) error {
+	result, err := r.db.NewUpdate().
+		Model((*models.User)(nil)).
+		Set("email_verification_code_hash = ?", codeHash).
+		Set("email_verification_expires_at = ?", expiresAt).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// VerifyEmail marks the user's email as verified when codeHash matches an unexpired
+// code, which is then used up. It returns errs.ErrNotFound when the code does not match.
+func (r *userRepository) VerifyEmail(ctx context.Context, id uuid.UUID, codeHash string, now time.Time) (*models.User, error) {
+	user := &models.User{}
+	err := r.db.NewUpdate().
+		Model(user).
+		Set("email_verified_at = ?", now).
+		Set("email_verification_code_hash = NULL").
+		Set("email_verification_expires_at = NULL").
+		Where("id = ?", id).
+		Where("email IS NOT NULL").
+		Where("email_verification_code_hash = ?", codeHash).
+		Where("email_verification_expires_at > ?", now).
+		Returning("*").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
 func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	_, err := r.db.NewDelete().
 		Model((*models.User)(nil)).
@@ -141,14 +251,14 @@ func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+// GetUsersWithDeviceTokens returns the given users that have at least one registered device.
 func (r *userRepository) GetUsersWithDeviceTokens(ctx context.Context, userIDs []uuid.UUID) ([]*models.User, error) {
 	var users []*models.User
 
 	err := r.db.NewSelect().
 		Model(&users).
 		Where("id IN (?)", bun.In(userIDs)).
-		Where("device_token IS NOT NULL").
-		Where("device_token != ''").
+		Where("EXISTS (SELECT 1 FROM user_devices AS ud WHERE ud.user_id = ?TableAlias.id)").
 		Scan(ctx)
 
 	if err != nil {
@@ -157,3 +267,51 @@ func (r *userRepository) GetUsersWithDeviceTokens(ctx context.Context, userIDs [
 
 	return users, nil
 }
+
+// FindByUsername looks up a user by their normalised, lowercase username.
+func (r *userRepository) FindByUsername(ctx context.Context, username string) (*models.User, error) {
+	return r.findWhere(ctx, "username = ?", username)
+}
+
+// FindByPhoneNumber looks up a user by their E.164 phone number.
+func (r *userRepository) FindByPhoneNumber(ctx context.Context, phoneNumber string) (*models.User, error) {
+	return r.findWhere(ctx, "phone_number = ?", phoneNumber)
+}
+
+func (r *userRepository) findWhere(ctx context.Context, query string, arg any) (*models.User, error) {
+	user := &models.User{}
+	err := r.db.NewSelect().
+		Model(user).
+		Where(query, arg).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// FindDiscoverableByPhoneHashes returns users whose phone number hash is in hashes and
+// who have not opted out of contact discovery, leaving out excludeUserID and anyone who
+// has blocked them.
+func (r *userRepository) FindDiscoverableByPhoneHashes(ctx context.Context, hashes []string, excludeUserID uuid.UUID) ([]*models.User, error) {
+	var users []*models.User
+	if len(hashes) == 0 {
+		return users, nil
+	}
+
+	err := r.db.NewSelect().
+		Model(&users).
+		ColumnExpr("?TableAlias.*").
+		Where("?TableAlias.phone_number_hash IN (?)", bun.In(hashes)).
+		Where("NOT ?TableAlias.phone_discovery_disabled").
+		Where("?TableAlias.id <> ?", excludeUserID).
+		Where("NOT EXISTS (SELECT 1 FROM user_blocks AS ub WHERE ub.blocker_id = ?TableAlias.id AND ub.blocked_id = ?)", excludeUserID).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
@@ -12,13 +12,14 @@ import (
 	"toggo/internal/types"
 	"toggo/internal/validators"
 	"toggo/internal/workflows/notifications"
+	"toggo/internal/workflows/trips"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/uptrace/bun"
 	"go.temporal.io/sdk/client"
 )
 
-func CreateApp(config *config.Configuration, db *bun.DB, publisher realtime.EventPublisher, wsHandler *realtime.WSHandler, activityFeedService services.ActivityFeedServiceInterface, temporalClient client.Client) *fiber.App {
+func CreateApp(config *config.Configuration, db *bun.DB, publisher realtime.EventPublisher, wsHandler *realtime.WSHandler, sseHandler *realtime.SSEHandler, activityFeedService services.ActivityFeedServiceInterface, temporalClient client.Client) *fiber.App {
 	app := fiber.New(fiber.Config{
 		ServerHeader: config.App.Name,
 		AppName:      fmt.Sprintf("%s API %s", config.App.Name, config.App.Version),
@@ -32,6 +33,11 @@ func CreateApp(config *config.Configuration, db *bun.DB, publisher realtime.Even
 		app.Get("/ws", wsHandler.Handler())
 	}
 
+	if sseHandler != nil {
+		app.Get("/sse", sseHandler.Handler())
+		app.Post("/sse/:clientID", sseHandler.MessageHandler())
+	}
+
 	repository := repository.NewRepository(db)
 
 	validator := validators.NewValidator()
@@ -46,15 +52,46 @@ func CreateApp(config *config.Configuration, db *bun.DB, publisher realtime.Even
 	})
 
 	var scheduler *notifications.PollScheduler
+	var pitchScheduler services.PitchDeadlineScheduler
+	var reminderScheduler services.TripReminderScheduler
+	var receiptScheduler services.ReceiptScheduler
+	var digestScheduler services.DigestScheduler
+	var purgeScheduler services.TripPurgeScheduler
+	var taskScheduler services.TaskReminderScheduler
 	if temporalClient != nil {
 		scheduler = notifications.NewPollScheduler(temporalClient)
+		pitchScheduler = notifications.NewPitchDeadlineScheduler(temporalClient)
+		reminderScheduler = notifications.NewTripReminderScheduler(temporalClient)
+		receiptScheduler = notifications.NewExpoReceiptScheduler(temporalClient)
+		digestScheduler = notifications.NewDigestScheduler(temporalClient)
+		purgeScheduler = trips.NewPurgeScheduler(temporalClient)
+		taskScheduler = notifications.NewTaskReminderScheduler(temporalClient)
 	}
 
-	notificationService := services.NewNotificationService(
-		repository.User,
-		repository.Membership,
-		services.NewExpoClient(""),
-	)
+	preferenceResolver := services.NewNotificationPreferenceResolver(repository.Membership, repository.NotificationPreferences)
+	notificationService := services.NewNotificationService(services.NotificationServiceConfig{
+		DeviceRepo:       repository.UserDevice,
+		DeliveryRepo:     repository.NotificationDelivery,
+		Preferences:      preferenceResolver,
+		ExpoClient:       services.NewExpoClient(""),
+		ReceiptScheduler: receiptScheduler,
+		UserRepo:         repository.User,
+		PreferencesRepo:  repository.NotificationPreferences,
+		DigestRepo:       repository.NotificationDigest,
+		DigestScheduler:  digestScheduler,
+		Channels:         services.NewNotificationChannels(config.NotificationChannels, config.Environment),
+	})
+
+	slackTrips := services.NewSlackTripService(services.SlackTripServiceConfig{
+		ChannelRepo:     repository.TripSlackChannel,
+		MembershipRepo:  repository.Membership,
+		TripRepo:        repository.Trip,
+		UserRepo:        repository.User,
+		PollRepo:        repository.Poll,
+		PollVotingRepo:  repository.PollVoting,
+		PollRankingRepo: repository.PollRanking,
+		Notifier:        services.NewSlackTripNotifier(config.Slack),
+	})
 
 	routeParams := types.RouteParams{
 		Validator: validator,
@@ -64,8 +101,15 @@ func CreateApp(config *config.Configuration, db *bun.DB, publisher realtime.Even
 			EventPublisher:      publisher,
 			FileService:         fileService,
 			NotificationService: notificationService,
+			PreferenceResolver:  preferenceResolver,
+			EmailSender:         services.NewEmailSender(config.NotificationChannels.SMTP, config.Environment),
 			PollService:         services.NewPollService(repository, publisher, scheduler),
+			PitchScheduler:      pitchScheduler,
+			ReminderScheduler:   reminderScheduler,
+			PurgeScheduler:      purgeScheduler,
+			TaskScheduler:       taskScheduler,
 			ActivityFeedService: activityFeedService,
+			SlackTrips:          slackTrips,
 			HTTPClient:          services.DefaultHTTPClient(),
 			TemporalClient:      temporalClient,
 		},
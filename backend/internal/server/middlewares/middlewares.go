@@ -1,6 +1,7 @@
 package middlewares
 
 import (
+	"strings"
 	"toggo/internal/config"
 
 	"github.com/MarceloPetrucio/go-scalar-api-reference"
@@ -40,6 +41,10 @@ func SetUpMiddlewares(app *fiber.App, config *config.Configuration) {
 	}))
 	app.Use(favicon.New())
 	app.Use(compress.New(compress.Config{
+		// Event streams must be flushed per message, not buffered for compression.
+		Next: func(c *fiber.Ctx) bool {
+			return strings.HasPrefix(c.Path(), "/sse")
+		},
 		Level: compress.LevelBestSpeed,
 	}))
 	app.Use(helmet.New())
@@ -0,0 +1,28 @@
+package middlewares
+
+import (
+	"time"
+	"toggo/internal/errs"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+// UserRateLimit allows each authenticated user max requests per window, falling back to
+// the client IP before authentication. Counts are kept in memory per instance.
+func UserRateLimit(maxRequests int, window time.Duration) fiber.Handler {
+	return limiter.New(limiter.Config{
+		Max:               maxRequests,
+		Expiration:        window,
+		LimiterMiddleware: limiter.SlidingWindow{},
+		KeyGenerator: func(c *fiber.Ctx) string {
+			if userID, ok := c.Locals("userID").(string); ok && userID != "" {
+				return "user:" + userID
+			}
+			return "ip:" + c.IP()
+		},
+		LimitReached: func(c *fiber.Ctx) error {
+			return errs.TooManyRequests()
+		},
+	})
+}
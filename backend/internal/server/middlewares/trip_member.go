@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"toggo/internal/errs"
+	"toggo/internal/models"
 	"toggo/internal/repository"
 	"toggo/internal/validators"
 
@@ -42,3 +43,41 @@ func TripMemberRequired(repo *repository.Repository) fiber.Handler {
 		return c.Next()
 	}
 }
+
+// TripPermissionRequired is TripMemberRequired that also checks the member's role grants
+// the permission. Non-members get 404 like TripMemberRequired; members without the
+// permission, or whose trip is archived, get 403. The role is stored in Locals("tripRole")
+// for handlers.
+func TripPermissionRequired(repo *repository.Repository, permission models.TripPermission) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userIDStr, ok := c.Locals("userID").(string)
+		if !ok {
+			return errs.Unauthorized()
+		}
+
+		userID, err := uuid.Parse(userIDStr)
+		if err != nil {
+			return errs.Unauthorized()
+		}
+
+		tripID, err := validators.ValidateID(c.Params("tripID"))
+		if err != nil {
+			return errs.InvalidUUID()
+		}
+
+		access, err := repo.Membership.FindAccess(c.Context(), tripID, userID)
+		if err != nil {
+			return err
+		}
+
+		if !access.Role.Can(permission) {
+			return errs.Forbidden()
+		}
+		if !access.Can(permission) {
+			return errs.ForbiddenReason(errs.ErrTripArchived)
+		}
+
+		c.Locals("tripRole", access.Role)
+		return c.Next()
+	}
+}
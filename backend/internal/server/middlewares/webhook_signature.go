@@ -3,7 +3,10 @@ package middlewares
 import (
 	"crypto/hmac"
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/hex"
+	"strconv"
+	"time"
 	"toggo/internal/config"
 
 	"github.com/gofiber/fiber/v2"
@@ -33,3 +36,39 @@ func ExpoWebhookVerify(cfg config.ExpoNotificationConfig) fiber.Handler {
 		return c.Next()
 	}
 }
+
+// slackRequestMaxAge rejects replayed Slack requests, as Slack recommends.
+const slackRequestMaxAge = 5 * time.Minute
+
+// SlackRequestVerify checks Slack's v0 request signature: the hex HMAC-SHA256 of
+// "v0:{timestamp}:{body}" keyed with the app's signing secret.
+func SlackRequestVerify(cfg config.SlackConfig) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if cfg.SigningSecret == "" {
+			return c.Status(fiber.StatusServiceUnavailable).SendString("Slack integration is not configured")
+		}
+
+		timestamp := c.Get("X-Slack-Request-Timestamp")
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil || time.Since(time.Unix(seconds, 0)).Abs() > slackRequestMaxAge {
+			return c.Status(fiber.StatusUnauthorized).SendString("Request timestamp is invalid or too old")
+		}
+
+		body := make([]byte, len(c.Body()))
+		copy(body, c.Body())
+
+		mac := hmac.New(sha256.New, []byte(cfg.SigningSecret))
+		mac.Write([]byte("v0:" + timestamp + ":"))
+		mac.Write(body)
+
+		expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+		if !hmac.Equal([]byte(expected), []byte(c.Get("X-Slack-Signature"))) {
+			return c.Status(fiber.StatusUnauthorized).SendString("Signatures didn't match")
+		}
+
+		c.Request().SetBody(body)
+
+		return c.Next()
+	}
+}
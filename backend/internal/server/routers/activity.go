@@ -2,6 +2,7 @@ package routers
 
 import (
 	"toggo/internal/controllers"
+	"toggo/internal/models"
 	"toggo/internal/server/middlewares"
 	"toggo/internal/services"
 	"toggo/internal/types"
@@ -18,10 +19,12 @@ func ActivityRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.
 	linkParserService := services.NewLinkParserServiceWithClient(routeParams.ServiceParams.HTTPClient)
 	activityController := controllers.NewActivityController(activityService, linkParserService, routeParams.Validator)
 
+	contribute := middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionContribute)
+
 	// /api/v1/trips/:tripID/activities
 	tripActivityGroup := apiGroup.Group("/trips/:tripID/activities")
 	tripActivityGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
-	tripActivityGroup.Post("", activityController.CreateActivity)
+	tripActivityGroup.Post("", contribute, activityController.CreateActivity)
 	tripActivityGroup.Get("", activityController.GetActivitiesByTripID)
 
 	// /api/v1/trips/:tripID/activities/parse-link
@@ -31,21 +34,21 @@ func ActivityRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.
 	// /api/v1/trips/:tripID/activities/:activityID
 	tripActivityIDGroup := tripActivityGroup.Group("/:activityID")
 	tripActivityIDGroup.Get("", activityController.GetActivity)
-	tripActivityIDGroup.Put("", activityController.UpdateActivity)
-	tripActivityIDGroup.Delete("", activityController.DeleteActivity)
+	tripActivityIDGroup.Put("", contribute, activityController.UpdateActivity)
+	tripActivityIDGroup.Delete("", contribute, activityController.DeleteActivity)
 
 	// /api/v1/trips/:tripID/activities/:activityID/categories
 	activityCategoryGroup := tripActivityIDGroup.Group("/categories")
 	activityCategoryGroup.Get("", activityController.GetActivityCategories)
 
 	// /api/v1/trips/:tripID/activities/:activityID/categories/:categoryName
-	activityCategoryGroup.Put("/:categoryName", activityController.AddCategoryToActivity)
-	activityCategoryGroup.Delete("/:categoryName", activityController.RemoveCategoryFromActivity)
+	activityCategoryGroup.Put("/:categoryName", contribute, activityController.AddCategoryToActivity)
+	activityCategoryGroup.Delete("/:categoryName", contribute, activityController.RemoveCategoryFromActivity)
 
 	// /api/v1/trips/:tripID/activities/:activityID/rsvps
 	activityRSVPGroup := tripActivityIDGroup.Group("/rsvps")
 	activityRSVPGroup.Get("", activityController.GetActivityRSVPs)
-	activityRSVPGroup.Post("", activityController.RSVPActivity)
+	activityRSVPGroup.Post("", contribute, activityController.RSVPActivity)
 
 	// /api/v1/trips/:tripID/activities/:activityID/rsvps/:userID
 	activityRSVPGroup.Delete("/:userID", activityController.RemoveActivityRSVP)
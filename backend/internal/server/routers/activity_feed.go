@@ -9,16 +9,17 @@ import (
 )
 
 func ActivityFeedRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
-	feedController := controllers.NewActivityFeedController(routeParams.ServiceParams.ActivityFeedService)
+	feedController := controllers.NewActivityFeedController(routeParams.ServiceParams.ActivityFeedService, routeParams.Validator)
 
 	// /api/v1/trips/:tripID/activity
 	group := apiGroup.Group("/trips/:tripID/activity")
 	group.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
 
-	// Registered before /:eventID to avoid parametric route shadowing.
 	group.Get("/unread-count", feedController.GetUnreadCount)
 	group.Get("", feedController.GetFeed)
-	group.Delete("/:eventID", feedController.MarkRead)
+	group.Post("/read", feedController.MarkAllRead)
+	group.Post("/:itemID/read", feedController.MarkRead)
+	group.Post("/:itemID/unread", feedController.MarkUnread)
 
 	return group
 }
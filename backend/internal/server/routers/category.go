@@ -2,6 +2,7 @@ package routers
 
 import (
 	"toggo/internal/controllers"
+	"toggo/internal/models"
 	"toggo/internal/server/middlewares"
 	"toggo/internal/services"
 	"toggo/internal/types"
@@ -13,20 +14,22 @@ func CategoryRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.
 	categoryService := services.NewCategoryService(routeParams.ServiceParams.Repository, routeParams.ServiceParams.EventPublisher)
 	categoryController := controllers.NewCategoryController(categoryService, routeParams.Validator)
 
+	editTrip := middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionEditTrip)
+
 	// /api/v1/trips/:tripID/categories
 	tripCategoryGroup := apiGroup.Group("/trips/:tripID/categories")
 	tripCategoryGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
 	tripCategoryGroup.Get("", categoryController.GetCategoriesByTripID)
-	tripCategoryGroup.Post("", categoryController.CreateCategory)
-	tripCategoryGroup.Delete("/:name", categoryController.DeleteCategory)
-	tripCategoryGroup.Put("/:name/hide", categoryController.HideCategory)
-	tripCategoryGroup.Put("/:name/show", categoryController.ShowCategory)
+	tripCategoryGroup.Post("", middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionContribute), categoryController.CreateCategory)
+	tripCategoryGroup.Delete("/:name", editTrip, categoryController.DeleteCategory)
+	tripCategoryGroup.Put("/:name/hide", editTrip, categoryController.HideCategory)
+	tripCategoryGroup.Put("/:name/show", editTrip, categoryController.ShowCategory)
 
 	// /api/v1/trips/:tripID/tabs
 	tripTabGroup := apiGroup.Group("/trips/:tripID/tabs")
 	tripTabGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
 	tripTabGroup.Get("", categoryController.GetTabs)
-	tripTabGroup.Put("/reorder", categoryController.ReorderTabs)
+	tripTabGroup.Put("/reorder", editTrip, categoryController.ReorderTabs)
 
 	return tripCategoryGroup
 }
@@ -0,0 +1,18 @@
+package routers
+
+import (
+	"toggo/internal/controllers"
+	"toggo/internal/realtime"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func EventCatalogueRoutes(apiGroup fiber.Router) fiber.Router {
+	catalogueController := controllers.NewEventCatalogueController(realtime.NewEventRegistry())
+
+	// /api/realtime/events
+	group := apiGroup.Group("/realtime/events")
+	group.Get("/catalogue", catalogueController.GetCatalogue)
+
+	return group
+}
@@ -0,0 +1,23 @@
+package routers
+
+import (
+	"toggo/internal/controllers"
+	"toggo/internal/services"
+	"toggo/internal/types"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func InboxRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
+	membershipService := services.NewMembershipService(routeParams.ServiceParams.Repository, routeParams.ServiceParams.FileService, routeParams.ServiceParams.EventPublisher)
+	inboxService := services.NewInboxService(routeParams.ServiceParams.Repository.ActivityFeed, routeParams.ServiceParams.Repository.User, membershipService)
+	inboxController := controllers.NewInboxController(inboxService, routeParams.Validator)
+
+	// /api/v1/users/me/inbox
+	inboxGroup := apiGroup.Group("/users/me/inbox")
+	inboxGroup.Get("", inboxController.GetInbox)
+	inboxGroup.Get("/unread-count", inboxController.GetUnreadCount)
+	inboxGroup.Post("/read", inboxController.MarkAllRead)
+
+	return inboxGroup
+}
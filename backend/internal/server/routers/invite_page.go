@@ -23,7 +23,12 @@ func InvitePageRoutes(app *fiber.App, routeParams types.RouteParams) {
 		routeParams.ServiceParams.FileService,
 		routeParams.ServiceParams.Config.App,
 	)
-	invitePageController := controllers.NewInvitePageController(invitePageService)
+	shareService := services.NewTripShareService(
+		routeParams.ServiceParams.Repository,
+		routeParams.ServiceParams.FileService,
+		routeParams.ServiceParams.Config.App,
+	)
+	invitePageController := controllers.NewInvitePageController(invitePageService, shareService)
 	wellKnownController := controllers.NewWellKnownController(routeParams.ServiceParams.Config.App)
 
 	// GET /invite/:code — universal link landing page (iOS intercepts if app is installed)
@@ -32,6 +37,9 @@ func InvitePageRoutes(app *fiber.App, routeParams types.RouteParams) {
 	// GET /join — legacy invite link, kept for backwards compatibility
 	app.Get("/join", relaxCOEP, invitePageController.JoinPage)
 
+	// GET /share/:token — public, read-only trip view for people without the app
+	app.Get("/share/:token", relaxCOEP, invitePageController.SharePage)
+
 	// GET /.well-known/* — required for iOS universal links validation
 	app.Get("/.well-known/apple-app-site-association", wellKnownController.AppleAppSiteAssociation)
 
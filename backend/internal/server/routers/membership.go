@@ -2,6 +2,7 @@ package routers
 
 import (
 	"toggo/internal/controllers"
+	"toggo/internal/models"
 	"toggo/internal/server/middlewares"
 	"toggo/internal/services"
 	"toggo/internal/types"
@@ -20,12 +21,27 @@ func MembershipRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fibe
 	// /api/v1/trip-invites/:code/join
 	apiGroup.Post("/trip-invites/:code/join", membershipController.JoinTripByInvite)
 
+	memberRequired := middlewares.TripMemberRequired(routeParams.ServiceParams.Repository)
+	manageRoles := middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionManageRoles)
+
+	// /api/v1/trips/:tripID/leave and /api/v1/trips/:tripID/ownership
+	apiGroup.Post("/trips/:tripID/leave", memberRequired, membershipController.LeaveTrip)
+	apiGroup.Post("/trips/:tripID/ownership", memberRequired, membershipController.TransferOwnership)
+
 	// /api/v1/trips/:tripID/memberships
 	tripMembershipGroup := apiGroup.Group("/trips/:tripID/memberships")
-	tripMembershipGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
+	tripMembershipGroup.Use(memberRequired)
 	tripMembershipGroup.Get("", membershipController.GetTripMembers)
-	tripMembershipGroup.Post("/:userID/promote", membershipController.PromoteToAdmin)
+	tripMembershipGroup.Post("/:userID/promote", manageRoles, membershipController.PromoteToAdmin)
 	tripMembershipGroup.Post("/:userID/demote", membershipController.DemoteFromAdmin)
+	tripMembershipGroup.Put("/:userID/role", membershipController.UpdateMemberRole)
+
+	// /api/v1/trips/:tripID/join-requests
+	joinRequestGroup := apiGroup.Group("/trips/:tripID/join-requests")
+	joinRequestGroup.Use(middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionManageInvites))
+	joinRequestGroup.Get("", membershipController.ListJoinRequests)
+	joinRequestGroup.Post("/:requestID/approve", membershipController.ApproveJoinRequest)
+	joinRequestGroup.Post("/:requestID/reject", membershipController.RejectJoinRequest)
 
 	// /api/v1/trips/:tripID/memberships/:userID
 	tripMembershipIDGroup := tripMembershipGroup.Group("/:userID")
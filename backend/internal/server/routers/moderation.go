@@ -0,0 +1,38 @@
+package routers
+
+import (
+	"time"
+	"toggo/internal/controllers"
+	"toggo/internal/models"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+	"toggo/internal/types"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ModerationRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
+	moderationService := services.NewModerationService(routeParams.ServiceParams.Repository, routeParams.ServiceParams.EventPublisher)
+	moderationController := controllers.NewModerationController(moderationService, routeParams.Validator)
+
+	// /api/v1/users/me/blocks
+	blockGroup := apiGroup.Group("/users/me/blocks")
+	blockGroup.Get("", moderationController.ListBlockedUsers)
+	blockGroup.Post("", moderationController.BlockUser)
+	blockGroup.Delete("/:userID", moderationController.UnblockUser)
+
+	// /api/v1/trips/:tripID/reports
+	reportGroup := apiGroup.Group("/trips/:tripID/reports")
+	reportGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
+	reportGroup.Post("", middlewares.UserRateLimit(30, time.Hour), moderationController.ReportContent)
+
+	// /api/v1/trips/:tripID/moderation
+	moderationGroup := apiGroup.Group("/trips/:tripID/moderation")
+	moderationGroup.Use(middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionModerateContent))
+	moderationGroup.Get("/reports", moderationController.ListReports)
+	moderationGroup.Post("/reports/:reportID/hide", moderationController.HideReportedContent)
+	moderationGroup.Post("/reports/:reportID/dismiss", moderationController.DismissReport)
+	moderationGroup.Delete("/hidden/:entityType/:entityID", moderationController.UnhideContent)
+
+	return moderationGroup
+}
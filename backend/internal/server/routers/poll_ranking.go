@@ -2,6 +2,7 @@ package routers
 
 import (
 	"toggo/internal/controllers"
+	"toggo/internal/models"
 	"toggo/internal/server/middlewares"
 	"toggo/internal/services"
 	"toggo/internal/types"
@@ -17,23 +18,25 @@ func RankPollRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.
 	)
 	rankPollController := controllers.NewRankPollController(rankPollService, routeParams.Validator)
 
+	contribute := middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionContribute)
+
 	// /api/v1/trips/:tripID/rank-polls
 	rankPollGroup := apiGroup.Group("/trips/:tripID/rank-polls")
 	rankPollGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
-	rankPollGroup.Post("", rankPollController.CreateRankPoll)
+	rankPollGroup.Post("", middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionCreatePolls), rankPollController.CreateRankPoll)
 
 	// /api/v1/trips/:tripID/rank-polls/:pollId
 	pollIDGroup := rankPollGroup.Group("/:pollId")
 	pollIDGroup.Get("", rankPollController.GetRankPollResults)
-	pollIDGroup.Patch("", rankPollController.UpdateRankPoll)
-	pollIDGroup.Delete("", rankPollController.DeleteRankPoll)
+	pollIDGroup.Patch("", contribute, rankPollController.UpdateRankPoll)
+	pollIDGroup.Delete("", contribute, rankPollController.DeleteRankPoll)
 
 	// /api/v1/trips/:tripID/rank-polls/:pollId/options
-	pollIDGroup.Post("/options", rankPollController.AddOption)
-	pollIDGroup.Delete("/options/:optionId", rankPollController.DeleteOption)
+	pollIDGroup.Post("/options", contribute, rankPollController.AddOption)
+	pollIDGroup.Delete("/options/:optionId", contribute, rankPollController.DeleteOption)
 
 	// /api/v1/trips/:tripID/rank-polls/:pollId/rank
-	pollIDGroup.Post("/rank", rankPollController.SubmitRanking)
+	pollIDGroup.Post("/rank", contribute, rankPollController.SubmitRanking)
 
 	// /api/v1/trips/:tripID/rank-polls/:pollId/voters
 	pollIDGroup.Get("/voters", rankPollController.GetPollVoters)
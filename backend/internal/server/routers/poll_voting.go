@@ -2,6 +2,7 @@ package routers
 
 import (
 	"toggo/internal/controllers"
+	"toggo/internal/models"
 	"toggo/internal/server/middlewares"
 	"toggo/internal/services"
 	"toggo/internal/types"
@@ -14,6 +15,7 @@ func VotePollRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.
 		routeParams.ServiceParams.Repository,
 		routeParams.ServiceParams.PollService,
 		routeParams.ServiceParams.NotificationService,
+		routeParams.ServiceParams.SlackTrips,
 	)
 
 	votePollController := controllers.NewVotePollController(
@@ -21,26 +23,28 @@ func VotePollRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.
 		routeParams.Validator,
 	)
 
+	contribute := middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionContribute)
+
 	// /api/v1/trips/:tripID/vote-polls
 	votePollGroup := apiGroup.Group("/trips/:tripID/vote-polls")
 	votePollGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
 
-	votePollGroup.Post("", votePollController.CreatePoll)
+	votePollGroup.Post("", middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionCreatePolls), votePollController.CreatePoll)
 	votePollGroup.Get("", votePollController.GetPollsByTripID)
 
 	// /api/v1/trips/:tripID/vote-polls/:pollId
 	pollIDGroup := votePollGroup.Group("/:pollId")
 
 	pollIDGroup.Get("", votePollController.GetPoll)
-	pollIDGroup.Patch("", votePollController.UpdatePoll)
-	pollIDGroup.Delete("", votePollController.DeletePoll)
+	pollIDGroup.Patch("", contribute, votePollController.UpdatePoll)
+	pollIDGroup.Delete("", contribute, votePollController.DeletePoll)
 
 	// /api/v1/trips/:tripID/vote-polls/:pollId/options
-	pollIDGroup.Post("/options", votePollController.AddOption)
-	pollIDGroup.Delete("/options/:optionId", votePollController.DeleteOption)
+	pollIDGroup.Post("/options", contribute, votePollController.AddOption)
+	pollIDGroup.Delete("/options/:optionId", contribute, votePollController.DeleteOption)
 
 	// /api/v1/trips/:tripID/vote-polls/:pollId/vote
-	pollIDGroup.Post("/vote", votePollController.CastVote)
+	pollIDGroup.Post("/vote", contribute, votePollController.CastVote)
 
 	// /api/v1/trips/:tripID/vote-polls/:pollId/voters
 	pollIDGroup.Get("/voters", votePollController.GetPollVoters)
@@ -13,6 +13,9 @@ func SetUpRoutes(app *fiber.App, routeParams types.RouteParams, middlewares ...f
 	// Expo Build & Submit webhooks
 	ExpoWebhooks(app, routeParams)
 
+	// Slack interaction callbacks
+	SlackInteractionRoutes(app, routeParams)
+
 	// Public invite page (no auth required)
 	InvitePageRoutes(app, routeParams)
 
@@ -22,12 +25,16 @@ func SetUpRoutes(app *fiber.App, routeParams types.RouteParams, middlewares ...f
 	// Test routes without auth for realtime testing
 	TestRoutes(apiGroup, routeParams)
 
+	// Public realtime event schema catalogue
+	EventCatalogueRoutes(apiGroup)
+
 	apiV1Group := apiGroup.Group("/v1", middlewares...)
 	UserRoutes(apiV1Group, routeParams)
 	TripRoutes(apiV1Group, routeParams)
 	MembershipRoutes(apiV1Group, routeParams)
 	NotificationRoutes(apiV1Group, routeParams)
 	NotificationPreferencesRoutes(apiV1Group, routeParams)
+	UserDeviceRoutes(apiV1Group, routeParams)
 	FileRoutes(apiV1Group, routeParams)
 	CommentRoutes(apiV1Group, routeParams)
 	ActivityRoutes(apiV1Group, routeParams)
@@ -36,6 +43,14 @@ func SetUpRoutes(app *fiber.App, routeParams types.RouteParams, middlewares ...f
 	RankPollRoutes(apiV1Group, routeParams)
 	SearchRoutes(apiV1Group, routeParams)
 	ActivityFeedRoutes(apiV1Group, routeParams)
+	InboxRoutes(apiV1Group, routeParams)
+	TripWebhookRoutes(apiV1Group, routeParams)
+	TripSlackRoutes(apiV1Group, routeParams)
+	TripShareRoutes(apiV1Group, routeParams)
+	TripTemplateRoutes(apiV1Group, routeParams)
+	TripDirectInviteRoutes(apiV1Group, routeParams)
+	ModerationRoutes(apiV1Group, routeParams)
+	TripTaskRoutes(apiV1Group, routeParams)
 
 	// 404 handler for routes not matched
 	setUpNotFoundHandler(app)
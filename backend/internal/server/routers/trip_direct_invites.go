@@ -0,0 +1,38 @@
+package routers
+
+import (
+	"time"
+	"toggo/internal/controllers"
+	"toggo/internal/models"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+	"toggo/internal/types"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TripDirectInviteRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
+	inviteService := services.NewTripDirectInviteService(
+		routeParams.ServiceParams.Repository,
+		routeParams.ServiceParams.EventPublisher,
+		routeParams.ServiceParams.NotificationService,
+		routeParams.ServiceParams.PreferenceResolver,
+	)
+	inviteController := controllers.NewTripDirectInviteController(inviteService, routeParams.Validator)
+
+	// /api/v1/trips/:tripID/direct-invites
+	tripInviteGroup := apiGroup.Group("/trips/:tripID/direct-invites")
+	tripInviteGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
+	// Rate limited so phone number invites cannot be used to work through the number space.
+	tripInviteGroup.Post("", middlewares.UserRateLimit(30, time.Hour), middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionInviteMembers), inviteController.InviteUser)
+	tripInviteGroup.Get("", middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionManageInvites), inviteController.ListTripInvites)
+	tripInviteGroup.Delete("/:inviteID", inviteController.CancelInvite)
+
+	// /api/v1/users/me/trip-invites
+	receivedGroup := apiGroup.Group("/users/me/trip-invites")
+	receivedGroup.Get("", inviteController.ListReceivedInvites)
+	receivedGroup.Post("/:inviteID/accept", inviteController.AcceptInvite)
+	receivedGroup.Post("/:inviteID/decline", inviteController.DeclineInvite)
+
+	return tripInviteGroup
+}
@@ -0,0 +1,31 @@
+package routers
+
+import (
+	"toggo/internal/controllers"
+	"toggo/internal/models"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+	"toggo/internal/types"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TripShareRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
+	shareService := services.NewTripShareService(
+		routeParams.ServiceParams.Repository,
+		routeParams.ServiceParams.FileService,
+		routeParams.ServiceParams.Config.App,
+	)
+	shareController := controllers.NewTripShareController(shareService, routeParams.Validator)
+	editTrip := middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionEditTrip)
+
+	// /api/v1/trips/:tripID/share-link
+	shareGroup := apiGroup.Group("/trips/:tripID/share-link")
+	shareGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
+	shareGroup.Get("", shareController.GetShareLink)
+	shareGroup.Put("", editTrip, shareController.EnableShareLink)
+	shareGroup.Post("/rotate", editTrip, shareController.RotateShareLink)
+	shareGroup.Delete("", editTrip, shareController.DisableShareLink)
+
+	return shareGroup
+}
@@ -0,0 +1,54 @@
+package routers
+
+import (
+	"toggo/internal/controllers"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+	"toggo/internal/types"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func newTripSlackController(routeParams types.RouteParams) *controllers.TripSlackController {
+	params := routeParams.ServiceParams
+	votingService := services.NewPollVotingService(
+		params.Repository,
+		params.PollService,
+		params.NotificationService,
+		params.SlackTrips,
+	)
+	interactionService := services.NewSlackInteractionService(services.SlackInteractionServiceConfig{
+		ChannelRepo:    params.Repository.TripSlackChannel,
+		MembershipRepo: params.Repository.Membership,
+		UserRepo:       params.Repository.User,
+		PollRepo:       params.Repository.Poll,
+		Voting:         votingService,
+		Notifier:       services.NewSlackTripNotifier(params.Config.Slack),
+	})
+	return controllers.NewTripSlackController(params.SlackTrips, interactionService, routeParams.Validator)
+}
+
+func TripSlackRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
+	slackController := newTripSlackController(routeParams)
+
+	// /api/v1/trips/:tripID/slack
+	slackGroup := apiGroup.Group("/trips/:tripID/slack")
+	slackGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
+	slackGroup.Get("", slackController.GetChannel)
+	slackGroup.Put("", slackController.LinkChannel)
+	slackGroup.Delete("", slackController.UnlinkChannel)
+
+	return slackGroup
+}
+
+// SlackInteractionRoutes receives Slack's interaction callbacks, which carry no user
+// token and are authenticated by their request signature instead.
+func SlackInteractionRoutes(app fiber.Router, routeParams types.RouteParams) fiber.Router {
+	slackController := newTripSlackController(routeParams)
+
+	slackGroup := app.Group("/slack")
+	slackGroup.Use(middlewares.SlackRequestVerify(routeParams.ServiceParams.Config.Slack))
+	slackGroup.Post("/interactions", slackController.HandleInteraction)
+
+	return slackGroup
+}
@@ -0,0 +1,32 @@
+package routers
+
+import (
+	"toggo/internal/controllers"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+	"toggo/internal/types"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TripTaskRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
+	taskService := services.NewTripTaskService(
+		routeParams.ServiceParams.Repository,
+		routeParams.ServiceParams.EventPublisher,
+		routeParams.ServiceParams.NotificationService,
+		routeParams.ServiceParams.TaskScheduler,
+		routeParams.ServiceParams.PreferenceResolver,
+	)
+	taskController := controllers.NewTripTaskController(taskService, routeParams.Validator)
+
+	// /api/v1/trips/:tripID/tasks
+	taskGroup := apiGroup.Group("/trips/:tripID/tasks")
+	taskGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
+	taskGroup.Get("", taskController.ListTasks)
+	taskGroup.Post("", taskController.CreateTask)
+	taskGroup.Get("/:taskID", taskController.GetTask)
+	taskGroup.Patch("/:taskID", taskController.UpdateTask)
+	taskGroup.Delete("/:taskID", taskController.DeleteTask)
+
+	return taskGroup
+}
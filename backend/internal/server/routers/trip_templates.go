@@ -0,0 +1,33 @@
+package routers
+
+import (
+	"toggo/internal/controllers"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+	"toggo/internal/types"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TripTemplateRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
+	templateService := services.NewTripTemplateService(
+		routeParams.ServiceParams.Repository,
+		routeParams.ServiceParams.EventPublisher,
+		routeParams.ServiceParams.ReminderScheduler,
+	)
+	templateController := controllers.NewTripTemplateController(templateService, routeParams.Validator)
+	tripMember := middlewares.TripMemberRequired(routeParams.ServiceParams.Repository)
+
+	// /api/v1/trips/:tripID/duplicate and /api/v1/trips/:tripID/template
+	apiGroup.Post("/trips/:tripID/duplicate", tripMember, templateController.DuplicateTrip)
+	apiGroup.Post("/trips/:tripID/template", tripMember, templateController.SaveTripAsTemplate)
+
+	// /api/v1/trip-templates
+	templateGroup := apiGroup.Group("/trip-templates")
+	templateGroup.Get("", templateController.ListTemplates)
+	templateGroup.Get("/:templateID", templateController.GetTemplate)
+	templateGroup.Delete("/:templateID", templateController.DeleteTemplate)
+	templateGroup.Post("/:templateID/trips", templateController.CreateTripFromTemplate)
+
+	return templateGroup
+}
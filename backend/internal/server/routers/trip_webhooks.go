@@ -0,0 +1,31 @@
+package routers
+
+import (
+	"toggo/internal/controllers"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+	"toggo/internal/types"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TripWebhookRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
+	webhookService := services.NewTripWebhookService(
+		routeParams.ServiceParams.Repository.TripWebhook,
+		routeParams.ServiceParams.Repository.Membership,
+		services.NewWebhookClient(services.NewWebhookHTTPClient()),
+	)
+	webhookController := controllers.NewTripWebhookController(webhookService, routeParams.Validator)
+
+	// /api/v1/trips/:tripID/webhooks
+	webhookGroup := apiGroup.Group("/trips/:tripID/webhooks")
+	webhookGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
+	webhookGroup.Post("", webhookController.CreateWebhook)
+	webhookGroup.Get("", webhookController.ListWebhooks)
+	webhookGroup.Patch("/:webhookID", webhookController.UpdateWebhook)
+	webhookGroup.Delete("/:webhookID", webhookController.DeleteWebhook)
+	webhookGroup.Get("/:webhookID/deliveries", webhookController.ListDeliveries)
+	webhookGroup.Post("/:webhookID/ping", webhookController.PingWebhook)
+
+	return webhookGroup
+}
@@ -2,6 +2,7 @@ package routers
 
 import (
 	"toggo/internal/controllers"
+	"toggo/internal/models"
 	"toggo/internal/server/middlewares"
 	"toggo/internal/services"
 	"toggo/internal/types"
@@ -14,6 +15,9 @@ func TripRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Rout
 		routeParams.ServiceParams.Repository,
 		routeParams.ServiceParams.FileService,
 		routeParams.ServiceParams.EventPublisher,
+		routeParams.ServiceParams.PitchScheduler,
+		routeParams.ServiceParams.ReminderScheduler,
+		routeParams.ServiceParams.PurgeScheduler,
 	)
 	tripController := controllers.NewTripController(tripService, routeParams.Validator)
 
@@ -28,6 +32,8 @@ func TripRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Rout
 		PitchLinkRepo:  routeParams.ServiceParams.Repository.PitchLink,
 		TripRepo:       routeParams.ServiceParams.Repository.Trip,
 		PollRepo:       routeParams.ServiceParams.Repository.Poll,
+		Publisher:      routeParams.ServiceParams.EventPublisher,
+		Slack:          routeParams.ServiceParams.SlackTrips,
 		BucketName:     awsCfg.BucketName,
 	})
 	pitchController := controllers.NewPitchController(pitchService, routeParams.Validator)
@@ -37,6 +43,7 @@ func TripRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Rout
 		routeParams.ServiceParams.Repository.PitchLink,
 		routeParams.ServiceParams.Repository.Pitch,
 		linkParser,
+		routeParams.ServiceParams.EventPublisher,
 	)
 	linkController := controllers.NewPitchLinkController(linkService, routeParams.Validator)
 
@@ -45,26 +52,39 @@ func TripRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Rout
 	tripGroup.Post("", tripController.CreateTrip)
 	tripGroup.Get("", tripController.GetAllTrips)
 
+	// Deleted trips are not visible to TripMemberRequired, so these are registered
+	// before the /:tripID group.
+	tripGroup.Get("/deleted", tripController.ListDeletedTrips)
+	tripGroup.Post("/deleted/:tripID/restore", tripController.RestoreTrip)
+
+	contribute := middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionContribute)
+	manageInvites := middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionManageInvites)
+
 	// /api/v1/trips/:tripID
 	tripIDGroup := tripGroup.Group("/:tripID")
 	tripIDGroup.Use(middlewares.TripMemberRequired(routeParams.ServiceParams.Repository))
 	tripIDGroup.Get("", tripController.GetTrip)
-	tripIDGroup.Patch("", tripController.UpdateTrip)
-	tripIDGroup.Delete("", tripController.DeleteTrip)
-	tripIDGroup.Post("/invites", tripController.CreateTripInvite)
+	tripIDGroup.Patch("", middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionEditTrip), tripController.UpdateTrip)
+	tripIDGroup.Delete("", middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionDeleteTrip), tripController.DeleteTrip)
+	tripIDGroup.Post("/archive", middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionArchiveTrip), tripController.ArchiveTrip)
+	tripIDGroup.Post("/unarchive", middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionArchiveTrip), tripController.UnarchiveTrip)
+	tripIDGroup.Post("/invites", middlewares.TripPermissionRequired(routeParams.ServiceParams.Repository, models.TripPermissionInviteMembers), tripController.CreateTripInvite)
+	tripIDGroup.Get("/invites", manageInvites, tripController.ListTripInvites)
+	tripIDGroup.Delete("/invites/:inviteID", tripController.RevokeTripInvite)
+	tripIDGroup.Get("/invites/:inviteID/joins", manageInvites, tripController.ListInviteJoins)
 
 	// /api/v1/trips/:tripID/pitches
-	tripIDGroup.Post("/pitches", pitchController.CreatePitch)
+	tripIDGroup.Post("/pitches", contribute, pitchController.CreatePitch)
 	tripIDGroup.Get("/pitches", pitchController.ListPitches)
 	tripIDGroup.Get("/pitches/:pitchID", pitchController.GetPitch)
-	tripIDGroup.Patch("/pitches/:pitchID", pitchController.UpdatePitch)
-	tripIDGroup.Delete("/pitches/:pitchID", pitchController.DeletePitch)
-	tripIDGroup.Post("/pitches/:pitchID/confirm-upload", pitchController.ConfirmPitchUpload)
+	tripIDGroup.Patch("/pitches/:pitchID", contribute, pitchController.UpdatePitch)
+	tripIDGroup.Delete("/pitches/:pitchID", contribute, pitchController.DeletePitch)
+	tripIDGroup.Post("/pitches/:pitchID/confirm-upload", contribute, pitchController.ConfirmPitchUpload)
 
 	// /api/v1/trips/:tripID/pitches/:pitchID/links
-	tripIDGroup.Post("/pitches/:pitchID/links", linkController.AddLink)
+	tripIDGroup.Post("/pitches/:pitchID/links", contribute, linkController.AddLink)
 	tripIDGroup.Get("/pitches/:pitchID/links", linkController.GetLinks)
-	tripIDGroup.Delete("/pitches/:pitchID/links/:linkID", linkController.DeleteLink)
+	tripIDGroup.Delete("/pitches/:pitchID/links/:linkID", contribute, linkController.DeleteLink)
 
 	return tripGroup
 }
@@ -0,0 +1,22 @@
+package routers
+
+import (
+	"toggo/internal/controllers"
+	"toggo/internal/services"
+	"toggo/internal/types"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func UserDeviceRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
+	deviceService := services.NewUserDeviceService(routeParams.ServiceParams.Repository)
+	deviceController := controllers.NewUserDeviceController(deviceService, routeParams.Validator)
+
+	// /api/v1/users/me/devices
+	deviceGroup := apiGroup.Group("/users/me/devices")
+	deviceGroup.Get("", deviceController.ListDevices)
+	deviceGroup.Post("", deviceController.RegisterDevice)
+	deviceGroup.Delete("", deviceController.UnregisterDevice)
+
+	return deviceGroup
+}
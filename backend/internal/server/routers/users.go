@@ -1,7 +1,9 @@
 package routers
 
 import (
+	"time"
 	"toggo/internal/controllers"
+	"toggo/internal/server/middlewares"
 	"toggo/internal/services"
 	"toggo/internal/types"
 
@@ -9,7 +11,7 @@ import (
 )
 
 func UserRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Router {
-	userService := services.NewUserService(routeParams.ServiceParams.Repository)
+	userService := services.NewUserService(routeParams.ServiceParams.Repository, routeParams.ServiceParams.FileService, routeParams.ServiceParams.EventPublisher, routeParams.ServiceParams.PurgeScheduler, routeParams.ServiceParams.EmailSender)
 	userController := controllers.NewUserController(userService, routeParams.Validator)
 
 	// /api/v1/users
@@ -17,6 +19,15 @@ func UserRoutes(apiGroup fiber.Router, routeParams types.RouteParams) fiber.Rout
 	userGroup.Post("", userController.CreateUser)
 	userGroup.Get("/me", userController.GetMe)
 
+	// Contact discovery is rate limited so the endpoint cannot be used to work through
+	// the phone number space.
+	userGroup.Post("/me/contacts/discover", middlewares.UserRateLimit(10, time.Hour), userController.DiscoverContacts)
+	userGroup.Get("/me/export", middlewares.UserRateLimit(5, time.Hour), userController.ExportUserData)
+
+	// Verification codes are six digits, so both sending and checking them are rate limited.
+	userGroup.Post("/me/email/verification", middlewares.UserRateLimit(5, time.Hour), userController.SendEmailVerification)
+	userGroup.Post("/me/email/verify", middlewares.UserRateLimit(10, time.Hour), userController.VerifyEmail)
+
 	// /api/v1/users/:userID
 	userIDGroup := userGroup.Group("/:userID")
 	userIDGroup.Get("", userController.GetUser)
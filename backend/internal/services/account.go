@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+// ExportUserData collects the user's profile and everything they have contributed to
+// their trips. Pitch audio is linked with presigned URLs rather than inlined.
+func (u *UserService) ExportUserData(ctx context.Context, id uuid.UUID) (*models.UserDataExport, error) {
+	user, err := u.User.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &models.UserDataExport{
+		ExportedAt: time.Now().UTC(),
+		Profile:    user,
+	}
+	if export.Memberships, err = u.Account.FindMemberships(ctx, id); err != nil {
+		return nil, err
+	}
+	if export.Comments, err = u.Account.FindComments(ctx, id); err != nil {
+		return nil, err
+	}
+	if export.PollVotes, err = u.Account.FindPollVotes(ctx, id); err != nil {
+		return nil, err
+	}
+	if export.PollRankings, err = u.Account.FindPollRankings(ctx, id); err != nil {
+		return nil, err
+	}
+	if export.RSVPs, err = u.Account.FindRSVPs(ctx, id); err != nil {
+		return nil, err
+	}
+	if export.Pitches, err = u.Account.FindPitches(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := u.linkPitchAudio(ctx, export.Pitches); err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+func (u *UserService) linkPitchAudio(ctx context.Context, pitches []*models.ExportedPitch) error {
+	if u.fileService == nil || len(pitches) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(pitches))
+	for _, p := range pitches {
+		if p.AudioS3Key != "" {
+			keys = append(keys, p.AudioS3Key)
+		}
+	}
+	files, err := u.fileService.GetFilesByKeys(ctx, models.GetFilesByKeysRequest{FileKeys: keys})
+	if err != nil {
+		return err
+	}
+
+	urls := make(map[string]string, len(files.Files))
+	for _, f := range files.Files {
+		urls[f.FileKey] = f.URL
+	}
+	for _, p := range pitches {
+		p.AudioURL = urls[p.AudioS3Key]
+	}
+	return nil
+}
+
+// DeleteUser deletes the account. Every trip the user administers is handed to another
+// member first, and trips they were alone on are deleted. Their comments, pitches,
+// activities and polls stay on their trips without an author whatever the trip's
+// departed content policy; their votes, profile picture and pitch recordings are
+// removed. Deleting an account that no longer exists succeeds.
+func (u *UserService) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	if _, err := u.User.Find(ctx, id); err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	memberships, err := u.Membership.FindByUserID(ctx, id)
+	if err != nil {
+		return err
+	}
+	var leftTrips []uuid.UUID
+	for _, membership := range memberships {
+		deleted, err := u.handOverTrip(ctx, id, membership)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			leftTrips = append(leftTrips, membership.TripID)
+		}
+	}
+
+	// Media goes first so a failed deletion can be retried without losing track of it.
+	keys, err := u.Account.FindMediaKeys(ctx, id)
+	if err != nil {
+		return err
+	}
+	if u.fileService != nil && len(keys) > 0 {
+		if err := u.fileService.DeleteFiles(ctx, keys); err != nil {
+			return err
+		}
+	}
+
+	if err := u.Account.Delete(ctx, id); err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	for _, tripID := range leftTrips {
+		publishMembershipEvent(ctx, u.publisher, realtime.EventTopicMembershipRemoved, tripID, id, id, "")
+	}
+	return nil
+}
+
+// handOverTrip keeps the trip administered once the user is gone: an owner's trip
+// passes to the longest-standing organiser, or failing that the longest-standing
+// member, and a last organiser is replaced the same way. A trip nobody else is on is
+// deleted, which it reports.
+func (u *UserService) handOverTrip(ctx context.Context, userID uuid.UUID, membership *models.Membership) (bool, error) {
+	if !membership.Role.IsAdmin() {
+		return false, nil
+	}
+	tripID := membership.TripID
+
+	members, err := u.Membership.FindByTripID(ctx, tripID)
+	if err != nil {
+		return false, err
+	}
+	successor, otherAdmins := accountSuccessor(members, userID)
+	if successor == nil {
+		return true, u.deleteAbandonedTrip(ctx, tripID)
+	}
+
+	switch {
+	case membership.Role == models.TripRoleOwner:
+		if err := u.Membership.TransferOwnership(ctx, tripID, userID, successor.UserID); err != nil {
+			return false, err
+		}
+		publishMembershipEvent(ctx, u.publisher, realtime.EventTopicMembershipUpdated, tripID, successor.UserID, userID, models.TripRoleOwner)
+	case otherAdmins == 0:
+		if _, err := u.Membership.UpdateRole(ctx, successor.UserID, tripID, models.TripRoleOrganiser); err != nil {
+			return false, err
+		}
+		publishMembershipEvent(ctx, u.publisher, realtime.EventTopicMembershipUpdated, tripID, successor.UserID, userID, models.TripRoleOrganiser)
+	}
+	return false, nil
+}
+
+// accountSuccessor picks who takes over from userID, preferring organisers, then
+// members, then viewers, and the longest-standing within each. It also counts the
+// other admins.
+func accountSuccessor(members []*models.MembershipDatabaseResponse, userID uuid.UUID) (*models.MembershipDatabaseResponse, int) {
+	rank := map[models.TripRole]int{
+		models.TripRoleOrganiser: 0,
+		models.TripRoleMember:    1,
+		models.TripRoleViewer:    2,
+	}
+
+	var successor *models.MembershipDatabaseResponse
+	otherAdmins := 0
+	// Members are ordered newest first, so later ties are longer standing.
+	for _, m := range members {
+		if m.UserID == userID {
+			continue
+		}
+		if m.Role.IsAdmin() {
+			otherAdmins++
+		}
+		r, ok := rank[m.Role]
+		if !ok {
+			continue
+		}
+		if successor == nil || r <= rank[successor.Role] {
+			successor = m
+		}
+	}
+	return successor, otherAdmins
+}
+
+// deleteAbandonedTrip deletes a trip whose only member is deleting their account, the
+// same way as deleting it from the app, so the purge workflow removes its media.
+func (u *UserService) deleteAbandonedTrip(ctx context.Context, tripID uuid.UUID) error {
+	deletedAt := time.Now().UTC()
+	if err := u.Trip.SoftDelete(ctx, tripID, deletedAt); err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if u.purgeScheduler != nil {
+		if err := u.purgeScheduler.SchedulePurge(ctx, tripID, deletedAt.Add(models.TripRestoreWindow)); err != nil {
+			log.Printf("Failed to schedule purge for trip %s: %v", tripID, err)
+		}
+	}
+	return nil
+}
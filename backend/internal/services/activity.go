@@ -136,28 +136,25 @@ func (s *ActivityService) CreateActivity(ctx context.Context, req models.CreateA
 }
 
 func (s *ActivityService) publishActivityCreated(ctx context.Context, activity *models.ActivityAPIResponse, actorID uuid.UUID) {
+	s.publishActivityEvent(ctx, realtime.EventTopicActivityCreated, activity.TripID, activity.ID, actorID, activity.ProposerUsername, activity)
+}
+
+// publishActivityUpdated re-reads the activity so members receive the same
+// enriched response a GET would return.
+func (s *ActivityService) publishActivityUpdated(ctx context.Context, tripID, activityID, actorID uuid.UUID) {
 	if s.publisher == nil {
 		return
 	}
-	actorName := ""
-	if activity.ProposerUsername != "" {
-		actorName = activity.ProposerUsername
-	}
-	event, err := realtime.NewEventWithActor(
-		realtime.EventTopicActivityCreated,
-		activity.TripID.String(),
-		activity.ID.String(),
-		actorID.String(),
-		actorName,
-		activity,
-	)
+	activity, err := s.GetActivity(ctx, tripID, activityID, actorID)
 	if err != nil {
-		log.Printf("Failed to create activity.created event: %v", err)
+		log.Printf("Failed to load activity for activity.updated event: %v", err)
 		return
 	}
-	if err := s.publisher.Publish(ctx, event); err != nil {
-		log.Printf("Failed to publish activity.created event: %v", err)
-	}
+	s.publishActivityEvent(ctx, realtime.EventTopicActivityUpdated, tripID, activityID, actorID, "", activity)
+}
+
+func (s *ActivityService) publishActivityEvent(ctx context.Context, topic realtime.EventTopic, tripID, activityID, actorID uuid.UUID, actorName string, data any) {
+	publishEvent(ctx, s.publisher, topic, tripID.String(), activityID.String(), actorID.String(), actorName, data)
 }
 
 func (s *ActivityService) GetActivity(ctx context.Context, tripID, activityID, userID uuid.UUID) (*models.ActivityAPIResponse, error) {
@@ -206,13 +203,13 @@ func (s *ActivityService) UpdateActivity(ctx context.Context, tripID, activityID
 		return nil, err
 	}
 
-	isAdmin, err := s.Membership.IsAdmin(ctx, tripID, userID)
+	canModerate, err := hasTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionModerateContent)
 	if err != nil {
 		return nil, err
 	}
 
 	isProposer := activity.ProposedBy != nil && *activity.ProposedBy == userID
-	if !isAdmin && !isProposer {
+	if !canModerate && !isProposer {
 		return nil, errs.Forbidden()
 	}
 
@@ -233,7 +230,14 @@ func (s *ActivityService) UpdateActivity(ctx context.Context, tripID, activityID
 	if err != nil {
 		return nil, err
 	}
-	return s.GetActivity(ctx, tripID, activityID, userID)
+
+	updated, err := s.GetActivity(ctx, tripID, activityID, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.publishActivityEvent(ctx, realtime.EventTopicActivityUpdated, tripID, activityID, userID, "", updated)
+
+	return updated, nil
 }
 
 func (s *ActivityService) DeleteActivity(ctx context.Context, tripID, activityID, userID uuid.UUID) error {
@@ -242,18 +246,26 @@ func (s *ActivityService) DeleteActivity(ctx context.Context, tripID, activityID
 		return err
 	}
 
-	isAdmin, err := s.Membership.IsAdmin(ctx, activity.TripID, userID)
+	canModerate, err := hasTripPermission(ctx, s.Membership, activity.TripID, userID, models.TripPermissionModerateContent)
 	if err != nil {
 		return err
 	}
 
 	isProposer := activity.ProposedBy != nil && *activity.ProposedBy == userID
-	if !isAdmin && !isProposer {
+	if !canModerate && !isProposer {
 		return errs.Forbidden()
 	}
 
 	// activity_images rows are cleaned up automatically via ON DELETE CASCADE
-	return s.Activity.Delete(ctx, activityID)
+	if err := s.Activity.Delete(ctx, activityID); err != nil {
+		return err
+	}
+
+	s.publishActivityEvent(ctx, realtime.EventTopicActivityDeleted, tripID, activityID, userID, "", realtime.ActivityDeletedPayload{
+		ID:     activityID,
+		TripID: tripID,
+	})
+	return nil
 }
 
 // GetActivityCategories retrieves categories for an activity with pagination
@@ -297,7 +309,12 @@ func (s *ActivityService) AddCategoryToActivity(ctx context.Context, tripID, act
 		return err
 	}
 
-	return s.ActivityCategory.AddCategoriesToActivity(ctx, activityID, activity.TripID, []string{categoryName})
+	if err := s.ActivityCategory.AddCategoriesToActivity(ctx, activityID, activity.TripID, []string{categoryName}); err != nil {
+		return err
+	}
+
+	s.publishActivityUpdated(ctx, tripID, activityID, userID)
+	return nil
 }
 
 // RemoveCategoryFromActivity removes a category from an activity
@@ -306,7 +323,12 @@ func (s *ActivityService) RemoveCategoryFromActivity(ctx context.Context, tripID
 		return err
 	}
 
-	return s.ActivityCategory.RemoveCategoryFromActivity(ctx, activityID, categoryName)
+	if err := s.ActivityCategory.RemoveCategoryFromActivity(ctx, activityID, categoryName); err != nil {
+		return err
+	}
+
+	s.publishActivityUpdated(ctx, tripID, activityID, userID)
+	return nil
 }
 
 func (s *ActivityService) UpdateActivityRSVP(ctx context.Context, tripID, activityID, userID uuid.UUID, req models.ActivityRSVPRequestPayload) (*models.ActivityRSVP, error) {
@@ -318,6 +340,8 @@ func (s *ActivityService) UpdateActivityRSVP(ctx context.Context, tripID, activi
 	if err != nil {
 		return nil, err
 	}
+
+	s.publishActivityEvent(ctx, realtime.EventTopicActivityRSVPUpdated, tripID, activityID, userID, "", rsvp)
 	return rsvp, nil
 }
 
@@ -382,21 +406,22 @@ func (s *ActivityService) GetActivityRSVPs(
 }
 
 // RemoveActivityRSVP removes a specific user's RSVP from an activity.
-// Only the activity proposer or a trip admin can remove another user's RSVP.
+// Only the activity proposer or a member who can moderate content can remove another
+// user's RSVP.
 func (s *ActivityService) RemoveActivityRSVP(ctx context.Context, tripID, activityID, callerID, targetUserID uuid.UUID) error {
 	activity, err := s.verifyActivityBelongsToTrip(ctx, tripID, activityID)
 	if err != nil {
 		return err
 	}
 
-	isAdmin, err := s.Membership.IsAdmin(ctx, tripID, callerID)
+	canModerate, err := hasTripPermission(ctx, s.Membership, tripID, callerID, models.TripPermissionModerateContent)
 	if err != nil {
 		return err
 	}
 
 	isProposer := activity.ProposedBy != nil && *activity.ProposedBy == callerID
 	isSelf := callerID == targetUserID
-	if !isAdmin && !isProposer && !isSelf {
+	if !canModerate && !isProposer && !isSelf {
 		return errs.Forbidden()
 	}
 
@@ -404,7 +429,16 @@ func (s *ActivityService) RemoveActivityRSVP(ctx context.Context, tripID, activi
 	if errors.Is(err, sql.ErrNoRows) {
 		return errs.ErrNotFound
 	}
-	return err
+	if err != nil {
+		return err
+	}
+
+	s.publishActivityEvent(ctx, realtime.EventTopicActivityRSVPRemoved, tripID, activityID, callerID, "", realtime.ActivityRSVPRemovedPayload{
+		ActivityID: activityID,
+		TripID:     tripID,
+		UserID:     targetUserID,
+	})
+	return nil
 }
 
 // Helper methods
@@ -2,19 +2,29 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"runtime/debug"
+	"toggo/internal/models"
 	"toggo/internal/realtime"
 	"toggo/internal/repository"
+	"toggo/internal/templates"
+	"toggo/internal/utilities/pagination"
 
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 )
 
+// maxFeedActorPreviews is the number of actors named on an aggregated feed item.
+const maxFeedActorPreviews = 3
+
 // ActivityFeedServiceInterface is the contract used by the controller.
 type ActivityFeedServiceInterface interface {
-	GetFeed(ctx context.Context, userID, tripID string) ([]*realtime.Event, error)
-	MarkRead(ctx context.Context, userID, tripID, eventID string) error
-	GetUnreadCount(ctx context.Context, userID, tripID string) (int64, error)
+	GetFeed(ctx context.Context, userID, tripID uuid.UUID, unreadOnly bool, limit int, cursorToken string) (*models.ActivityFeedPageResult, error)
+	MarkRead(ctx context.Context, userID, tripID, itemID uuid.UUID) error
+	MarkUnread(ctx context.Context, userID, tripID, itemID uuid.UUID) error
+	MarkAllRead(ctx context.Context, userID, tripID uuid.UUID) error
+	GetUnreadCount(ctx context.Context, userID, tripID uuid.UUID) (int64, error)
 }
 
 var _ ActivityFeedServiceInterface = (*ActivityFeedService)(nil)
@@ -22,18 +32,19 @@ var _ ActivityFeedServiceInterface = (*ActivityFeedService)(nil)
 // ActivityFeedService manages the activity feed lifecycle and exposes the business
 // methods used by the HTTP layer.
 type ActivityFeedService struct {
-	store      *realtime.ActivityFeedStore
+	feedRepo   repository.ActivityFeedRepository
+	userRepo   repository.UserRepository
 	subscriber *realtime.ActivityFeedSubscriber
 	ctx        context.Context
 	cancel     context.CancelFunc
 }
 
-func NewActivityFeedService(redisClient *redis.Client, membershipRepo repository.MembershipRepository) *ActivityFeedService {
-	store := realtime.NewActivityFeedStore(redisClient)
-	subscriber := realtime.NewActivityFeedSubscriber(store, membershipRepo, redisClient)
+func NewActivityFeedService(redisClient *redis.Client, membershipRepo repository.MembershipRepository, feedRepo repository.ActivityFeedRepository, userRepo repository.UserRepository) *ActivityFeedService {
+	subscriber := realtime.NewActivityFeedSubscriber(feedRepo, membershipRepo, redisClient)
 	ctx, cancel := context.WithCancel(context.Background())
 	return &ActivityFeedService{
-		store:      store,
+		feedRepo:   feedRepo,
+		userRepo:   userRepo,
 		subscriber: subscriber,
 		ctx:        ctx,
 		cancel:     cancel,
@@ -59,27 +70,177 @@ func (s *ActivityFeedService) Shutdown() {
 	log.Println("Activity feed service stopped")
 }
 
-// GetFeed returns all unread events for the user in the given trip (events strictly
-// after their last-seen cursor) and advances the cursor to now.
-func (s *ActivityFeedService) GetFeed(ctx context.Context, userID, tripID string) ([]*realtime.Event, error) {
-	cursorMs, err := s.store.GetCursor(ctx, userID, tripID)
+// GetFeed returns a page of aggregated feed items for the user in the given trip,
+// newest first. Reading the feed does not change read state.
+func (s *ActivityFeedService) GetFeed(ctx context.Context, userID, tripID uuid.UUID, unreadOnly bool, limit int, cursorToken string) (*models.ActivityFeedPageResult, error) {
+	return getFeedPage(ctx, s.feedRepo, s.userRepo, userID, []uuid.UUID{tripID}, unreadOnly, limit, cursorToken)
+}
+
+// MarkRead marks every event in a feed item as read.
+func (s *ActivityFeedService) MarkRead(ctx context.Context, userID, tripID, itemID uuid.UUID) error {
+	return s.feedRepo.SetGroupRead(ctx, userID, tripID, itemID, true)
+}
+
+// MarkUnread restores a feed item to unread.
+func (s *ActivityFeedService) MarkUnread(ctx context.Context, userID, tripID, itemID uuid.UUID) error {
+	return s.feedRepo.SetGroupRead(ctx, userID, tripID, itemID, false)
+}
+
+// MarkAllRead marks the user's whole feed for a trip as read.
+func (s *ActivityFeedService) MarkAllRead(ctx context.Context, userID, tripID uuid.UUID) error {
+	return s.feedRepo.MarkAllRead(ctx, userID, []uuid.UUID{tripID})
+}
+
+// GetUnreadCount returns the number of feed items with unread events for the given trip.
+func (s *ActivityFeedService) GetUnreadCount(ctx context.Context, userID, tripID uuid.UUID) (int64, error) {
+	counts, err := s.feedRepo.CountUnreadGroupsByTrip(ctx, userID, []uuid.UUID{tripID})
+	if err != nil {
+		return 0, err
+	}
+	return counts[tripID], nil
+}
+
+// getFeedPage loads a page of aggregated feed items across the given trips and
+// renders their summaries, in the user's locale, and actor previews.
+func getFeedPage(ctx context.Context, feedRepo repository.ActivityFeedRepository, userRepo repository.UserRepository, userID uuid.UUID, tripIDs []uuid.UUID, unreadOnly bool, limit int, cursorToken string) (*models.ActivityFeedPageResult, error) {
+	cursor, err := pagination.DecodeTimeUUIDCursor(cursorToken)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, nextCursor, err := feedRepo.FindGroupsWithCursor(ctx, userID, tripIDs, unreadOnly, limit, cursor)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.store.GetFeedAfterCursor(ctx, userID, tripID, cursorMs)
+	groupIDs := make([]uuid.UUID, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.GroupID)
+	}
+	actorRows, err := feedRepo.FindGroupActors(ctx, userID, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	actorsByGroup := make(map[uuid.UUID][]models.ActivityFeedActor, len(groups))
+	for _, row := range actorRows {
+		if len(actorsByGroup[row.GroupID]) < maxFeedActorPreviews {
+			actorsByGroup[row.GroupID] = append(actorsByGroup[row.GroupID], models.ActivityFeedActor{
+				UserID:   row.UserID,
+				Name:     row.Name,
+				Username: row.Username,
+			})
+		}
+	}
+
+	locale := feedLocale(ctx, userRepo, userID)
+	items := make([]*models.ActivityFeedItem, 0, len(groups))
+	for _, group := range groups {
+		actors := actorsByGroup[group.GroupID]
+		if actors == nil {
+			actors = []models.ActivityFeedActor{}
+		}
+		items = append(items, &models.ActivityFeedItem{
+			ID:         group.GroupID,
+			TripID:     group.TripID,
+			TripName:   group.TripName,
+			Topic:      group.Topic,
+			EntityID:   group.EntityID,
+			Summary:    summarizeFeedItem(locale, group.Topic, actors, group.ActorCount, group.Data),
+			Actors:     actors,
+			ActorCount: group.ActorCount,
+			EventCount: group.EventCount,
+			IsRead:     !group.HasUnread,
+			LatestAt:   group.LatestAt,
+			Version:    group.Version,
+			Data:       group.Data,
+		})
+	}
+
+	result := &models.ActivityFeedPageResult{
+		Items: items,
+		Limit: limit,
+	}
+	if nextCursor != nil {
+		token, err := pagination.EncodeTimeUUIDCursor(*nextCursor)
+		if err != nil {
+			return nil, err
+		}
+		result.NextCursor = &token
+	}
+	return result, nil
 }
 
-// MarkRead removes a specific event from the user's feed, permanently dismissing it.
-func (s *ActivityFeedService) MarkRead(ctx context.Context, userID, tripID, eventID string) error {
-	return s.store.MarkRead(ctx, userID, tripID, eventID)
+// feedSubjectFields are the payload fields checked, in order, for a human-readable title.
+var feedSubjectFields = []string{"question", "title", "label", "name"}
+
+// summarizeFeedItem renders e.g. "Ana and 3 others voted on Where to eat" in locale.
+// Each topic has a "feed.<topic>" message and, when the payload carries a title, an
+// optional "feed.<topic>.subject" variant.
+func summarizeFeedItem(locale, topic string, actors []models.ActivityFeedActor, actorCount int, data json.RawMessage) string {
+	vars := map[string]any{"ActorCount": actorCount}
+
+	action, ok := "", false
+	if subject := feedSubject(data); subject != "" {
+		vars["Subject"] = subject
+		action, ok = templates.Lookup(locale, "feed."+topic+".subject", vars)
+	}
+	if !ok {
+		action, ok = templates.Lookup(locale, "feed."+topic, vars)
+	}
+	if !ok {
+		action = templates.Text(locale, "feed.unknown", vars)
+	}
+
+	return templates.Text(locale, "feed.summary", map[string]any{
+		"Actors": feedActorPhrase(locale, actors, actorCount),
+		"Action": action,
+	})
 }
 
-// GetUnreadCount returns the number of events after the user's cursor for the given trip.
-func (s *ActivityFeedService) GetUnreadCount(ctx context.Context, userID, tripID string) (int64, error) {
-	cursorMs, err := s.store.GetCursor(ctx, userID, tripID)
+// feedLocale returns the user's locale, or the default when it cannot be loaded.
+func feedLocale(ctx context.Context, userRepo repository.UserRepository, userID uuid.UUID) string {
+	if userRepo == nil {
+		return models.DefaultLocale
+	}
+	user, err := userRepo.Find(ctx, userID)
 	if err != nil {
-		return 0, err
+		return models.DefaultLocale
+	}
+	return user.Locale
+}
+
+func feedSubject(data json.RawMessage) string {
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return ""
+	}
+	for _, key := range feedSubjectFields {
+		if value, ok := fields[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func feedActorPhrase(locale string, actors []models.ActivityFeedActor, actorCount int) string {
+	if len(actors) == 0 {
+		return templates.Text(locale, "feed.actors.none", nil)
+	}
+
+	first := feedActorName(actors[0])
+	switch {
+	case actorCount <= 1:
+		return first
+	case actorCount == 2 && len(actors) > 1:
+		return templates.Text(locale, "feed.actors.two", map[string]any{"First": first, "Second": feedActorName(actors[1])})
+	default:
+		return templates.Text(locale, "feed.actors.others", map[string]any{"First": first, "Others": actorCount - 1})
+	}
+}
+
+func feedActorName(actor models.ActivityFeedActor) string {
+	if actor.Name != "" {
+		return actor.Name
 	}
-	return s.store.GetUnreadCount(ctx, userID, tripID, cursorMs)
+	return actor.Username
 }
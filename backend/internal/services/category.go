@@ -3,7 +3,6 @@ package services
 import (
 	"context"
 	"errors"
-	"log"
 	"toggo/internal/errs"
 	"toggo/internal/models"
 	"toggo/internal/realtime"
@@ -41,12 +40,12 @@ func (s *CategoryService) GetCategoriesByTripID(ctx context.Context, tripID, use
 		return nil, errs.ErrNotFound
 	}
 
-	isAdmin, err := s.Membership.IsAdmin(ctx, tripID, userID)
+	canEdit, err := hasTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionEditTrip)
 	if err != nil {
 		return nil, err
 	}
 
-	if isAdmin && includeHidden {
+	if canEdit && includeHidden {
 		categories, err := s.Category.FindByTripID(ctx, tripID, true)
 		if err != nil {
 			return nil, err
@@ -54,7 +53,7 @@ func (s *CategoryService) GetCategoriesByTripID(ctx context.Context, tripID, use
 		return s.convertToAPICategoriesWithHidden(categories), nil
 	}
 
-	if !isAdmin {
+	if !canEdit {
 		isMember, err := s.Membership.IsMember(ctx, tripID, userID)
 		if err != nil {
 			return nil, err
@@ -125,27 +124,17 @@ func (s *CategoryService) CreateCategory(ctx context.Context, tripID, userID uui
 }
 
 func (s *CategoryService) publishCategoryCreated(ctx context.Context, tripID, actorID string, category *models.Category) {
-	if s.publisher == nil {
-		return
-	}
-	event, err := realtime.NewEventWithActor(realtime.EventTopicCategoryCreated, tripID, category.Name, actorID, "", category)
-	if err != nil {
-		log.Printf("Failed to create category.created event: %v", err)
-		return
-	}
-	if err := s.publisher.Publish(ctx, event); err != nil {
-		log.Printf("Failed to publish category.created event: %v", err)
-	}
+	s.publishCategoryEvent(ctx, realtime.EventTopicCategoryCreated, tripID, category.Name, actorID, category)
+}
+
+func (s *CategoryService) publishCategoryEvent(ctx context.Context, topic realtime.EventTopic, tripID, entityID, actorID string, data any) {
+	publishEvent(ctx, s.publisher, topic, tripID, entityID, actorID, "", data)
 }
 
 func (s *CategoryService) DeleteCategory(ctx context.Context, tripID, userID uuid.UUID, name string) error {
-	isAdmin, err := s.Membership.IsAdmin(ctx, tripID, userID)
-	if err != nil {
+	if err := requireTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionEditTrip); err != nil {
 		return err
 	}
-	if !isAdmin {
-		return errs.Forbidden()
-	}
 
 	category, err := s.Category.Find(ctx, tripID, name)
 	if err != nil {
@@ -156,23 +145,36 @@ func (s *CategoryService) DeleteCategory(ctx context.Context, tripID, userID uui
 		return errs.BadRequest(errors.New("cannot delete a default category, use hide instead"))
 	}
 
-	return s.Category.Delete(ctx, tripID, name)
+	if err := s.Category.Delete(ctx, tripID, name); err != nil {
+		return err
+	}
+
+	s.publishCategoryEvent(ctx, realtime.EventTopicCategoryDeleted, tripID.String(), name, userID.String(), realtime.CategoryDeletedPayload{
+		TripID: tripID,
+		Name:   name,
+	})
+	return nil
 }
 
 func (s *CategoryService) SetCategoryVisibility(ctx context.Context, tripID, userID uuid.UUID, name string, isHidden bool) error {
-	isAdmin, err := s.Membership.IsAdmin(ctx, tripID, userID)
-	if err != nil {
+	if err := requireTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionEditTrip); err != nil {
 		return err
 	}
-	if !isAdmin {
-		return errs.Forbidden()
-	}
 
 	if _, err := s.Category.Find(ctx, tripID, name); err != nil {
 		return err
 	}
 
-	return s.Category.SetHidden(ctx, tripID, name, isHidden)
+	if err := s.Category.SetHidden(ctx, tripID, name, isHidden); err != nil {
+		return err
+	}
+
+	s.publishCategoryEvent(ctx, realtime.EventTopicCategoryVisibilityChanged, tripID.String(), name, userID.String(), realtime.CategoryVisibilityPayload{
+		TripID:   tripID,
+		Name:     name,
+		IsHidden: isHidden,
+	})
+	return nil
 }
 
 // GetTabs returns all visible (non-hidden) categories for a trip ordered by position
@@ -236,7 +238,15 @@ func (s *CategoryService) ReorderTabs(ctx context.Context, tripID, userID uuid.U
 		seenPositions[t.Position] = true
 	}
 
-	return s.Category.UpdateOrder(ctx, tripID, req.Tabs)
+	if err := s.Category.UpdateOrder(ctx, tripID, req.Tabs); err != nil {
+		return err
+	}
+
+	s.publishCategoryEvent(ctx, realtime.EventTopicCategoryReordered, tripID.String(), tripID.String(), userID.String(), realtime.CategoryReorderedPayload{
+		TripID: tripID,
+		Tabs:   req.Tabs,
+	})
+	return nil
 }
 
 func (s *CategoryService) toAPIResponse(category *models.Category) *models.CategoryAPIResponse {
@@ -2,8 +2,10 @@ package services
 
 import (
 	"context"
+	"log"
 
 	"toggo/internal/models"
+	"toggo/internal/realtime"
 	"toggo/internal/repository"
 	"toggo/internal/utilities/pagination"
 
@@ -22,12 +24,14 @@ var _ CommentReactionServiceInterface = (*CommentReactionService)(nil)
 type CommentReactionService struct {
 	repository  *repository.Repository
 	fileService FileServiceInterface
+	publisher   realtime.EventPublisher
 }
 
-func NewCommentReactionService(repo *repository.Repository, fileService FileServiceInterface) CommentReactionServiceInterface {
+func NewCommentReactionService(repo *repository.Repository, fileService FileServiceInterface, publisher realtime.EventPublisher) CommentReactionServiceInterface {
 	return &CommentReactionService{
 		repository:  repo,
 		fileService: fileService,
+		publisher:   publisher,
 	}
 }
 
@@ -39,21 +43,58 @@ func (s *CommentReactionService) AddReaction(ctx context.Context, commentID uuid
 	if err := s.ensureCommentVisibleToUser(ctx, commentID, userID); err != nil {
 		return nil, err
 	}
+	if err := requireCommentContribution(ctx, s.repository, commentID, userID); err != nil {
+		return nil, err
+	}
 
-	return s.repository.CommentReaction.Create(ctx, &models.CommentReaction{
+	reaction, err := s.repository.CommentReaction.Create(ctx, &models.CommentReaction{
 		CommentID: commentID,
 		UserID:    userID,
 		Emoji:     req.Emoji,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishReactionEvent(ctx, realtime.EventTopicCommentReactionAdded, commentID, userID, req.Emoji)
+	return reaction, nil
 }
 
 func (s *CommentReactionService) RemoveReaction(ctx context.Context, commentID uuid.UUID, userID uuid.UUID, req models.DeleteCommentReactionRequest) error {
 	if err := s.ensureCommentVisibleToUser(ctx, commentID, userID); err != nil {
 		return err
 	}
+	if err := requireCommentContribution(ctx, s.repository, commentID, userID); err != nil {
+		return err
+	}
 
 	// Idempotent: deleting a non-existent reaction should not error.
-	return s.repository.CommentReaction.DeleteByUserEmoji(ctx, commentID, userID, req.Emoji)
+	if err := s.repository.CommentReaction.DeleteByUserEmoji(ctx, commentID, userID, req.Emoji); err != nil {
+		return err
+	}
+
+	s.publishReactionEvent(ctx, realtime.EventTopicCommentReactionRemoved, commentID, userID, req.Emoji)
+	return nil
+}
+
+// publishReactionEvent looks up the comment so the event can be routed to its
+// trip and tell clients which activity or pitch thread to refresh.
+func (s *CommentReactionService) publishReactionEvent(ctx context.Context, topic realtime.EventTopic, commentID, userID uuid.UUID, emoji string) {
+	if s.publisher == nil {
+		return
+	}
+	comment, err := s.repository.Comment.FindByID(ctx, commentID)
+	if err != nil {
+		log.Printf("Failed to load comment for %s event: %v", topic, err)
+		return
+	}
+	publishEvent(ctx, s.publisher, topic, comment.TripID.String(), commentID.String(), userID.String(), "", realtime.CommentReactionPayload{
+		CommentID:  commentID,
+		EntityType: comment.EntityType,
+		EntityID:   comment.EntityID,
+		UserID:     userID,
+		Emoji:      emoji,
+	})
 }
 
 func (s *CommentReactionService) GetReactionSummary(ctx context.Context, commentID uuid.UUID, userID uuid.UUID) (*models.CommentReactionsSummaryResponse, error) {
@@ -77,7 +118,7 @@ func (s *CommentReactionService) GetReactionUsers(ctx context.Context, commentID
 		return nil, err
 	}
 
-	rows, err := s.repository.CommentReaction.ListUsersForEmoji(ctx, commentID, emoji)
+	rows, err := s.repository.CommentReaction.ListUsersForEmoji(ctx, commentID, userID, emoji)
 	if err != nil {
 		return nil, err
 	}
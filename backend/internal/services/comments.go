@@ -3,10 +3,10 @@ package services
 import (
 	"context"
 	"log"
-	"toggo/internal/errs"
 	"toggo/internal/models"
 	"toggo/internal/realtime"
 	"toggo/internal/repository"
+	"toggo/internal/templates"
 	"toggo/internal/utilities/pagination"
 
 	"github.com/google/uuid"
@@ -16,7 +16,7 @@ type CommentServiceInterface interface {
 	CreateComment(ctx context.Context, req models.CreateCommentRequest, userID uuid.UUID) (*models.Comment, error)
 	UpdateComment(ctx context.Context, id uuid.UUID, userID uuid.UUID, req models.UpdateCommentRequest) (*models.Comment, error)
 	DeleteComment(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
-	GetPaginatedComments(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID uuid.UUID, limit int, cursorToken string) (*models.PaginatedCommentsResponse, error)
+	GetPaginatedComments(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID uuid.UUID, userID uuid.UUID, limit int, cursorToken string) (*models.PaginatedCommentsResponse, error)
 }
 
 var _ CommentServiceInterface = (*CommentService)(nil)
@@ -38,15 +38,10 @@ func NewCommentService(repo *repository.Repository, fileService FileServiceInter
 }
 
 func (s *CommentService) CreateComment(ctx context.Context, req models.CreateCommentRequest, userID uuid.UUID) (*models.Comment, error) {
-	isMember, err := s.repository.Membership.IsMember(ctx, req.TripID, userID)
-	if err != nil {
+	if err := requireTripPermission(ctx, s.repository.Membership, req.TripID, userID, models.TripPermissionContribute); err != nil {
 		return nil, err
 	}
 
-	if !isMember {
-		return nil, errs.Forbidden()
-	}
-
 	comment, err := s.repository.Comment.Create(ctx, &models.Comment{
 		TripID:     req.TripID,
 		EntityType: req.EntityType,
@@ -73,8 +68,7 @@ func (s *CommentService) notifyNewComment(tripID uuid.UUID, actorID uuid.UUID) {
 		tripID,
 		actorID,
 		models.NotificationPreferenceNewComment,
-		"New comment",
-		"Someone commented on your trip",
+		templates.Message{Key: templates.NotificationNewComment},
 		nil,
 	)
 	if err != nil {
@@ -83,39 +77,41 @@ func (s *CommentService) notifyNewComment(tripID uuid.UUID, actorID uuid.UUID) {
 }
 
 func (s *CommentService) publishCommentCreated(ctx context.Context, comment *models.Comment, actorID uuid.UUID) {
-	if s.publisher == nil {
-		return
-	}
-	event, err := realtime.NewEventWithActor(
-		realtime.EventTopicCommentCreated,
-		comment.TripID.String(),
-		comment.EntityID.String(),
-		actorID.String(),
-		"",
-		comment,
-	)
-	if err != nil {
-		log.Printf("Failed to create comment.created event: %v", err)
-		return
-	}
-	if err := s.publisher.Publish(ctx, event); err != nil {
-		log.Printf("Failed to publish comment.created event: %v", err)
-	}
+	publishEvent(ctx, s.publisher, realtime.EventTopicCommentCreated, comment.TripID.String(), comment.EntityID.String(), actorID.String(), "", comment)
 }
 
 func (s *CommentService) UpdateComment(ctx context.Context, id uuid.UUID, userID uuid.UUID, req models.UpdateCommentRequest) (*models.Comment, error) {
+	if err := requireCommentContribution(ctx, s.repository, id, userID); err != nil {
+		return nil, err
+	}
 	return s.repository.Comment.Update(ctx, id, userID, req.Content)
 }
 
 func (s *CommentService) DeleteComment(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	if err := requireCommentContribution(ctx, s.repository, id, userID); err != nil {
+		return err
+	}
 	return s.repository.Comment.Delete(ctx, id, userID)
 }
 
+// requireCommentContribution checks the user may still contribute to the comment's trip,
+// so comments on archived trips can no longer be changed or reacted to.
+func requireCommentContribution(ctx context.Context, repo *repository.Repository, commentID, userID uuid.UUID) error {
+	comment, err := repo.Comment.FindByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	return requireTripPermission(ctx, repo.Membership, comment.TripID, userID, models.TripPermissionContribute)
+}
+
+// GetPaginatedComments returns a page of comments as seen by userID, without comments
+// from users they have blocked.
 func (s *CommentService) GetPaginatedComments(
 	ctx context.Context,
 	tripID uuid.UUID,
 	entityType models.EntityType,
 	entityID uuid.UUID,
+	userID uuid.UUID,
 	limit int,
 	cursorToken string,
 ) (*models.PaginatedCommentsResponse, error) {
@@ -134,7 +130,7 @@ func (s *CommentService) GetPaginatedComments(
 	}
 
 	comments, err := s.repository.Comment.FindPaginatedComments(
-		ctx, tripID, entityType, entityID, requestLimit, commentCursor,
+		ctx, tripID, entityType, entityID, userID, requestLimit, commentCursor,
 	)
 	if err != nil {
 		return nil, err
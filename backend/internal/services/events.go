@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+	"log"
+	"toggo/internal/realtime"
+)
+
+// publishEvent publishes a realtime event attributed to actorID. Events are published
+// once the change is saved, so failures are logged rather than returned. Nothing is
+// published without a publisher.
+func publishEvent(ctx context.Context, publisher realtime.EventPublisher, topic realtime.EventTopic, tripID, entityID, actorID, actorName string, data any) {
+	if publisher == nil {
+		return
+	}
+	event, err := realtime.NewEventWithActor(topic, tripID, entityID, actorID, actorName, data)
+	if err != nil {
+		log.Printf("Failed to create %s event: %v", topic, err)
+		return
+	}
+	if err := publisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish %s event: %v", topic, err)
+	}
+}
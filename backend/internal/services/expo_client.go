@@ -7,24 +7,37 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 )
 
 // ExpoClient handles communication with Expo API
 type ExpoClient interface {
 	SendNotifications(ctx context.Context, tokens []string, title string, body string, data map[string]interface{}) (*ExpoBulkResponse, error)
+	GetReceipts(ctx context.Context, ticketIDs []string) (*ExpoReceiptsResponse, error)
 }
 
 // expoClient implements ExpoClient
 type expoClient struct {
 	accessToken string
+	baseURL     string
 	httpClient  *http.Client
 }
 
-const expoAPIURL = "https://exp.host/--/api/v2/push/send"
+const expoAPIBaseURL = "https://exp.host/--/api/v2/push"
+
+// ExpoMaxReceiptIDs is the maximum number of ticket IDs Expo accepts per receipts request.
+const ExpoMaxReceiptIDs = 1000
 
 func NewExpoClient(accessToken string) ExpoClient {
+	return NewExpoClientWithBaseURL(accessToken, expoAPIBaseURL)
+}
+
+// NewExpoClientWithBaseURL points the client at a different push API, e.g. a local fake
+// server in tests.
+func NewExpoClientWithBaseURL(accessToken, baseURL string) ExpoClient {
 	return &expoClient{
 		accessToken: accessToken,
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
 		httpClient:  &http.Client{},
 	}
 }
@@ -44,10 +57,22 @@ type ExpoNotificationResponse struct {
 	Details map[string]interface{} `json:"details,omitempty"`
 }
 
+// ErrorCode returns the Expo error code from the ticket details, e.g. DeviceNotRegistered.
+func (r ExpoNotificationResponse) ErrorCode() string {
+	code, _ := r.Details["error"].(string)
+	return code
+}
+
 type ExpoBulkResponse struct {
 	Data []ExpoNotificationResponse `json:"data"`
 }
 
+// ExpoReceiptsResponse maps ticket IDs to their delivery receipts. Tickets whose
+// receipts are not ready yet are absent from Data.
+type ExpoReceiptsResponse struct {
+	Data map[string]ExpoNotificationResponse `json:"data"`
+}
+
 // sends notifications to multiple devices (max 100 per call per Expo limits)
 func (c *expoClient) SendNotifications(ctx context.Context, tokens []string, title string, body string, data map[string]interface{}) (*ExpoBulkResponse, error) {
 	if len(tokens) == 0 {
@@ -73,9 +98,44 @@ func (c *expoClient) SendNotifications(ctx context.Context, tokens []string, tit
 		return nil, fmt.Errorf("failed to marshal notifications: %w", err)
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", expoAPIURL, bytes.NewBuffer(payload))
+	var expoResp ExpoBulkResponse
+	if err := c.post(ctx, "/send", payload, &expoResp); err != nil {
+		return nil, fmt.Errorf("failed to send notifications: %w", err)
+	}
+
+	return &expoResp, nil
+}
+
+// GetReceipts fetches delivery receipts for previously issued push tickets.
+func (c *expoClient) GetReceipts(ctx context.Context, ticketIDs []string) (*ExpoReceiptsResponse, error) {
+	if len(ticketIDs) == 0 {
+		return &ExpoReceiptsResponse{Data: map[string]ExpoNotificationResponse{}}, nil
+	}
+
+	if len(ticketIDs) > ExpoMaxReceiptIDs {
+		return nil, fmt.Errorf("cannot fetch more than %d receipts at once (got %d)", ExpoMaxReceiptIDs, len(ticketIDs))
+	}
+
+	payload, err := json.Marshal(map[string][]string{"ids": ticketIDs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal receipt request: %w", err)
+	}
+
+	var receipts ExpoReceiptsResponse
+	if err := c.post(ctx, "/getReceipts", payload, &receipts); err != nil {
+		return nil, fmt.Errorf("failed to get receipts: %w", err)
+	}
+	if receipts.Data == nil {
+		receipts.Data = map[string]ExpoNotificationResponse{}
+	}
+
+	return &receipts, nil
+}
+
+func (c *expoClient) post(ctx context.Context, path string, payload []byte, out any) error {
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+path, bytes.NewBuffer(payload))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
@@ -85,23 +145,22 @@ func (c *expoClient) SendNotifications(ctx context.Context, tokens []string, tit
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send notifications: %w", err)
+		return err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return fmt.Errorf("failed to read response: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("expo api error: status %d, body: %s", resp.StatusCode, string(respBody))
+		return fmt.Errorf("expo api error: status %d, body: %s", resp.StatusCode, string(respBody))
 	}
 
-	var expoResp ExpoBulkResponse
-	if err := json.Unmarshal(respBody, &expoResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	return &expoResp, nil
+	return nil
 }
@@ -22,6 +22,7 @@ type FileServiceInterface interface {
 	GetFilesByKeys(ctx context.Context, req models.GetFilesByKeysRequest) (*models.GetFilesByKeysResponse, error)
 	GetFilesByImageIDs(ctx context.Context, imageIDs []uuid.UUID, size models.ImageSize) (map[uuid.UUID]string, error)
 	DeleteImage(ctx context.Context, imageID uuid.UUID) error
+	DeleteFiles(ctx context.Context, fileKeys []string) error
 }
 
 var _ FileServiceInterface = (*FileService)(nil)
@@ -312,6 +313,20 @@ func (f *FileService) DeleteImage(ctx context.Context, imageID uuid.UUID) error
 	return f.imageRepo.DeleteByID(ctx, imageID)
 }
 
+// DeleteFiles removes the objects from S3. Keys that no longer exist are not an error,
+// so a failed run can be retried.
+func (f *FileService) DeleteFiles(ctx context.Context, fileKeys []string) error {
+	for _, fileKey := range fileKeys {
+		if _, err := f.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(f.bucketName),
+			Key:    aws.String(fileKey),
+		}); err != nil {
+			return fmt.Errorf("failed to delete S3 object %s: %w", fileKey, err)
+		}
+	}
+	return nil
+}
+
 // GetFilesByImageIDs retrieves presigned URLs for multiple image IDs at a given size
 func (f *FileService) GetFilesByImageIDs(ctx context.Context, imageIDs []uuid.UUID, size models.ImageSize) (map[uuid.UUID]string, error) {
 	if len(imageIDs) == 0 {
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// InboxServiceInterface merges a user's activity feeds across all of their trips.
+type InboxServiceInterface interface {
+	GetInbox(ctx context.Context, userID uuid.UUID, tripIDs []uuid.UUID, unreadOnly bool, limit int, cursorToken string) (*models.ActivityFeedPageResult, error)
+	GetUnreadCount(ctx context.Context, userID uuid.UUID) (*models.InboxUnreadCountResponse, error)
+	MarkAllRead(ctx context.Context, userID uuid.UUID, tripIDs []uuid.UUID) error
+}
+
+var _ InboxServiceInterface = (*InboxService)(nil)
+
+type InboxService struct {
+	feedRepo          repository.ActivityFeedRepository
+	userRepo          repository.UserRepository
+	membershipService MembershipServiceInterface
+}
+
+func NewInboxService(feedRepo repository.ActivityFeedRepository, userRepo repository.UserRepository, membershipService MembershipServiceInterface) InboxServiceInterface {
+	return &InboxService{
+		feedRepo:          feedRepo,
+		userRepo:          userRepo,
+		membershipService: membershipService,
+	}
+}
+
+// GetInbox returns a page of feed items across the user's trips, newest first.
+// When tripIDs is non-empty only those trips are included.
+func (s *InboxService) GetInbox(ctx context.Context, userID uuid.UUID, tripIDs []uuid.UUID, unreadOnly bool, limit int, cursorToken string) (*models.ActivityFeedPageResult, error) {
+	memberTripIDs, err := s.memberTripIDs(ctx, userID, tripIDs)
+	if err != nil {
+		return nil, err
+	}
+	return getFeedPage(ctx, s.feedRepo, s.userRepo, userID, memberTripIDs, unreadOnly, limit, cursorToken)
+}
+
+// GetUnreadCount returns the total unread badge count with a per-trip breakdown.
+func (s *InboxService) GetUnreadCount(ctx context.Context, userID uuid.UUID) (*models.InboxUnreadCountResponse, error) {
+	memberTripIDs, err := s.memberTripIDs(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.feedRepo.CountUnreadGroupsByTrip(ctx, userID, memberTripIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.InboxUnreadCountResponse{Trips: make([]models.InboxTripUnreadCount, 0, len(counts))}
+	for _, tripID := range memberTripIDs {
+		count, ok := counts[tripID]
+		if !ok {
+			continue
+		}
+		response.UnreadCount += count
+		response.Trips = append(response.Trips, models.InboxTripUnreadCount{TripID: tripID, UnreadCount: count})
+	}
+	return response, nil
+}
+
+// MarkAllRead marks the inbox as read, optionally limited to tripIDs.
+func (s *InboxService) MarkAllRead(ctx context.Context, userID uuid.UUID, tripIDs []uuid.UUID) error {
+	memberTripIDs, err := s.memberTripIDs(ctx, userID, tripIDs)
+	if err != nil {
+		return err
+	}
+	return s.feedRepo.MarkAllRead(ctx, userID, memberTripIDs)
+}
+
+// memberTripIDs returns the trips the user currently belongs to, narrowed to filter
+// when it is non-empty. Feed entries from trips the user has left are never returned.
+func (s *InboxService) memberTripIDs(ctx context.Context, userID uuid.UUID, filter []uuid.UUID) ([]uuid.UUID, error) {
+	memberships, err := s.membershipService.GetUserTrips(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[uuid.UUID]bool, len(filter))
+	for _, tripID := range filter {
+		wanted[tripID] = true
+	}
+
+	tripIDs := make([]uuid.UUID, 0, len(memberships))
+	for _, membership := range memberships {
+		if len(wanted) > 0 && !wanted[membership.TripID] {
+			continue
+		}
+		tripIDs = append(tripIDs, membership.TripID)
+	}
+	return tripIDs, nil
+}
@@ -93,7 +93,13 @@ func isPrivateOrLocalhost(host string) bool {
 		}
 		ip = addrs[0]
 	}
-	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast()
+	return isPrivateIP(ip)
+}
+
+// isPrivateIP reports whether ip is loopback, private, link-local or unspecified, i.e.
+// an address outbound requests on behalf of users must not reach.
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified()
 }
 
 func detectLinkType(u *url.URL) models.LinkType {
@@ -16,15 +16,21 @@ import (
 
 type MembershipServiceInterface interface {
 	AddMember(ctx context.Context, req models.CreateMembershipRequest) (*models.Membership, error)
-	JoinTripByInviteCode(ctx context.Context, userID uuid.UUID, code string) (*models.Membership, error)
+	JoinTripByInviteCode(ctx context.Context, userID uuid.UUID, code string) (*models.JoinTripResult, error)
+	ListJoinRequests(ctx context.Context, tripID, actorID uuid.UUID) ([]*models.TripInviteJoinAPIResponse, error)
+	ApproveJoinRequest(ctx context.Context, tripID, requestID, actorID uuid.UUID) (*models.Membership, error)
+	RejectJoinRequest(ctx context.Context, tripID, requestID, actorID uuid.UUID) error
 	GetMembership(ctx context.Context, tripID, userID uuid.UUID) (*models.MembershipAPIResponse, error)
 	GetTripMembers(ctx context.Context, tripID uuid.UUID, limit int, cursorToken string) (*models.MembershipCursorPageResult, error)
 	GetUserTrips(ctx context.Context, userID uuid.UUID) ([]*models.Membership, error)
 	UpdateMembership(ctx context.Context, userID, tripID uuid.UUID, req models.UpdateMembershipRequest) (*models.Membership, error)
 	UpdateNotificationPreferences(ctx context.Context, userID, tripID uuid.UUID, req models.UpdateNotificationPreferencesRequest) (*models.Membership, error)
-	RemoveMember(ctx context.Context, tripID, userID uuid.UUID) error
-	PromoteToAdmin(ctx context.Context, tripID, userID uuid.UUID) error
-	DemoteFromAdmin(ctx context.Context, tripID, userID uuid.UUID) error
+	RemoveMember(ctx context.Context, tripID, userID, actorID uuid.UUID) error
+	LeaveTrip(ctx context.Context, tripID, userID uuid.UUID, req models.LeaveTripRequest) error
+	TransferOwnership(ctx context.Context, tripID, actorID, newOwnerID uuid.UUID) error
+	UpdateMemberRole(ctx context.Context, tripID, userID, actorID uuid.UUID, role models.TripRole) (*models.Membership, error)
+	PromoteToAdmin(ctx context.Context, tripID, userID, actorID uuid.UUID) error
+	DemoteFromAdmin(ctx context.Context, tripID, userID, actorID uuid.UUID) error
 	IsMember(ctx context.Context, tripID, userID uuid.UUID) (bool, error)
 	IsAdmin(ctx context.Context, tripID, userID uuid.UUID) (bool, error)
 	GetMemberCount(ctx context.Context, tripID uuid.UUID) (int, error)
@@ -62,31 +68,27 @@ func (s *MembershipService) AddMember(ctx context.Context, req models.CreateMemb
 		return nil, errs.BadRequest(errors.New("budget maximum must be greater than or equal to minimum"))
 	}
 
-	existingMembership, err := s.Membership.Find(ctx, req.UserID, req.TripID)
-	if err == nil {
-		return &models.Membership{
-			UserID:            existingMembership.UserID,
-			TripID:            existingMembership.TripID,
-			IsAdmin:           existingMembership.IsAdmin,
-			BudgetMin:         existingMembership.BudgetMin,
-			BudgetMax:         existingMembership.BudgetMax,
-			Availability:      existingMembership.Availability,
-			NotifyNewPitches:  existingMembership.NotifyNewPitches,
-			NotifyNewPolls:    existingMembership.NotifyNewPolls,
-			NotifyNewComments: existingMembership.NotifyNewComments,
-			CreatedAt:         existingMembership.CreatedAt,
-			UpdatedAt:         existingMembership.UpdatedAt,
-		}, nil
-	}
-	if !errors.Is(err, errs.ErrNotFound) {
+	existingMembership, err := s.findExistingMembership(ctx, req.UserID, req.TripID)
+	if err != nil {
 		return nil, err
 	}
+	if existingMembership != nil {
+		return existingMembership, nil
+	}
+
+	role := req.Role
+	if role == "" {
+		role = models.TripRoleMember
+		if req.IsAdmin {
+			role = models.TripRoleOrganiser
+		}
+	}
 
 	// Create membership
 	membership := &models.Membership{
 		UserID:            req.UserID,
 		TripID:            req.TripID,
-		IsAdmin:           req.IsAdmin,
+		Role:              role,
 		BudgetMin:         req.BudgetMin,
 		BudgetMax:         req.BudgetMax,
 		NotifyNewPitches:  true,
@@ -101,9 +103,10 @@ func (s *MembershipService) AddMember(ctx context.Context, req models.CreateMemb
 
 // JoinTripByInviteCode adds the authenticated user to a trip using an invite code.
 // - If the code is invalid -> error
-// - If the invite is expired or revoked -> error
+// - If the invite is expired, revoked or used up -> error
 // - If the user is already a member -> returns existing membership (no error)
-func (s *MembershipService) JoinTripByInviteCode(ctx context.Context, userID uuid.UUID, code string) (*models.Membership, error) {
+// - If the invite requires approval -> returns a pending join request for an admin to decide
+func (s *MembershipService) JoinTripByInviteCode(ctx context.Context, userID uuid.UUID, code string) (*models.JoinTripResult, error) {
 	invite, err := s.TripInvite.FindByCode(ctx, code)
 	if err != nil {
 		if errors.Is(err, errs.ErrNotFound) {
@@ -118,70 +121,221 @@ func (s *MembershipService) JoinTripByInviteCode(ctx context.Context, userID uui
 	}
 
 	// If already a member, return existing membership.
-	existingMembership, err := s.Membership.Find(ctx, userID, invite.TripID)
+	existing, err := s.findExistingMembership(ctx, userID, invite.TripID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &models.JoinTripResult{Membership: existing}, nil
+	}
+
+	if invite.RequiresApproval {
+		return s.requestToJoin(ctx, invite, userID)
+	}
+
+	if err := s.claimInviteUse(ctx, invite); err != nil {
+		return nil, err
+	}
+
+	created, err := s.createBasicMembership(ctx, userID, invite.TripID)
+	if err != nil {
+		s.releaseInviteUse(ctx, invite.ID)
+		// If there was a race and the membership already exists, treat as success.
+		if errors.Is(err, errs.ErrDuplicate) {
+			existing, findErr := s.findExistingMembership(ctx, userID, invite.TripID)
+			if findErr != nil {
+				return nil, findErr
+			}
+			return &models.JoinTripResult{Membership: existing}, nil
+		}
+		return nil, err
+	}
+
+	if _, err := s.TripInvite.CreateJoin(ctx, &models.TripInviteJoin{
+		ID:       uuid.New(),
+		InviteID: invite.ID,
+		TripID:   invite.TripID,
+		UserID:   userID,
+		Status:   models.TripInviteJoinJoined,
+	}); err != nil {
+		log.Printf("Failed to record join through invite %s: %v", invite.ID, err)
+	}
+
+	s.publishMembershipAdded(ctx, created)
+
+	return &models.JoinTripResult{Membership: created}, nil
+}
+
+// requestToJoin records a pending join request, which uses up a slot on the invite
+// until an admin rejects it. Asking again returns the open request.
+func (s *MembershipService) requestToJoin(ctx context.Context, invite *models.TripInvite, userID uuid.UUID) (*models.JoinTripResult, error) {
+	pending, err := s.TripInvite.FindPendingJoin(ctx, invite.TripID, userID)
 	if err == nil {
-		return &models.Membership{
-			UserID:            existingMembership.UserID,
-			TripID:            existingMembership.TripID,
-			IsAdmin:           existingMembership.IsAdmin,
-			BudgetMin:         existingMembership.BudgetMin,
-			BudgetMax:         existingMembership.BudgetMax,
-			Availability:      existingMembership.Availability,
-			NotifyNewPitches:  existingMembership.NotifyNewPitches,
-			NotifyNewPolls:    existingMembership.NotifyNewPolls,
-			NotifyNewComments: existingMembership.NotifyNewComments,
-			CreatedAt:         existingMembership.CreatedAt,
-			UpdatedAt:         existingMembership.UpdatedAt,
-		}, nil
+		return &models.JoinTripResult{Request: pending}, nil
 	}
 	if !errors.Is(err, errs.ErrNotFound) {
 		return nil, err
 	}
 
-	// Not a member yet; create a basic membership.
-	membership := &models.Membership{
-		UserID:            userID,
-		TripID:            invite.TripID,
-		IsAdmin:           false,
-		BudgetMin:         0,
-		BudgetMax:         0,
-		NotifyNewPitches:  true,
-		NotifyNewPolls:    true,
-		NotifyNewComments: true,
-		CreatedAt:         now,
-		UpdatedAt:         now,
+	if err := s.claimInviteUse(ctx, invite); err != nil {
+		return nil, err
 	}
 
-	created, err := s.Membership.Create(ctx, membership)
+	request, err := s.TripInvite.CreateJoin(ctx, &models.TripInviteJoin{
+		ID:       uuid.New(),
+		InviteID: invite.ID,
+		TripID:   invite.TripID,
+		UserID:   userID,
+		Status:   models.TripInviteJoinPending,
+	})
 	if err != nil {
-		// If there was a race and the membership already exists, treat as success.
+		s.releaseInviteUse(ctx, invite.ID)
+		// A concurrent request from the same user won the race; return theirs.
 		if errors.Is(err, errs.ErrDuplicate) {
-			existingMembership, findErr := s.Membership.Find(ctx, userID, invite.TripID)
+			pending, findErr := s.TripInvite.FindPendingJoin(ctx, invite.TripID, userID)
 			if findErr != nil {
 				return nil, findErr
 			}
-			return &models.Membership{
-				UserID:            existingMembership.UserID,
-				TripID:            existingMembership.TripID,
-				IsAdmin:           existingMembership.IsAdmin,
-				BudgetMin:         existingMembership.BudgetMin,
-				BudgetMax:         existingMembership.BudgetMax,
-				Availability:      existingMembership.Availability,
-				NotifyNewPitches:  existingMembership.NotifyNewPitches,
-				NotifyNewPolls:    existingMembership.NotifyNewPolls,
-				NotifyNewComments: existingMembership.NotifyNewComments,
-				CreatedAt:         existingMembership.CreatedAt,
-				UpdatedAt:         existingMembership.UpdatedAt,
-			}, nil
+			return &models.JoinTripResult{Request: pending}, nil
 		}
 		return nil, err
 	}
+	return &models.JoinTripResult{Request: request}, nil
+}
 
-	s.publishMembershipAdded(ctx, created.TripID.String(), userID.String())
+// ListJoinRequests returns the trip's pending join requests.
+func (s *MembershipService) ListJoinRequests(ctx context.Context, tripID, actorID uuid.UUID) ([]*models.TripInviteJoinAPIResponse, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, models.TripPermissionManageInvites); err != nil {
+		return nil, err
+	}
+	return s.TripInvite.FindPendingJoinsByTripID(ctx, tripID)
+}
+
+// ApproveJoinRequest adds the requester to the trip as a member.
+func (s *MembershipService) ApproveJoinRequest(ctx context.Context, tripID, requestID, actorID uuid.UUID) (*models.Membership, error) {
+	request, err := s.decideJoinRequest(ctx, tripID, requestID, actorID, models.TripInviteJoinJoined)
+	if err != nil {
+		return nil, err
+	}
 
+	created, err := s.createBasicMembership(ctx, request.UserID, tripID)
+	if err != nil {
+		if errors.Is(err, errs.ErrDuplicate) {
+			return s.findExistingMembership(ctx, request.UserID, tripID)
+		}
+		return nil, err
+	}
+
+	s.publishMembershipAdded(ctx, created)
 	return created, nil
 }
 
+// RejectJoinRequest declines the request and frees its slot on the invite.
+func (s *MembershipService) RejectJoinRequest(ctx context.Context, tripID, requestID, actorID uuid.UUID) error {
+	request, err := s.decideJoinRequest(ctx, tripID, requestID, actorID, models.TripInviteJoinRejected)
+	if err != nil {
+		return err
+	}
+	s.releaseInviteUse(ctx, request.InviteID)
+	return nil
+}
+
+func (s *MembershipService) decideJoinRequest(ctx context.Context, tripID, requestID, actorID uuid.UUID, status models.TripInviteJoinStatus) (*models.TripInviteJoin, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, models.TripPermissionManageInvites); err != nil {
+		return nil, err
+	}
+
+	request, err := s.TripInvite.FindJoin(ctx, tripID, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != models.TripInviteJoinPending {
+		return nil, errs.BadRequest(errors.New("join request has already been decided"))
+	}
+
+	decided, err := s.TripInvite.DecideJoin(ctx, requestID, status, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !decided {
+		return nil, errs.BadRequest(errors.New("join request has already been decided"))
+	}
+	return request, nil
+}
+
+// claimInviteUse counts a use of the invite, failing once it is used up.
+func (s *MembershipService) claimInviteUse(ctx context.Context, invite *models.TripInvite) error {
+	if invite.IsUsedUp() {
+		return errs.BadRequest(errors.New("invite link has reached its usage limit"))
+	}
+	claimed, err := s.TripInvite.ClaimUse(ctx, invite.ID)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		// Used up, revoked or expired since it was loaded.
+		return errs.BadRequest(errors.New("invite link is no longer valid"))
+	}
+	return nil
+}
+
+func (s *MembershipService) releaseInviteUse(ctx context.Context, inviteID uuid.UUID) {
+	if err := s.TripInvite.ReleaseUse(ctx, inviteID); err != nil {
+		log.Printf("Failed to release use of invite %s: %v", inviteID, err)
+	}
+}
+
+func (s *MembershipService) createBasicMembership(ctx context.Context, userID, tripID uuid.UUID) (*models.Membership, error) {
+	return createBasicMembership(ctx, s.Membership, userID, tripID)
+}
+
+// createBasicMembership adds the user to the trip as a member with the default
+// notification settings.
+func createBasicMembership(ctx context.Context, memberships repository.MembershipRepository, userID, tripID uuid.UUID) (*models.Membership, error) {
+	now := time.Now().UTC()
+	return memberships.Create(ctx, &models.Membership{
+		UserID:            userID,
+		TripID:            tripID,
+		Role:              models.TripRoleMember,
+		BudgetMin:         0,
+		BudgetMax:         0,
+		NotifyNewPitches:  true,
+		NotifyNewPolls:    true,
+		NotifyNewComments: true,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	})
+}
+
+func (s *MembershipService) findExistingMembership(ctx context.Context, userID, tripID uuid.UUID) (*models.Membership, error) {
+	return findExistingMembership(ctx, s.Membership, userID, tripID)
+}
+
+// findExistingMembership returns nil without an error when the user is not a member.
+func findExistingMembership(ctx context.Context, memberships repository.MembershipRepository, userID, tripID uuid.UUID) (*models.Membership, error) {
+	existingMembership, err := memberships.Find(ctx, userID, tripID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &models.Membership{
+		UserID:            existingMembership.UserID,
+		TripID:            existingMembership.TripID,
+		Role:              existingMembership.Role,
+		IsAdmin:           existingMembership.IsAdmin,
+		BudgetMin:         existingMembership.BudgetMin,
+		BudgetMax:         existingMembership.BudgetMax,
+		Availability:      existingMembership.Availability,
+		NotifyNewPitches:  existingMembership.NotifyNewPitches,
+		NotifyNewPolls:    existingMembership.NotifyNewPolls,
+		NotifyNewComments: existingMembership.NotifyNewComments,
+		CreatedAt:         existingMembership.CreatedAt,
+		UpdatedAt:         existingMembership.UpdatedAt,
+	}, nil
+}
+
 func (s *MembershipService) GetMembership(ctx context.Context, tripID, userID uuid.UUID) (*models.MembershipAPIResponse, error) {
 	membership, err := s.Membership.Find(ctx, userID, tripID)
 	if err != nil {
@@ -248,36 +402,218 @@ func (s *MembershipService) UpdateMembership(ctx context.Context, userID, tripID
 	return s.Membership.Update(ctx, userID, tripID, &req)
 }
 
-func (s *MembershipService) RemoveMember(ctx context.Context, tripID, userID uuid.UUID) error {
-	// Check if member exists
-	isMember, err := s.Membership.IsMember(ctx, tripID, userID)
+// RemoveMember removes userID from the trip. Members can always remove themselves;
+// removing others needs the remove_members permission, and only the owner can remove
+// an organiser. When the owner leaves, the longest-standing organiser takes over.
+func (s *MembershipService) RemoveMember(ctx context.Context, tripID, userID, actorID uuid.UUID) error {
+	membership, err := s.Membership.Find(ctx, userID, tripID)
 	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return errs.BadRequest(errors.New("user is not a member of this trip"))
+		}
 		return err
 	}
-	if !isMember {
-		return errors.New("user is not a member of this trip")
+
+	if actorID != userID {
+		if err := s.requireRoleAuthority(ctx, tripID, actorID, membership.Role, models.TripPermissionRemoveMembers); err != nil {
+			return err
+		}
 	}
 
 	// Prevent removing the last admin
+	if membership.Role.IsAdmin() {
+		admins, err := s.Membership.CountAdmins(ctx, tripID)
+		if err != nil {
+			return err
+		}
+		if admins <= 1 {
+			return errs.BadRequest(errors.New("cannot remove the last admin of a trip"))
+		}
+	}
+
+	if membership.Role == models.TripRoleOwner {
+		successor, err := s.longestStandingOrganiser(ctx, tripID)
+		if err != nil {
+			return err
+		}
+		if err := s.Membership.TransferOwnership(ctx, tripID, userID, successor); err != nil {
+			return err
+		}
+	}
+
+	return s.removeMembership(ctx, tripID, userID, actorID)
+}
+
+// LeaveTrip removes userID from the trip. The last admin must name a successor, and a
+// leaving owner hands ownership to the successor or the longest-standing organiser.
+func (s *MembershipService) LeaveTrip(ctx context.Context, tripID, userID uuid.UUID, req models.LeaveTripRequest) error {
 	membership, err := s.Membership.Find(ctx, userID, tripID)
 	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return errs.BadRequest(errors.New("you are not a member of this trip"))
+		}
 		return err
 	}
-	if membership.IsAdmin {
+
+	var successor *models.MembershipDatabaseResponse
+	if req.SuccessorID != nil {
+		if *req.SuccessorID == userID {
+			return errs.BadRequest(errors.New("successor must be another member of the trip"))
+		}
+		successor, err = s.Membership.Find(ctx, *req.SuccessorID, tripID)
+		if err != nil {
+			if errors.Is(err, errs.ErrNotFound) {
+				return errs.BadRequest(errors.New("successor is not a member of this trip"))
+			}
+			return err
+		}
+	}
+
+	if membership.Role.IsAdmin() {
 		admins, err := s.Membership.CountAdmins(ctx, tripID)
 		if err != nil {
 			return err
 		}
+		if admins <= 1 && successor == nil {
+			return errs.BadRequest(errors.New("the last admin must choose a successor before leaving"))
+		}
+	}
+
+	switch {
+	case membership.Role == models.TripRoleOwner:
+		newOwnerID := uuid.Nil
+		if successor != nil {
+			newOwnerID = successor.UserID
+		} else if newOwnerID, err = s.longestStandingOrganiser(ctx, tripID); err != nil {
+			return err
+		}
+		if err := s.TransferOwnership(ctx, tripID, userID, newOwnerID); err != nil {
+			return err
+		}
+	case successor != nil && !successor.Role.IsAdmin():
+		if _, err := s.Membership.UpdateRole(ctx, successor.UserID, tripID, models.TripRoleOrganiser); err != nil {
+			return err
+		}
+		s.publishMembershipEvent(ctx, realtime.EventTopicMembershipUpdated, tripID, successor.UserID, userID, models.TripRoleOrganiser)
+	}
+
+	return s.removeMembership(ctx, tripID, userID, userID)
+}
+
+// TransferOwnership makes newOwnerID the trip's owner; the current owner stays on as
+// an organiser. Owner only.
+func (s *MembershipService) TransferOwnership(ctx context.Context, tripID, actorID, newOwnerID uuid.UUID) error {
+	access, err := requireTripAccess(ctx, s.Membership, tripID, actorID, models.TripPermissionManageRoles)
+	if err != nil {
+		return err
+	}
+	if access.Role != models.TripRoleOwner {
+		return errs.Forbidden()
+	}
+	if newOwnerID == actorID {
+		return errs.BadRequest(errors.New("you already own this trip"))
+	}
+	if _, err := s.Membership.Find(ctx, newOwnerID, tripID); err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return errs.BadRequest(errors.New("new owner must be a member of this trip"))
+		}
+		return err
+	}
+
+	if err := s.Membership.TransferOwnership(ctx, tripID, actorID, newOwnerID); err != nil {
+		return err
+	}
+	s.publishMembershipEvent(ctx, realtime.EventTopicMembershipUpdated, tripID, actorID, actorID, models.TripRoleOrganiser)
+	s.publishMembershipEvent(ctx, realtime.EventTopicMembershipUpdated, tripID, newOwnerID, actorID, models.TripRoleOwner)
+	return nil
+}
+
+// removeMembership deletes the membership and hands the member's pitches and
+// activities to the trip owner, or leaves them without an author, per the trip's
+// departed content policy.
+func (s *MembershipService) removeMembership(ctx context.Context, tripID, userID, actorID uuid.UUID) error {
+	trip, err := s.Trip.Find(ctx, tripID)
+	if err != nil {
+		return err
+	}
+
+	var newAuthorID *uuid.UUID
+	if trip.DepartedContentPolicy != models.DepartedContentAnonymise {
+		newAuthorID, err = s.tripOwner(ctx, tripID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.Membership.DeleteAndHandOverContent(ctx, userID, tripID, newAuthorID); err != nil {
+		return err
+	}
+	s.publishMembershipEvent(ctx, realtime.EventTopicMembershipRemoved, tripID, userID, actorID, "")
+	return nil
+}
+
+// tripOwner returns nil when the trip has no owner.
+func (s *MembershipService) tripOwner(ctx context.Context, tripID uuid.UUID) (*uuid.UUID, error) {
+	members, err := s.Membership.FindByTripID(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if m.Role == models.TripRoleOwner {
+			return &m.UserID, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateMemberRole changes userID's role. Owners can change anyone else's role;
+// organisers can change members' and viewers' roles and step down themselves. The
+// owner's role only changes by transferring ownership.
+func (s *MembershipService) UpdateMemberRole(ctx context.Context, tripID, userID, actorID uuid.UUID, role models.TripRole) (*models.Membership, error) {
+	if role == models.TripRoleOwner {
+		return nil, errs.BadRequest(errors.New("ownership must be transferred, not assigned"))
+	}
+
+	membership, err := s.Membership.Find(ctx, userID, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedRole := membership.Role
+	if actorID == userID {
+		// Stepping down is allowed; stepping up still needs the permission.
+		allowedRole = models.TripRoleMember
+	}
+	if err := s.requireRoleAuthority(ctx, tripID, actorID, allowedRole, models.TripPermissionManageRoles); err != nil {
+		return nil, err
+	}
+
+	if membership.Role == models.TripRoleOwner {
+		return nil, errs.BadRequest(errors.New("the owner's role cannot be changed; transfer ownership instead"))
+	}
+	if membership.Role == role {
+		return s.findExistingMembership(ctx, userID, tripID)
+	}
+
+	if membership.Role.IsAdmin() && !role.IsAdmin() {
+		admins, err := s.Membership.CountAdmins(ctx, tripID)
+		if err != nil {
+			return nil, err
+		}
 		if admins <= 1 {
-			return errs.BadRequest(errors.New("cannot remove the last admin of a trip"))
+			return nil, errs.BadRequest(errors.New("cannot demote the last admin"))
 		}
 	}
 
-	return s.Membership.Delete(ctx, userID, tripID)
+	updated, err := s.Membership.UpdateRole(ctx, userID, tripID, role)
+	if err != nil {
+		return nil, err
+	}
+	s.publishMembershipEvent(ctx, realtime.EventTopicMembershipUpdated, tripID, userID, actorID, role)
+	return updated, nil
 }
 
-func (s *MembershipService) PromoteToAdmin(ctx context.Context, tripID, userID uuid.UUID) error {
-	// Get membership
+func (s *MembershipService) PromoteToAdmin(ctx context.Context, tripID, userID, actorID uuid.UUID) error {
 	membership, err := s.Membership.Find(ctx, userID, tripID)
 	if err != nil {
 		return errors.New("user is not a member of this trip")
@@ -287,18 +623,11 @@ func (s *MembershipService) PromoteToAdmin(ctx context.Context, tripID, userID u
 		return errors.New("user is already an admin")
 	}
 
-	// Update to admin using pointer
-	isAdmin := true
-	updateReq := models.UpdateMembershipRequest{
-		IsAdmin: &isAdmin,
-	}
-
-	_, err = s.Membership.Update(ctx, userID, tripID, &updateReq)
+	_, err = s.UpdateMemberRole(ctx, tripID, userID, actorID, models.TripRoleOrganiser)
 	return err
 }
 
-func (s *MembershipService) DemoteFromAdmin(ctx context.Context, tripID, userID uuid.UUID) error {
-	// Get membership
+func (s *MembershipService) DemoteFromAdmin(ctx context.Context, tripID, userID, actorID uuid.UUID) error {
 	membership, err := s.Membership.Find(ctx, userID, tripID)
 	if err != nil {
 		return errors.New("user is not a member of this trip")
@@ -308,23 +637,41 @@ func (s *MembershipService) DemoteFromAdmin(ctx context.Context, tripID, userID
 		return errors.New("user is not an admin")
 	}
 
-	// Check if this is the last admin
-	admins, err := s.Membership.CountAdmins(ctx, tripID)
+	_, err = s.UpdateMemberRole(ctx, tripID, userID, actorID, models.TripRoleMember)
+	return err
+}
+
+// requireRoleAuthority checks the actor has the permission and outranks a target with
+// targetRole: only the owner can act on organisers, and nobody can act on the owner.
+func (s *MembershipService) requireRoleAuthority(ctx context.Context, tripID, actorID uuid.UUID, targetRole models.TripRole, permission models.TripPermission) error {
+	access, err := requireTripAccess(ctx, s.Membership, tripID, actorID, permission)
 	if err != nil {
 		return err
 	}
-	if admins <= 1 {
-		return errs.BadRequest(errors.New("cannot demote the last admin"))
+	switch targetRole {
+	case models.TripRoleOwner:
+		return errs.Forbidden()
+	case models.TripRoleOrganiser:
+		if access.Role != models.TripRoleOwner {
+			return errs.Forbidden()
+		}
 	}
+	return nil
+}
 
-	// Update to non-admin using pointer
-	isAdmin := false
-	updateReq := models.UpdateMembershipRequest{
-		IsAdmin: &isAdmin,
+// longestStandingOrganiser picks who inherits a trip when its owner leaves.
+func (s *MembershipService) longestStandingOrganiser(ctx context.Context, tripID uuid.UUID) (uuid.UUID, error) {
+	members, err := s.Membership.FindByTripID(ctx, tripID)
+	if err != nil {
+		return uuid.Nil, err
 	}
-
-	_, err = s.Membership.Update(ctx, userID, tripID, &updateReq)
-	return err
+	// Members are ordered newest first.
+	for i := len(members) - 1; i >= 0; i-- {
+		if members[i].Role == models.TripRoleOrganiser {
+			return members[i].UserID, nil
+		}
+	}
+	return uuid.Nil, errs.BadRequest(errors.New("the owner cannot leave without an organiser to take over"))
 }
 
 func (s *MembershipService) IsMember(ctx context.Context, tripID, userID uuid.UUID) (bool, error) {
@@ -366,6 +713,7 @@ func (s *MembershipService) toAPIResponse(ctx context.Context, membership *model
 	return &models.MembershipAPIResponse{
 		UserID:            membership.UserID,
 		TripID:            membership.TripID,
+		Role:              membership.Role,
 		IsAdmin:           membership.IsAdmin,
 		CreatedAt:         membership.CreatedAt,
 		UpdatedAt:         membership.UpdatedAt,
@@ -394,6 +742,7 @@ func (s *MembershipService) convertToAPIMemberships(memberships []*models.Member
 		apiMemberships = append(apiMemberships, &models.MembershipAPIResponse{
 			UserID:            membership.UserID,
 			TripID:            membership.TripID,
+			Role:              membership.Role,
 			IsAdmin:           membership.IsAdmin,
 			CreatedAt:         membership.CreatedAt,
 			UpdatedAt:         membership.UpdatedAt,
@@ -425,16 +774,21 @@ func (s *MembershipService) buildMembershipPageResult(apiMemberships []*models.M
 	return result, nil
 }
 
-func (s *MembershipService) publishMembershipAdded(ctx context.Context, tripID, actorID string) {
-	if s.publisher == nil {
-		return
-	}
-	event, err := realtime.NewEventWithActor(realtime.EventTopicMembershipAdded, tripID, actorID, actorID, "", nil)
-	if err != nil {
-		log.Printf("Failed to create membership.added event: %v", err)
-		return
-	}
-	if err := s.publisher.Publish(ctx, event); err != nil {
-		log.Printf("Failed to publish membership.added event: %v", err)
-	}
+func (s *MembershipService) publishMembershipAdded(ctx context.Context, membership *models.Membership) {
+	s.publishMembershipEvent(ctx, realtime.EventTopicMembershipAdded, membership.TripID, membership.UserID, membership.UserID, membership.Role)
+}
+
+// publishMembershipEvent announces a change to userID's membership made by actorID.
+// Removals pass no role and are published as non-admin.
+func (s *MembershipService) publishMembershipEvent(ctx context.Context, topic realtime.EventTopic, tripID, userID, actorID uuid.UUID, role models.TripRole) {
+	publishMembershipEvent(ctx, s.publisher, topic, tripID, userID, actorID, role)
+}
+
+func publishMembershipEvent(ctx context.Context, publisher realtime.EventPublisher, topic realtime.EventTopic, tripID, userID, actorID uuid.UUID, role models.TripRole) {
+	publishEvent(ctx, publisher, topic, tripID.String(), userID.String(), actorID.String(), "", realtime.MembershipPayload{
+		UserID:  userID,
+		TripID:  tripID,
+		Role:    role,
+		IsAdmin: role.IsAdmin(),
+	})
 }
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var errReportResolved = errors.New("report has already been resolved")
+
+type ModerationServiceInterface interface {
+	BlockUser(ctx context.Context, userID, blockedID uuid.UUID) error
+	UnblockUser(ctx context.Context, userID, blockedID uuid.UUID) error
+	ListBlockedUsers(ctx context.Context, userID uuid.UUID) ([]*models.ContactUser, error)
+	ReportContent(ctx context.Context, tripID, userID uuid.UUID, req models.CreateContentReportRequest) (*models.ContentReport, error)
+	ListReports(ctx context.Context, tripID, actorID uuid.UUID) ([]*models.ModerationQueueItem, error)
+	HideReportedContent(ctx context.Context, tripID, reportID, actorID uuid.UUID) error
+	DismissReport(ctx context.Context, tripID, reportID, actorID uuid.UUID) error
+	UnhideContent(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID, actorID uuid.UUID) error
+}
+
+var _ ModerationServiceInterface = (*ModerationService)(nil)
+
+type ModerationService struct {
+	*repository.Repository
+	publisher realtime.EventPublisher
+}
+
+func NewModerationService(repo *repository.Repository, publisher realtime.EventPublisher) ModerationServiceInterface {
+	return &ModerationService{
+		Repository: repo,
+		publisher:  publisher,
+	}
+}
+
+// BlockUser hides the blocked user's comments and reactions from userID and stops them
+// from sending userID direct invites.
+func (s *ModerationService) BlockUser(ctx context.Context, userID, blockedID uuid.UUID) error {
+	if userID == blockedID {
+		return errs.BadRequest(errors.New("you cannot block yourself"))
+	}
+	if _, err := s.User.Find(ctx, blockedID); err != nil {
+		return err
+	}
+	return s.UserBlock.Block(ctx, userID, blockedID)
+}
+
+func (s *ModerationService) UnblockUser(ctx context.Context, userID, blockedID uuid.UUID) error {
+	return s.UserBlock.Unblock(ctx, userID, blockedID)
+}
+
+func (s *ModerationService) ListBlockedUsers(ctx context.Context, userID uuid.UUID) ([]*models.ContactUser, error) {
+	return s.UserBlock.FindBlockedUsers(ctx, userID)
+}
+
+// ReportContent flags a comment, pitch or activity in the trip for its moderators. Any
+// member can report content other than their own; reporting the same content twice
+// returns the pending report.
+func (s *ModerationService) ReportContent(ctx context.Context, tripID, userID uuid.UUID, req models.CreateContentReportRequest) (*models.ContentReport, error) {
+	isMember, err := s.Membership.IsMember(ctx, tripID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, errs.Forbidden()
+	}
+
+	authorID, err := s.Moderation.FindEntityAuthor(ctx, tripID, req.EntityType, req.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	if authorID != nil && *authorID == userID {
+		return nil, errs.BadRequest(errors.New("you cannot report your own content"))
+	}
+
+	report, _, err := s.Moderation.CreateReport(ctx, &models.ContentReport{
+		TripID:     tripID,
+		EntityType: req.EntityType,
+		EntityID:   req.EntityID,
+		ReportedBy: &userID,
+		Reason:     req.Reason,
+		Details:    req.Details,
+	})
+	return report, err
+}
+
+// ListReports returns the trip's pending reports for moderators.
+func (s *ModerationService) ListReports(ctx context.Context, tripID, actorID uuid.UUID) ([]*models.ModerationQueueItem, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, models.TripPermissionModerateContent); err != nil {
+		return nil, err
+	}
+	return s.Moderation.FindPendingReports(ctx, tripID)
+}
+
+// HideReportedContent hides the reported content from the trip and resolves every
+// pending report on it.
+func (s *ModerationService) HideReportedContent(ctx context.Context, tripID, reportID, actorID uuid.UUID) error {
+	report, err := s.findTripReport(ctx, tripID, reportID, actorID)
+	if err != nil {
+		return err
+	}
+	if report.Status == models.ContentReportDismissed {
+		return errs.BadRequest(errReportResolved)
+	}
+
+	if err := s.Moderation.Hide(ctx, &models.HiddenContent{
+		EntityType: report.EntityType,
+		EntityID:   report.EntityID,
+		TripID:     tripID,
+		HiddenBy:   &actorID,
+	}); err != nil {
+		return err
+	}
+	s.publishVisibilityChanged(ctx, tripID, report.EntityType, report.EntityID, actorID, true)
+	return nil
+}
+
+// DismissReport closes a report without hiding the content.
+func (s *ModerationService) DismissReport(ctx context.Context, tripID, reportID, actorID uuid.UUID) error {
+	if _, err := s.findTripReport(ctx, tripID, reportID, actorID); err != nil {
+		return err
+	}
+	dismissed, err := s.Moderation.DismissReport(ctx, reportID, actorID)
+	if err != nil {
+		return err
+	}
+	if !dismissed {
+		return errs.BadRequest(errReportResolved)
+	}
+	return nil
+}
+
+// UnhideContent shows hidden content in the trip again.
+func (s *ModerationService) UnhideContent(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID, actorID uuid.UUID) error {
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, models.TripPermissionModerateContent); err != nil {
+		return err
+	}
+	if err := s.Moderation.Unhide(ctx, tripID, entityType, entityID); err != nil {
+		return err
+	}
+	s.publishVisibilityChanged(ctx, tripID, entityType, entityID, actorID, false)
+	return nil
+}
+
+// findTripReport checks the actor can moderate the trip and returns the report if it
+// belongs to the trip.
+func (s *ModerationService) findTripReport(ctx context.Context, tripID, reportID, actorID uuid.UUID) (*models.ContentReport, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, models.TripPermissionModerateContent); err != nil {
+		return nil, err
+	}
+	report, err := s.Moderation.FindReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if report.TripID != tripID {
+		return nil, errs.ErrNotFound
+	}
+	return report, nil
+}
+
+func (s *ModerationService) publishVisibilityChanged(ctx context.Context, tripID uuid.UUID, entityType models.EntityType, entityID, actorID uuid.UUID, hidden bool) {
+	publishEvent(ctx, s.publisher, realtime.EventTopicContentVisibilityChanged, tripID.String(), entityID.String(), actorID.String(), "", realtime.ContentVisibilityPayload{
+		TripID:     tripID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		IsHidden:   hidden,
+	})
+}
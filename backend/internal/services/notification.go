@@ -3,96 +3,221 @@ package services
 import (
 	"context"
 	"fmt"
+	"log"
 	"toggo/internal/models"
 	"toggo/internal/repository"
+	"toggo/internal/templates"
 
 	"github.com/google/uuid"
 )
 
-// NotificationService handles sending push notifications
+// NotificationService handles sending notifications over push and any configured
+// email or SMS channels.
 type NotificationService interface {
 	SendNotification(ctx context.Context, req models.SendNotificationRequest) error
 	SendNotificationBatch(ctx context.Context, req models.SendBulkNotificationRequest) (*models.NotificationResponse, error)
-	NotifyTripMembers(ctx context.Context, tripID uuid.UUID, excludeUserID uuid.UUID, preference models.NotificationPreference, title, body string, data map[string]interface{}) error
+	NotifyTripMembers(ctx context.Context, tripID uuid.UUID, excludeUserID uuid.UUID, preference models.NotificationPreference, msg templates.Message, data map[string]interface{}) error
+}
+
+// ReceiptScheduler arranges for Expo delivery receipts to be checked later. Implemented
+// by the Temporal notifications package.
+type ReceiptScheduler interface {
+	ScheduleReceiptCheck(ctx context.Context, ticketIDs []string) error
 }
 
 // expoNotificationService implements NotificationService using Expo API
 type expoNotificationService struct {
-	userRepo       repository.UserRepository
-	membershipRepo repository.MembershipRepository
-	expoClient     ExpoClient
+	deviceRepo       repository.UserDeviceRepository
+	deliveryRepo     repository.NotificationDeliveryRepository
+	preferences      NotificationPreferenceResolver
+	expoClient       ExpoClient
+	receiptScheduler ReceiptScheduler
+	userRepo         repository.UserRepository
+	prefsRepo        repository.NotificationPreferencesRepository
+	digestRepo       repository.NotificationDigestRepository
+	digestScheduler  DigestScheduler
+	channels         []NotificationChannel
 }
 
-func NewNotificationService(userRepo repository.UserRepository, membershipRepo repository.MembershipRepository, expoClient ExpoClient) NotificationService {
+// NotificationServiceConfig wires the notification service. DeliveryRepo and
+// ReceiptScheduler are optional; without them deliveries are not tracked. Quiet hours
+// and digests apply only when UserRepo, PreferencesRepo, DigestRepo and DigestScheduler
+// are all set; otherwise every notification is sent immediately. With PreferencesRepo
+// set, push goes only to users who enabled it; Channels additionally need UserRepo to
+// reach users by email or SMS.
+type NotificationServiceConfig struct {
+	DeviceRepo       repository.UserDeviceRepository
+	DeliveryRepo     repository.NotificationDeliveryRepository
+	Preferences      NotificationPreferenceResolver
+	ExpoClient       ExpoClient
+	ReceiptScheduler ReceiptScheduler
+	UserRepo         repository.UserRepository
+	PreferencesRepo  repository.NotificationPreferencesRepository
+	DigestRepo       repository.NotificationDigestRepository
+	DigestScheduler  DigestScheduler
+	Channels         []NotificationChannel
+}
+
+func NewNotificationService(cfg NotificationServiceConfig) NotificationService {
 	return &expoNotificationService{
-		userRepo:       userRepo,
-		membershipRepo: membershipRepo,
-		expoClient:     expoClient,
+		deviceRepo:       cfg.DeviceRepo,
+		deliveryRepo:     cfg.DeliveryRepo,
+		preferences:      cfg.Preferences,
+		expoClient:       cfg.ExpoClient,
+		receiptScheduler: cfg.ReceiptScheduler,
+		userRepo:         cfg.UserRepo,
+		prefsRepo:        cfg.PreferencesRepo,
+		digestRepo:       cfg.DigestRepo,
+		digestScheduler:  cfg.DigestScheduler,
+		channels:         cfg.Channels,
 	}
 }
 
+// expoBatchSize is the maximum number of messages Expo accepts per request.
+const expoBatchSize = 100
+
+// ExpoDeviceNotRegistered is the ticket or receipt error Expo returns for tokens that
+// can no longer receive notifications (app uninstalled, token rotated).
+const ExpoDeviceNotRegistered = "DeviceNotRegistered"
+
+// SendNotification sends to every device the user has registered and over each other
+// channel they enabled. It fails only if the user could not be reached at all.
 func (s *expoNotificationService) SendNotification(ctx context.Context, req models.SendNotificationRequest) error {
-	users, err := s.userRepo.GetUsersWithDeviceTokens(ctx, []uuid.UUID{req.UserID})
-	if err != nil {
-		return fmt.Errorf("failed to get user: %w", err)
+	pushIDs, channelResp := s.sendToChannels(ctx, []uuid.UUID{req.UserID}, req.Title, req.Body)
+
+	var devices []*models.UserDevice
+	if len(pushIDs) > 0 {
+		var err error
+		devices, err = s.deviceRepo.FindByUserID(ctx, req.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to get user devices: %w", err)
+		}
 	}
 
-	if len(users) == 0 {
-		return fmt.Errorf("user has no device token registered")
+	response := s.sendToDevices(ctx, devices, req.Title, req.Body, req.Data)
+	if response.SuccessCount > 0 || channelResp.SuccessCount > 0 {
+		return nil
+	}
+	if failures := append(response.Errors, channelResp.Errors...); len(failures) > 0 {
+		return fmt.Errorf("failed to send notification: %s", failures[0].Message)
 	}
+	return fmt.Errorf("user has no device token registered")
+}
 
-	user := users[0]
-	if user.DeviceToken == nil || *user.DeviceToken == "" {
-		return fmt.Errorf("user has no device token registered")
+// SendNotificationBatch fans out to every registered device of each user and to their
+// other enabled channels. Counts in the response are per device or channel message.
+func (s *expoNotificationService) SendNotificationBatch(ctx context.Context, req models.SendBulkNotificationRequest) (*models.NotificationResponse, error) {
+	pushIDs, response := s.sendToChannels(ctx, req.UserIDs, req.Title, req.Body)
+	if len(pushIDs) == 0 {
+		return response, nil
 	}
 
-	_, err = s.expoClient.SendNotifications(ctx, []string{*user.DeviceToken}, req.Title, req.Body, req.Data)
+	devices, err := s.deviceRepo.FindByUserIDs(ctx, pushIDs)
 	if err != nil {
-		return fmt.Errorf("failed to send notification: %w", err)
+		return nil, fmt.Errorf("failed to get user devices: %w", err)
 	}
 
-	return nil
+	pushResp := s.sendToDevices(ctx, devices, req.Title, req.Body, req.Data)
+	response.SuccessCount += pushResp.SuccessCount
+	response.FailureCount += pushResp.FailureCount
+	response.Errors = append(response.Errors, pushResp.Errors...)
+	return response, nil
 }
 
-func (s *expoNotificationService) SendNotificationBatch(ctx context.Context, req models.SendBulkNotificationRequest) (*models.NotificationResponse, error) {
-	const batchSize = 100
+// sendToChannels delivers the message over email and SMS to users who enabled and can be
+// reached on each channel, and returns the users who should also get it by push. Without
+// saved preferences a user gets push only. If preferences or users cannot be loaded,
+// everyone falls back to push.
+func (s *expoNotificationService) sendToChannels(ctx context.Context, userIDs []uuid.UUID, title, body string) ([]uuid.UUID, *models.NotificationResponse) {
+	response := &models.NotificationResponse{Errors: []models.NotificationError{}}
+	if s.prefsRepo == nil || len(userIDs) == 0 {
+		return userIDs, response
+	}
 
-	users, err := s.userRepo.GetUsersWithDeviceTokens(ctx, req.UserIDs)
+	saved, err := s.prefsRepo.FindByUserIDs(ctx, userIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get users: %w", err)
+		log.Printf("Failed to load notification channel preferences, using push: %v", err)
+		return userIDs, response
+	}
+	prefsByUser := make(map[uuid.UUID]*models.NotificationPreferences, len(saved))
+	for _, prefs := range saved {
+		prefsByUser[prefs.UserID] = prefs
+	}
+	prefsFor := func(userID uuid.UUID) *models.NotificationPreferences {
+		if prefs, ok := prefsByUser[userID]; ok {
+			return prefs
+		}
+		return models.DefaultNotificationPreferences(userID)
 	}
 
-	var tokens []string
-	tokenToUserID := make(map[string]uuid.UUID)
+	pushIDs := make([]uuid.UUID, 0, len(userIDs))
+	var channelIDs []uuid.UUID
+	for _, userID := range userIDs {
+		prefs := prefsFor(userID)
+		if prefs.PushEnabled {
+			pushIDs = append(pushIDs, userID)
+		}
+		if prefs.EmailEnabled || prefs.SMSEnabled {
+			channelIDs = append(channelIDs, userID)
+		}
+	}
+	if len(s.channels) == 0 || s.userRepo == nil || len(channelIDs) == 0 {
+		return pushIDs, response
+	}
 
+	users, err := s.userRepo.FindByIDs(ctx, channelIDs)
+	if err != nil {
+		log.Printf("Failed to load users for email and SMS notifications: %v", err)
+		return pushIDs, response
+	}
 	for _, user := range users {
-		if user.DeviceToken != nil && *user.DeviceToken != "" {
-			tokens = append(tokens, *user.DeviceToken)
-			tokenToUserID[*user.DeviceToken] = user.ID
+		prefs := prefsFor(user.ID)
+		for _, channel := range s.channels {
+			if !prefs.ChannelEnabled(channel.Channel()) || !channel.Reachable(user) {
+				continue
+			}
+			if err := channel.Send(ctx, user, title, body); err != nil {
+				response.FailureCount++
+				response.Errors = append(response.Errors, models.NotificationError{
+					UserID:  user.ID,
+					Message: fmt.Sprintf("%s: %v", channel.Channel(), err),
+				})
+				continue
+			}
+			response.SuccessCount++
 		}
 	}
+	return pushIDs, response
+}
 
+func (s *expoNotificationService) sendToDevices(ctx context.Context, devices []*models.UserDevice, title, body string, data map[string]interface{}) *models.NotificationResponse {
 	response := &models.NotificationResponse{
 		SuccessCount: 0,
 		FailureCount: 0,
 		Errors:       []models.NotificationError{},
 	}
 
-	for i := 0; i < len(tokens); i += batchSize {
-		end := i + batchSize
-		if end > len(tokens) {
-			end = len(tokens)
+	var deadTokens []string
+	var deliveries []*models.NotificationDelivery
+
+	for i := 0; i < len(devices); i += expoBatchSize {
+		end := i + expoBatchSize
+		if end > len(devices) {
+			end = len(devices)
 		}
 
-		batch := tokens[i:end]
+		batch := devices[i:end]
+		tokens := make([]string, len(batch))
+		for idx, device := range batch {
+			tokens[idx] = device.Token
+		}
 
-		expoResp, err := s.expoClient.SendNotifications(ctx, batch, req.Title, req.Body, req.Data)
+		expoResp, err := s.expoClient.SendNotifications(ctx, tokens, title, body, data)
 		if err != nil {
-			for _, token := range batch {
-				userID := tokenToUserID[token]
+			for _, device := range batch {
 				response.Errors = append(response.Errors, models.NotificationError{
-					UserID:  userID,
-					Token:   token,
+					UserID:  device.UserID,
+					Token:   device.Token,
 					Message: err.Error(),
 				})
 				response.FailureCount++
@@ -105,42 +230,154 @@ func (s *expoNotificationService) SendNotificationBatch(ctx context.Context, req
 				break
 			}
 
-			token := batch[idx]
-			userID := tokenToUserID[token]
+			device := batch[idx]
+			deliveries = append(deliveries, newNotificationDelivery(device, title, ticket))
 
 			if ticket.Status == "ok" {
 				response.SuccessCount++
-			} else {
-				response.FailureCount++
-				response.Errors = append(response.Errors, models.NotificationError{
-					UserID:  userID,
-					Token:   token,
-					Message: ticket.Message,
-				})
+				continue
+			}
+
+			response.FailureCount++
+			response.Errors = append(response.Errors, models.NotificationError{
+				UserID:  device.UserID,
+				Token:   device.Token,
+				Message: ticket.Message,
+			})
+			if ticket.ErrorCode() == ExpoDeviceNotRegistered {
+				deadTokens = append(deadTokens, device.Token)
 			}
 		}
 	}
 
-	return response, nil
+	s.pruneTokens(ctx, deadTokens)
+	s.trackDeliveries(ctx, deliveries)
+	return response
+}
+
+func newNotificationDelivery(device *models.UserDevice, title string, ticket ExpoNotificationResponse) *models.NotificationDelivery {
+	delivery := &models.NotificationDelivery{
+		UserID: device.UserID,
+		Token:  device.Token,
+		Title:  title,
+		Status: models.NotificationDeliveryPending,
+	}
+	if ticket.ID != "" {
+		delivery.TicketID = &ticket.ID
+	}
+	if ticket.Status != "ok" {
+		delivery.Status = models.NotificationDeliveryFailed
+		if code := ticket.ErrorCode(); code != "" {
+			delivery.ErrorCode = &code
+		}
+		if ticket.Message != "" {
+			message := ticket.Message
+			delivery.ErrorMessage = &message
+		}
+	}
+	return delivery
+}
+
+// trackDeliveries records each message and schedules a receipt check for the tickets
+// Expo accepted. Failures are logged; tracking never fails a send.
+func (s *expoNotificationService) trackDeliveries(ctx context.Context, deliveries []*models.NotificationDelivery) {
+	if s.deliveryRepo == nil || len(deliveries) == 0 {
+		return
+	}
+	if err := s.deliveryRepo.CreateMany(ctx, deliveries); err != nil {
+		log.Printf("Failed to record notification deliveries: %v", err)
+		return
+	}
+
+	if s.receiptScheduler == nil {
+		return
+	}
+	var ticketIDs []string
+	for _, delivery := range deliveries {
+		if delivery.Status == models.NotificationDeliveryPending && delivery.TicketID != nil {
+			ticketIDs = append(ticketIDs, *delivery.TicketID)
+		}
+	}
+	if len(ticketIDs) == 0 {
+		return
+	}
+	if err := s.receiptScheduler.ScheduleReceiptCheck(ctx, ticketIDs); err != nil {
+		log.Printf("Failed to schedule push receipt check: %v", err)
+	}
 }
 
-// NotifyTripMembers sends a push notification to all trip members who have the given
-// notification preference enabled, excluding the actor (excludeUserID).
-func (s *expoNotificationService) NotifyTripMembers(ctx context.Context, tripID uuid.UUID, excludeUserID uuid.UUID, preference models.NotificationPreference, title, body string, data map[string]interface{}) error {
-	userIDs, err := s.membershipRepo.FindUserIDsWithNotificationPreference(ctx, tripID, preference, excludeUserID)
+// pruneTokens removes tokens Expo reported as unregistered so they are not retried.
+func (s *expoNotificationService) pruneTokens(ctx context.Context, tokens []string) {
+	if len(tokens) == 0 {
+		return
+	}
+	deleted, err := s.deviceRepo.DeleteByTokens(ctx, tokens)
 	if err != nil {
-		return fmt.Errorf("failed to get members with preference: %w", err)
+		log.Printf("Failed to prune unregistered device tokens: %v", err)
+		return
 	}
+	log.Printf("Pruned %d unregistered device tokens", deleted)
+}
 
+// NotifyTripMembers sends a notification to all trip members whose global and
+// per-trip preferences allow it, excluding the actor (excludeUserID). The message is
+// rendered in each recipient's locale. Members in quiet hours or digest mode receive it
+// later as part of a summary.
+func (s *expoNotificationService) NotifyTripMembers(ctx context.Context, tripID uuid.UUID, excludeUserID uuid.UUID, preference models.NotificationPreference, msg templates.Message, data map[string]interface{}) error {
+	userIDs, err := s.preferences.ResolveRecipients(ctx, models.NotificationAudience{
+		TripID:         tripID,
+		ExcludeUserID:  excludeUserID,
+		Category:       preference.Category(),
+		TripPreference: preference,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resolve notification recipients: %w", err)
+	}
+
+	if preference.Category().Deferrable() {
+		userIDs = s.holdBackRecipients(ctx, userIDs, tripID, preference, msg)
+	}
 	if len(userIDs) == 0 {
 		return nil
 	}
 
-	_, err = s.SendNotificationBatch(ctx, models.SendBulkNotificationRequest{
-		UserIDs: userIDs,
-		Title:   title,
-		Body:    body,
-		Data:    data,
-	})
-	return err
+	for locale, ids := range s.groupByLocale(ctx, userIDs) {
+		title, body := templates.Notification(locale, msg)
+		if _, err := s.SendNotificationBatch(ctx, models.SendBulkNotificationRequest{
+			UserIDs: ids,
+			Title:   title,
+			Body:    body,
+			Data:    data,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groupByLocale splits recipients by their resolved locale. Without a user repository,
+// or if users cannot be loaded, everyone gets the default locale.
+func (s *expoNotificationService) groupByLocale(ctx context.Context, userIDs []uuid.UUID) map[string][]uuid.UUID {
+	if s.userRepo == nil {
+		return map[string][]uuid.UUID{models.DefaultLocale: userIDs}
+	}
+	users, err := s.userRepo.FindByIDs(ctx, userIDs)
+	if err != nil {
+		log.Printf("Failed to load recipient locales, using %s: %v", models.DefaultLocale, err)
+		return map[string][]uuid.UUID{models.DefaultLocale: userIDs}
+	}
+
+	locales := make(map[uuid.UUID]string, len(users))
+	for _, user := range users {
+		locales[user.ID] = templates.ResolveLocale(user.Locale)
+	}
+	groups := make(map[string][]uuid.UUID)
+	for _, id := range userIDs {
+		locale, ok := locales[id]
+		if !ok {
+			locale = models.DefaultLocale
+		}
+		groups[locale] = append(groups[locale], id)
+	}
+	return groups
 }
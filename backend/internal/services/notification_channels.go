@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"sync"
+	"toggo/internal/config"
+	"toggo/internal/models"
+)
+
+// NotificationChannel delivers notifications outside of push, e.g. email or SMS.
+type NotificationChannel interface {
+	Channel() models.NotificationChannel
+	// Reachable reports whether the user has the contact details the channel needs.
+	Reachable(user *models.User) bool
+	Send(ctx context.Context, user *models.User, title, body string) error
+}
+
+// EmailSender sends a plain-text email.
+type EmailSender interface {
+	SendEmail(ctx context.Context, to, subject, body string) error
+}
+
+// SMSSender sends a text message to a phone number.
+type SMSSender interface {
+	SendSMS(ctx context.Context, to, body string) error
+}
+
+// NewNotificationChannels builds the email and SMS channels from configuration. Outside
+// production, unconfigured channels fall back to recording senders that only log.
+func NewNotificationChannels(cfg config.NotificationChannelsConfig, environment string) []NotificationChannel {
+	var channels []NotificationChannel
+
+	if sender := NewEmailSender(cfg.SMTP, environment); sender != nil {
+		channels = append(channels, NewEmailChannel(sender))
+	}
+
+	switch {
+	case cfg.SMS.Enabled():
+		channels = append(channels, NewSMSChannel(NewTwilioSMSSender(cfg.SMS, DefaultHTTPClient())))
+	case environment != "prod":
+		channels = append(channels, NewSMSChannel(&RecordingSMSSender{}))
+	}
+
+	return channels
+}
+
+// NewEmailSender returns the SMTP sender when it is configured, a recording sender
+// outside production, and nil when production has no SMTP relay.
+func NewEmailSender(cfg config.SMTPConfig, environment string) EmailSender {
+	switch {
+	case cfg.Enabled():
+		return NewSMTPEmailSender(cfg)
+	case environment != "prod":
+		return &RecordingEmailSender{}
+	}
+	return nil
+}
+
+type emailChannel struct {
+	sender EmailSender
+}
+
+func NewEmailChannel(sender EmailSender) NotificationChannel {
+	return &emailChannel{sender: sender}
+}
+
+func (c *emailChannel) Channel() models.NotificationChannel {
+	return models.NotificationChannelEmail
+}
+
+// Reachable only accepts verified addresses, so nothing is mailed to an address the
+// user has not proved they own.
+func (c *emailChannel) Reachable(user *models.User) bool {
+	return user.Email != nil && *user.Email != "" && user.EmailVerifiedAt != nil
+}
+
+func (c *emailChannel) Send(ctx context.Context, user *models.User, title, body string) error {
+	return c.sender.SendEmail(ctx, *user.Email, title, body)
+}
+
+type smsChannel struct {
+	sender SMSSender
+}
+
+func NewSMSChannel(sender SMSSender) NotificationChannel {
+	return &smsChannel{sender: sender}
+}
+
+func (c *smsChannel) Channel() models.NotificationChannel {
+	return models.NotificationChannelSMS
+}
+
+func (c *smsChannel) Reachable(user *models.User) bool {
+	return user.PhoneNumber != ""
+}
+
+// Send puts the title on its own line since SMS has no subject.
+func (c *smsChannel) Send(ctx context.Context, user *models.User, title, body string) error {
+	return c.sender.SendSMS(ctx, user.PhoneNumber, title+"\n"+body)
+}
+
+// smtpEmailSender sends email through an SMTP relay.
+type smtpEmailSender struct {
+	cfg config.SMTPConfig
+}
+
+func NewSMTPEmailSender(cfg config.SMTPConfig) EmailSender {
+	return &smtpEmailSender{cfg: cfg}
+}
+
+func (s *smtpEmailSender) SendEmail(_ context.Context, to, subject, body string) error {
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		auth = smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+	}
+
+	msg := strings.Join([]string{
+		"From: " + s.cfg.From,
+		"To: " + to,
+		"Subject: " + mime.QEncoding.Encode("utf-8", subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	addr := net.JoinHostPort(s.cfg.Host, s.cfg.Port)
+	if err := smtp.SendMail(addr, auth, s.cfg.From, []string{to}, []byte(msg)); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
+
+const defaultTwilioBaseURL = "https://api.twilio.com"
+
+// twilioSMSSender sends text messages through the Twilio Messages API, or any provider
+// exposing the same interface at BaseURL.
+type twilioSMSSender struct {
+	cfg        config.SMSConfig
+	httpClient *http.Client
+}
+
+func NewTwilioSMSSender(cfg config.SMSConfig, httpClient *http.Client) SMSSender {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = defaultTwilioBaseURL
+	}
+	return &twilioSMSSender{cfg: cfg, httpClient: httpClient}
+}
+
+func (s *twilioSMSSender) SendSMS(ctx context.Context, to, body string) error {
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", strings.TrimRight(s.cfg.BaseURL, "/"), url.PathEscape(s.cfg.AccountSID))
+	form := url.Values{
+		"To":   {to},
+		"From": {s.cfg.FromNumber},
+		"Body": {body},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create SMS request: %w", err)
+	}
+	req.SetBasicAuth(s.cfg.AccountSID, s.cfg.AuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send SMS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if json.Unmarshal(raw, &apiErr) == nil && apiErr.Message != "" {
+			return fmt.Errorf("SMS provider returned status %d: %s", resp.StatusCode, apiErr.Message)
+		}
+		return fmt.Errorf("SMS provider returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// RecordedEmail is an email captured by RecordingEmailSender.
+type RecordedEmail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// RecordingEmailSender is a local stand-in that logs and keeps every email instead of
+// sending it.
+type RecordingEmailSender struct {
+	mu   sync.Mutex
+	sent []RecordedEmail
+}
+
+func (s *RecordingEmailSender) SendEmail(_ context.Context, to, subject, body string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, RecordedEmail{To: to, Subject: subject, Body: body})
+	log.Printf("Email to %s (not sent): %s", to, subject)
+	return nil
+}
+
+// Sent returns the emails recorded so far.
+func (s *RecordingEmailSender) Sent() []RecordedEmail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RecordedEmail(nil), s.sent...)
+}
+
+// RecordedSMS is a text message captured by RecordingSMSSender.
+type RecordedSMS struct {
+	To   string
+	Body string
+}
+
+// RecordingSMSSender is a local stand-in that logs and keeps every text message instead
+// of sending it.
+type RecordingSMSSender struct {
+	mu   sync.Mutex
+	sent []RecordedSMS
+}
+
+func (s *RecordingSMSSender) SendSMS(_ context.Context, to, body string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, RecordedSMS{To: to, Body: body})
+	log.Printf("SMS to %s (not sent)", to)
+	return nil
+}
+
+// Sent returns the text messages recorded so far.
+func (s *RecordingSMSSender) Sent() []RecordedSMS {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RecordedSMS(nil), s.sent...)
+}
@@ -44,6 +44,10 @@ type MockExpoClient struct {
 	SendNotificationsData     map[string]interface{}
 	SendNotificationsResponse *ExpoBulkResponse
 	SendNotificationsError    error
+	GetReceiptsCalled         bool
+	GetReceiptsTicketIDs      []string
+	GetReceiptsResponse       *ExpoReceiptsResponse
+	GetReceiptsError          error
 }
 
 func (m *MockExpoClient) SendNotifications(ctx context.Context, tokens []string, title string, body string, data map[string]interface{}) (*ExpoBulkResponse, error) {
@@ -67,3 +71,14 @@ func (m *MockExpoClient) SendNotifications(ctx context.Context, tokens []string,
 
 	return &ExpoBulkResponse{Data: tickets}, m.SendNotificationsError
 }
+
+func (m *MockExpoClient) GetReceipts(ctx context.Context, ticketIDs []string) (*ExpoReceiptsResponse, error) {
+	m.GetReceiptsCalled = true
+	m.GetReceiptsTicketIDs = ticketIDs
+
+	if m.GetReceiptsResponse != nil {
+		return m.GetReceiptsResponse, m.GetReceiptsError
+	}
+
+	return &ExpoReceiptsResponse{Data: map[string]ExpoNotificationResponse{}}, m.GetReceiptsError
+}
@@ -32,19 +32,17 @@ func (s *NotificationPreferencesService) GetPreferences(ctx context.Context, use
 }
 
 func (s *NotificationPreferencesService) CreatePreferences(ctx context.Context, userID uuid.UUID, req models.CreateNotificationPreferencesRequest) (*models.NotificationPreferences, error) {
-	prefs := &models.NotificationPreferences{
-		UserID:             userID,
-		PushEnabled:        true,
-		UpcomingTrip:       true,
-		VotingReminders:    true,
-		FinalizedDecisions: true,
-		TripActivity:       true,
-		DeadlineReminders:  true,
-	}
+	prefs := models.DefaultNotificationPreferences(userID)
 
 	if req.PushEnabled != nil {
 		prefs.PushEnabled = *req.PushEnabled
 	}
+	if req.EmailEnabled != nil {
+		prefs.EmailEnabled = *req.EmailEnabled
+	}
+	if req.SMSEnabled != nil {
+		prefs.SMSEnabled = *req.SMSEnabled
+	}
 	if req.UpcomingTrip != nil {
 		prefs.UpcomingTrip = *req.UpcomingTrip
 	}
@@ -60,6 +58,21 @@ func (s *NotificationPreferencesService) CreatePreferences(ctx context.Context,
 	if req.DeadlineReminders != nil {
 		prefs.DeadlineReminders = *req.DeadlineReminders
 	}
+	if req.QuietHoursEnabled != nil {
+		prefs.QuietHoursEnabled = *req.QuietHoursEnabled
+	}
+	if req.QuietHoursStart != nil {
+		prefs.QuietHoursStart = *req.QuietHoursStart
+	}
+	if req.QuietHoursEnd != nil {
+		prefs.QuietHoursEnd = *req.QuietHoursEnd
+	}
+	if req.DigestEnabled != nil {
+		prefs.DigestEnabled = *req.DigestEnabled
+	}
+	if req.DigestTime != nil {
+		prefs.DigestTime = *req.DigestTime
+	}
 
 	return s.NotificationPreferences.Create(ctx, prefs)
 }
@@ -81,15 +94,7 @@ func (s *NotificationPreferencesService) GetOrCreateDefaultPreferences(ctx conte
 		return nil, err
 	}
 
-	prefs := &models.NotificationPreferences{
-		UserID:             userID,
-		PushEnabled:        true,
-		UpcomingTrip:       true,
-		VotingReminders:    true,
-		FinalizedDecisions: true,
-		TripActivity:       true,
-		DeadlineReminders:  true,
-	}
+	prefs := models.DefaultNotificationPreferences(userID)
 
 	return s.NotificationPreferences.Create(ctx, prefs)
 }
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+	"toggo/internal/models"
+	"toggo/internal/templates"
+
+	"github.com/google/uuid"
+)
+
+// DigestScheduler arranges for a user's held-back notifications to be delivered at
+// deliverAt. Implemented by the Temporal notifications package.
+type DigestScheduler interface {
+	ScheduleDigest(ctx context.Context, userID uuid.UUID, deliverAt time.Time) error
+}
+
+// DeferNotificationUntil returns when a non-urgent notification should reach the user,
+// or false if it can be sent now. Digest users receive everything at their digest time;
+// quiet hours push delivery to the end of the quiet window. Clock times are interpreted
+// in the user's timezone, falling back to UTC.
+func DeferNotificationUntil(prefs *models.NotificationPreferences, timezone string, now time.Time) (time.Time, bool) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" {
+		loc = time.UTC
+	}
+	local := now.In(loc)
+
+	at := local
+	deferred := false
+	if prefs.DigestEnabled {
+		if next, ok := nextClockTime(local, prefs.DigestTime); ok {
+			at, deferred = next, true
+		}
+	}
+	if prefs.QuietHoursEnabled && inQuietHours(at, prefs.QuietHoursStart, prefs.QuietHoursEnd) {
+		if next, ok := nextClockTime(at, prefs.QuietHoursEnd); ok {
+			at, deferred = next, true
+		}
+	}
+
+	if !deferred {
+		return time.Time{}, false
+	}
+	return at.UTC(), true
+}
+
+// parseClock parses an "HH:MM" clock time into minutes after midnight.
+func parseClock(clock string) (int, bool) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// inQuietHours reports whether t falls in [start, end). Windows where start is after end
+// wrap past midnight.
+func inQuietHours(t time.Time, start, end string) bool {
+	startMin, ok := parseClock(start)
+	if !ok {
+		return false
+	}
+	endMin, ok := parseClock(end)
+	if !ok || startMin == endMin {
+		return false
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	if startMin < endMin {
+		return minute >= startMin && minute < endMin
+	}
+	return minute >= startMin || minute < endMin
+}
+
+// nextClockTime returns the first occurrence of clock strictly after t, in t's location.
+func nextClockTime(t time.Time, clock string) (time.Time, bool) {
+	minutes, ok := parseClock(clock)
+	if !ok {
+		return time.Time{}, false
+	}
+	next := time.Date(t.Year(), t.Month(), t.Day(), minutes/60, minutes%60, 0, 0, t.Location())
+	if !next.After(t) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next, true
+}
+
+// holdBackRecipients stores a digest item, rendered in the recipient's locale, for every
+// recipient whose quiet hours or digest setting defers the notification and returns the
+// users to notify now. If the items cannot be stored the notification is sent to
+// everyone immediately rather than lost.
+func (s *expoNotificationService) holdBackRecipients(ctx context.Context, userIDs []uuid.UUID, tripID uuid.UUID, kind models.NotificationPreference, msg templates.Message) []uuid.UUID {
+	if s.digestRepo == nil || s.digestScheduler == nil || s.userRepo == nil || s.prefsRepo == nil {
+		return userIDs
+	}
+
+	items, immediate, err := s.planDeliveries(ctx, userIDs, tripID, kind, msg, time.Now())
+	if err != nil {
+		log.Printf("Failed to plan quiet hours for trip %s, sending now: %v", tripID, err)
+		return userIDs
+	}
+	if len(items) == 0 {
+		return immediate
+	}
+
+	if err := s.digestRepo.CreateMany(ctx, items); err != nil {
+		log.Printf("Failed to hold back notifications for trip %s, sending now: %v", tripID, err)
+		return userIDs
+	}
+	for _, item := range items {
+		if err := s.digestScheduler.ScheduleDigest(ctx, item.UserID, item.DeliverAt); err != nil {
+			log.Printf("Failed to schedule notification digest for user %s: %v", item.UserID, err)
+		}
+	}
+	return immediate
+}
+
+func (s *expoNotificationService) planDeliveries(ctx context.Context, userIDs []uuid.UUID, tripID uuid.UUID, kind models.NotificationPreference, msg templates.Message, now time.Time) ([]*models.NotificationDigestItem, []uuid.UUID, error) {
+	users, err := s.userRepo.FindByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load recipients: %w", err)
+	}
+	saved, err := s.prefsRepo.FindByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load notification preferences: %w", err)
+	}
+	prefsByUser := make(map[uuid.UUID]*models.NotificationPreferences, len(saved))
+	for _, prefs := range saved {
+		prefsByUser[prefs.UserID] = prefs
+	}
+
+	var items []*models.NotificationDigestItem
+	immediate := make([]uuid.UUID, 0, len(users))
+	for _, user := range users {
+		prefs, ok := prefsByUser[user.ID]
+		if !ok {
+			prefs = models.DefaultNotificationPreferences(user.ID)
+		}
+
+		deliverAt, deferred := DeferNotificationUntil(prefs, user.Timezone, now)
+		if !deferred {
+			immediate = append(immediate, user.ID)
+			continue
+		}
+		title, body := templates.Notification(user.Locale, msg)
+		items = append(items, &models.NotificationDigestItem{
+			UserID:    user.ID,
+			TripID:    tripID,
+			Kind:      kind,
+			Title:     title,
+			Body:      body,
+			DeliverAt: deliverAt,
+		})
+	}
+	return items, immediate, nil
+}
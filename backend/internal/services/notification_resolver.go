@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPreferenceResolver is the single place that decides whether a user should
+// receive a notification. Every sender resolves its recipients through it.
+type NotificationPreferenceResolver interface {
+	ResolveRecipients(ctx context.Context, audience models.NotificationAudience) ([]uuid.UUID, error)
+}
+
+var _ NotificationPreferenceResolver = (*notificationPreferenceResolver)(nil)
+
+type notificationPreferenceResolver struct {
+	membershipRepo repository.MembershipRepository
+	prefsRepo      repository.NotificationPreferencesRepository
+}
+
+func NewNotificationPreferenceResolver(membershipRepo repository.MembershipRepository, prefsRepo repository.NotificationPreferencesRepository) NotificationPreferenceResolver {
+	return &notificationPreferenceResolver{
+		membershipRepo: membershipRepo,
+		prefsRepo:      prefsRepo,
+	}
+}
+
+// ResolveRecipients returns the users in the audience who should be notified, in the
+// order they were given (or trip membership order when UserIDs is nil). When the
+// audience is tied to a trip, users who are not members are dropped.
+func (r *notificationPreferenceResolver) ResolveRecipients(ctx context.Context, audience models.NotificationAudience) ([]uuid.UUID, error) {
+	var members map[uuid.UUID]*models.MembershipDatabaseResponse
+	candidates := audience.UserIDs
+
+	if audience.TripID != uuid.Nil {
+		memberships, err := r.membershipRepo.FindByTripID(ctx, audience.TripID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get trip members: %w", err)
+		}
+		members = make(map[uuid.UUID]*models.MembershipDatabaseResponse, len(memberships))
+		for _, membership := range memberships {
+			members[membership.UserID] = membership
+		}
+		if candidates == nil {
+			candidates = make([]uuid.UUID, 0, len(memberships))
+			for _, membership := range memberships {
+				candidates = append(candidates, membership.UserID)
+			}
+		}
+	}
+
+	filtered := make([]uuid.UUID, 0, len(candidates))
+	for _, userID := range candidates {
+		if userID != audience.ExcludeUserID {
+			filtered = append(filtered, userID)
+		}
+	}
+	if len(filtered) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	saved, err := r.prefsRepo.FindByUserIDs(ctx, filtered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	prefsByUser := make(map[uuid.UUID]*models.NotificationPreferences, len(saved))
+	for _, prefs := range saved {
+		prefsByUser[prefs.UserID] = prefs
+	}
+
+	recipients := make([]uuid.UUID, 0, len(filtered))
+	for _, userID := range filtered {
+		var membership *models.MembershipDatabaseResponse
+		if members != nil {
+			membership = members[userID]
+			if membership == nil {
+				continue
+			}
+		}
+		if AllowsNotification(prefsByUser[userID], membership, audience.Category, audience.TripPreference) {
+			recipients = append(recipients, userID)
+		}
+	}
+	return recipients, nil
+}
+
+// AllowsNotification combines the user's global preferences, their per-trip membership
+// toggles and the notification category. A nil prefs means the user never saved any and
+// gets the defaults; a nil membership skips the per-trip check.
+func AllowsNotification(prefs *models.NotificationPreferences, membership *models.MembershipDatabaseResponse, category models.NotificationCategory, tripPreference models.NotificationPreference) bool {
+	if prefs == nil {
+		prefs = models.DefaultNotificationPreferences(uuid.Nil)
+	}
+	if !prefs.Allows(category) {
+		return false
+	}
+	if membership != nil && !tripPreference.AllowedBy(membership) {
+		return false
+	}
+	return true
+}
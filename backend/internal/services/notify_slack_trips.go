@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"toggo/internal/config"
+	"toggo/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/slack-go/slack"
+)
+
+// SlackTripNotifierInterface posts a trip's updates to its linked Slack channel.
+type SlackTripNotifierInterface interface {
+	NotifyChannelLinked(ctx context.Context, channelID, tripName string) error
+	NotifyPoll(ctx context.Context, channelID string, poll *models.PollAPIResponse) error
+	UpdatePoll(ctx context.Context, channelID, messageTS string, poll *models.PollAPIResponse) error
+	NotifyPitch(ctx context.Context, channelID, authorName string, pitch *models.PitchAPIResponse) error
+	NotifyDecision(ctx context.Context, channelID, question string, winners []string) error
+	NotifyUser(ctx context.Context, channelID, slackUserID, text string) error
+	LookupUserEmail(ctx context.Context, slackUserID string) (string, error)
+}
+
+var _ SlackTripNotifierInterface = (*SlackNotifier)(nil)
+
+// NewSlackTripNotifier returns nil when no bot token is configured, which disables the
+// trip integration.
+func NewSlackTripNotifier(cfg config.SlackConfig) SlackTripNotifierInterface {
+	if cfg.BotToken == "" {
+		return nil
+	}
+	return NewSlackNotifier(cfg.BotToken)
+}
+
+// slackButtonsPerRow keeps vote buttons readable on mobile; Slack allows 25 per block.
+const slackButtonsPerRow = 5
+
+func (s *SlackNotifier) NotifyChannelLinked(ctx context.Context, channelID, tripName string) error {
+	_, _, err := s.client.PostMessageContext(
+		ctx,
+		channelID,
+		slack.MsgOptionText(fmt.Sprintf("🔗 This channel now gets new polls, pitches and decisions from *%s*.", slackEscape(tripName)), false),
+	)
+	return err
+}
+
+func (s *SlackNotifier) NotifyPoll(ctx context.Context, channelID string, poll *models.PollAPIResponse) error {
+	_, _, err := s.client.PostMessageContext(
+		ctx,
+		channelID,
+		slack.MsgOptionText("New poll: "+poll.Question, false),
+		slack.MsgOptionBlocks(SlackPollBlocks(poll)...),
+	)
+	return err
+}
+
+// UpdatePoll redraws a posted poll with its current vote counts.
+func (s *SlackNotifier) UpdatePoll(ctx context.Context, channelID, messageTS string, poll *models.PollAPIResponse) error {
+	_, _, _, err := s.client.UpdateMessageContext(
+		ctx,
+		channelID,
+		messageTS,
+		slack.MsgOptionText("New poll: "+poll.Question, false),
+		slack.MsgOptionBlocks(SlackPollBlocks(poll)...),
+	)
+	return err
+}
+
+func (s *SlackNotifier) NotifyPitch(ctx context.Context, channelID, authorName string, pitch *models.PitchAPIResponse) error {
+	text := fmt.Sprintf("🎤 *New pitch from %s:* %s", slackEscape(authorName), slackEscape(pitch.Title))
+	if pitch.Description != "" {
+		text += "\n" + slackEscape(pitch.Description)
+	}
+
+	_, _, err := s.client.PostMessageContext(
+		ctx,
+		channelID,
+		slack.MsgOptionText("New pitch: "+pitch.Title, false),
+		slack.MsgOptionBlocks(slackSection(text)),
+	)
+	return err
+}
+
+// NotifyDecision announces a closed poll. Ties list every leading option.
+func (s *SlackNotifier) NotifyDecision(ctx context.Context, channelID, question string, winners []string) error {
+	text := fmt.Sprintf("✅ *Decided:* %s\n", slackEscape(question))
+	switch len(winners) {
+	case 0:
+		text += "Voting closed without any votes."
+	case 1:
+		text += "*" + slackEscape(winners[0]) + "*"
+	default:
+		escaped := make([]string, len(winners))
+		for i, w := range winners {
+			escaped[i] = "*" + slackEscape(w) + "*"
+		}
+		text += "Tied between " + strings.Join(escaped, ", ")
+	}
+
+	_, _, err := s.client.PostMessageContext(
+		ctx,
+		channelID,
+		slack.MsgOptionText("Decided: "+question, false),
+		slack.MsgOptionBlocks(slackSection(text)),
+	)
+	return err
+}
+
+// NotifyUser posts a message only the Slack user can see.
+func (s *SlackNotifier) NotifyUser(ctx context.Context, channelID, slackUserID, text string) error {
+	_, err := s.client.PostEphemeralContext(ctx, channelID, slackUserID, slack.MsgOptionText(text, false))
+	return err
+}
+
+// LookupUserEmail needs the users:read.email scope. Bots and deactivated accounts have
+// no email as far as voting is concerned.
+func (s *SlackNotifier) LookupUserEmail(ctx context.Context, slackUserID string) (string, error) {
+	user, err := s.client.GetUserInfoContext(ctx, slackUserID)
+	if err != nil {
+		return "", err
+	}
+	if user.IsBot || user.Deleted {
+		return "", nil
+	}
+	return user.Profile.Email, nil
+}
+
+// SlackPollBlocks renders a poll with its vote counts. Vote polls get one button per
+// option; rank polls link members back to the app.
+func SlackPollBlocks(poll *models.PollAPIResponse) []slack.Block {
+	var lines []string
+	for _, opt := range poll.Options {
+		lines = append(lines, fmt.Sprintf("• %s — %d", slackEscape(opt.Name), opt.VoteCount))
+	}
+
+	header := "🗳️ *" + slackEscape(poll.Question) + "*"
+	if poll.PollType == models.PollTypeMulti {
+		header += "\n_Pick as many as you like._"
+	}
+	if len(lines) > 0 {
+		header += "\n" + strings.Join(lines, "\n")
+	}
+	blocks := []slack.Block{slackSection(header)}
+
+	if poll.PollType == models.PollTypeRank {
+		return append(blocks, slackContext("Rank the options in the app."))
+	}
+
+	for start := 0; start < len(poll.Options); start += slackButtonsPerRow {
+		end := min(start+slackButtonsPerRow, len(poll.Options))
+		var buttons []slack.BlockElement
+		for _, opt := range poll.Options[start:end] {
+			buttons = append(buttons, slack.NewButtonBlockElement(
+				SlackPollVoteActionID(opt.ID),
+				SlackPollVoteValue(poll.ID, opt.ID),
+				slack.NewTextBlockObject(slack.PlainTextType, truncateRunes(opt.Name, 75), false, false),
+			))
+		}
+		blocks = append(blocks, slack.NewActionBlock("", buttons...))
+	}
+
+	if poll.Deadline != nil {
+		blocks = append(blocks, slackContext(fmt.Sprintf("Voting closes <!date^%d^{date_short_pretty} at {time}|%s>.",
+			poll.Deadline.Unix(), poll.Deadline.UTC().Format("Jan 2 15:04 UTC"))))
+	}
+	return blocks
+}
+
+// SlackPollVoteActionID must be unique per button, so it carries the option ID after
+// models.SlackPollVoteActionID.
+func SlackPollVoteActionID(optionID uuid.UUID) string {
+	return models.SlackPollVoteActionID + ":" + optionID.String()
+}
+
+func SlackPollVoteValue(pollID, optionID uuid.UUID) string {
+	return pollID.String() + ":" + optionID.String()
+}
+
+// ParseSlackPollVoteValue reverses SlackPollVoteValue.
+func ParseSlackPollVoteValue(value string) (pollID, optionID uuid.UUID, err error) {
+	pollPart, optionPart, ok := strings.Cut(value, ":")
+	if !ok {
+		return uuid.Nil, uuid.Nil, errors.New("malformed poll vote value")
+	}
+	if pollID, err = uuid.Parse(pollPart); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if optionID, err = uuid.Parse(optionPart); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return pollID, optionID, nil
+}
+
+func slackSection(text string) slack.Block {
+	return slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil)
+}
+
+func slackContext(text string) slack.Block {
+	return slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, text, false, false))
+}
+
+// slackEscape escapes the characters Slack treats as control sequences in mrkdwn.
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// hasTripPermission reports whether the user's role in the trip grants the permission.
+// Non-members have no permissions, and archived trips grant only archive and delete.
+func hasTripPermission(ctx context.Context, memberships repository.MembershipRepository, tripID, userID uuid.UUID, permission models.TripPermission) (bool, error) {
+	access, err := memberships.FindAccess(ctx, tripID, userID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return access.Can(permission), nil
+}
+
+// requireTripPermission returns Forbidden unless the user's role grants the permission.
+// Members who could act on the trip were it not archived are told it is archived.
+func requireTripPermission(ctx context.Context, memberships repository.MembershipRepository, tripID, userID uuid.UUID, permission models.TripPermission) error {
+	_, err := requireTripAccess(ctx, memberships, tripID, userID, permission)
+	return err
+}
+
+// requireTripAccess is requireTripPermission for callers that also need the member's role.
+func requireTripAccess(ctx context.Context, memberships repository.MembershipRepository, tripID, userID uuid.UUID, permission models.TripPermission) (models.TripAccess, error) {
+	access, err := memberships.FindAccess(ctx, tripID, userID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return models.TripAccess{}, errs.Forbidden()
+		}
+		return models.TripAccess{}, err
+	}
+	if !access.Role.Can(permission) {
+		return models.TripAccess{}, errs.Forbidden()
+	}
+	if !access.Can(permission) {
+		return models.TripAccess{}, errs.ForbiddenReason(errs.ErrTripArchived)
+	}
+	return access, nil
+}
@@ -11,6 +11,7 @@ import (
 	"toggo/internal/errs"
 	"toggo/internal/interfaces"
 	"toggo/internal/models"
+	"toggo/internal/realtime"
 	"toggo/internal/repository"
 	"toggo/internal/utilities/pagination"
 
@@ -31,7 +32,7 @@ type PitchServiceInterface interface {
 	GetByID(ctx context.Context, tripID, pitchID uuid.UUID) (*models.PitchAPIResponse, error)
 	List(ctx context.Context, tripID uuid.UUID, limit int, cursorToken string) (*models.PitchCursorPageResult, error)
 	Update(ctx context.Context, tripID, pitchID, userID uuid.UUID, req models.UpdatePitchRequest) (*models.PitchAPIResponse, error)
-	Delete(ctx context.Context, tripID, pitchID uuid.UUID) error
+	Delete(ctx context.Context, tripID, pitchID, userID uuid.UUID) error
 }
 
 var _ PitchServiceInterface = (*PitchService)(nil)
@@ -46,6 +47,8 @@ type PitchService struct {
 	pitchLinkRepo  repository.PitchLinkRepository
 	tripRepo       repository.TripRepository
 	pollRepo       repository.PollRepository
+	publisher      realtime.EventPublisher
+	slack          SlackTripPoster
 	bucketName     string
 	urlExpiration  time.Duration
 }
@@ -60,8 +63,11 @@ type PitchServiceConfig struct {
 	PitchLinkRepo  repository.PitchLinkRepository
 	TripRepo       repository.TripRepository
 	PollRepo       repository.PollRepository
-	BucketName     string
-	URLExpiration  time.Duration
+	Publisher      realtime.EventPublisher
+	// Slack is optional and mirrors new pitches into the trip's Slack channel.
+	Slack         SlackTripPoster
+	BucketName    string
+	URLExpiration time.Duration
 }
 
 func NewPitchService(cfg PitchServiceConfig) PitchServiceInterface {
@@ -79,6 +85,8 @@ func NewPitchService(cfg PitchServiceConfig) PitchServiceInterface {
 		pitchLinkRepo:  cfg.PitchLinkRepo,
 		tripRepo:       cfg.TripRepo,
 		pollRepo:       cfg.PollRepo,
+		publisher:      cfg.Publisher,
+		slack:          cfg.Slack,
 		bucketName:     cfg.BucketName,
 		urlExpiration:  expiration,
 	}
@@ -102,7 +110,7 @@ func (s *PitchService) Create(ctx context.Context, tripID, userID uuid.UUID, req
 	if trip.PitchDeadline == nil {
 		return nil, errs.BadRequest(errors.New("pitching is not open yet — a deadline must be set first"))
 	}
-	if time.Now().UTC().After(*trip.PitchDeadline) {
+	if trip.PitchingClosedAt != nil || time.Now().UTC().After(*trip.PitchDeadline) {
 		return nil, errs.BadRequest(errors.New("pitching is closed — the deadline has passed"))
 	}
 
@@ -160,6 +168,11 @@ func (s *PitchService) Create(ctx context.Context, tripID, userID uuid.UUID, req
 	}
 	apiPitch := pitchToAPIResponse(created, "", images)
 
+	s.publishPitchEvent(ctx, realtime.EventTopicPitchCreated, tripID, pitchID, userID.String(), apiPitch)
+	if s.slack != nil {
+		go s.slack.PostPitch(context.Background(), &apiPitch)
+	}
+
 	return &models.CreatePitchResponse{
 		Pitch:     apiPitch,
 		UploadURL: presigned.URL,
@@ -417,9 +430,16 @@ func (s *PitchService) Update(ctx context.Context, tripID, pitchID, userID uuid.
 	if err != nil {
 		return nil, err
 	}
+
+	s.publishPitchEvent(ctx, realtime.EventTopicPitchUpdated, tripID, pitchID, userID.String(), resp)
+
 	return &resp, nil
 }
 
+func (s *PitchService) publishPitchEvent(ctx context.Context, topic realtime.EventTopic, tripID, pitchID uuid.UUID, actorID string, data any) {
+	publishEvent(ctx, s.publisher, topic, tripID.String(), pitchID.String(), actorID, "", data)
+}
+
 // mergeUpdatedPitch combines mutable fields from a TripPitch (post-update) with
 // the user-enriched fields from the pre-fetched PitchDatabaseResponse, avoiding
 // an extra DB round-trip after an update.
@@ -465,11 +485,24 @@ func (s *PitchService) addPitchToRankPoll(ctx context.Context, tripID, pitchID u
 }
 
 // Delete removes a pitch by id and trip id.
-func (s *PitchService) Delete(ctx context.Context, tripID, pitchID uuid.UUID) error {
-	return s.pitchRepo.Delete(ctx, pitchID, tripID)
+func (s *PitchService) Delete(ctx context.Context, tripID, pitchID, userID uuid.UUID) error {
+	if err := s.pitchRepo.Delete(ctx, pitchID, tripID); err != nil {
+		return err
+	}
+
+	s.publishPitchEvent(ctx, realtime.EventTopicPitchDeleted, tripID, pitchID, userID.String(), realtime.PitchDeletedPayload{
+		ID:     pitchID,
+		TripID: tripID,
+	})
+	return nil
 }
 
+// presignGetURL returns an empty URL for pitches without audio, such as those whose
+// author deleted their account.
 func (s *PitchService) presignGetURL(ctx context.Context, key string) (string, error) {
+	if key == "" {
+		return "", nil
+	}
 	presigned, err := s.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(s.bucketName),
 		Key:    aws.String(key),
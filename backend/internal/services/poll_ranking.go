@@ -11,6 +11,7 @@ import (
 	"toggo/internal/models"
 	"toggo/internal/realtime"
 	"toggo/internal/repository"
+	"toggo/internal/templates"
 
 	"github.com/google/uuid"
 )
@@ -84,8 +85,7 @@ func (s *RankPollService) notifyNewPoll(tripID uuid.UUID, actorID uuid.UUID) {
 		tripID,
 		actorID,
 		models.NotificationPreferenceNewPoll,
-		"New poll",
-		"A new poll has been created for your trip",
+		templates.Message{Key: templates.NotificationNewPoll},
 		nil,
 	)
 	if err != nil {
@@ -208,6 +208,14 @@ func (s *RankPollService) SubmitRanking(ctx context.Context, tripID uuid.UUID, p
 		return errs.BadRequest(errors.New("cannot submit ranking after deadline"))
 	}
 
+	locked, err := s.votingLocked(ctx, poll)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return errs.BadRequest(errors.New("voting opens when pitching closes"))
+	}
+
 	options, err := s.repository.Poll.FindPollByID(ctx, pollID)
 	if err != nil {
 		return err
@@ -223,11 +231,11 @@ func (s *RankPollService) SubmitRanking(ctx context.Context, tripID uuid.UUID, p
 
 	results, totalVoters := s.getResultsForEvent(ctx, pollID)
 
-	s.pollService.PublishEvent(ctx, realtime.EventTopicPollRankingSubmitted, tripID.String(), map[string]interface{}{
-		"poll_id":      pollID.String(),
-		"user_id":      userID.String(),
-		"total_voters": totalVoters,
-		"top_3":        results,
+	s.pollService.PublishEvent(ctx, realtime.EventTopicPollRankingSubmitted, tripID.String(), realtime.PollRankingSubmittedPayload{
+		PollID:      pollID,
+		UserID:      userID,
+		TotalVoters: totalVoters,
+		Top3:        results,
 	})
 
 	return nil
@@ -259,11 +267,17 @@ func (s *RankPollService) GetRankPollResults(ctx context.Context, tripID uuid.UU
 		return nil, err
 	}
 
+	locked, err := s.votingLocked(ctx, poll)
+	if err != nil {
+		return nil, err
+	}
+
 	return &models.RankPollResultsResponse{
 		PollID:       poll.ID,
 		Question:     poll.Question,
 		PollType:     poll.PollType,
 		Deadline:     poll.Deadline,
+		VotingLocked: locked,
 		CreatedBy:    poll.CreatedBy,
 		CreatedAt:    poll.CreatedAt,
 		TotalVoters:  totalVoters,
@@ -354,13 +368,9 @@ func (s *RankPollService) DeleteRankPoll(ctx context.Context, tripID uuid.UUID,
 	}
 
 	if poll.CreatedBy != userID {
-		isAdmin, err := s.repository.Membership.IsAdmin(ctx, tripID, userID)
-		if err != nil {
+		if err := requireTripPermission(ctx, s.repository.Membership, tripID, userID, models.TripPermissionModerateContent); err != nil {
 			return nil, err
 		}
-		if !isAdmin {
-			return nil, errs.Forbidden()
-		}
 	}
 
 	deleted, err := s.repository.Poll.DeletePoll(ctx, pollID)
@@ -397,6 +407,23 @@ func (s *RankPollService) validateRankPollAccess(ctx context.Context, tripID uui
 	return poll, nil
 }
 
+// votingLocked reports whether the poll is still closed to votes. Voting opens once
+// the trip's pitch deadline passes; the poll's flag is only cleared by the pitch
+// close job, so it is checked against the trip in case that job is late or failed.
+func (s *RankPollService) votingLocked(ctx context.Context, poll *models.Poll) (bool, error) {
+	if !poll.VotingLocked {
+		return false, nil
+	}
+	trip, err := s.repository.Trip.Find(ctx, poll.TripID)
+	if err != nil {
+		return false, err
+	}
+	if trip.PitchingClosedAt != nil || trip.PitchDeadline == nil {
+		return false, nil
+	}
+	return time.Now().UTC().Before(*trip.PitchDeadline), nil
+}
+
 const maxRankingSlots = 3
 
 func (s *RankPollService) validateRanking(rankings []models.RankingItem, options []models.PollOption) error {
@@ -528,6 +555,7 @@ func (s *RankPollService) toRankPollAPIResponse(poll *models.Poll, categoryNames
 		Categories:          categories,
 		IsAnonymous:         poll.IsAnonymous,
 		ShouldNotifyMembers: poll.ShouldNotifyMembers,
+		VotingLocked:        poll.VotingLocked,
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	"toggo/internal/models"
 	"toggo/internal/realtime"
 	"toggo/internal/repository"
+	"toggo/internal/templates"
 	"toggo/internal/utilities/pagination"
 
 	"github.com/google/uuid"
@@ -34,14 +35,17 @@ type PollVotingService struct {
 	repository          *repository.Repository
 	pollService         PollServiceInterface
 	notificationService NotificationService
+	slack               SlackTripPoster
 }
 
 // NewPollVotingService creates a poll voting service with the given repository and event publisher.
-func NewPollVotingService(repo *repository.Repository, pollService PollServiceInterface, notificationService NotificationService) PollVotingServiceInterface {
+// slack may be nil when the Slack integration is not wired in.
+func NewPollVotingService(repo *repository.Repository, pollService PollServiceInterface, notificationService NotificationService, slack SlackTripPoster) PollVotingServiceInterface {
 	return &PollVotingService{
 		repository:          repo,
 		pollService:         pollService,
 		notificationService: notificationService,
+		slack:               slack,
 	}
 }
 
@@ -83,6 +87,9 @@ func (s *PollVotingService) CreateVotePoll(
 
 	s.pollService.PublishEventWithActor(ctx, realtime.EventTopicPollCreated, tripID.String(), created.ID.String(), userID.String(), resp)
 	go s.notifyNewPoll(tripID, userID)
+	if s.slack != nil {
+		go s.slack.PostPoll(context.Background(), resp)
+	}
 
 	return resp, nil
 }
@@ -96,8 +103,7 @@ func (s *PollVotingService) notifyNewPoll(tripID uuid.UUID, actorID uuid.UUID) {
 		tripID,
 		actorID,
 		models.NotificationPreferenceNewPoll,
-		"New poll",
-		"A new poll has been created for your trip",
+		templates.Message{Key: templates.NotificationNewPoll},
 		nil,
 	)
 	if err != nil {
@@ -188,7 +194,7 @@ func (s *PollVotingService) UpdateVotePoll(
 	return resp, nil
 }
 
-// DeletePoll removes a poll. Only the poll creator or a trip admin can delete.
+// DeletePoll removes a poll. Only the poll creator or a member who can moderate content can delete.
 func (s *PollVotingService) DeleteVotePoll(ctx context.Context, tripID, pollID, userID uuid.UUID) (*models.PollAPIResponse, error) {
 	poll, err := s.repository.Poll.FindPollByID(ctx, pollID)
 	if err != nil {
@@ -199,13 +205,9 @@ func (s *PollVotingService) DeleteVotePoll(ctx context.Context, tripID, pollID,
 	}
 
 	if poll.CreatedBy != userID {
-		isAdmin, err := s.repository.Membership.IsAdmin(ctx, tripID, userID)
-		if err != nil {
+		if err := requireTripPermission(ctx, s.repository.Membership, tripID, userID, models.TripPermissionModerateContent); err != nil {
 			return nil, err
 		}
-		if !isAdmin {
-			return nil, errs.Forbidden()
-		}
 	}
 
 	summary, err := s.repository.PollVoting.GetPollVotes(ctx, pollID, userID)
@@ -52,32 +52,12 @@ func NewPollService(repo *repository.Repository, publisher realtime.EventPublish
 
 // PublishEvent publishes a realtime event; failures are logged but never block the caller.
 func (s *PollService) PublishEvent(ctx context.Context, topic realtime.EventTopic, tripID string, data any) {
-	if s.publisher == nil {
-		return
-	}
-	event, err := realtime.NewEvent(topic, tripID, data)
-	if err != nil {
-		log.Printf("Failed to create %s event: %v", topic, err)
-		return
-	}
-	if err := s.publisher.Publish(ctx, event); err != nil {
-		log.Printf("Failed to publish %s event: %v", topic, err)
-	}
+	publishEvent(ctx, s.publisher, topic, tripID, "", "", "", data)
 }
 
 // PublishEventWithActor publishes a realtime event with actor attribution.
 func (s *PollService) PublishEventWithActor(ctx context.Context, topic realtime.EventTopic, tripID, entityID, actorID string, data any) {
-	if s.publisher == nil {
-		return
-	}
-	event, err := realtime.NewEventWithActor(topic, tripID, entityID, actorID, "", data)
-	if err != nil {
-		log.Printf("Failed to create %s event: %v", topic, err)
-		return
-	}
-	if err := s.publisher.Publish(ctx, event); err != nil {
-		log.Printf("Failed to publish %s event: %v", topic, err)
-	}
+	publishEvent(ctx, s.publisher, topic, tripID, entityID, actorID, "", data)
 }
 
 func (s *PollService) ValidateDeadline(deadline *time.Time) error {
@@ -156,6 +156,7 @@ func (s *SearchService) SearchTripMembers(ctx context.Context, tripID uuid.UUID,
 		items = append(items, &models.MembershipAPIResponse{
 			UserID:            row.UserID,
 			TripID:            row.TripID,
+			Role:              row.Role,
 			IsAdmin:           row.IsAdmin,
 			CreatedAt:         row.CreatedAt,
 			UpdatedAt:         row.UpdatedAt,
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type SlackInteractionServiceInterface interface {
+	HandlePollVote(ctx context.Context, vote models.SlackPollVote) error
+}
+
+var _ SlackInteractionServiceInterface = (*SlackInteractionService)(nil)
+
+// SlackInteractionService casts votes pressed on polls posted to Slack. Slack users are
+// matched to trip members by the email address on their Slack profile.
+type SlackInteractionService struct {
+	channelRepo    repository.TripSlackChannelRepository
+	membershipRepo repository.MembershipRepository
+	userRepo       repository.UserRepository
+	pollRepo       repository.PollRepository
+	voting         PollVotingServiceInterface
+	notifier       SlackTripNotifierInterface
+}
+
+type SlackInteractionServiceConfig struct {
+	ChannelRepo    repository.TripSlackChannelRepository
+	MembershipRepo repository.MembershipRepository
+	UserRepo       repository.UserRepository
+	PollRepo       repository.PollRepository
+	Voting         PollVotingServiceInterface
+	Notifier       SlackTripNotifierInterface
+}
+
+func NewSlackInteractionService(cfg SlackInteractionServiceConfig) SlackInteractionServiceInterface {
+	return &SlackInteractionService{
+		channelRepo:    cfg.ChannelRepo,
+		membershipRepo: cfg.MembershipRepo,
+		userRepo:       cfg.UserRepo,
+		pollRepo:       cfg.PollRepo,
+		voting:         cfg.Voting,
+		notifier:       cfg.Notifier,
+	}
+}
+
+// HandlePollVote toggles the pressed option for the voter: single-choice polls switch
+// to it, multi-choice polls add or remove it. Problems the voter can fix are sent to
+// them as an ephemeral message rather than returned.
+func (s *SlackInteractionService) HandlePollVote(ctx context.Context, vote models.SlackPollVote) error {
+	if s.notifier == nil {
+		return errs.BadRequest(errors.New("slack integration is not configured"))
+	}
+
+	poll, err := s.pollRepo.FindPollMetaByID(ctx, vote.PollID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return s.tell(ctx, vote, "This poll has been deleted.")
+		}
+		return err
+	}
+
+	link, err := s.channelRepo.Find(ctx, poll.TripID)
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		return err
+	}
+	if link == nil || link.ChannelID != vote.ChannelID {
+		return s.tell(ctx, vote, "This channel is no longer linked to the trip.")
+	}
+
+	userID, ok, err := s.resolveMember(ctx, vote.SlackUserID, poll.TripID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return s.tell(ctx, vote, "Only trip members can vote here. Make sure your profile uses the same email address as Slack and that you have verified it in the app.")
+	}
+
+	current, err := s.voting.GetVotePoll(ctx, poll.TripID, poll.ID, userID)
+	if err != nil {
+		return err
+	}
+	if current.PollType == models.PollTypeRank {
+		return s.tell(ctx, vote, "Rank polls can only be answered in the app.")
+	}
+
+	optionIDs, optionName, voted := toggledSelection(current, vote.OptionID)
+	updated, err := s.voting.CastVote(ctx, poll.TripID, poll.ID, userID, models.CastVoteRequest{OptionIDs: optionIDs})
+	if err != nil {
+		var apiErr errs.APIError
+		if errors.As(err, &apiErr) {
+			return s.tell(ctx, vote, fmt.Sprintf("Your vote was not counted: %v", apiErr.Message))
+		}
+		return err
+	}
+
+	if err := s.notifier.UpdatePoll(ctx, vote.ChannelID, vote.MessageTS, updated); err != nil {
+		log.Printf("slack: failed to refresh poll %s in channel %s: %v", poll.ID, vote.ChannelID, err)
+	}
+	if voted {
+		return s.tell(ctx, vote, fmt.Sprintf("You voted for %s.", optionName))
+	}
+	return s.tell(ctx, vote, fmt.Sprintf("You removed your vote for %s.", optionName))
+}
+
+// toggledSelection returns the voter's options after pressing optionID, its name, and
+// whether it is now selected.
+func toggledSelection(poll *models.PollAPIResponse, optionID uuid.UUID) ([]uuid.UUID, string, bool) {
+	optionIDs := []uuid.UUID{}
+	var name string
+	var wasVoted bool
+	for _, opt := range poll.Options {
+		if opt.ID == optionID {
+			name = opt.Name
+			wasVoted = opt.Voted
+			continue
+		}
+		if opt.Voted && poll.PollType == models.PollTypeMulti {
+			optionIDs = append(optionIDs, opt.ID)
+		}
+	}
+	if !wasVoted {
+		optionIDs = append(optionIDs, optionID)
+	}
+	return optionIDs, name, !wasVoted
+}
+
+// resolveMember maps a Slack user to a trip member by email. Only an address the member
+// has verified in the app counts, and verified addresses are unique, so typing someone
+// else's Slack email into a profile does not let anyone vote as them.
+func (s *SlackInteractionService) resolveMember(ctx context.Context, slackUserID string, tripID uuid.UUID) (uuid.UUID, bool, error) {
+	email, err := s.notifier.LookupUserEmail(ctx, slackUserID)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("look up slack user %s: %w", slackUserID, err)
+	}
+	if email == "" {
+		return uuid.Nil, false, nil
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return uuid.Nil, false, nil
+		}
+		return uuid.Nil, false, err
+	}
+	if user.EmailVerifiedAt == nil {
+		return uuid.Nil, false, nil
+	}
+
+	isMember, err := s.membershipRepo.IsMember(ctx, tripID, user.ID)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	return user.ID, isMember, nil
+}
+
+func (s *SlackInteractionService) tell(ctx context.Context, vote models.SlackPollVote, text string) error {
+	return s.notifier.NotifyUser(ctx, vote.ChannelID, vote.SlackUserID, text)
+}
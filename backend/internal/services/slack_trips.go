@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// SlackTripPoster mirrors new polls, pitches and finalised decisions into the trip's
+// linked Slack channel. Trips without a linked channel are skipped.
+type SlackTripPoster interface {
+	PostPoll(ctx context.Context, poll *models.PollAPIResponse)
+	PostPitch(ctx context.Context, pitch *models.PitchAPIResponse)
+	PostDecision(ctx context.Context, pollID uuid.UUID) error
+}
+
+type SlackTripServiceInterface interface {
+	SlackTripPoster
+	LinkChannel(ctx context.Context, tripID, userID uuid.UUID, req models.LinkSlackChannelRequest) (*models.TripSlackChannel, error)
+	GetChannel(ctx context.Context, tripID uuid.UUID) (*models.TripSlackChannel, error)
+	UnlinkChannel(ctx context.Context, tripID, userID uuid.UUID) error
+}
+
+var _ SlackTripServiceInterface = (*SlackTripService)(nil)
+
+type SlackTripService struct {
+	channelRepo     repository.TripSlackChannelRepository
+	membershipRepo  repository.MembershipRepository
+	tripRepo        repository.TripRepository
+	userRepo        repository.UserRepository
+	pollRepo        repository.PollRepository
+	pollVotingRepo  repository.PollVotingRepository
+	pollRankingRepo repository.PollRankingRepository
+	notifier        SlackTripNotifierInterface
+}
+
+type SlackTripServiceConfig struct {
+	ChannelRepo     repository.TripSlackChannelRepository
+	MembershipRepo  repository.MembershipRepository
+	TripRepo        repository.TripRepository
+	UserRepo        repository.UserRepository
+	PollRepo        repository.PollRepository
+	PollVotingRepo  repository.PollVotingRepository
+	PollRankingRepo repository.PollRankingRepository
+	// Notifier is nil when no Slack bot token is configured, which disables the integration.
+	Notifier SlackTripNotifierInterface
+}
+
+func NewSlackTripService(cfg SlackTripServiceConfig) SlackTripServiceInterface {
+	return &SlackTripService{
+		channelRepo:     cfg.ChannelRepo,
+		membershipRepo:  cfg.MembershipRepo,
+		tripRepo:        cfg.TripRepo,
+		userRepo:        cfg.UserRepo,
+		pollRepo:        cfg.PollRepo,
+		pollVotingRepo:  cfg.PollVotingRepo,
+		pollRankingRepo: cfg.PollRankingRepo,
+		notifier:        cfg.Notifier,
+	}
+}
+
+// LinkChannel replaces the trip's channel. The confirmation message doubles as a check
+// that the bot has been invited to the channel. Requires edit_trip.
+func (s *SlackTripService) LinkChannel(ctx context.Context, tripID, userID uuid.UUID, req models.LinkSlackChannelRequest) (*models.TripSlackChannel, error) {
+	if err := requireTripPermission(ctx, s.membershipRepo, tripID, userID, models.TripPermissionEditTrip); err != nil {
+		return nil, err
+	}
+	if s.notifier == nil {
+		return nil, errs.BadRequest(errors.New("slack integration is not configured"))
+	}
+
+	trip, err := s.tripRepo.Find(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.notifier.NotifyChannelLinked(ctx, req.ChannelID, trip.Name); err != nil {
+		return nil, errs.BadRequest(fmt.Errorf("cannot post to slack channel %s; invite the app to the channel first: %w", req.ChannelID, err))
+	}
+
+	return s.channelRepo.Upsert(ctx, &models.TripSlackChannel{
+		TripID:    tripID,
+		ChannelID: req.ChannelID,
+		LinkedBy:  &userID,
+	})
+}
+
+func (s *SlackTripService) GetChannel(ctx context.Context, tripID uuid.UUID) (*models.TripSlackChannel, error) {
+	return s.channelRepo.Find(ctx, tripID)
+}
+
+// UnlinkChannel requires edit_trip.
+func (s *SlackTripService) UnlinkChannel(ctx context.Context, tripID, userID uuid.UUID) error {
+	if err := requireTripPermission(ctx, s.membershipRepo, tripID, userID, models.TripPermissionEditTrip); err != nil {
+		return err
+	}
+	return s.channelRepo.Delete(ctx, tripID)
+}
+
+// PostPoll posts the poll with a vote button per option. Failures are logged.
+func (s *SlackTripService) PostPoll(ctx context.Context, poll *models.PollAPIResponse) {
+	channelID, ok := s.linkedChannel(ctx, poll.TripID)
+	if !ok {
+		return
+	}
+	if err := s.notifier.NotifyPoll(ctx, channelID, poll); err != nil {
+		log.Printf("slack: failed to post poll %s to channel %s: %v", poll.ID, channelID, err)
+	}
+}
+
+// PostPitch posts the pitch's title and description. Failures are logged.
+func (s *SlackTripService) PostPitch(ctx context.Context, pitch *models.PitchAPIResponse) {
+	channelID, ok := s.linkedChannel(ctx, pitch.TripID)
+	if !ok {
+		return
+	}
+
+	authorName := pitch.Name
+	if authorName == "" {
+		if author, err := s.userRepo.Find(ctx, pitch.UserID); err == nil {
+			authorName = author.Name
+		}
+	}
+	if err := s.notifier.NotifyPitch(ctx, channelID, authorName, pitch); err != nil {
+		log.Printf("slack: failed to post pitch %s to channel %s: %v", pitch.ID, channelID, err)
+	}
+}
+
+// PostDecision announces the leading option of a closed poll: the most votes for vote
+// polls, the highest Borda score for rank polls.
+func (s *SlackTripService) PostDecision(ctx context.Context, pollID uuid.UUID) error {
+	poll, err := s.pollRepo.FindPollByID(ctx, pollID)
+	if err != nil {
+		return err
+	}
+	channelID, ok := s.linkedChannel(ctx, poll.TripID)
+	if !ok {
+		return nil
+	}
+
+	winners, err := s.leadingOptions(ctx, poll)
+	if err != nil {
+		return err
+	}
+	return s.notifier.NotifyDecision(ctx, channelID, poll.Question, winners)
+}
+
+type scoredOption struct {
+	name  string
+	score int
+}
+
+func (s *SlackTripService) leadingOptions(ctx context.Context, poll *models.Poll) ([]string, error) {
+	var options []scoredOption
+
+	if poll.PollType == models.PollTypeRank {
+		results, err := s.pollRankingRepo.GetAggregatedResults(ctx, poll.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range results {
+			if r.VoteCount > 0 {
+				options = append(options, scoredOption{name: r.Name, score: r.BordaScore})
+			}
+		}
+	} else {
+		summary, err := s.pollVotingRepo.GetPollVotes(ctx, poll.ID, uuid.Nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, opt := range poll.Options {
+			if count := summary.OptionVoteCounts[opt.ID]; count > 0 {
+				options = append(options, scoredOption{name: opt.Name, score: count})
+			}
+		}
+	}
+
+	return topScoring(options), nil
+}
+
+// topScoring returns every option tied for the highest score, in their original order.
+func topScoring(options []scoredOption) []string {
+	best := 0
+	for _, opt := range options {
+		best = max(best, opt.score)
+	}
+	var winners []string
+	for _, opt := range options {
+		if opt.score == best {
+			winners = append(winners, opt.name)
+		}
+	}
+	return winners
+}
+
+func (s *SlackTripService) linkedChannel(ctx context.Context, tripID uuid.UUID) (string, bool) {
+	if s.notifier == nil {
+		return "", false
+	}
+	link, err := s.channelRepo.Find(ctx, tripID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrNotFound) {
+			log.Printf("slack: failed to look up channel for trip %s: %v", tripID, err)
+		}
+		return "", false
+	}
+	return link.ChannelID, true
+}
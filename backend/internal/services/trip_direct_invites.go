@@ -0,0 +1,297 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/templates"
+	"toggo/internal/utilities"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errDirectInviteAnswered = errors.New("invite has already been answered")
+	errInviteeNotFound      = errors.New("user not found")
+)
+
+type TripDirectInviteServiceInterface interface {
+	InviteUser(ctx context.Context, tripID, actorID uuid.UUID, req models.CreateTripDirectInviteRequest) (*models.TripDirectInvite, error)
+	ListTripInvites(ctx context.Context, tripID, actorID uuid.UUID) ([]*models.TripDirectInviteAPIResponse, error)
+	CancelInvite(ctx context.Context, tripID, inviteID, actorID uuid.UUID) error
+	ListReceivedInvites(ctx context.Context, userID uuid.UUID) ([]*models.TripDirectInviteAPIResponse, error)
+	AcceptInvite(ctx context.Context, inviteID, userID uuid.UUID) (*models.Membership, error)
+	DeclineInvite(ctx context.Context, inviteID, userID uuid.UUID) error
+}
+
+var _ TripDirectInviteServiceInterface = (*TripDirectInviteService)(nil)
+
+type TripDirectInviteService struct {
+	*repository.Repository
+	publisher           realtime.EventPublisher
+	notificationService NotificationService
+	preferences         NotificationPreferenceResolver
+}
+
+func NewTripDirectInviteService(repo *repository.Repository, publisher realtime.EventPublisher, notificationService NotificationService, preferences NotificationPreferenceResolver) TripDirectInviteServiceInterface {
+	return &TripDirectInviteService{
+		Repository:          repo,
+		publisher:           publisher,
+		notificationService: notificationService,
+		preferences:         preferences,
+	}
+}
+
+// InviteUser invites a user, found by username or phone number, to the trip. Users who
+// blocked the inviter cannot be found at all. Inviting someone who already has a pending
+// invite returns that invite.
+//
+// Phone number invites always return a nil invite and no error about the invitee, so
+// the endpoint cannot be used to learn whether a number belongs to a user. Numbers of
+// users who opted out of contact discovery are never matched.
+func (s *TripDirectInviteService) InviteUser(ctx context.Context, tripID, actorID uuid.UUID, req models.CreateTripDirectInviteRequest) (*models.TripDirectInvite, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, models.TripPermissionInviteMembers); err != nil {
+		return nil, err
+	}
+
+	if req.Username != nil {
+		invitee, err := s.User.FindByUsername(ctx, strings.ToLower(strings.TrimSpace(*req.Username)))
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, errs.NewAPIError(http.StatusNotFound, errInviteeNotFound)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.invite(ctx, tripID, actorID, invitee)
+	}
+
+	phone, err := utilities.NormalizeUSPhone(*req.PhoneNumber)
+	if err != nil {
+		return nil, errs.InvalidRequestData(map[string]string{"phone_number": err.Error()})
+	}
+	return nil, s.inviteByPhone(ctx, tripID, actorID, phone)
+}
+
+// inviteByPhone invites the user with the phone number, if there is one who can be
+// invited. Outcomes that depend on who owns the number are not reported.
+func (s *TripDirectInviteService) inviteByPhone(ctx context.Context, tripID, actorID uuid.UUID, phone string) error {
+	invitee, err := s.User.FindByPhoneNumber(ctx, phone)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if invitee.PhoneDiscoveryDisabled {
+		return nil
+	}
+
+	_, err = s.invite(ctx, tripID, actorID, invitee)
+	var apiErr errs.APIError
+	if errors.As(err, &apiErr) {
+		return nil
+	}
+	return err
+}
+
+func (s *TripDirectInviteService) invite(ctx context.Context, tripID, actorID uuid.UUID, invitee *models.User) (*models.TripDirectInvite, error) {
+	if invitee.ID == actorID {
+		return nil, errs.BadRequest(errors.New("you cannot invite yourself"))
+	}
+	if err := s.checkNotBlocked(ctx, actorID, invitee.ID); err != nil {
+		return nil, err
+	}
+	isMember, err := s.Membership.IsMember(ctx, tripID, invitee.ID)
+	if err != nil {
+		return nil, err
+	}
+	if isMember {
+		return nil, errs.BadRequest(errors.New("user is already a member of this trip"))
+	}
+
+	invite, created, err := s.TripDirectInvite.CreatePending(ctx, &models.TripDirectInvite{
+		TripID:    tripID,
+		InvitedBy: &actorID,
+		InviteeID: invitee.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		s.notifyInvitee(ctx, invite, invitee, actorID)
+	}
+	return invite, nil
+}
+
+// checkNotBlocked stops invites between users where either has blocked the other. The
+// inviter is not told they have been blocked.
+func (s *TripDirectInviteService) checkNotBlocked(ctx context.Context, inviterID, inviteeID uuid.UUID) error {
+	blocked, err := s.UserBlock.IsBlocked(ctx, inviteeID, inviterID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errs.NewAPIError(http.StatusNotFound, errInviteeNotFound)
+	}
+
+	blocked, err = s.UserBlock.IsBlocked(ctx, inviterID, inviteeID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errs.BadRequest(errors.New("unblock this user to invite them"))
+	}
+	return nil
+}
+
+// notifyInvitee tells the invitee about the invite if their trip activity notifications
+// are on. Failures are logged; the invite has already been created.
+func (s *TripDirectInviteService) notifyInvitee(ctx context.Context, invite *models.TripDirectInvite, invitee *models.User, actorID uuid.UUID) {
+	if s.notificationService == nil || s.preferences == nil {
+		return
+	}
+	// The invitee is not a member yet, so the audience is not tied to the trip.
+	recipients, err := s.preferences.ResolveRecipients(ctx, models.NotificationAudience{
+		UserIDs:  []uuid.UUID{invitee.ID},
+		Category: models.NotificationCategoryTripActivity,
+	})
+	if err != nil {
+		log.Printf("Failed to resolve notification preferences for direct invite %s: %v", invite.ID, err)
+		return
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	trip, err := s.Trip.Find(ctx, invite.TripID)
+	if err != nil {
+		log.Printf("Failed to load trip %s for direct invite: %v", invite.TripID, err)
+		return
+	}
+	inviter, err := s.User.Find(ctx, actorID)
+	if err != nil {
+		log.Printf("Failed to load inviter %s for direct invite: %v", actorID, err)
+		return
+	}
+
+	title, body := templates.Notification(invitee.Locale, templates.Message{
+		Key:  templates.NotificationTripDirectInvite,
+		Data: map[string]any{"InviterName": inviter.Name, "TripName": trip.Name},
+	})
+	if err := s.notificationService.SendNotification(ctx, models.SendNotificationRequest{
+		UserID: invitee.ID,
+		Title:  title,
+		Body:   body,
+		Data: map[string]interface{}{
+			"trip_id":   invite.TripID.String(),
+			"invite_id": invite.ID.String(),
+		},
+	}); err != nil {
+		log.Printf("Failed to notify user %s of direct invite %s: %v", invitee.ID, invite.ID, err)
+	}
+}
+
+// ListTripInvites returns the trip's pending direct invites.
+func (s *TripDirectInviteService) ListTripInvites(ctx context.Context, tripID, actorID uuid.UUID) ([]*models.TripDirectInviteAPIResponse, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, models.TripPermissionManageInvites); err != nil {
+		return nil, err
+	}
+	return s.TripDirectInvite.FindPendingByTripID(ctx, tripID)
+}
+
+// CancelInvite withdraws a pending invite. The inviter can cancel their own invites and
+// members with manage_invites can cancel any.
+func (s *TripDirectInviteService) CancelInvite(ctx context.Context, tripID, inviteID, actorID uuid.UUID) error {
+	invite, err := s.TripDirectInvite.Find(ctx, inviteID)
+	if err != nil {
+		return err
+	}
+	if invite.TripID != tripID {
+		return errs.ErrNotFound
+	}
+	permission := models.TripPermissionManageInvites
+	if invite.InvitedBy != nil && *invite.InvitedBy == actorID {
+		permission = models.TripPermissionInviteMembers
+	}
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, permission); err != nil {
+		return err
+	}
+	return s.respond(ctx, inviteID, models.TripDirectInviteCancelled)
+}
+
+// ListReceivedInvites returns the user's pending invites.
+func (s *TripDirectInviteService) ListReceivedInvites(ctx context.Context, userID uuid.UUID) ([]*models.TripDirectInviteAPIResponse, error) {
+	return s.TripDirectInvite.FindPendingByInviteeID(ctx, userID)
+}
+
+// AcceptInvite adds the invitee to the trip as a member.
+func (s *TripDirectInviteService) AcceptInvite(ctx context.Context, inviteID, userID uuid.UUID) (*models.Membership, error) {
+	invite, err := s.findReceivedInvite(ctx, inviteID, userID)
+	if err != nil {
+		return nil, err
+	}
+	// Invites to deleted trips can no longer be accepted.
+	if _, err := s.Trip.Find(ctx, invite.TripID); err != nil {
+		return nil, err
+	}
+	if err := s.respond(ctx, inviteID, models.TripDirectInviteAccepted); err != nil {
+		return nil, err
+	}
+
+	// The invitee may have joined through an invite link in the meantime.
+	existing, err := findExistingMembership(ctx, s.Membership, userID, invite.TripID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	membership, err := createBasicMembership(ctx, s.Membership, userID, invite.TripID)
+	if err != nil {
+		return nil, err
+	}
+	publishMembershipEvent(ctx, s.publisher, realtime.EventTopicMembershipAdded, membership.TripID, userID, userID, membership.Role)
+	return membership, nil
+}
+
+// DeclineInvite turns the invite down. The inviter can invite the user again later.
+func (s *TripDirectInviteService) DeclineInvite(ctx context.Context, inviteID, userID uuid.UUID) error {
+	if _, err := s.findReceivedInvite(ctx, inviteID, userID); err != nil {
+		return err
+	}
+	return s.respond(ctx, inviteID, models.TripDirectInviteDeclined)
+}
+
+// findReceivedInvite returns the invite if it was sent to userID. Other users' invites
+// are reported as not found.
+func (s *TripDirectInviteService) findReceivedInvite(ctx context.Context, inviteID, userID uuid.UUID) (*models.TripDirectInvite, error) {
+	invite, err := s.TripDirectInvite.Find(ctx, inviteID)
+	if err != nil {
+		return nil, err
+	}
+	if invite.InviteeID != userID {
+		return nil, errs.ErrNotFound
+	}
+	if invite.Status != models.TripDirectInvitePending {
+		return nil, errs.BadRequest(errDirectInviteAnswered)
+	}
+	return invite, nil
+}
+
+func (s *TripDirectInviteService) respond(ctx context.Context, inviteID uuid.UUID, status models.TripDirectInviteStatus) error {
+	responded, err := s.TripDirectInvite.Respond(ctx, inviteID, status)
+	if err != nil {
+		return err
+	}
+	if !responded {
+		return errs.BadRequest(errDirectInviteAnswered)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"toggo/internal/errs"
 	"toggo/internal/models"
+	"toggo/internal/realtime"
 	"toggo/internal/repository"
 
 	"github.com/google/uuid"
@@ -14,7 +15,7 @@ import (
 type PitchLinkServiceInterface interface {
 	AddLink(ctx context.Context, tripID, pitchID, userID uuid.UUID, req models.CreatePitchLinkRequest) (*models.PitchLink, error)
 	GetLinks(ctx context.Context, pitchID uuid.UUID) ([]*models.PitchLink, error)
-	DeleteLink(ctx context.Context, pitchID, linkID uuid.UUID) error
+	DeleteLink(ctx context.Context, tripID, pitchID, linkID, userID uuid.UUID) error
 }
 
 var _ PitchLinkServiceInterface = (*PitchLinkService)(nil)
@@ -23,13 +24,15 @@ type PitchLinkService struct {
 	linkRepo   repository.PitchLinkRepository
 	pitchRepo  repository.PitchRepository
 	linkParser LinkParserServiceInterface
+	publisher  realtime.EventPublisher
 }
 
-func NewPitchLinkService(linkRepo repository.PitchLinkRepository, pitchRepo repository.PitchRepository, linkParser LinkParserServiceInterface) PitchLinkServiceInterface {
+func NewPitchLinkService(linkRepo repository.PitchLinkRepository, pitchRepo repository.PitchRepository, linkParser LinkParserServiceInterface, publisher realtime.EventPublisher) PitchLinkServiceInterface {
 	return &PitchLinkService{
 		linkRepo:   linkRepo,
 		pitchRepo:  pitchRepo,
 		linkParser: linkParser,
+		publisher:  publisher,
 	}
 }
 
@@ -67,13 +70,31 @@ func (s *PitchLinkService) AddLink(ctx context.Context, tripID, pitchID, userID
 		}
 	}
 
-	return s.linkRepo.Create(ctx, link)
+	created, err := s.linkRepo.Create(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishLinkEvent(ctx, realtime.EventTopicPitchLinkAdded, tripID, pitchID, userID, created)
+	return created, nil
 }
 
 func (s *PitchLinkService) GetLinks(ctx context.Context, pitchID uuid.UUID) ([]*models.PitchLink, error) {
 	return s.linkRepo.FindByPitchID(ctx, pitchID)
 }
 
-func (s *PitchLinkService) DeleteLink(ctx context.Context, pitchID, linkID uuid.UUID) error {
-	return s.linkRepo.Delete(ctx, linkID, pitchID)
+func (s *PitchLinkService) DeleteLink(ctx context.Context, tripID, pitchID, linkID, userID uuid.UUID) error {
+	if err := s.linkRepo.Delete(ctx, linkID, pitchID); err != nil {
+		return err
+	}
+
+	s.publishLinkEvent(ctx, realtime.EventTopicPitchLinkRemoved, tripID, pitchID, userID, realtime.PitchLinkRemovedPayload{
+		ID:      linkID,
+		PitchID: pitchID,
+	})
+	return nil
+}
+
+func (s *PitchLinkService) publishLinkEvent(ctx context.Context, topic realtime.EventTopic, tripID, pitchID, actorID uuid.UUID, data any) {
+	publishEvent(ctx, s.publisher, topic, tripID.String(), pitchID.String(), actorID.String(), "", data)
 }
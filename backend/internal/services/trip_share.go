@@ -0,0 +1,384 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	"toggo/internal/config"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxSharedActivities bounds how many activities the public share page lists.
+	maxSharedActivities = 100
+	// maxSharedPolls bounds how many recent polls are checked for final results.
+	maxSharedPolls = 50
+	// maxItineraryRangeDays stops a long date range from filling the itinerary.
+	maxItineraryRangeDays = 31
+)
+
+type TripShareServiceInterface interface {
+	GetShareLink(ctx context.Context, tripID, userID uuid.UUID) (*models.TripShareLinkAPIResponse, error)
+	EnableShareLink(ctx context.Context, tripID, userID uuid.UUID, req models.UpdateTripShareLinkRequest) (*models.TripShareLinkAPIResponse, error)
+	RotateShareLink(ctx context.Context, tripID, userID uuid.UUID) (*models.TripShareLinkAPIResponse, error)
+	DisableShareLink(ctx context.Context, tripID, userID uuid.UUID) error
+	GetTripSharePageData(ctx context.Context, token string) (*models.TripSharePageData, error)
+}
+
+var _ TripShareServiceInterface = (*TripShareService)(nil)
+
+type TripShareService struct {
+	*repository.Repository
+	fileService FileServiceInterface
+	publicURL   string
+}
+
+func NewTripShareService(repo *repository.Repository, fileService FileServiceInterface, appConfig config.AppConfig) TripShareServiceInterface {
+	return &TripShareService{
+		Repository:  repo,
+		fileService: fileService,
+		publicURL:   appConfig.PublicURL,
+	}
+}
+
+// GetShareLink returns the trip's share link. Like changing the link it needs the
+// edit_trip permission, but it can still be read while the trip is archived.
+func (s *TripShareService) GetShareLink(ctx context.Context, tripID, userID uuid.UUID) (*models.TripShareLinkAPIResponse, error) {
+	access, err := s.Membership.FindAccess(ctx, tripID, userID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, errs.Forbidden()
+		}
+		return nil, err
+	}
+	if !access.Role.Can(models.TripPermissionEditTrip) {
+		return nil, errs.Forbidden()
+	}
+
+	link, err := s.TripShareLink.Find(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toAPIResponse(link), nil
+}
+
+// EnableShareLink creates the trip's share link, or changes which tabs an existing
+// link shows while keeping its token. Requires the edit_trip permission.
+func (s *TripShareService) EnableShareLink(ctx context.Context, tripID, userID uuid.UUID, req models.UpdateTripShareLinkRequest) (*models.TripShareLinkAPIResponse, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionEditTrip); err != nil {
+		return nil, err
+	}
+
+	link, err := s.TripShareLink.Find(ctx, tripID)
+	switch {
+	case errors.Is(err, errs.ErrNotFound):
+		token, err := generateShareToken()
+		if err != nil {
+			return nil, err
+		}
+		link = &models.TripShareLink{
+			TripID:      tripID,
+			Token:       token,
+			VisibleTabs: models.TripShareTabs,
+			CreatedBy:   &userID,
+		}
+	case err != nil:
+		return nil, err
+	}
+	if req.VisibleTabs != nil {
+		link.VisibleTabs = *req.VisibleTabs
+	}
+
+	link, err = s.TripShareLink.Upsert(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+	return s.toAPIResponse(link), nil
+}
+
+// RotateShareLink replaces the token so the old link stops working. Requires the
+// edit_trip permission.
+func (s *TripShareService) RotateShareLink(ctx context.Context, tripID, userID uuid.UUID) (*models.TripShareLinkAPIResponse, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionEditTrip); err != nil {
+		return nil, err
+	}
+
+	link, err := s.TripShareLink.Find(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if link.Token, err = generateShareToken(); err != nil {
+		return nil, err
+	}
+
+	link, err = s.TripShareLink.Upsert(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+	return s.toAPIResponse(link), nil
+}
+
+// DisableShareLink requires the edit_trip permission.
+func (s *TripShareService) DisableShareLink(ctx context.Context, tripID, userID uuid.UUID) error {
+	if err := requireTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionEditTrip); err != nil {
+		return err
+	}
+	return s.TripShareLink.Delete(ctx, tripID)
+}
+
+// GetTripSharePageData loads the read-only view of a shared trip. Unknown tokens
+// render an error message rather than failing the page.
+func (s *TripShareService) GetTripSharePageData(ctx context.Context, token string) (*models.TripSharePageData, error) {
+	data := &models.TripSharePageData{}
+
+	link, err := s.TripShareLink.FindByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			data.ErrorMessage = "This share link is invalid or has been turned off."
+			return data, nil
+		}
+		return nil, err
+	}
+
+	trip, err := s.Trip.FindWithCoverImage(ctx, link.TripID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			data.ErrorMessage = "This trip no longer exists."
+			return data, nil
+		}
+		return nil, err
+	}
+
+	data.TripName = trip.Name
+	if trip.Location != nil {
+		data.Location = *trip.Location
+	}
+	data.Dates = formatTripDates(trip.StartDate, trip.EndDate)
+	data.CanonicalURL = s.shareURL(token)
+	if trip.CoverImageID != nil {
+		if fileResp, err := s.fileService.GetFile(ctx, *trip.CoverImageID, models.ImageSizeMedium); err == nil {
+			data.CoverImageURL = &fileResp.URL
+		}
+	}
+	if data.MemberCount, err = s.Membership.CountMembers(ctx, link.TripID); err != nil {
+		return nil, err
+	}
+
+	data.ShowItinerary = link.ShowsTab(models.TripShareTabItinerary)
+	data.ShowActivities = link.ShowsTab(models.TripShareTabActivities)
+	data.ShowPolls = link.ShowsTab(models.TripShareTabPolls)
+
+	if data.ShowItinerary || data.ShowActivities {
+		activities, _, err := s.Activity.FindByTripID(ctx, link.TripID, nil, maxSharedActivities)
+		if err != nil {
+			return nil, err
+		}
+		if data.ShowItinerary {
+			data.Itinerary = buildSharedItinerary(activities)
+		}
+		if data.ShowActivities {
+			for _, a := range activities {
+				data.Activities = append(data.Activities, toSharedActivity(a))
+			}
+		}
+	}
+
+	if data.ShowPolls {
+		if data.Polls, err = s.finalPollResults(ctx, link.TripID); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// finalPollResults returns the outcome of each recent poll whose deadline has passed.
+func (s *TripShareService) finalPollResults(ctx context.Context, tripID uuid.UUID) ([]models.TripSharePollResult, error) {
+	polls, _, err := s.Poll.FindPollsByTripIDWithCursor(ctx, tripID, maxSharedPolls, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var closed []*models.Poll
+	var votePollIDs []uuid.UUID
+	for _, poll := range polls {
+		if !poll.IsDeadlinePassed() {
+			continue
+		}
+		closed = append(closed, poll)
+		if poll.PollType != models.PollTypeRank {
+			votePollIDs = append(votePollIDs, poll.ID)
+		}
+	}
+
+	summaries, err := s.PollVoting.GetPollsVotes(ctx, votePollIDs, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]models.TripSharePollResult, 0, len(closed))
+	for _, poll := range closed {
+		var options []scoredOption
+		if poll.PollType == models.PollTypeRank {
+			ranked, err := s.PollRanking.GetAggregatedResults(ctx, poll.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range ranked {
+				if r.VoteCount > 0 {
+					options = append(options, scoredOption{name: r.Name, score: r.BordaScore})
+				}
+			}
+		} else {
+			for _, opt := range poll.Options {
+				options = append(options, scoredOption{name: opt.Name, score: summaries[poll.ID].OptionVoteCounts[opt.ID]})
+			}
+		}
+
+		results = append(results, toSharedPollResult(poll.Question, options))
+	}
+	return results, nil
+}
+
+// toSharedPollResult marks every option tied for the most votes, or the highest Borda
+// score, as a winner. Polls nobody voted on have no winners.
+func toSharedPollResult(question string, options []scoredOption) models.TripSharePollResult {
+	result := models.TripSharePollResult{Question: question}
+	best := 0
+	for _, opt := range options {
+		best = max(best, opt.score)
+	}
+	for _, opt := range options {
+		isWinner := best > 0 && opt.score == best
+		result.Options = append(result.Options, models.TripSharePollOption{Name: opt.name, Score: opt.score, IsWinner: isWinner})
+		if isWinner {
+			result.Winners = append(result.Winners, opt.name)
+		}
+	}
+	return result
+}
+
+func (s *TripShareService) toAPIResponse(link *models.TripShareLink) *models.TripShareLinkAPIResponse {
+	return &models.TripShareLinkAPIResponse{TripShareLink: link, URL: s.shareURL(link.Token)}
+}
+
+func (s *TripShareService) shareURL(token string) string {
+	if s.publicURL == "" {
+		return ""
+	}
+	return strings.TrimRight(s.publicURL, "/") + "/share/" + token
+}
+
+// generateShareToken returns a 32-character token. Share links grant access without
+// signing in, so they are longer than invite codes.
+func generateShareToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func formatTripDates(start, end *time.Time) string {
+	switch {
+	case start == nil:
+		return ""
+	case end == nil || end.Equal(*start):
+		return start.Format("Jan 2, 2006")
+	case start.Year() == end.Year():
+		return start.Format("Jan 2") + " – " + end.Format("Jan 2, 2006")
+	default:
+		return start.Format("Jan 2, 2006") + " – " + end.Format("Jan 2, 2006")
+	}
+}
+
+// buildSharedItinerary groups dated activities by day, ordering each day by time of
+// day with untimed activities last.
+func buildSharedItinerary(activities []*models.ActivityDatabaseResponse) []models.TripShareDay {
+	byDate := make(map[string][]*models.ActivityDatabaseResponse)
+	for _, a := range activities {
+		if a.Dates == nil {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, r := range *a.Dates {
+			start, err := time.Parse(time.DateOnly, r.Start)
+			if err != nil {
+				continue
+			}
+			end, err := time.Parse(time.DateOnly, r.End)
+			if err != nil || end.Before(start) {
+				end = start
+			}
+			for day, n := start, 0; !day.After(end) && n < maxItineraryRangeDays; day, n = day.AddDate(0, 0, 1), n+1 {
+				key := day.Format(time.DateOnly)
+				if !seen[key] {
+					seen[key] = true
+					byDate[key] = append(byDate[key], a)
+				}
+			}
+		}
+	}
+
+	dates := make([]string, 0, len(byDate))
+	for date := range byDate {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	days := make([]models.TripShareDay, 0, len(dates))
+	for _, date := range dates {
+		dayActivities := byDate[date]
+		sort.SliceStable(dayActivities, func(i, j int) bool {
+			return timeOfDayOrder(dayActivities[i].TimeOfDay) < timeOfDayOrder(dayActivities[j].TimeOfDay)
+		})
+		day, _ := time.Parse(time.DateOnly, date)
+		shareDay := models.TripShareDay{Label: day.Format("Monday, Jan 2")}
+		for _, a := range dayActivities {
+			shareDay.Activities = append(shareDay.Activities, toSharedActivity(a))
+		}
+		days = append(days, shareDay)
+	}
+	return days
+}
+
+func timeOfDayOrder(t *models.ActivityTimeOfDay) int {
+	if t == nil {
+		return 3
+	}
+	switch *t {
+	case models.ActivityTimeOfDayMorning:
+		return 0
+	case models.ActivityTimeOfDayAfternoon:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// toSharedActivity keeps only what the public page shows; proposers and attendees are
+// left out.
+func toSharedActivity(a *models.ActivityDatabaseResponse) models.TripShareActivity {
+	shared := models.TripShareActivity{Name: a.Name}
+	if a.Description != nil {
+		shared.Description = *a.Description
+	}
+	if a.LocationName != nil {
+		shared.LocationName = *a.LocationName
+	}
+	if a.TimeOfDay != nil {
+		shared.TimeOfDay = string(*a.TimeOfDay)
+	}
+	if a.ThumbnailURL != nil {
+		shared.ThumbnailURL = *a.ThumbnailURL
+	}
+	return shared
+}
@@ -0,0 +1,367 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/templates"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errTaskAssigneeNotMember = errors.New("assignees must be trip members who can contribute")
+	errTaskActivityNotFound  = errors.New("activity not found in this trip")
+)
+
+// TaskReminderScheduler reminds a task's assignees shortly before it is due, replacing
+// any reminder previously scheduled for the task.
+type TaskReminderScheduler interface {
+	ScheduleTaskReminder(ctx context.Context, taskID, tripID uuid.UUID, dueAt time.Time) error
+	CancelTaskReminder(ctx context.Context, taskID uuid.UUID) error
+}
+
+type TripTaskServiceInterface interface {
+	CreateTask(ctx context.Context, tripID, actorID uuid.UUID, req models.CreateTripTaskRequest) (*models.TripTask, error)
+	ListTasks(ctx context.Context, tripID, userID uuid.UUID, params models.TripTaskQueryParams) ([]*models.TripTask, error)
+	GetTask(ctx context.Context, tripID, taskID, userID uuid.UUID) (*models.TripTask, error)
+	UpdateTask(ctx context.Context, tripID, taskID, actorID uuid.UUID, req models.UpdateTripTaskRequest) (*models.TripTask, error)
+	DeleteTask(ctx context.Context, tripID, taskID, actorID uuid.UUID) error
+}
+
+var _ TripTaskServiceInterface = (*TripTaskService)(nil)
+
+type TripTaskService struct {
+	*repository.Repository
+	publisher           realtime.EventPublisher
+	notificationService NotificationService
+	reminderScheduler   TaskReminderScheduler
+	preferences         NotificationPreferenceResolver
+}
+
+func NewTripTaskService(repo *repository.Repository, publisher realtime.EventPublisher, notificationService NotificationService, reminderScheduler TaskReminderScheduler, preferences NotificationPreferenceResolver) TripTaskServiceInterface {
+	return &TripTaskService{
+		Repository:          repo,
+		publisher:           publisher,
+		notificationService: notificationService,
+		reminderScheduler:   reminderScheduler,
+		preferences:         preferences,
+	}
+}
+
+// CreateTask adds a task to the trip. Only organisers can create tasks; assignees are
+// told they have been given one.
+func (s *TripTaskService) CreateTask(ctx context.Context, tripID, actorID uuid.UUID, req models.CreateTripTaskRequest) (*models.TripTask, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, models.TripPermissionManageTasks); err != nil {
+		return nil, err
+	}
+	if err := s.validateTaskActivity(ctx, tripID, req.ActivityID); err != nil {
+		return nil, err
+	}
+	assigneeIDs := uniqueUUIDs(req.AssigneeIDs)
+	if err := s.validateTaskAssignees(ctx, tripID, assigneeIDs); err != nil {
+		return nil, err
+	}
+
+	task, err := s.TripTask.Create(ctx, &models.TripTask{
+		TripID:      tripID,
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      models.TripTaskTodo,
+		DueAt:       req.DueAt,
+		ActivityID:  req.ActivityID,
+		CreatedBy:   &actorID,
+		AssigneeIDs: assigneeIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishTaskEvent(ctx, realtime.EventTopicTaskCreated, task, actorID, task)
+	s.notifyAssignees(ctx, task, assigneeIDs, actorID)
+	s.scheduleReminder(ctx, task)
+	return task, nil
+}
+
+// ListTasks returns the trip's tasks, open ones first and soonest due first.
+func (s *TripTaskService) ListTasks(ctx context.Context, tripID, userID uuid.UUID, params models.TripTaskQueryParams) ([]*models.TripTask, error) {
+	if err := s.requireMember(ctx, tripID, userID); err != nil {
+		return nil, err
+	}
+	return s.TripTask.FindByTripID(ctx, tripID, params)
+}
+
+func (s *TripTaskService) GetTask(ctx context.Context, tripID, taskID, userID uuid.UUID) (*models.TripTask, error) {
+	if err := s.requireMember(ctx, tripID, userID); err != nil {
+		return nil, err
+	}
+	return s.findTripTask(ctx, tripID, taskID)
+}
+
+// UpdateTask changes the fields set in the request. Organisers can change anything;
+// assignees can only move their own task between statuses.
+func (s *TripTaskService) UpdateTask(ctx context.Context, tripID, taskID, actorID uuid.UUID, req models.UpdateTripTaskRequest) (*models.TripTask, error) {
+	task, err := s.findTripTask(ctx, tripID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	permission := models.TripPermissionManageTasks
+	if req.OnlyChangesStatus() && task.IsAssignee(actorID) {
+		permission = models.TripPermissionContribute
+	}
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, permission); err != nil {
+		return nil, err
+	}
+
+	previousDueAt, previousStatus := task.DueAt, task.Status
+	previousAssignees := task.AssigneeIDs
+
+	if req.Title != nil {
+		task.Title = *req.Title
+	}
+	if req.Description != nil {
+		task.Description = req.Description
+	}
+	if req.ClearDueAt {
+		task.DueAt = nil
+	} else if req.DueAt != nil {
+		task.DueAt = req.DueAt
+	}
+	if req.ClearActivity {
+		task.ActivityID = nil
+	} else if req.ActivityID != nil {
+		if err := s.validateTaskActivity(ctx, tripID, req.ActivityID); err != nil {
+			return nil, err
+		}
+		task.ActivityID = req.ActivityID
+	}
+	if req.Status != nil && *req.Status != task.Status {
+		task.Status = *req.Status
+		if task.Status == models.TripTaskDone {
+			now := time.Now().UTC()
+			task.CompletedAt = &now
+		} else {
+			task.CompletedAt = nil
+		}
+	}
+	if req.AssigneeIDs != nil {
+		task.AssigneeIDs = uniqueUUIDs(*req.AssigneeIDs)
+		if err := s.validateTaskAssignees(ctx, tripID, task.AssigneeIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.TripTask.Update(ctx, task, req.AssigneeIDs != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishTaskEvent(ctx, realtime.EventTopicTaskUpdated, updated, actorID, updated)
+	if req.AssigneeIDs != nil {
+		s.notifyAssignees(ctx, updated, newlyAssigned(previousAssignees, updated.AssigneeIDs), actorID)
+	}
+	if updated.Status != previousStatus || !equalTimes(updated.DueAt, previousDueAt) {
+		s.scheduleReminder(ctx, updated)
+	}
+	return updated, nil
+}
+
+// DeleteTask removes the task and its comments. Only organisers can delete tasks.
+func (s *TripTaskService) DeleteTask(ctx context.Context, tripID, taskID, actorID uuid.UUID) error {
+	if err := requireTripPermission(ctx, s.Membership, tripID, actorID, models.TripPermissionManageTasks); err != nil {
+		return err
+	}
+	task, err := s.findTripTask(ctx, tripID, taskID)
+	if err != nil {
+		return err
+	}
+	if err := s.TripTask.Delete(ctx, task.ID); err != nil {
+		return err
+	}
+
+	s.publishTaskEvent(ctx, realtime.EventTopicTaskDeleted, task, actorID, realtime.TaskDeletedPayload{
+		ID:     task.ID,
+		TripID: task.TripID,
+	})
+	if s.reminderScheduler != nil {
+		if err := s.reminderScheduler.CancelTaskReminder(ctx, task.ID); err != nil {
+			log.Printf("Failed to cancel reminder for task %s: %v", task.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *TripTaskService) requireMember(ctx context.Context, tripID, userID uuid.UUID) error {
+	isMember, err := s.Membership.IsMember(ctx, tripID, userID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return errs.Forbidden()
+	}
+	return nil
+}
+
+func (s *TripTaskService) findTripTask(ctx context.Context, tripID, taskID uuid.UUID) (*models.TripTask, error) {
+	task, err := s.TripTask.Find(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.TripID != tripID {
+		return nil, errs.ErrNotFound
+	}
+	return task, nil
+}
+
+func (s *TripTaskService) validateTaskActivity(ctx context.Context, tripID uuid.UUID, activityID *uuid.UUID) error {
+	if activityID == nil {
+		return nil
+	}
+	activity, err := s.Activity.Find(ctx, *activityID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return errs.BadRequest(errTaskActivityNotFound)
+		}
+		return err
+	}
+	if activity.TripID != tripID {
+		return errs.BadRequest(errTaskActivityNotFound)
+	}
+	return nil
+}
+
+// validateTaskAssignees requires every assignee to be a member who can contribute, so
+// viewers are never handed work they cannot mark done.
+func (s *TripTaskService) validateTaskAssignees(ctx context.Context, tripID uuid.UUID, userIDs []uuid.UUID) error {
+	for _, userID := range userIDs {
+		canContribute, err := hasTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionContribute)
+		if err != nil {
+			return err
+		}
+		if !canContribute {
+			return errs.BadRequest(errTaskAssigneeNotMember)
+		}
+	}
+	return nil
+}
+
+// scheduleReminder reminds the assignees a day before an open task is due and cancels
+// the reminder of a task that is done, undated or already overdue.
+func (s *TripTaskService) scheduleReminder(ctx context.Context, task *models.TripTask) {
+	if s.reminderScheduler == nil {
+		return
+	}
+	if task.DueAt == nil || task.Status == models.TripTaskDone || !task.DueAt.After(time.Now()) {
+		if err := s.reminderScheduler.CancelTaskReminder(ctx, task.ID); err != nil {
+			log.Printf("Failed to cancel reminder for task %s: %v", task.ID, err)
+		}
+		return
+	}
+	if err := s.reminderScheduler.ScheduleTaskReminder(ctx, task.ID, task.TripID, *task.DueAt); err != nil {
+		log.Printf("Failed to schedule reminder for task %s: %v", task.ID, err)
+	}
+}
+
+// notifyAssignees pushes the task to the users just assigned to it, other than the
+// person who assigned them and anyone who muted trip activity. Failures are logged;
+// the task has already been saved.
+func (s *TripTaskService) notifyAssignees(ctx context.Context, task *models.TripTask, userIDs []uuid.UUID, actorID uuid.UUID) {
+	if s.notificationService == nil || s.preferences == nil || len(userIDs) == 0 {
+		return
+	}
+	recipients, err := s.preferences.ResolveRecipients(ctx, models.NotificationAudience{
+		TripID:        task.TripID,
+		UserIDs:       userIDs,
+		ExcludeUserID: actorID,
+		Category:      models.NotificationCategoryTripActivity,
+	})
+	if err != nil {
+		log.Printf("Failed to resolve notification preferences for task %s: %v", task.ID, err)
+		return
+	}
+	if len(recipients) == 0 {
+		return
+	}
+	trip, err := s.Trip.Find(ctx, task.TripID)
+	if err != nil {
+		log.Printf("Failed to load trip %s for task %s: %v", task.TripID, task.ID, err)
+		return
+	}
+	assigner, err := s.User.Find(ctx, actorID)
+	if err != nil {
+		log.Printf("Failed to load assigner %s for task %s: %v", actorID, task.ID, err)
+		return
+	}
+
+	for _, userID := range recipients {
+		assignee, err := s.User.Find(ctx, userID)
+		if err != nil {
+			log.Printf("Failed to load assignee %s for task %s: %v", userID, task.ID, err)
+			continue
+		}
+		title, body := templates.Notification(assignee.Locale, templates.Message{
+			Key: templates.NotificationTripTaskAssigned,
+			Data: map[string]any{
+				"AssignerName": assigner.Name,
+				"TaskTitle":    task.Title,
+				"TripName":     trip.Name,
+			},
+		})
+		if err := s.notificationService.SendNotification(ctx, models.SendNotificationRequest{
+			UserID: userID,
+			Title:  title,
+			Body:   body,
+			Data: map[string]interface{}{
+				"trip_id": task.TripID.String(),
+				"task_id": task.ID.String(),
+			},
+		}); err != nil {
+			log.Printf("Failed to notify user %s of task %s: %v", userID, task.ID, err)
+		}
+	}
+}
+
+func (s *TripTaskService) publishTaskEvent(ctx context.Context, topic realtime.EventTopic, task *models.TripTask, actorID uuid.UUID, payload any) {
+	publishEvent(ctx, s.publisher, topic, task.TripID.String(), task.ID.String(), actorID.String(), "", payload)
+}
+
+// uniqueUUIDs drops repeated IDs, keeping the first occurrence of each.
+func uniqueUUIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	var unique []uuid.UUID
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// newlyAssigned returns the users in current that were not in previous.
+func newlyAssigned(previous, current []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(previous))
+	for _, id := range previous {
+		seen[id] = true
+	}
+	var added []uuid.UUID
+	for _, id := range current {
+		if !seen[id] {
+			added = append(added, id)
+		}
+	}
+	return added
+}
+
+func equalTimes(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TripTemplateServiceInterface interface {
+	DuplicateTrip(ctx context.Context, userID, tripID uuid.UUID, req models.DuplicateTripRequest) (*models.Trip, error)
+	SaveTripAsTemplate(ctx context.Context, userID, tripID uuid.UUID, req models.CreateTripTemplateRequest) (*models.TripTemplate, error)
+	ListTemplates(ctx context.Context, userID uuid.UUID) (*models.TripTemplateListResponse, error)
+	GetTemplate(ctx context.Context, userID, templateID uuid.UUID) (*models.TripTemplate, error)
+	DeleteTemplate(ctx context.Context, userID, templateID uuid.UUID) error
+	CreateTripFromTemplate(ctx context.Context, userID, templateID uuid.UUID, req models.CreateTripFromTemplateRequest) (*models.Trip, error)
+}
+
+var _ TripTemplateServiceInterface = (*TripTemplateService)(nil)
+
+type TripTemplateService struct {
+	*repository.Repository
+	publisher         realtime.EventPublisher
+	reminderScheduler TripReminderScheduler
+}
+
+func NewTripTemplateService(repo *repository.Repository, publisher realtime.EventPublisher, reminderScheduler TripReminderScheduler) TripTemplateServiceInterface {
+	return &TripTemplateService{
+		Repository:        repo,
+		publisher:         publisher,
+		reminderScheduler: reminderScheduler,
+	}
+}
+
+// DuplicateTrip copies the trip's plan into a new trip owned by the caller. Any member
+// can duplicate a trip, including an archived one.
+func (s *TripTemplateService) DuplicateTrip(ctx context.Context, userID, tripID uuid.UUID, req models.DuplicateTripRequest) (*models.Trip, error) {
+	if _, err := s.findAccess(ctx, tripID, userID); err != nil {
+		return nil, err
+	}
+
+	trip, err := s.Trip.Find(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.TripTemplate.Snapshot(ctx, trip)
+	if err != nil {
+		return nil, err
+	}
+
+	name := trip.Name + " (copy)"
+	if req.Name != nil {
+		name = *req.Name
+	}
+	return s.createTrip(ctx, userID, name, data, req.StartDate)
+}
+
+// SaveTripAsTemplate snapshots the trip into a template owned by the caller. Requires
+// the edit_trip permission; archived trips can still be saved.
+func (s *TripTemplateService) SaveTripAsTemplate(ctx context.Context, userID, tripID uuid.UUID, req models.CreateTripTemplateRequest) (*models.TripTemplate, error) {
+	access, err := s.findAccess(ctx, tripID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !access.Role.Can(models.TripPermissionEditTrip) {
+		return nil, errs.Forbidden()
+	}
+
+	trip, err := s.Trip.Find(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.TripTemplate.Snapshot(ctx, trip)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.TripTemplate.Create(ctx, &models.TripTemplate{
+		ID:           uuid.New(),
+		Name:         req.Name,
+		Description:  req.Description,
+		CreatedBy:    &userID,
+		SourceTripID: &tripID,
+		IsPublic:     req.IsPublic,
+		Data:         *data,
+	})
+}
+
+// ListTemplates returns the caller's templates and every public one.
+func (s *TripTemplateService) ListTemplates(ctx context.Context, userID uuid.UUID) (*models.TripTemplateListResponse, error) {
+	templates, err := s.TripTemplate.FindUsable(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*models.TripTemplateSummary, 0, len(templates))
+	for _, t := range templates {
+		summary := &models.TripTemplateSummary{
+			ID:            t.ID,
+			Name:          t.Name,
+			Description:   t.Description,
+			CreatedBy:     t.CreatedBy,
+			IsPublic:      t.IsPublic,
+			CategoryCount: len(t.Data.Categories),
+			ActivityCount: len(t.Data.Activities),
+			PollCount:     len(t.Data.Polls),
+			CreatedAt:     t.CreatedAt,
+		}
+		if t.Data.StartDate != nil && t.Data.EndDate != nil {
+			days := int(t.Data.EndDate.Sub(*t.Data.StartDate).Hours()/24) + 1
+			summary.TripLengthDays = &days
+		}
+		items = append(items, summary)
+	}
+	return &models.TripTemplateListResponse{Items: items}, nil
+}
+
+// GetTemplate returns a template the caller can use. Other users' private templates
+// are reported as not found.
+func (s *TripTemplateService) GetTemplate(ctx context.Context, userID, templateID uuid.UUID) (*models.TripTemplate, error) {
+	template, err := s.TripTemplate.Find(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if !template.CanUse(userID) {
+		return nil, errs.ErrNotFound
+	}
+	return template, nil
+}
+
+// DeleteTemplate deletes one of the caller's templates. Trips started from it are kept.
+func (s *TripTemplateService) DeleteTemplate(ctx context.Context, userID, templateID uuid.UUID) error {
+	template, err := s.GetTemplate(ctx, userID, templateID)
+	if err != nil {
+		return err
+	}
+	if template.CreatedBy == nil || *template.CreatedBy != userID {
+		return errs.Forbidden()
+	}
+	return s.TripTemplate.Delete(ctx, templateID)
+}
+
+// CreateTripFromTemplate starts a new trip owned by the caller from a template.
+func (s *TripTemplateService) CreateTripFromTemplate(ctx context.Context, userID, templateID uuid.UUID, req models.CreateTripFromTemplateRequest) (*models.Trip, error) {
+	template, err := s.GetTemplate(ctx, userID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := template.Name
+	if req.Name != nil {
+		name = *req.Name
+	}
+	return s.createTrip(ctx, userID, name, &template.Data, req.StartDate)
+}
+
+// findAccess returns the caller's access to the trip, or Forbidden if they are not a
+// member.
+func (s *TripTemplateService) findAccess(ctx context.Context, tripID, userID uuid.UUID) (models.TripAccess, error) {
+	access, err := s.Membership.FindAccess(ctx, tripID, userID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return models.TripAccess{}, errs.Forbidden()
+		}
+		return models.TripAccess{}, err
+	}
+	return access, nil
+}
+
+// createTrip creates a trip owned by userID with the template's settings and content,
+// its dates shifted to startDate.
+func (s *TripTemplateService) createTrip(ctx context.Context, userID uuid.UUID, name string, data *models.TripTemplateData, startDate *time.Time) (*models.Trip, error) {
+	start, end, activities := data.ShiftedTo(startDate)
+	content := *data
+	content.Activities = activities
+
+	currency := data.Currency
+	if currency == "" {
+		currency = "USD"
+	}
+	trip := &models.Trip{
+		ID:                    uuid.New(),
+		Name:                  name,
+		BudgetMin:             data.BudgetMin,
+		BudgetMax:             data.BudgetMax,
+		Currency:              currency,
+		Location:              data.Location,
+		DepartedContentPolicy: data.DepartedContentPolicy,
+		StartDate:             start,
+		EndDate:               end,
+	}
+
+	err := s.GetDB().RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := insertTripWithOwnerTx(ctx, tx, trip, userID); err != nil {
+			return err
+		}
+		if len(content.Categories) == 0 {
+			if err := s.Category.UpsertBatchTx(ctx, tx, trip.ID, models.DefaultCategoryNames); err != nil {
+				return err
+			}
+		}
+		return s.TripTemplate.CopyContentTx(ctx, tx, trip.ID, userID, &content)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleTripReminders(ctx, s.reminderScheduler, trip)
+
+	publishEvent(ctx, s.publisher, realtime.EventTopicTripCreated, trip.ID.String(), trip.ID.String(), userID.String(), "", trip)
+
+	return trip, nil
+}
@@ -0,0 +1,370 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxTripWebhooks caps subscriptions per trip so one trip cannot fan out unboundedly.
+	maxTripWebhooks = 10
+	// webhookDeliveryLogLimit is how many recent deliveries the log endpoint returns.
+	webhookDeliveryLogLimit = 50
+	maxWebhookErrorLength   = 500
+)
+
+// Headers sent with every webhook delivery. The signature is "sha256=" followed by the
+// hex HMAC-SHA256 of the request body keyed with the webhook secret.
+const (
+	WebhookSignatureHeader = "X-Toggo-Signature"
+	WebhookEventHeader     = "X-Toggo-Event"
+	WebhookDeliveryHeader  = "X-Toggo-Delivery"
+)
+
+var webhookTopics = realtime.NewEventRegistry()
+
+type TripWebhookServiceInterface interface {
+	CreateWebhook(ctx context.Context, tripID, userID uuid.UUID, req models.CreateTripWebhookRequest) (*models.CreatedTripWebhookResponse, error)
+	ListWebhooks(ctx context.Context, tripID, userID uuid.UUID) ([]*models.TripWebhook, error)
+	UpdateWebhook(ctx context.Context, tripID, webhookID, userID uuid.UUID, req models.UpdateTripWebhookRequest) (*models.TripWebhook, error)
+	DeleteWebhook(ctx context.Context, tripID, webhookID, userID uuid.UUID) error
+	ListDeliveries(ctx context.Context, tripID, webhookID, userID uuid.UUID) ([]*models.TripWebhookDelivery, error)
+	PingWebhook(ctx context.Context, tripID, webhookID, userID uuid.UUID) (*models.TripWebhookDelivery, error)
+}
+
+var _ TripWebhookServiceInterface = (*TripWebhookService)(nil)
+
+// TripWebhookService manages a trip's webhook subscriptions. Only members with edit_trip
+// can see or change them, and not while the trip is archived.
+type TripWebhookService struct {
+	webhookRepo    repository.TripWebhookRepository
+	membershipRepo repository.MembershipRepository
+	client         WebhookClient
+}
+
+func NewTripWebhookService(webhookRepo repository.TripWebhookRepository, membershipRepo repository.MembershipRepository, client WebhookClient) TripWebhookServiceInterface {
+	return &TripWebhookService{
+		webhookRepo:    webhookRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+func (s *TripWebhookService) CreateWebhook(ctx context.Context, tripID, userID uuid.UUID, req models.CreateTripWebhookRequest) (*models.CreatedTripWebhookResponse, error) {
+	if err := requireTripPermission(ctx, s.membershipRepo, tripID, userID, models.TripPermissionEditTrip); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookURL(req.URL); err != nil {
+		return nil, err
+	}
+	topics, err := validateWebhookTopics(req.Topics)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.webhookRepo.FindByTripID(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxTripWebhooks {
+		return nil, errs.BadRequest(fmt.Errorf("a trip can have at most %d webhooks", maxTripWebhooks))
+	}
+
+	secret := ""
+	if req.Secret != nil {
+		secret = *req.Secret
+	} else if secret, err = generateWebhookSecret(); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.webhookRepo.Create(ctx, &models.TripWebhook{
+		ID:        uuid.New(),
+		TripID:    tripID,
+		CreatedBy: &userID,
+		URL:       req.URL,
+		Secret:    secret,
+		Topics:    topics,
+		Enabled:   true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &models.CreatedTripWebhookResponse{TripWebhook: webhook, Secret: secret}, nil
+}
+
+func (s *TripWebhookService) ListWebhooks(ctx context.Context, tripID, userID uuid.UUID) ([]*models.TripWebhook, error) {
+	if err := requireTripPermission(ctx, s.membershipRepo, tripID, userID, models.TripPermissionEditTrip); err != nil {
+		return nil, err
+	}
+	return s.webhookRepo.FindByTripID(ctx, tripID)
+}
+
+func (s *TripWebhookService) UpdateWebhook(ctx context.Context, tripID, webhookID, userID uuid.UUID, req models.UpdateTripWebhookRequest) (*models.TripWebhook, error) {
+	webhook, err := s.findWebhook(ctx, tripID, webhookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := validateWebhookURL(*req.URL); err != nil {
+			return nil, err
+		}
+		webhook.URL = *req.URL
+	}
+	if req.Topics != nil {
+		topics, err := validateWebhookTopics(*req.Topics)
+		if err != nil {
+			return nil, err
+		}
+		webhook.Topics = topics
+	}
+	if req.Enabled != nil {
+		webhook.Enabled = *req.Enabled
+	}
+
+	return s.webhookRepo.Update(ctx, webhook)
+}
+
+func (s *TripWebhookService) DeleteWebhook(ctx context.Context, tripID, webhookID, userID uuid.UUID) error {
+	if err := requireTripPermission(ctx, s.membershipRepo, tripID, userID, models.TripPermissionEditTrip); err != nil {
+		return err
+	}
+	return s.webhookRepo.Delete(ctx, tripID, webhookID)
+}
+
+func (s *TripWebhookService) ListDeliveries(ctx context.Context, tripID, webhookID, userID uuid.UUID) ([]*models.TripWebhookDelivery, error) {
+	if _, err := s.findWebhook(ctx, tripID, webhookID, userID); err != nil {
+		return nil, err
+	}
+	return s.webhookRepo.FindDeliveries(ctx, webhookID, webhookDeliveryLogLimit)
+}
+
+// PingWebhook sends a test event straight away, without retries, and returns the logged
+// delivery so the caller can see whether the endpoint accepted it. Disabled webhooks can
+// be pinged too.
+func (s *TripWebhookService) PingWebhook(ctx context.Context, tripID, webhookID, userID uuid.UUID) (*models.TripWebhookDelivery, error) {
+	webhook, err := s.findWebhook(ctx, tripID, webhookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(map[string]any{"webhook_id": webhook.ID})
+	if err != nil {
+		return nil, err
+	}
+	event := &realtime.Event{
+		ID:        uuid.New().String(),
+		Topic:     models.WebhookPingTopic,
+		Version:   1,
+		TripID:    tripID.String(),
+		ActorID:   userID.String(),
+		Data:      data,
+		Timestamp: time.Now().UTC(),
+	}
+
+	delivery, err := DeliverTripWebhook(ctx, s.webhookRepo, s.client, webhook, event)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status == models.TripWebhookDeliveryPending {
+		if err := s.webhookRepo.MarkFailed(ctx, webhook.ID, event.ID); err != nil {
+			return nil, err
+		}
+		delivery.Status = models.TripWebhookDeliveryFailed
+	}
+	return delivery, nil
+}
+
+func (s *TripWebhookService) findWebhook(ctx context.Context, tripID, webhookID, userID uuid.UUID) (*models.TripWebhook, error) {
+	if err := requireTripPermission(ctx, s.membershipRepo, tripID, userID, models.TripPermissionEditTrip); err != nil {
+		return nil, err
+	}
+	webhook, err := s.webhookRepo.Find(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook.TripID != tripID {
+		return nil, errs.ErrNotFound
+	}
+	return webhook, nil
+}
+
+// DeliverTripWebhook sends the event to the webhook and logs the attempt. A rejected
+// delivery is not an error: it is logged as pending with the reason, and callers decide
+// whether to retry. The error is only returned when the attempt could not be logged.
+func DeliverTripWebhook(ctx context.Context, repo repository.TripWebhookRepository, client WebhookClient, webhook *models.TripWebhook, event *realtime.Event) (*models.TripWebhookDelivery, error) {
+	statusCode, deliveryErr := client.Deliver(ctx, webhook, event)
+
+	attempt := &models.TripWebhookDelivery{
+		ID:        uuid.New(),
+		WebhookID: webhook.ID,
+		EventID:   event.ID,
+		Topic:     event.Topic,
+		Status:    models.TripWebhookDeliverySucceeded,
+	}
+	if statusCode != 0 {
+		attempt.ResponseStatus = &statusCode
+	}
+	if deliveryErr != nil {
+		message := deliveryErr.Error()
+		if len(message) > maxWebhookErrorLength {
+			message = message[:maxWebhookErrorLength]
+		}
+		attempt.Status = models.TripWebhookDeliveryPending
+		attempt.ErrorMessage = &message
+	}
+
+	delivery, err := repo.RecordAttempt(ctx, attempt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+	return delivery, nil
+}
+
+// validateWebhookURL rejects URLs that are not absolute http(s) or that name a local or
+// private host outright. Hostnames resolving to such addresses are refused when
+// delivering, see NewWebhookHTTPClient.
+func validateWebhookURL(raw string) error {
+	parsed, err := url.ParseRequestURI(raw)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return errs.BadRequest(errors.New("webhook URL must be an absolute http or https URL"))
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errs.BadRequest(errWebhookAddressForbidden)
+	}
+	if ip := net.ParseIP(host); ip != nil && isPrivateIP(ip) {
+		return errs.BadRequest(errWebhookAddressForbidden)
+	}
+	return nil
+}
+
+// validateWebhookTopics checks each topic is a registered realtime event and drops
+// duplicates.
+func validateWebhookTopics(topics []string) ([]string, error) {
+	seen := make(map[string]bool, len(topics))
+	valid := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		if !webhookTopics.IsAllowed(topic) {
+			return nil, errs.BadRequest(fmt.Errorf("unknown event topic %q", topic))
+		}
+		if !seen[topic] {
+			seen[topic] = true
+			valid = append(valid, topic)
+		}
+	}
+	return valid, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+// SignWebhookPayload returns the signature header value for a delivery body.
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookClient posts signed events to webhook URLs. It returns the response status code
+// (0 if no response was received) and an error unless the endpoint answered 2xx.
+type WebhookClient interface {
+	Deliver(ctx context.Context, webhook *models.TripWebhook, event *realtime.Event) (int, error)
+}
+
+var errWebhookAddressForbidden = errors.New("webhook URL must not point at a local or private address")
+
+// NewWebhookHTTPClient returns the HTTP client deliveries should be sent with. The
+// address is checked after DNS resolution, right before connecting, so a public hostname
+// cannot be pointed at internal services, and redirects are returned rather than followed.
+func NewWebhookHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: httpRequestTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateIP(ip) {
+				return errWebhookAddressForbidden
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialled instead of the endpoint, bypassing the address check.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   httpRequestTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+type httpWebhookClient struct {
+	httpClient *http.Client
+}
+
+func NewWebhookClient(httpClient *http.Client) WebhookClient {
+	return &httpWebhookClient{httpClient: httpClient}
+}
+
+func (c *httpWebhookClient) Deliver(ctx context.Context, webhook *models.TripWebhook, event *realtime.Event) (int, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Toggo-Webhooks/1.0")
+	req.Header.Set(WebhookEventHeader, event.Topic)
+	req.Header.Set(WebhookDeliveryHeader, event.ID)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, body))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook endpoint returned status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -23,25 +23,59 @@ import (
 type TripServiceInterface interface {
 	CreateTrip(ctx context.Context, creatorUserID uuid.UUID, req models.CreateTripRequest) (*models.Trip, error)
 	GetTrip(ctx context.Context, id uuid.UUID) (*models.TripAPIResponse, error)
-	GetTripsWithCursor(ctx context.Context, userID uuid.UUID, limit int, cursorToken string, endDateBefore *time.Time) (*models.TripCursorPageResult, error)
+	GetTripsWithCursor(ctx context.Context, userID uuid.UUID, limit int, cursorToken string, endDateBefore *time.Time, includeArchived bool) (*models.TripCursorPageResult, error)
 	UpdateTrip(ctx context.Context, tripID uuid.UUID, actorID uuid.UUID, req models.UpdateTripRequest) (*models.Trip, error)
 	DeleteTrip(ctx context.Context, userID, tripID uuid.UUID) error
+	ArchiveTrip(ctx context.Context, userID, tripID uuid.UUID) (*models.Trip, error)
+	UnarchiveTrip(ctx context.Context, userID, tripID uuid.UUID) (*models.Trip, error)
+	ListDeletedTrips(ctx context.Context, userID uuid.UUID) ([]*models.DeletedTripAPIResponse, error)
+	RestoreTrip(ctx context.Context, userID, tripID uuid.UUID) (*models.Trip, error)
 	CreateTripInvite(ctx context.Context, tripID uuid.UUID, createdBy uuid.UUID, req models.CreateTripInviteRequest) (*models.TripInviteAPIResponse, error)
+	ListTripInvites(ctx context.Context, tripID, userID uuid.UUID) ([]*models.TripInviteAPIResponse, error)
+	RevokeTripInvite(ctx context.Context, tripID, inviteID, userID uuid.UUID) error
+	ListInviteJoins(ctx context.Context, tripID, inviteID, userID uuid.UUID) ([]*models.TripInviteJoinAPIResponse, error)
 }
 
 var _ TripServiceInterface = (*TripService)(nil)
 
+// PitchDeadlineScheduler schedules the reminder and close jobs for a trip's pitch
+// deadline, replacing any previously scheduled for the trip. Implemented by the Temporal
+// notifications package.
+type PitchDeadlineScheduler interface {
+	SchedulePitchDeadline(ctx context.Context, tripID uuid.UUID, deadline time.Time) error
+}
+
+// TripReminderScheduler schedules the countdown, day-of and daily itinerary notifications
+// for a trip's dates, replacing any previously scheduled for the trip.
+type TripReminderScheduler interface {
+	ScheduleTripReminders(ctx context.Context, tripID uuid.UUID, startDate, endDate time.Time) error
+	CancelTripReminders(ctx context.Context, tripID uuid.UUID) error
+}
+
+// TripPurgeScheduler permanently removes a deleted trip and its media at purgeAt unless
+// the purge is cancelled by restoring the trip.
+type TripPurgeScheduler interface {
+	SchedulePurge(ctx context.Context, tripID uuid.UUID, purgeAt time.Time) error
+	CancelPurge(ctx context.Context, tripID uuid.UUID) error
+}
+
 type TripService struct {
 	*repository.Repository
-	fileService FileServiceInterface
-	publisher   realtime.EventPublisher
+	fileService       FileServiceInterface
+	publisher         realtime.EventPublisher
+	pitchScheduler    PitchDeadlineScheduler
+	reminderScheduler TripReminderScheduler
+	purgeScheduler    TripPurgeScheduler
 }
 
-func NewTripService(repo *repository.Repository, fileService FileServiceInterface, publisher realtime.EventPublisher) TripServiceInterface {
+func NewTripService(repo *repository.Repository, fileService FileServiceInterface, publisher realtime.EventPublisher, pitchScheduler PitchDeadlineScheduler, reminderScheduler TripReminderScheduler, purgeScheduler TripPurgeScheduler) TripServiceInterface {
 	return &TripService{
-		Repository:  repo,
-		fileService: fileService,
-		publisher:   publisher,
+		Repository:        repo,
+		fileService:       fileService,
+		publisher:         publisher,
+		pitchScheduler:    pitchScheduler,
+		reminderScheduler: reminderScheduler,
+		purgeScheduler:    purgeScheduler,
 	}
 }
 
@@ -99,36 +133,11 @@ func (s *TripService) CreateTrip(ctx context.Context, creatorUserID uuid.UUID, r
 	// Use transaction to ensure trip creation, membership, and default categories are atomic
 	var createdTrip *models.Trip
 	err := s.Repository.GetDB().RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		// Create trip within transaction
-		_, err := tx.NewInsert().
-			Model(trip).
-			Returning("*").
-			Exec(ctx)
-		if err != nil {
+		if err := insertTripWithOwnerTx(ctx, tx, trip, creatorUserID); err != nil {
 			return err
 		}
 		createdTrip = trip
 
-		// Create membership within the same transaction
-		membership := &models.Membership{
-			UserID:            creatorUserID,
-			TripID:            trip.ID,
-			IsAdmin:           true,
-			BudgetMin:         req.BudgetMin,
-			BudgetMax:         req.BudgetMax,
-			NotifyNewPitches:  true,
-			NotifyNewPolls:    true,
-			NotifyNewComments: true,
-		}
-
-		_, err = tx.NewInsert().
-			Model(membership).
-			Returning("*").
-			Exec(ctx)
-		if err != nil {
-			return err
-		}
-
 		// Seed default categories as a single batch insert
 		return s.Category.UpsertBatchTx(ctx, tx, trip.ID, models.DefaultCategoryNames)
 	})
@@ -137,19 +146,42 @@ func (s *TripService) CreateTrip(ctx context.Context, creatorUserID uuid.UUID, r
 		return nil, err
 	}
 
-	// Publish trip.created event
-	if s.publisher != nil {
-		event, err := realtime.NewEventWithActor(realtime.EventTopicTripCreated, createdTrip.ID.String(), createdTrip.ID.String(), creatorUserID.String(), "", createdTrip)
-		if err != nil {
-			log.Printf("Failed to create trip.created event: %v", err)
-		} else if err := s.publisher.Publish(ctx, event); err != nil {
-			log.Printf("Failed to publish trip.created event: %v", err)
-		}
-	}
+	s.schedulePitchDeadline(ctx, createdTrip)
+	s.scheduleTripReminders(ctx, createdTrip)
+
+	s.publishTripEvent(ctx, realtime.EventTopicTripCreated, createdTrip.ID, creatorUserID, createdTrip)
 
 	return createdTrip, nil
 }
 
+// insertTripWithOwnerTx inserts the trip and makes ownerID its owner, with the trip's
+// budget as their own.
+func insertTripWithOwnerTx(ctx context.Context, tx bun.Tx, trip *models.Trip, ownerID uuid.UUID) error {
+	_, err := tx.NewInsert().
+		Model(trip).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	membership := &models.Membership{
+		UserID:            ownerID,
+		TripID:            trip.ID,
+		Role:              models.TripRoleOwner,
+		BudgetMin:         trip.BudgetMin,
+		BudgetMax:         trip.BudgetMax,
+		NotifyNewPitches:  true,
+		NotifyNewPolls:    true,
+		NotifyNewComments: true,
+	}
+	_, err = tx.NewInsert().
+		Model(membership).
+		Returning("*").
+		Exec(ctx)
+	return err
+}
+
 func (s *TripService) GetTrip(ctx context.Context, id uuid.UUID) (*models.TripAPIResponse, error) {
 	tripData, err := s.Trip.FindWithCoverImage(ctx, id)
 	if err != nil {
@@ -162,13 +194,13 @@ func (s *TripService) GetTrip(ctx context.Context, id uuid.UUID) (*models.TripAP
 	return s.toAPIResponse(ctx, tripData)
 }
 
-func (s *TripService) GetTripsWithCursor(ctx context.Context, userID uuid.UUID, limit int, cursorToken string, endDateBefore *time.Time) (*models.TripCursorPageResult, error) {
+func (s *TripService) GetTripsWithCursor(ctx context.Context, userID uuid.UUID, limit int, cursorToken string, endDateBefore *time.Time, includeArchived bool) (*models.TripCursorPageResult, error) {
 	cursor, err := pagination.ParseCursor(cursorToken)
 	if err != nil {
 		return nil, err
 	}
 
-	tripsData, nextCursor, err := s.Trip.FindAllWithCursorAndCoverImage(ctx, userID, limit, cursor, endDateBefore)
+	tripsData, nextCursor, err := s.Trip.FindAllWithCursorAndCoverImage(ctx, userID, limit, cursor, endDateBefore, includeArchived)
 	if err != nil {
 		return nil, err
 	}
@@ -252,21 +284,24 @@ func (s *TripService) convertToAPITrips(
 		}
 
 		tripResponses = append(tripResponses, &models.TripAPIResponse{
-			ID:             tripData.TripID,
-			Name:           tripData.Name,
-			CoverImageURL:  coverImageURL,
-			BudgetMin:      tripData.BudgetMin,
-			BudgetMax:      tripData.BudgetMax,
-			Currency:       tripData.Currency,
-			PitchDeadline:  tripData.PitchDeadline,
-			RankPollID:     tripData.RankPollID,
-			StartDate:      tripData.StartDate,
-			EndDate:        tripData.EndDate,
-			Location:       tripData.Location,
-			MemberCount:    memberCount,
-			MemberPreviews: memberPreviews,
-			CreatedAt:      tripData.CreatedAt,
-			UpdatedAt:      tripData.UpdatedAt,
+			ID:                    tripData.TripID,
+			Name:                  tripData.Name,
+			CoverImageURL:         coverImageURL,
+			BudgetMin:             tripData.BudgetMin,
+			BudgetMax:             tripData.BudgetMax,
+			Currency:              tripData.Currency,
+			PitchDeadline:         tripData.PitchDeadline,
+			RankPollID:            tripData.RankPollID,
+			PitchingClosedAt:      tripData.PitchingClosedAt,
+			StartDate:             tripData.StartDate,
+			EndDate:               tripData.EndDate,
+			Location:              tripData.Location,
+			MemberCount:           memberCount,
+			DepartedContentPolicy: tripData.DepartedContentPolicy,
+			ArchivedAt:            tripData.ArchivedAt,
+			MemberPreviews:        memberPreviews,
+			CreatedAt:             tripData.CreatedAt,
+			UpdatedAt:             tripData.UpdatedAt,
 		})
 	}
 	return tripResponses
@@ -335,30 +370,120 @@ func (s *TripService) UpdateTrip(ctx context.Context, tripID uuid.UUID, actorID
 		return nil, err
 	}
 
-	// Publish trip.updated event
-	if s.publisher != nil {
-		event, err := realtime.NewEventWithActor(realtime.EventTopicTripUpdated, tripID.String(), tripID.String(), actorID.String(), "", trip)
-		if err != nil {
-			log.Printf("Failed to create trip.updated event: %v", err)
-		} else if err := s.publisher.Publish(ctx, event); err != nil {
-			log.Printf("Failed to publish trip.updated event: %v", err)
-		}
+	if req.PitchDeadline != nil {
+		s.schedulePitchDeadline(ctx, trip)
+	}
+	if req.StartDate != nil || req.EndDate != nil {
+		s.scheduleTripReminders(ctx, trip)
 	}
 
+	s.publishTripEvent(ctx, realtime.EventTopicTripUpdated, tripID, actorID, trip)
+
 	return trip, nil
 }
 
+// DeleteTrip hides the trip from every member. It can be restored within
+// models.TripRestoreWindow, after which the purge workflow removes it and its media.
 func (s *TripService) DeleteTrip(ctx context.Context, userID, tripID uuid.UUID) error {
-	// Only admins can delete trips
-	isAdmin, err := s.Membership.IsAdmin(ctx, tripID, userID)
-	if err != nil {
+	if err := requireTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionDeleteTrip); err != nil {
 		return err
 	}
-	if !isAdmin {
-		return errs.Forbidden()
+
+	deletedAt := time.Now().UTC()
+	if err := s.Trip.SoftDelete(ctx, tripID, deletedAt); err != nil {
+		return err
 	}
 
-	return s.Trip.Delete(ctx, tripID)
+	if s.reminderScheduler != nil {
+		if err := s.reminderScheduler.CancelTripReminders(ctx, tripID); err != nil {
+			log.Printf("Failed to cancel trip reminders for trip %s: %v", tripID, err)
+		}
+	}
+	if s.purgeScheduler != nil {
+		if err := s.purgeScheduler.SchedulePurge(ctx, tripID, deletedAt.Add(models.TripRestoreWindow)); err != nil {
+			log.Printf("Failed to schedule purge for trip %s: %v", tripID, err)
+		}
+	}
+
+	s.publishTripEvent(ctx, realtime.EventTopicTripDeleted, tripID, userID, realtime.TripDeletedPayload{TripID: tripID})
+	return nil
+}
+
+// ArchiveTrip makes the trip read-only and hides it from the trip list by default.
+func (s *TripService) ArchiveTrip(ctx context.Context, userID, tripID uuid.UUID) (*models.Trip, error) {
+	archivedAt := time.Now().UTC()
+	return s.setArchived(ctx, userID, tripID, &archivedAt)
+}
+
+func (s *TripService) UnarchiveTrip(ctx context.Context, userID, tripID uuid.UUID) (*models.Trip, error) {
+	return s.setArchived(ctx, userID, tripID, nil)
+}
+
+func (s *TripService) setArchived(ctx context.Context, userID, tripID uuid.UUID, archivedAt *time.Time) (*models.Trip, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionArchiveTrip); err != nil {
+		return nil, err
+	}
+
+	trip, err := s.Trip.SetArchived(ctx, tripID, archivedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishTripEvent(ctx, realtime.EventTopicTripUpdated, tripID, userID, trip)
+	return trip, nil
+}
+
+// ListDeletedTrips returns the user's deleted trips that can still be restored.
+func (s *TripService) ListDeletedTrips(ctx context.Context, userID uuid.UUID) ([]*models.DeletedTripAPIResponse, error) {
+	trips, err := s.Trip.FindDeletedByUserID(ctx, userID, time.Now().UTC().Add(-models.TripRestoreWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*models.DeletedTripAPIResponse, 0, len(trips))
+	for _, trip := range trips {
+		if trip.DeletedAt == nil {
+			continue
+		}
+		items = append(items, &models.DeletedTripAPIResponse{
+			ID:        trip.ID,
+			Name:      trip.Name,
+			DeletedAt: *trip.DeletedAt,
+			PurgeAt:   *trip.PurgeAt(),
+		})
+	}
+	return items, nil
+}
+
+// RestoreTrip undeletes a trip within models.TripRestoreWindow and cancels its purge.
+// Only members whose role could delete the trip may restore it.
+func (s *TripService) RestoreTrip(ctx context.Context, userID, tripID uuid.UUID) (*models.Trip, error) {
+	role, err := s.Membership.FindRole(ctx, tripID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Can(models.TripPermissionDeleteTrip) {
+		return nil, errs.Forbidden()
+	}
+
+	trip, err := s.Trip.Restore(ctx, tripID, time.Now().UTC().Add(-models.TripRestoreWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	if s.purgeScheduler != nil {
+		if err := s.purgeScheduler.CancelPurge(ctx, tripID); err != nil {
+			log.Printf("Failed to cancel purge for trip %s: %v", tripID, err)
+		}
+	}
+	s.scheduleTripReminders(ctx, trip)
+
+	s.publishTripEvent(ctx, realtime.EventTopicTripUpdated, tripID, userID, trip)
+	return trip, nil
+}
+
+func (s *TripService) publishTripEvent(ctx context.Context, topic realtime.EventTopic, tripID, actorID uuid.UUID, payload any) {
+	publishEvent(ctx, s.publisher, topic, tripID.String(), tripID.String(), actorID.String(), "", payload)
 }
 
 func (s *TripService) toAPIResponse(ctx context.Context, tripData *models.TripDatabaseResponse) (*models.TripAPIResponse, error) {
@@ -372,19 +497,22 @@ func (s *TripService) toAPIResponse(ctx context.Context, tripData *models.TripDa
 	}
 
 	return &models.TripAPIResponse{
-		ID:            tripData.TripID,
-		Name:          tripData.Name,
-		CoverImageURL: coverImageURL,
-		BudgetMin:     tripData.BudgetMin,
-		BudgetMax:     tripData.BudgetMax,
-		Currency:      tripData.Currency,
-		PitchDeadline: tripData.PitchDeadline,
-		RankPollID:    tripData.RankPollID,
-		StartDate:     tripData.StartDate,
-		EndDate:       tripData.EndDate,
-		Location:      tripData.Location,
-		CreatedAt:     tripData.CreatedAt,
-		UpdatedAt:     tripData.UpdatedAt,
+		ID:                    tripData.TripID,
+		Name:                  tripData.Name,
+		CoverImageURL:         coverImageURL,
+		BudgetMin:             tripData.BudgetMin,
+		BudgetMax:             tripData.BudgetMax,
+		Currency:              tripData.Currency,
+		PitchDeadline:         tripData.PitchDeadline,
+		RankPollID:            tripData.RankPollID,
+		PitchingClosedAt:      tripData.PitchingClosedAt,
+		StartDate:             tripData.StartDate,
+		EndDate:               tripData.EndDate,
+		Location:              tripData.Location,
+		DepartedContentPolicy: tripData.DepartedContentPolicy,
+		ArchivedAt:            tripData.ArchivedAt,
+		CreatedAt:             tripData.CreatedAt,
+		UpdatedAt:             tripData.UpdatedAt,
 	}, nil
 }
 
@@ -397,6 +525,8 @@ func (s *TripService) createTripRankPollTx(ctx context.Context, tx bun.Tx, trip
 		CreatedBy: creatorID,
 		Question:  rankPollQuestion,
 		PollType:  models.PollTypeRank,
+		// Voting opens when the pitch deadline passes.
+		VotingLocked: true,
 	}
 	if _, err := tx.NewInsert().Model(poll).Returning("*").Exec(ctx, poll); err != nil {
 		return err
@@ -408,6 +538,37 @@ func (s *TripService) createTripRankPollTx(ctx context.Context, tx bun.Tx, trip
 	return nil
 }
 
+// schedulePitchDeadline arranges the reminder and close jobs for the trip's pitch
+// deadline. Failures are logged; the trip change itself has already succeeded.
+func (s *TripService) schedulePitchDeadline(ctx context.Context, trip *models.Trip) {
+	if s.pitchScheduler == nil || trip.PitchDeadline == nil {
+		return
+	}
+	if err := s.pitchScheduler.SchedulePitchDeadline(ctx, trip.ID, *trip.PitchDeadline); err != nil {
+		log.Printf("Failed to schedule pitch deadline for trip %s: %v", trip.ID, err)
+	}
+}
+
+// scheduleTripReminders arranges the upcoming-trip notifications for the trip's dates.
+// A trip without an end date is treated as a single day. Failures are logged; the trip
+// change itself has already succeeded.
+func (s *TripService) scheduleTripReminders(ctx context.Context, trip *models.Trip) {
+	scheduleTripReminders(ctx, s.reminderScheduler, trip)
+}
+
+func scheduleTripReminders(ctx context.Context, scheduler TripReminderScheduler, trip *models.Trip) {
+	if scheduler == nil || trip.StartDate == nil {
+		return
+	}
+	endDate := *trip.StartDate
+	if trip.EndDate != nil {
+		endDate = *trip.EndDate
+	}
+	if err := scheduler.ScheduleTripReminders(ctx, trip.ID, *trip.StartDate, endDate); err != nil {
+		log.Printf("Failed to schedule trip reminders for trip %s: %v", trip.ID, err)
+	}
+}
+
 const defaultInviteExpiry = 7 * 24 * time.Hour
 
 func generateInviteCode() (string, error) {
@@ -427,18 +588,29 @@ func (s *TripService) CreateTripInvite(ctx context.Context, tripID uuid.UUID, cr
 		}
 	}
 
+	maxUses := req.MaxUses
+	if req.SingleUse {
+		if maxUses != nil && *maxUses != 1 {
+			return nil, errs.BadRequest(errors.New("single_use invites cannot set max_uses above 1"))
+		}
+		one := 1
+		maxUses = &one
+	}
+
 	code, err := generateInviteCode()
 	if err != nil {
 		return nil, err
 	}
 
 	invite := &models.TripInvite{
-		ID:        uuid.New(),
-		TripID:    tripID,
-		CreatedBy: createdBy,
-		Code:      code,
-		ExpiresAt: expiresAt,
-		IsRevoked: false,
+		ID:               uuid.New(),
+		TripID:           tripID,
+		CreatedBy:        createdBy,
+		Code:             code,
+		ExpiresAt:        expiresAt,
+		IsRevoked:        false,
+		MaxUses:          maxUses,
+		RequiresApproval: req.RequiresApproval,
 	}
 
 	created, err := s.TripInvite.Create(ctx, invite)
@@ -456,22 +628,100 @@ func (s *TripService) CreateTripInvite(ctx context.Context, tripID uuid.UUID, cr
 		}
 	}
 
+	return toTripInviteAPIResponse(created), nil
+}
+
+// ListTripInvites returns the trip's invites, newest first, with how many people joined
+// through each.
+func (s *TripService) ListTripInvites(ctx context.Context, tripID, userID uuid.UUID) ([]*models.TripInviteAPIResponse, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionManageInvites); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.TripInvite.FindByTripID(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	inviteIDs := make([]uuid.UUID, len(invites))
+	for i, invite := range invites {
+		inviteIDs[i] = invite.ID
+	}
+	stats, err := s.TripInvite.GetJoinStats(ctx, inviteIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*models.TripInviteAPIResponse, len(invites))
+	for i, invite := range invites {
+		responses[i] = toTripInviteAPIResponse(invite)
+		responses[i].Stats = stats[invite.ID]
+		if responses[i].Stats == nil {
+			responses[i].Stats = &models.TripInviteJoinStats{}
+		}
+	}
+	return responses, nil
+}
+
+// RevokeTripInvite stops an invite from being used. Pending join requests made through
+// it can still be decided. Creators can revoke their own invites.
+func (s *TripService) RevokeTripInvite(ctx context.Context, tripID, inviteID, userID uuid.UUID) error {
+	invite, err := s.findTripInvite(ctx, tripID, inviteID)
+	if err != nil {
+		return err
+	}
+	permission := models.TripPermissionManageInvites
+	if invite.CreatedBy == userID {
+		permission = models.TripPermissionInviteMembers
+	}
+	if err := requireTripPermission(ctx, s.Membership, tripID, userID, permission); err != nil {
+		return err
+	}
+	return s.TripInvite.Revoke(ctx, tripID, inviteID)
+}
+
+// ListInviteJoins returns who joined, or asked to join, through an invite.
+func (s *TripService) ListInviteJoins(ctx context.Context, tripID, inviteID, userID uuid.UUID) ([]*models.TripInviteJoinAPIResponse, error) {
+	if err := requireTripPermission(ctx, s.Membership, tripID, userID, models.TripPermissionManageInvites); err != nil {
+		return nil, err
+	}
+	if _, err := s.findTripInvite(ctx, tripID, inviteID); err != nil {
+		return nil, err
+	}
+	return s.TripInvite.FindJoinsByInviteID(ctx, inviteID)
+}
+
+func (s *TripService) findTripInvite(ctx context.Context, tripID, inviteID uuid.UUID) (*models.TripInvite, error) {
+	invite, err := s.TripInvite.FindByID(ctx, inviteID)
+	if err != nil {
+		return nil, err
+	}
+	if invite.TripID != tripID {
+		return nil, errs.ErrNotFound
+	}
+	return invite, nil
+}
+
+func toTripInviteAPIResponse(invite *models.TripInvite) *models.TripInviteAPIResponse {
 	var joinURL *string
 	baseURL := os.Getenv("APP_PUBLIC_URL")
 	if baseURL != "" {
 		trimmed := strings.TrimRight(baseURL, "/")
-		u := trimmed + "/join?code=" + created.Code
+		u := trimmed + "/join?code=" + invite.Code
 		joinURL = &u
 	}
 
 	return &models.TripInviteAPIResponse{
-		ID:        created.ID,
-		TripID:    created.TripID,
-		CreatedBy: created.CreatedBy,
-		Code:      created.Code,
-		ExpiresAt: created.ExpiresAt,
-		IsRevoked: created.IsRevoked,
-		CreatedAt: created.CreatedAt,
-		JoinURL:   joinURL,
-	}, nil
+		ID:               invite.ID,
+		TripID:           invite.TripID,
+		CreatedBy:        invite.CreatedBy,
+		Code:             invite.Code,
+		ExpiresAt:        invite.ExpiresAt,
+		IsRevoked:        invite.IsRevoked,
+		MaxUses:          invite.MaxUses,
+		UseCount:         invite.UseCount,
+		RequiresApproval: invite.RequiresApproval,
+		CreatedAt:        invite.CreatedAt,
+		JoinURL:          joinURL,
+	}
 }
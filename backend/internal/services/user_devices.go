@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type UserDeviceServiceInterface interface {
+	ListDevices(ctx context.Context, userID uuid.UUID) ([]*models.UserDevice, error)
+	RegisterDevice(ctx context.Context, userID uuid.UUID, req models.RegisterDeviceRequest) (*models.UserDevice, error)
+	UnregisterDevice(ctx context.Context, userID uuid.UUID, token string) error
+}
+
+var _ UserDeviceServiceInterface = (*UserDeviceService)(nil)
+
+type UserDeviceService struct {
+	*repository.Repository
+}
+
+func NewUserDeviceService(repo *repository.Repository) UserDeviceServiceInterface {
+	return &UserDeviceService{Repository: repo}
+}
+
+func (s *UserDeviceService) ListDevices(ctx context.Context, userID uuid.UUID) ([]*models.UserDevice, error) {
+	return s.UserDevice.FindByUserID(ctx, userID)
+}
+
+// RegisterDevice adds the token to the user's devices, or refreshes its platform, app
+// version and last seen time if it is already registered.
+func (s *UserDeviceService) RegisterDevice(ctx context.Context, userID uuid.UUID, req models.RegisterDeviceRequest) (*models.UserDevice, error) {
+	platform := models.DevicePlatformUnknown
+	if req.Platform != nil {
+		platform = *req.Platform
+	}
+
+	return s.UserDevice.Upsert(ctx, &models.UserDevice{
+		UserID:     userID,
+		Token:      strings.TrimSpace(req.Token),
+		Platform:   platform,
+		AppVersion: req.AppVersion,
+	})
+}
+
+func (s *UserDeviceService) UnregisterDevice(ctx context.Context, userID uuid.UUID, token string) error {
+	return s.UserDevice.Delete(ctx, userID, strings.TrimSpace(token))
+}
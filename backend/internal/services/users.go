@@ -5,6 +5,7 @@ import (
 	"strings"
 	"toggo/internal/errs"
 	"toggo/internal/models"
+	"toggo/internal/realtime"
 	"toggo/internal/repository"
 	"toggo/internal/utilities"
 
@@ -16,16 +17,31 @@ type UserServiceInterface interface {
 	GetUser(ctx context.Context, id uuid.UUID) (*models.User, error)
 	UpdateUser(ctx context.Context, id uuid.UUID, userBody models.UpdateUserRequest) (*models.User, error)
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	ExportUserData(ctx context.Context, id uuid.UUID) (*models.UserDataExport, error)
+	DiscoverContacts(ctx context.Context, userID uuid.UUID, req models.DiscoverContactsRequest) (*models.DiscoverContactsResponse, error)
+	SendEmailVerification(ctx context.Context, userID uuid.UUID) error
+	VerifyEmail(ctx context.Context, userID uuid.UUID, req models.VerifyEmailRequest) (*models.User, error)
 }
 
 var _ UserServiceInterface = (*UserService)(nil)
 
 type UserService struct {
 	*repository.Repository
+	fileService    FileServiceInterface
+	publisher      realtime.EventPublisher
+	purgeScheduler TripPurgeScheduler
+	// emailSender delivers verification codes; nil when email is not configured.
+	emailSender EmailSender
 }
 
-func NewUserService(repo *repository.Repository) UserServiceInterface {
-	return &UserService{Repository: repo}
+func NewUserService(repo *repository.Repository, fileService FileServiceInterface, publisher realtime.EventPublisher, purgeScheduler TripPurgeScheduler, emailSender EmailSender) UserServiceInterface {
+	return &UserService{
+		Repository:     repo,
+		fileService:    fileService,
+		publisher:      publisher,
+		purgeScheduler: purgeScheduler,
+		emailSender:    emailSender,
+	}
 }
 
 func (u *UserService) CreateUser(ctx context.Context, userBody models.CreateUserRequest, userID uuid.UUID) (*models.User, error) {
@@ -42,6 +58,7 @@ func (u *UserService) CreateUser(ctx context.Context, userBody models.CreateUser
 		Username:          username,
 		PhoneNumber:       phone,
 		Timezone:          "UTC",
+		Locale:            models.DefaultLocale,
 		AppleMapsEnabled:  true,
 		GoogleMapsEnabled: true,
 		ID:                userID,
@@ -79,9 +96,58 @@ func (u *UserService) UpdateUser(ctx context.Context, id uuid.UUID, userBody mod
 		userBody.Username = &normalized
 	}
 
-	return u.User.Update(ctx, id, &userBody)
+	if userBody.Email != nil {
+		trimmed := strings.TrimSpace(*userBody.Email)
+		userBody.Email = &trimmed
+	}
+
+	user, err := u.User.Update(ctx, id, &userBody)
+	if err != nil {
+		return nil, err
+	}
+
+	// Older app builds still send a single device_token on the profile; register it
+	// as a device so it keeps receiving notifications alongside newer devices.
+	if userBody.DeviceToken != nil {
+		if token := strings.TrimSpace(*userBody.DeviceToken); token != "" {
+			if _, err := u.UserDevice.Upsert(ctx, &models.UserDevice{
+				UserID:   id,
+				Token:    token,
+				Platform: models.DevicePlatformUnknown,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return user, nil
 }
 
-func (u *UserService) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	return u.User.Delete(ctx, id)
+// DiscoverContacts matches hashed address book phone numbers against registered users.
+// Only the submitted hashes that match are echoed back, with the user's public profile;
+// users who opted out of discovery and the caller are never returned.
+func (u *UserService) DiscoverContacts(ctx context.Context, userID uuid.UUID, req models.DiscoverContactsRequest) (*models.DiscoverContactsResponse, error) {
+	hashes := make([]string, 0, len(req.PhoneHashes))
+	for _, hash := range req.PhoneHashes {
+		hashes = append(hashes, strings.ToLower(hash))
+	}
+
+	users, err := u.User.FindDiscoverableByPhoneHashes(ctx, hashes, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*models.ContactMatch, 0, len(users))
+	for _, user := range users {
+		matches = append(matches, &models.ContactMatch{
+			PhoneHash: user.PhoneNumberHash,
+			User: models.ContactUser{
+				ID:             user.ID,
+				Name:           user.Name,
+				Username:       user.Username,
+				ProfilePicture: user.ProfilePicture,
+			},
+		})
+	}
+	return &models.DiscoverContactsResponse{Matches: matches}, nil
 }
@@ -0,0 +1,176 @@
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	texttemplate "text/template"
+	"toggo/internal/models"
+)
+
+// Notification catalogue keys. Push messages render "<key>.title" and "<key>.body".
+const (
+	NotificationNewPoll               = "new_poll"
+	NotificationNewComment            = "new_comment"
+	NotificationPollDeadlineReminder  = "poll_deadline_reminder"
+	NotificationPitchDeadlineReminder = "pitch_deadline_reminder"
+	NotificationVotingOpen            = "voting_open"
+	NotificationDigest                = "digest"
+	NotificationTripCountdownWeek     = "trip_countdown_week"
+	NotificationTripCountdownTomorrow = "trip_countdown_tomorrow"
+	NotificationTripStartsToday       = "trip_starts_today"
+	NotificationTripDailyBriefing     = "trip_daily_briefing"
+	NotificationTripDirectInvite      = "trip_direct_invite"
+	NotificationTripTaskAssigned      = "trip_task_assigned"
+	NotificationTaskDueReminder       = "task_due_reminder"
+	NotificationEmailVerification     = "email_verification"
+)
+
+// DateTimeLayoutKey holds each locale's time.Format layout for dates in messages.
+const DateTimeLayoutKey = "format.datetime"
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// Message is a notification catalogue entry and the data its templates are rendered with.
+type Message struct {
+	Key  string
+	Data map[string]any
+}
+
+// localeFile is the on-disk format of a translation file.
+type localeFile struct {
+	// Conjunction joins the last two items of a list, e.g. "and".
+	Conjunction string            `json:"conjunction"`
+	Messages    map[string]string `json:"messages"`
+}
+
+type localeCatalogue struct {
+	templates *texttemplate.Template
+}
+
+// pluralRules reports whether a count takes the singular form in a locale. Locales
+// without an entry use the English rule.
+var pluralRules = map[string]func(int) bool{
+	"en": func(n int) bool { return n == 1 },
+	"es": func(n int) bool { return n == 1 },
+}
+
+var catalogues map[string]*localeCatalogue
+
+// Catalogues are loaded in init rather than a variable initializer because the "t"
+// template function renders through the loaded catalogues.
+func init() {
+	catalogues = make(map[string]*localeCatalogue, len(models.SupportedLocales))
+	for _, locale := range models.SupportedLocales {
+		catalogue, err := loadCatalogue(locale)
+		if err != nil {
+			panic(err)
+		}
+		catalogues[locale] = catalogue
+	}
+}
+
+func loadCatalogue(locale string) (*localeCatalogue, error) {
+	raw, err := localeFS.ReadFile("locales/" + locale + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("missing translations for locale %q: %w", locale, err)
+	}
+	var file localeFile
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("invalid translations for locale %q: %w", locale, err)
+	}
+
+	root := texttemplate.New(locale).Option("missingkey=error").Funcs(localeFuncs(locale, file.Conjunction))
+	for key, text := range file.Messages {
+		if _, err := root.New(key).Parse(text); err != nil {
+			return nil, fmt.Errorf("invalid %q message for locale %q: %w", key, locale, err)
+		}
+	}
+	return &localeCatalogue{templates: root}, nil
+}
+
+// localeFuncs are available to every message:
+//
+//	{{plural .Count "new poll" "new polls"}}  singular or plural form for the locale
+//	{{list .Items}}                           "a, b and c" with the locale's conjunction
+//	{{t "time_of_day.morning"}}               another message in the same locale
+func localeFuncs(locale, conjunction string) texttemplate.FuncMap {
+	isOne, ok := pluralRules[locale]
+	if !ok {
+		isOne = pluralRules[models.DefaultLocale]
+	}
+	return texttemplate.FuncMap{
+		"plural": func(count int, one, other string) string {
+			if isOne(count) {
+				return one
+			}
+			return other
+		},
+		"list": func(items []string) string {
+			if len(items) <= 1 {
+				return strings.Join(items, "")
+			}
+			return strings.Join(items[:len(items)-1], ", ") + " " + conjunction + " " + items[len(items)-1]
+		},
+		"t": func(key string) string {
+			return Text(locale, key, nil)
+		},
+	}
+}
+
+// ResolveLocale maps a user's locale to a supported one, e.g. "es-MX" to "es". Unknown
+// or empty locales resolve to the default.
+func ResolveLocale(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+	if _, ok := catalogues[locale]; ok {
+		return locale
+	}
+	return models.DefaultLocale
+}
+
+// Lookup renders key in locale, falling back to the default locale when the locale has
+// no usable translation. It reports false when no locale has the message.
+func Lookup(locale, key string, data any) (string, bool) {
+	locale = ResolveLocale(locale)
+	if text, ok := catalogues[locale].render(key, data); ok {
+		return text, true
+	}
+	if locale != models.DefaultLocale {
+		return catalogues[models.DefaultLocale].render(key, data)
+	}
+	return "", false
+}
+
+// Text renders key like Lookup, returning the key itself when no message is found.
+func Text(locale, key string, data any) string {
+	if text, ok := Lookup(locale, key, data); ok {
+		return text
+	}
+	log.Printf("notification catalogue: no message for %q", key)
+	return key
+}
+
+// Notification renders a push notification's title and body in locale.
+func Notification(locale string, msg Message) (string, string) {
+	return Text(locale, msg.Key+".title", msg.Data), Text(locale, msg.Key+".body", msg.Data)
+}
+
+func (c *localeCatalogue) render(key string, data any) (string, bool) {
+	tmpl := c.templates.Lookup(key)
+	if tmpl == nil {
+		return "", false
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Printf("notification catalogue: failed to render %q: %v", key, err)
+		return "", false
+	}
+	return buf.String(), true
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-//go:embed trip_invite.html join_enter_code.html
+//go:embed trip_invite.html join_enter_code.html trip_share.html
 var inviteTemplateFS embed.FS
 
 //go:embed static/*
@@ -66,6 +66,7 @@ var templateFuncs = htmltemplate.FuncMap{
 
 var inviteTemplate = htmltemplate.Must(htmltemplate.New("trip_invite.html").Funcs(templateFuncs).ParseFS(inviteTemplateFS, "trip_invite.html"))
 var joinEnterCodeTemplate = htmltemplate.Must(htmltemplate.ParseFS(inviteTemplateFS, "join_enter_code.html"))
+var tripShareTemplate = htmltemplate.Must(htmltemplate.ParseFS(inviteTemplateFS, "trip_share.html"))
 
 type TripInviteView struct {
 	AppName         string
@@ -101,3 +102,22 @@ func RenderJoinEnterCodePage(view JoinEnterCodeView) ([]byte, error) {
 
 	return buf.Bytes(), nil
 }
+
+// TripShareView is the data for the public /share/:token page.
+type TripShareView struct {
+	AppName         string
+	PageTitle       string
+	MetaDescription string
+	MetaImage       string
+	CanonicalURL    string
+	Data            *models.TripSharePageData
+}
+
+func RenderTripSharePage(view TripShareView) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tripShareTemplate.Execute(&buf, view); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,245 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+	"toggo/internal/tests/testkit/fakes"
+
+	testkit "toggo/internal/tests/testkit/builders"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryAccountRepo records which accounts were deleted.
+type memoryAccountRepo struct {
+	repository.AccountRepository
+	mediaKeys []string
+	deleted   []uuid.UUID
+}
+
+func (r *memoryAccountRepo) FindMediaKeys(_ context.Context, _ uuid.UUID) ([]string, error) {
+	return r.mediaKeys, nil
+}
+
+func (r *memoryAccountRepo) Delete(_ context.Context, userID uuid.UUID) error {
+	r.deleted = append(r.deleted, userID)
+	return nil
+}
+
+// recordingFileService records the S3 keys it was asked to delete.
+type recordingFileService struct {
+	services.FileServiceInterface
+	deletedKeys []string
+}
+
+func (f *recordingFileService) DeleteFiles(_ context.Context, fileKeys []string) error {
+	f.deletedKeys = append(f.deletedKeys, fileKeys...)
+	return nil
+}
+
+func TestDeleteAccount(t *testing.T) {
+	ctx := context.Background()
+
+	newService := func(userID uuid.UUID, members *roleMembershipRepo, trips *softDeleteTripRepo) (services.UserServiceInterface, *memoryAccountRepo, *recordingFileService, *recordingPurgeScheduler, *capturePublisher) {
+		accounts := &memoryAccountRepo{mediaKeys: []string{"profile/small.jpg", "trips/t/pitches/p.m4a"}}
+		files := &recordingFileService{}
+		purges := &recordingPurgeScheduler{scheduled: make(map[uuid.UUID]time.Time)}
+		publisher := &capturePublisher{}
+		svc := services.NewUserService(&repository.Repository{
+			User:       &lookupUserRepo{users: []*models.User{{ID: userID}}},
+			Membership: members,
+			Trip:       trips,
+			Account:    accounts,
+		}, files, publisher, purges, nil)
+		return svc, accounts, files, purges, publisher
+	}
+
+	t.Run("owner's trip passes to the longest-standing organiser", func(t *testing.T) {
+		tripID := uuid.New()
+		members := newRoleMembershipRepo(tripID)
+		owner := members.add(models.TripRoleOwner)
+		members.add(models.TripRoleMember)
+		organiser := members.add(models.TripRoleOrganiser)
+		members.add(models.TripRoleOrganiser)
+		svc, accounts, files, _, publisher := newService(owner, members, &softDeleteTripRepo{trip: models.Trip{ID: tripID}})
+
+		require.NoError(t, svc.DeleteUser(ctx, owner))
+		assert.Equal(t, models.TripRoleOwner, members.members[organiser].Role)
+		assert.Equal(t, []uuid.UUID{owner}, accounts.deleted)
+		assert.Equal(t, accounts.mediaKeys, files.deletedKeys)
+
+		updated := publisher.topics(realtime.EventTopicMembershipUpdated)
+		require.Len(t, updated, 1)
+		assert.Equal(t, organiser.String(), updated[0].EntityID)
+		removed := publisher.topics(realtime.EventTopicMembershipRemoved)
+		require.Len(t, removed, 1)
+		assert.Equal(t, owner.String(), removed[0].EntityID)
+	})
+
+	t.Run("owner without organisers hands over to the longest-standing member", func(t *testing.T) {
+		tripID := uuid.New()
+		members := newRoleMembershipRepo(tripID)
+		viewer := members.add(models.TripRoleViewer)
+		owner := members.add(models.TripRoleOwner)
+		member := members.add(models.TripRoleMember)
+		svc, _, _, _, _ := newService(owner, members, &softDeleteTripRepo{trip: models.Trip{ID: tripID}})
+
+		require.NoError(t, svc.DeleteUser(ctx, owner))
+		assert.Equal(t, models.TripRoleOwner, members.members[member].Role)
+		assert.Equal(t, models.TripRoleViewer, members.members[viewer].Role)
+	})
+
+	t.Run("last organiser is replaced", func(t *testing.T) {
+		tripID := uuid.New()
+		members := newRoleMembershipRepo(tripID)
+		organiser := members.add(models.TripRoleOrganiser)
+		member := members.add(models.TripRoleMember)
+		svc, _, _, _, _ := newService(organiser, members, &softDeleteTripRepo{trip: models.Trip{ID: tripID}})
+
+		require.NoError(t, svc.DeleteUser(ctx, organiser))
+		assert.Equal(t, models.TripRoleOrganiser, members.members[member].Role)
+	})
+
+	t.Run("organiser with an owner leaves roles alone", func(t *testing.T) {
+		tripID := uuid.New()
+		members := newRoleMembershipRepo(tripID)
+		owner := members.add(models.TripRoleOwner)
+		organiser := members.add(models.TripRoleOrganiser)
+		member := members.add(models.TripRoleMember)
+		svc, _, _, _, publisher := newService(organiser, members, &softDeleteTripRepo{trip: models.Trip{ID: tripID}})
+
+		require.NoError(t, svc.DeleteUser(ctx, organiser))
+		assert.Equal(t, models.TripRoleOwner, members.members[owner].Role)
+		assert.Equal(t, models.TripRoleMember, members.members[member].Role)
+		assert.Empty(t, publisher.topics(realtime.EventTopicMembershipUpdated))
+	})
+
+	t.Run("trip with no one else is deleted", func(t *testing.T) {
+		tripID := uuid.New()
+		members := newRoleMembershipRepo(tripID)
+		owner := members.add(models.TripRoleOwner)
+		trips := &softDeleteTripRepo{trip: models.Trip{ID: tripID}}
+		svc, accounts, _, purges, publisher := newService(owner, members, trips)
+
+		require.NoError(t, svc.DeleteUser(ctx, owner))
+		require.NotNil(t, trips.trip.DeletedAt)
+		assert.Equal(t, trips.trip.DeletedAt.Add(models.TripRestoreWindow), purges.scheduled[tripID])
+		assert.Equal(t, []uuid.UUID{owner}, accounts.deleted)
+		assert.Empty(t, publisher.topics(realtime.EventTopicMembershipRemoved))
+	})
+
+	t.Run("deleting a missing account succeeds", func(t *testing.T) {
+		members := newRoleMembershipRepo(uuid.New())
+		svc, accounts, files, _, _ := newService(uuid.New(), members, &softDeleteTripRepo{})
+
+		require.NoError(t, svc.DeleteUser(ctx, uuid.New()))
+		assert.Empty(t, accounts.deleted)
+		assert.Empty(t, files.deletedKeys)
+	})
+}
+
+func TestAccountExportAndDeletionAPI(t *testing.T) {
+	app := fakes.GetSharedTestApp()
+
+	owner := createTestUser(t, app, "Owner", fakes.GenerateRandomUsername(), fakes.GenerateRandomPhoneNumber())
+	member := createTestUser(t, app, "Member", fakes.GenerateRandomUsername(), fakes.GenerateRandomPhoneNumber())
+	tripID := createTestTrip(t, app, owner, "Lisbon", 500, 1500)
+	addUserToTrip(t, app, owner, member, tripID)
+
+	t.Run("export contains profile and memberships", func(t *testing.T) {
+		resp := testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  "/api/v1/users/me/export",
+				Method: testkit.GET,
+				UserID: &owner,
+			}).
+			AssertStatus(http.StatusOK).
+			GetBody()
+
+		profile := resp["profile"].(map[string]interface{})
+		assert.Equal(t, owner, profile["id"])
+		memberships := resp["memberships"].([]interface{})
+		require.Len(t, memberships, 1)
+		assert.Equal(t, tripID, memberships[0].(map[string]interface{})["trip_id"])
+		assert.Equal(t, "Lisbon", memberships[0].(map[string]interface{})["trip_name"])
+		assert.Empty(t, resp["comments"])
+	})
+
+	t.Run("cannot delete someone else's account", func(t *testing.T) {
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  fmt.Sprintf("/api/v1/users/%s", owner),
+				Method: testkit.DELETE,
+				UserID: &member,
+			}).
+			AssertStatus(http.StatusNotFound)
+	})
+
+	t.Run("deleting the owner hands the trip over", func(t *testing.T) {
+		ctx := context.Background()
+		db := fakes.GetSharedDB()
+		ownerID, memberID, eventID := uuid.MustParse(owner), uuid.MustParse(member), uuid.New()
+		require.NoError(t, repository.NewActivityFeedRepository(db).CreateEventWithEntries(ctx, &models.ActivityFeedEvent{
+			ID:        eventID,
+			TripID:    uuid.MustParse(tripID),
+			Topic:     string(realtime.EventTopicCommentCreated),
+			ActorID:   &ownerID,
+			ActorName: "Owner",
+			Data:      []byte(`{"content":"meet at the station"}`),
+			GroupID:   uuid.New(),
+			CreatedAt: time.Now(),
+		}, []uuid.UUID{memberID}))
+
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  fmt.Sprintf("/api/v1/users/%s", owner),
+				Method: testkit.DELETE,
+				UserID: &owner,
+			}).
+			AssertStatus(http.StatusNoContent)
+
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  fmt.Sprintf("/api/v1/trips/%s/memberships/%s", tripID, member),
+				Method: testkit.GET,
+				UserID: &member,
+			}).
+			AssertStatus(http.StatusOK).
+			AssertField("role", string(models.TripRoleOwner))
+
+		events, err := db.NewSelect().
+			Model((*models.ActivityFeedEvent)(nil)).
+			Where("actor_id = ?", ownerID).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, events)
+		entries, err := db.NewSelect().
+			Model((*models.ActivityFeedEntry)(nil)).
+			Where("event_id = ?", eventID).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, entries)
+
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  fmt.Sprintf("/api/v1/users/%s", owner),
+				Method: testkit.GET,
+				UserID: &member,
+			}).
+			AssertStatus(http.StatusNotFound)
+	})
+}
@@ -0,0 +1,270 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+	"toggo/internal/utilities/pagination"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeActivityFeedRepository struct {
+	groups      []*models.ActivityFeedGroupRow
+	actors      []*models.ActivityFeedActorRow
+	nextCursor  *models.ActivityFeedCursor
+	unread      map[uuid.UUID]int64
+	gotCursor   *models.ActivityFeedCursor
+	gotTripIDs  []uuid.UUID
+	readCalls   map[uuid.UUID]bool
+	readTripIDs []uuid.UUID
+}
+
+func (f *fakeActivityFeedRepository) CreateEventWithEntries(context.Context, *models.ActivityFeedEvent, []uuid.UUID) error {
+	return nil
+}
+
+func (f *fakeActivityFeedRepository) FindGroupsWithCursor(_ context.Context, _ uuid.UUID, tripIDs []uuid.UUID, _ bool, _ int, cursor *models.ActivityFeedCursor) ([]*models.ActivityFeedGroupRow, *models.ActivityFeedCursor, error) {
+	f.gotTripIDs = tripIDs
+	f.gotCursor = cursor
+	return f.groups, f.nextCursor, nil
+}
+
+func (f *fakeActivityFeedRepository) FindGroupActors(context.Context, uuid.UUID, []uuid.UUID) ([]*models.ActivityFeedActorRow, error) {
+	return f.actors, nil
+}
+
+func (f *fakeActivityFeedRepository) SetGroupRead(_ context.Context, _, _, groupID uuid.UUID, read bool) error {
+	if f.readCalls == nil {
+		f.readCalls = map[uuid.UUID]bool{}
+	}
+	f.readCalls[groupID] = read
+	return nil
+}
+
+func (f *fakeActivityFeedRepository) MarkAllRead(_ context.Context, _ uuid.UUID, tripIDs []uuid.UUID) error {
+	f.readTripIDs = tripIDs
+	return nil
+}
+
+func (f *fakeActivityFeedRepository) CountUnreadGroupsByTrip(_ context.Context, _ uuid.UUID, tripIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	counts := make(map[uuid.UUID]int64)
+	for _, tripID := range tripIDs {
+		if count, ok := f.unread[tripID]; ok {
+			counts[tripID] = count
+		}
+	}
+	return counts, nil
+}
+
+// fakeUserTrips stubs MembershipServiceInterface.GetUserTrips for inbox tests.
+type fakeUserTrips struct {
+	services.MembershipServiceInterface
+	tripIDs []uuid.UUID
+}
+
+func (f *fakeUserTrips) GetUserTrips(_ context.Context, userID uuid.UUID) ([]*models.Membership, error) {
+	memberships := make([]*models.Membership, 0, len(f.tripIDs))
+	for _, tripID := range f.tripIDs {
+		memberships = append(memberships, &models.Membership{UserID: userID, TripID: tripID})
+	}
+	return memberships, nil
+}
+
+// fakeLocaleUserRepo returns a user with the given locale from Find.
+type fakeLocaleUserRepo struct {
+	repository.UserRepository
+	locale string
+}
+
+func (f *fakeLocaleUserRepo) Find(_ context.Context, id uuid.UUID) (*models.User, error) {
+	return &models.User{ID: id, Locale: f.locale}, nil
+}
+
+func TestActivityFeedService_GetFeed(t *testing.T) {
+	ctx := context.Background()
+	userID, tripID := uuid.New(), uuid.New()
+	voteGroup, tabGroup := uuid.New(), uuid.New()
+	now := time.Now().UTC()
+
+	repo := &fakeActivityFeedRepository{
+		groups: []*models.ActivityFeedGroupRow{
+			{
+				GroupID:    voteGroup,
+				TripID:     tripID,
+				Topic:      string(realtime.EventTopicPollVoteAdded),
+				LatestAt:   now,
+				EventCount: 5,
+				ActorCount: 5,
+				HasUnread:  true,
+				Data:       json.RawMessage(`{"question":"Where to eat"}`),
+			},
+			{
+				GroupID:    tabGroup,
+				TripID:     tripID,
+				Topic:      string(realtime.EventTopicCategoryCreated),
+				LatestAt:   now.Add(-time.Hour),
+				EventCount: 1,
+				ActorCount: 1,
+				Data:       json.RawMessage(`{"label":"Food"}`),
+			},
+		},
+		actors: []*models.ActivityFeedActorRow{
+			{GroupID: voteGroup, UserID: uuid.New(), Name: "Ana"},
+			{GroupID: voteGroup, UserID: uuid.New(), Name: "Ben"},
+			{GroupID: voteGroup, UserID: uuid.New(), Name: "Cy"},
+			{GroupID: voteGroup, UserID: uuid.New(), Name: "Di"},
+			{GroupID: tabGroup, UserID: uuid.New(), Username: "eve"},
+		},
+		nextCursor: &models.ActivityFeedCursor{CreatedAt: now.Add(-time.Hour), ID: tabGroup},
+	}
+	service := services.NewActivityFeedService(nil, nil, repo, nil)
+
+	result, err := service.GetFeed(ctx, userID, tripID, false, 2, "")
+	require.NoError(t, err)
+	require.Len(t, result.Items, 2)
+	assert.Nil(t, repo.gotCursor)
+
+	votes := result.Items[0]
+	assert.Equal(t, voteGroup, votes.ID)
+	assert.Equal(t, "Ana and 4 others voted on Where to eat", votes.Summary)
+	assert.Len(t, votes.Actors, 3)
+	assert.Equal(t, 5, votes.EventCount)
+	assert.False(t, votes.IsRead)
+
+	tab := result.Items[1]
+	assert.Equal(t, "eve added the Food tab", tab.Summary)
+	assert.True(t, tab.IsRead)
+
+	require.NotNil(t, result.NextCursor)
+	decoded, err := pagination.DecodeTimeUUIDCursor(*result.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, tabGroup, decoded.ID)
+
+	t.Run("next cursor is passed back to the repository", func(t *testing.T) {
+		_, err := service.GetFeed(ctx, userID, tripID, false, 2, *result.NextCursor)
+		require.NoError(t, err)
+		require.NotNil(t, repo.gotCursor)
+		assert.Equal(t, tabGroup, repo.gotCursor.ID)
+	})
+
+	t.Run("invalid cursor is rejected", func(t *testing.T) {
+		_, err := service.GetFeed(ctx, userID, tripID, false, 2, "not-a-cursor")
+		assert.Error(t, err)
+	})
+
+	t.Run("summaries are rendered in the reader's locale", func(t *testing.T) {
+		localized := services.NewActivityFeedService(nil, nil, repo, &fakeLocaleUserRepo{locale: "es"})
+		result, err := localized.GetFeed(ctx, userID, tripID, false, 2, "")
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, "Ana y 4 personas más votaron en Where to eat", result.Items[0].Summary)
+		assert.Equal(t, "eve añadió la pestaña Food", result.Items[1].Summary)
+	})
+
+	t.Run("items can be marked read and unread", func(t *testing.T) {
+		require.NoError(t, service.MarkRead(ctx, userID, tripID, voteGroup))
+		require.NoError(t, service.MarkUnread(ctx, userID, tripID, tabGroup))
+		assert.Equal(t, map[uuid.UUID]bool{voteGroup: true, tabGroup: false}, repo.readCalls)
+	})
+}
+
+func TestInboxService(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	tripA, tripB, leftTrip := uuid.New(), uuid.New(), uuid.New()
+
+	newInbox := func() (services.InboxServiceInterface, *fakeActivityFeedRepository) {
+		repo := &fakeActivityFeedRepository{
+			groups: []*models.ActivityFeedGroupRow{
+				{GroupID: uuid.New(), TripID: tripB, TripName: "Lisbon", Topic: string(realtime.EventTopicTripUpdated), ActorCount: 1, HasUnread: true},
+				{GroupID: uuid.New(), TripID: tripA, TripName: "Tokyo", Topic: string(realtime.EventTopicTripUpdated), ActorCount: 1},
+			},
+			unread: map[uuid.UUID]int64{tripA: 2, tripB: 3, leftTrip: 7},
+		}
+		return services.NewInboxService(repo, nil, &fakeUserTrips{tripIDs: []uuid.UUID{tripA, tripB}}), repo
+	}
+
+	t.Run("merges feeds across every trip the user belongs to", func(t *testing.T) {
+		inbox, repo := newInbox()
+		result, err := inbox.GetInbox(ctx, userID, nil, false, 20, "")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{tripA, tripB}, repo.gotTripIDs)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, "Lisbon", result.Items[0].TripName)
+	})
+
+	t.Run("filters to requested trips the user belongs to", func(t *testing.T) {
+		inbox, repo := newInbox()
+		_, err := inbox.GetInbox(ctx, userID, []uuid.UUID{tripB, leftTrip}, false, 20, "")
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{tripB}, repo.gotTripIDs)
+	})
+
+	t.Run("unread count totals current trips only", func(t *testing.T) {
+		inbox, _ := newInbox()
+		counts, err := inbox.GetUnreadCount(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), counts.UnreadCount)
+		assert.Equal(t, []models.InboxTripUnreadCount{
+			{TripID: tripA, UnreadCount: 2},
+			{TripID: tripB, UnreadCount: 3},
+		}, counts.Trips)
+	})
+
+	t.Run("mark all read covers every current trip", func(t *testing.T) {
+		inbox, repo := newInbox()
+		require.NoError(t, inbox.MarkAllRead(ctx, userID, nil))
+		assert.Equal(t, []uuid.UUID{tripA, tripB}, repo.readTripIDs)
+
+		require.NoError(t, inbox.MarkAllRead(ctx, userID, []uuid.UUID{tripA}))
+		assert.Equal(t, []uuid.UUID{tripA}, repo.readTripIDs)
+	})
+}
+
+func TestFeedGroupID(t *testing.T) {
+	tripID, pollID := uuid.New().String(), uuid.New().String()
+	morning := time.Date(2026, 4, 20, 9, 0, 0, 0, time.UTC)
+
+	newEvent := func(topic realtime.EventTopic, entityID string, at time.Time) *realtime.Event {
+		return &realtime.Event{
+			ID:        uuid.New().String(),
+			Topic:     string(topic),
+			TripID:    tripID,
+			EntityID:  entityID,
+			Timestamp: at,
+		}
+	}
+
+	t.Run("votes on the same poll on the same day share a group", func(t *testing.T) {
+		first := realtime.FeedGroupID(newEvent(realtime.EventTopicPollVoteAdded, pollID, morning))
+		second := realtime.FeedGroupID(newEvent(realtime.EventTopicPollVoteAdded, pollID, morning.Add(8*time.Hour)))
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("votes on another day or poll start a new group", func(t *testing.T) {
+		base := realtime.FeedGroupID(newEvent(realtime.EventTopicPollVoteAdded, pollID, morning))
+		nextDay := realtime.FeedGroupID(newEvent(realtime.EventTopicPollVoteAdded, pollID, morning.Add(24*time.Hour)))
+		otherPoll := realtime.FeedGroupID(newEvent(realtime.EventTopicPollVoteAdded, uuid.New().String(), morning))
+		assert.NotEqual(t, base, nextDay)
+		assert.NotEqual(t, base, otherPoll)
+	})
+
+	t.Run("joins are grouped per trip", func(t *testing.T) {
+		first := realtime.FeedGroupID(newEvent(realtime.EventTopicMembershipAdded, uuid.New().String(), morning))
+		second := realtime.FeedGroupID(newEvent(realtime.EventTopicMembershipAdded, uuid.New().String(), morning))
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("non-aggregated topics are their own item", func(t *testing.T) {
+		event := newEvent(realtime.EventTopicPollCreated, pollID, morning)
+		assert.Equal(t, uuid.MustParse(event.ID), realtime.FeedGroupID(event))
+	})
+}
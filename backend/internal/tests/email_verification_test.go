@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// verificationUserRepo keeps a single user and their pending verification code.
+type verificationUserRepo struct {
+	repository.UserRepository
+	user *models.User
+}
+
+func (r *verificationUserRepo) Find(_ context.Context, id uuid.UUID) (*models.User, error) {
+	if r.user.ID != id {
+		return nil, errs.ErrNotFound
+	}
+	return r.user, nil
+}
+
+func (r *verificationUserRepo) SetEmailVerificationCode(_ context.Context, _ uuid.UUID, codeHash string, expiresAt time.Time) error {
+	r.user.EmailVerificationCodeHash = &codeHash
+	r.user.EmailVerificationExpiresAt = &expiresAt
+	return nil
+}
+
+func (r *verificationUserRepo) VerifyEmail(_ context.Context, _ uuid.UUID, codeHash string, now time.Time) (*models.User, error) {
+	u := r.user
+	if u.EmailVerificationCodeHash == nil || *u.EmailVerificationCodeHash != codeHash || !u.EmailVerificationExpiresAt.After(now) {
+		return nil, errs.ErrNotFound
+	}
+	u.EmailVerifiedAt, u.EmailVerificationCodeHash, u.EmailVerificationExpiresAt = &now, nil, nil
+	return u, nil
+}
+
+func TestEmailVerification(t *testing.T) {
+	ctx := context.Background()
+	codePattern := regexp.MustCompile(`\b\d{6}\b`)
+
+	newService := func(user *models.User, sender services.EmailSender) services.UserServiceInterface {
+		return services.NewUserService(&repository.Repository{
+			User: &verificationUserRepo{user: user},
+		}, nil, nil, nil, sender)
+	}
+
+	t.Run("the emailed code verifies the address", func(t *testing.T) {
+		email := "sam@example.com"
+		user := &models.User{ID: uuid.New(), Email: &email, Locale: models.DefaultLocale}
+		emails := &services.RecordingEmailSender{}
+		svc := newService(user, emails)
+
+		require.NoError(t, svc.SendEmailVerification(ctx, user.ID))
+		require.Len(t, emails.Sent(), 1)
+		assert.Equal(t, email, emails.Sent()[0].To)
+		code := codePattern.FindString(emails.Sent()[0].Body)
+		require.NotEmpty(t, code)
+		assert.NotEqual(t, code, *user.EmailVerificationCodeHash)
+
+		_, err := svc.VerifyEmail(ctx, user.ID, models.VerifyEmailRequest{Code: "000000"})
+		if code != "000000" {
+			assertAPIStatus(t, err, http.StatusBadRequest)
+		}
+
+		verified, err := svc.VerifyEmail(ctx, user.ID, models.VerifyEmailRequest{Code: code})
+		require.NoError(t, err)
+		assert.NotNil(t, verified.EmailVerifiedAt)
+
+		_, err = svc.VerifyEmail(ctx, user.ID, models.VerifyEmailRequest{Code: code})
+		assertAPIStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("expired codes are refused", func(t *testing.T) {
+		email := "sam@example.com"
+		user := &models.User{ID: uuid.New(), Email: &email}
+		emails := &services.RecordingEmailSender{}
+		svc := newService(user, emails)
+
+		require.NoError(t, svc.SendEmailVerification(ctx, user.ID))
+		code := codePattern.FindString(emails.Sent()[0].Body)
+		expired := time.Now().Add(-time.Minute)
+		user.EmailVerificationExpiresAt = &expired
+
+		_, err := svc.VerifyEmail(ctx, user.ID, models.VerifyEmailRequest{Code: code})
+		assertAPIStatus(t, err, http.StatusBadRequest)
+		assert.Nil(t, user.EmailVerifiedAt)
+	})
+
+	t.Run("users without an address get a bad request", func(t *testing.T) {
+		user := &models.User{ID: uuid.New()}
+		emails := &services.RecordingEmailSender{}
+
+		assertAPIStatus(t, newService(user, emails).SendEmailVerification(ctx, user.ID), http.StatusBadRequest)
+		assert.Empty(t, emails.Sent())
+	})
+
+	t.Run("without an email sender verification is unavailable", func(t *testing.T) {
+		email := "sam@example.com"
+		user := &models.User{ID: uuid.New(), Email: &email}
+
+		assertAPIStatus(t, newService(user, nil).SendEmailVerification(ctx, user.ID), http.StatusServiceUnavailable)
+	})
+}
@@ -237,6 +237,63 @@ func (_c *MockFileServiceInterface_CreateUploadURLs_Call) RunAndReturn(run func(
 	return _c
 }
 
+// DeleteFiles provides a mock function for the type MockFileServiceInterface
+func (_mock *MockFileServiceInterface) DeleteFiles(ctx context.Context, fileKeys []string) error {
+	ret := _mock.Called(ctx, fileKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFiles")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = returnFunc(ctx, fileKeys)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFileServiceInterface_DeleteFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFiles'
+type MockFileServiceInterface_DeleteFiles_Call struct {
+	*mock.Call
+}
+
+// DeleteFiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fileKeys []string
+func (_e *MockFileServiceInterface_Expecter) DeleteFiles(ctx interface{}, fileKeys interface{}) *MockFileServiceInterface_DeleteFiles_Call {
+	return &MockFileServiceInterface_DeleteFiles_Call{Call: _e.mock.On("DeleteFiles", ctx, fileKeys)}
+}
+
+func (_c *MockFileServiceInterface_DeleteFiles_Call) Run(run func(ctx context.Context, fileKeys []string)) *MockFileServiceInterface_DeleteFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFileServiceInterface_DeleteFiles_Call) Return(err error) *MockFileServiceInterface_DeleteFiles_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFileServiceInterface_DeleteFiles_Call) RunAndReturn(run func(ctx context.Context, fileKeys []string) error) *MockFileServiceInterface_DeleteFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteImage provides a mock function for the type MockFileServiceInterface
 func (_mock *MockFileServiceInterface) DeleteImage(ctx context.Context, imageID uuid.UUID) error {
 	ret := _mock.Called(ctx, imageID)
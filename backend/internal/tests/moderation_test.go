@@ -0,0 +1,462 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+	testkit "toggo/internal/tests/testkit/builders"
+	"toggo/internal/tests/testkit/fakes"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryBlockRepo keeps user blocks in memory.
+type memoryBlockRepo struct {
+	repository.UserBlockRepository
+	blocks map[[2]uuid.UUID]bool
+}
+
+func newMemoryBlockRepo() *memoryBlockRepo {
+	return &memoryBlockRepo{blocks: map[[2]uuid.UUID]bool{}}
+}
+
+func (r *memoryBlockRepo) Block(_ context.Context, blockerID, blockedID uuid.UUID) error {
+	r.blocks[[2]uuid.UUID{blockerID, blockedID}] = true
+	return nil
+}
+
+func (r *memoryBlockRepo) Unblock(_ context.Context, blockerID, blockedID uuid.UUID) error {
+	key := [2]uuid.UUID{blockerID, blockedID}
+	if !r.blocks[key] {
+		return errs.ErrNotFound
+	}
+	delete(r.blocks, key)
+	return nil
+}
+
+func (r *memoryBlockRepo) IsBlocked(_ context.Context, blockerID, blockedID uuid.UUID) (bool, error) {
+	return r.blocks[[2]uuid.UUID{blockerID, blockedID}], nil
+}
+
+// memoryModerationRepo keeps one trip's reports and hidden content in memory. authors
+// maps each reportable entity to its author.
+type memoryModerationRepo struct {
+	repository.ModerationRepository
+	tripID  uuid.UUID
+	authors map[uuid.UUID]uuid.UUID
+	reports map[uuid.UUID]*models.ContentReport
+	hidden  map[uuid.UUID]bool
+}
+
+func (r *memoryModerationRepo) FindEntityAuthor(_ context.Context, tripID uuid.UUID, _ models.EntityType, entityID uuid.UUID) (*uuid.UUID, error) {
+	author, ok := r.authors[entityID]
+	if !ok || tripID != r.tripID {
+		return nil, errs.ErrNotFound
+	}
+	return &author, nil
+}
+
+func (r *memoryModerationRepo) CreateReport(_ context.Context, report *models.ContentReport) (*models.ContentReport, bool, error) {
+	for _, existing := range r.reports {
+		if existing.EntityID == report.EntityID && *existing.ReportedBy == *report.ReportedBy && existing.Status == models.ContentReportPending {
+			return existing, false, nil
+		}
+	}
+	report.ID = uuid.New()
+	report.Status = models.ContentReportPending
+	r.reports[report.ID] = report
+	return report, true, nil
+}
+
+func (r *memoryModerationRepo) FindReport(_ context.Context, id uuid.UUID) (*models.ContentReport, error) {
+	report, ok := r.reports[id]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	copied := *report
+	return &copied, nil
+}
+
+func (r *memoryModerationRepo) FindPendingReports(_ context.Context, _ uuid.UUID) ([]*models.ModerationQueueItem, error) {
+	items := []*models.ModerationQueueItem{}
+	for _, report := range r.reports {
+		if report.Status == models.ContentReportPending {
+			items = append(items, &models.ModerationQueueItem{ContentReport: *report})
+		}
+	}
+	return items, nil
+}
+
+func (r *memoryModerationRepo) DismissReport(_ context.Context, id, resolvedBy uuid.UUID) (bool, error) {
+	report := r.reports[id]
+	if report.Status != models.ContentReportPending {
+		return false, nil
+	}
+	report.Status = models.ContentReportDismissed
+	report.ResolvedBy = &resolvedBy
+	return true, nil
+}
+
+func (r *memoryModerationRepo) Hide(_ context.Context, hidden *models.HiddenContent) error {
+	r.hidden[hidden.EntityID] = true
+	for _, report := range r.reports {
+		if report.EntityID == hidden.EntityID && report.Status == models.ContentReportPending {
+			report.Status = models.ContentReportHidden
+		}
+	}
+	return nil
+}
+
+func (r *memoryModerationRepo) Unhide(_ context.Context, _ uuid.UUID, _ models.EntityType, entityID uuid.UUID) error {
+	if !r.hidden[entityID] {
+		return errs.ErrNotFound
+	}
+	delete(r.hidden, entityID)
+	return nil
+}
+
+func TestContentModeration(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	organiser := members.add(models.TripRoleOrganiser)
+	author := members.add(models.TripRoleMember)
+	viewer := members.add(models.TripRoleViewer)
+	outsider := uuid.New()
+
+	commentID := uuid.New()
+	moderation := &memoryModerationRepo{
+		tripID:  tripID,
+		authors: map[uuid.UUID]uuid.UUID{commentID: author},
+		reports: map[uuid.UUID]*models.ContentReport{},
+		hidden:  map[uuid.UUID]bool{},
+	}
+	publisher := &capturePublisher{}
+	svc := services.NewModerationService(&repository.Repository{Membership: members, Moderation: moderation}, publisher)
+
+	reportComment := func(userID uuid.UUID) (*models.ContentReport, error) {
+		return svc.ReportContent(ctx, tripID, userID, models.CreateContentReportRequest{
+			EntityType: models.CommentEntity,
+			EntityID:   commentID,
+			Reason:     models.ContentReportHarassment,
+		})
+	}
+
+	_, err := reportComment(outsider)
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	_, err = reportComment(author)
+	assertAPIStatus(t, err, http.StatusBadRequest)
+
+	_, err = svc.ReportContent(ctx, tripID, viewer, models.CreateContentReportRequest{
+		EntityType: models.PitchEntity,
+		EntityID:   uuid.New(),
+		Reason:     models.ContentReportSpam,
+	})
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+
+	// Viewers can report, and reporting twice returns the pending report.
+	report, err := reportComment(viewer)
+	require.NoError(t, err)
+	again, err := reportComment(viewer)
+	require.NoError(t, err)
+	assert.Equal(t, report.ID, again.ID)
+	other, err := reportComment(organiser)
+	require.NoError(t, err)
+
+	_, err = svc.ListReports(ctx, tripID, author)
+	assertAPIStatus(t, err, http.StatusForbidden)
+	queue, err := svc.ListReports(ctx, tripID, organiser)
+	require.NoError(t, err)
+	assert.Len(t, queue, 2)
+
+	assertAPIStatus(t, svc.HideReportedContent(ctx, tripID, report.ID, author), http.StatusForbidden)
+	foreign := &models.ContentReport{ID: uuid.New(), TripID: uuid.New(), Status: models.ContentReportPending}
+	moderation.reports[foreign.ID] = foreign
+	assert.ErrorIs(t, svc.HideReportedContent(ctx, tripID, foreign.ID, organiser), errs.ErrNotFound)
+	delete(moderation.reports, foreign.ID)
+
+	// Hiding resolves every pending report on the content.
+	require.NoError(t, svc.HideReportedContent(ctx, tripID, report.ID, organiser))
+	assert.True(t, moderation.hidden[commentID])
+	assert.Equal(t, models.ContentReportHidden, moderation.reports[other.ID].Status)
+	assert.Len(t, publisher.topics(realtime.EventTopicContentVisibilityChanged), 1)
+
+	assertAPIStatus(t, svc.DismissReport(ctx, tripID, other.ID, organiser), http.StatusBadRequest)
+
+	require.NoError(t, svc.UnhideContent(ctx, tripID, models.CommentEntity, commentID, organiser))
+	assert.False(t, moderation.hidden[commentID])
+	assert.Len(t, publisher.topics(realtime.EventTopicContentVisibilityChanged), 2)
+	assert.ErrorIs(t, svc.UnhideContent(ctx, tripID, models.CommentEntity, commentID, organiser), errs.ErrNotFound)
+
+	// Dismissed reports cannot be acted on again.
+	report, err = reportComment(viewer)
+	require.NoError(t, err)
+	require.NoError(t, svc.DismissReport(ctx, tripID, report.ID, organiser))
+	assertAPIStatus(t, svc.HideReportedContent(ctx, tripID, report.ID, organiser), http.StatusBadRequest)
+}
+
+func TestBlockedUsersCannotSendDirectInvites(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	organiser := members.add(models.TripRoleOrganiser)
+
+	friend := &models.User{ID: uuid.New(), Username: "sam"}
+	blocks := newMemoryBlockRepo()
+	repo := &repository.Repository{
+		Membership: members,
+		User:       &lookupUserRepo{users: []*models.User{friend, {ID: organiser}}},
+		UserBlock:  blocks,
+		TripDirectInvite: &memoryDirectInviteRepo{
+			invites: map[uuid.UUID]*models.TripDirectInvite{},
+		},
+	}
+	moderationSvc := services.NewModerationService(repo, nil)
+	inviteSvc := services.NewTripDirectInviteService(repo, nil, nil, nil)
+	username := "sam"
+	invite := models.CreateTripDirectInviteRequest{Username: &username}
+
+	assertAPIStatus(t, moderationSvc.BlockUser(ctx, friend.ID, friend.ID), http.StatusBadRequest)
+	assert.ErrorIs(t, moderationSvc.BlockUser(ctx, friend.ID, uuid.New()), errs.ErrNotFound)
+
+	// Blocked inviters are told the user does not exist.
+	require.NoError(t, moderationSvc.BlockUser(ctx, friend.ID, organiser))
+	_, err := inviteSvc.InviteUser(ctx, tripID, organiser, invite)
+	assertAPIStatus(t, err, http.StatusNotFound)
+
+	require.NoError(t, moderationSvc.UnblockUser(ctx, friend.ID, organiser))
+	require.NoError(t, moderationSvc.BlockUser(ctx, organiser, friend.ID))
+	_, err = inviteSvc.InviteUser(ctx, tripID, organiser, invite)
+	assertAPIStatus(t, err, http.StatusBadRequest)
+
+	require.NoError(t, moderationSvc.UnblockUser(ctx, organiser, friend.ID))
+	_, err = inviteSvc.InviteUser(ctx, tripID, organiser, invite)
+	require.NoError(t, err)
+}
+
+func TestBlockingAndModerationAPI(t *testing.T) {
+	app := fakes.GetSharedTestApp()
+	entityID := uuid.New()
+
+	owner := createTestUser(t, app, "Owner", fakes.GenerateRandomUsername(), fakes.GenerateRandomPhoneNumber())
+	member := createTestUser(t, app, "Member", fakes.GenerateRandomUsername(), fakes.GenerateRandomPhoneNumber())
+	tripID := createTestTrip(t, app, owner, "Trip", 1000, 5000)
+	addUserToTrip(t, app, owner, member, tripID)
+
+	commentID := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/comments",
+			Method: testkit.POST,
+			UserID: &member,
+			Body: models.CreateCommentRequest{
+				TripID:     uuid.MustParse(tripID),
+				EntityType: models.ActivityEntity,
+				EntityID:   entityID,
+				Content:    "rude comment",
+			},
+		}).
+		AssertStatus(http.StatusCreated).
+		GetBody()["id"].(string)
+
+	commentsRoute := fmt.Sprintf("/api/v1/trips/%s/activity/%s/comments", tripID, entityID)
+	countComments := func(userID string) int {
+		return len(testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  commentsRoute,
+				Method: testkit.GET,
+				UserID: &userID,
+			}).
+			AssertStatus(http.StatusOK).
+			GetBody()["items"].([]interface{}))
+	}
+
+	// Blocking hides the member's comments from the owner only.
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/users/me/blocks",
+			Method: testkit.POST,
+			UserID: &owner,
+			Body:   models.BlockUserRequest{UserID: uuid.MustParse(member)},
+		}).
+		AssertStatus(http.StatusNoContent)
+	assert.Equal(t, 0, countComments(owner))
+	assert.Equal(t, 1, countComments(member))
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/users/me/blocks/%s", member),
+			Method: testkit.DELETE,
+			UserID: &owner,
+		}).
+		AssertStatus(http.StatusNoContent)
+	assert.Equal(t, 1, countComments(owner))
+
+	reportID := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/reports", tripID),
+			Method: testkit.POST,
+			UserID: &owner,
+			Body: models.CreateContentReportRequest{
+				EntityType: models.CommentEntity,
+				EntityID:   uuid.MustParse(commentID),
+				Reason:     models.ContentReportHarassment,
+			},
+		}).
+		AssertStatus(http.StatusCreated).
+		AssertField("status", string(models.ContentReportPending)).
+		GetBody()["id"].(string)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/moderation/reports", tripID),
+			Method: testkit.GET,
+			UserID: &member,
+		}).
+		AssertStatus(http.StatusForbidden)
+
+	queue := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/moderation/reports", tripID),
+			Method: testkit.GET,
+			UserID: &owner,
+		}).
+		AssertStatus(http.StatusOK).
+		GetBody()["items"].([]interface{})
+	require.Len(t, queue, 1)
+	assert.Equal(t, "rude comment", queue[0].(map[string]interface{})["preview"])
+
+	// Hidden comments are left out for everyone.
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/moderation/reports/%s/hide", tripID, reportID),
+			Method: testkit.POST,
+			UserID: &owner,
+		}).
+		AssertStatus(http.StatusNoContent)
+	assert.Equal(t, 0, countComments(member))
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/moderation/hidden/comment/%s", tripID, commentID),
+			Method: testkit.DELETE,
+			UserID: &owner,
+		}).
+		AssertStatus(http.StatusNoContent)
+	assert.Equal(t, 1, countComments(member))
+}
+
+func TestHiddenContentIsNotFound(t *testing.T) {
+	ctx := context.Background()
+	app := fakes.GetSharedTestApp()
+	db := fakes.GetSharedDB()
+	owner := createUser(t, app)
+	member := createUser(t, app)
+	tripID := createTrip(t, app, owner)
+	addMember(t, app, owner, member, tripID)
+	activityID := createActivity(t, app, member, tripID, "Karaoke")
+
+	pitch := &models.TripPitch{
+		ID:         uuid.New(),
+		TripID:     uuid.MustParse(tripID),
+		UserID:     uuid.MustParse(member),
+		Title:      "Lisbon",
+		AudioS3Key: "trips/" + tripID + "/pitches/lisbon.m4a",
+	}
+	_, err := db.NewInsert().Model(pitch).Exec(ctx)
+	require.NoError(t, err)
+
+	hide := func(entityType models.EntityType, entityID uuid.UUID) {
+		_, err := db.NewInsert().Model(&models.HiddenContent{
+			EntityType: entityType,
+			EntityID:   entityID,
+			TripID:     uuid.MustParse(tripID),
+		}).Exec(ctx)
+		require.NoError(t, err)
+	}
+	hide(models.ActivityEntity, uuid.MustParse(activityID))
+	hide(models.PitchEntity, pitch.ID)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/activities/%s", tripID, activityID),
+			Method: testkit.GET,
+			UserID: &member,
+		}).
+		AssertStatus(http.StatusNotFound)
+
+	_, err = repository.NewPitchRepository(db).FindByIDAndTripID(ctx, pitch.ID, pitch.TripID)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestHiddenContentLeavesTheFeed(t *testing.T) {
+	ctx := context.Background()
+	app := fakes.GetSharedTestApp()
+	db := fakes.GetSharedDB()
+	owner := createUser(t, app)
+	member := createUser(t, app)
+	tripID := uuid.MustParse(createTrip(t, app, owner))
+	feed := repository.NewActivityFeedRepository(db)
+	ownerID := uuid.MustParse(owner)
+	memberID := uuid.MustParse(member)
+
+	activityID, pitchID, commentID := uuid.New(), uuid.New(), uuid.New()
+	record := func(topic realtime.EventTopic, entityID uuid.UUID, data string) {
+		require.NoError(t, feed.CreateEventWithEntries(ctx, &models.ActivityFeedEvent{
+			ID:        uuid.New(),
+			TripID:    tripID,
+			Topic:     string(topic),
+			EntityID:  entityID.String(),
+			ActorID:   &memberID,
+			Data:      []byte(data),
+			GroupID:   uuid.New(),
+			CreatedAt: time.Now(),
+		}, []uuid.UUID{ownerID}))
+	}
+	record(realtime.EventTopicActivityCreated, activityID, fmt.Sprintf(`{"id":%q,"name":"Karaoke"}`, activityID))
+	record(realtime.EventTopicCommentCreated, pitchID, fmt.Sprintf(`{"id":%q,"entity_id":%q,"content":"rude"}`, commentID, pitchID))
+	record(realtime.EventTopicPitchCreated, pitchID, fmt.Sprintf(`{"id":%q,"title":"Lisbon"}`, pitchID))
+
+	groups, _, err := feed.FindGroupsWithCursor(ctx, ownerID, []uuid.UUID{tripID}, false, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, groups, 3)
+
+	hide := func(entityType models.EntityType, entityID uuid.UUID) {
+		_, err := db.NewInsert().Model(&models.HiddenContent{
+			EntityType: entityType,
+			EntityID:   entityID,
+			TripID:     tripID,
+		}).Exec(ctx)
+		require.NoError(t, err)
+	}
+	hide(models.ActivityEntity, activityID)
+	hide(models.CommentEntity, commentID)
+
+	groups, _, err = feed.FindGroupsWithCursor(ctx, ownerID, []uuid.UUID{tripID}, false, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, string(realtime.EventTopicPitchCreated), groups[0].Topic)
+
+	unread, err := feed.CountUnreadGroupsByTrip(ctx, ownerID, []uuid.UUID{tripID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), unread[tripID])
+}
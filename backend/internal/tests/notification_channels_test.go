@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"toggo/internal/config"
+	"toggo/internal/models"
+	"toggo/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationChannels(t *testing.T) {
+	ctx := context.Background()
+	email := "sam@example.com"
+	verifiedAt := time.Now()
+
+	pushOnly, emailOnly, everything, noApp, unverified := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	prefs := func(userID uuid.UUID, push, email, sms bool) *models.NotificationPreferences {
+		p := models.DefaultNotificationPreferences(userID)
+		p.PushEnabled, p.EmailEnabled, p.SMSEnabled = push, email, sms
+		return p
+	}
+
+	setup := func() (services.NotificationService, *services.MockExpoClient, *services.RecordingEmailSender, *services.RecordingSMSSender) {
+		expo := &services.MockExpoClient{}
+		emails := &services.RecordingEmailSender{}
+		texts := &services.RecordingSMSSender{}
+		svc := services.NewNotificationService(services.NotificationServiceConfig{
+			DeviceRepo: &mockUserDeviceRepo{devices: []*models.UserDevice{
+				{UserID: pushOnly, Token: "ExponentPushToken[push]"},
+				{UserID: emailOnly, Token: "ExponentPushToken[email]"},
+				{UserID: everything, Token: "ExponentPushToken[everything]"},
+			}},
+			Preferences: &recordingResolver{},
+			ExpoClient:  expo,
+			UserRepo: &fakeDigestUserRepo{users: []*models.User{
+				{ID: emailOnly, Email: &email, EmailVerifiedAt: &verifiedAt},
+				{ID: everything, Email: &email, EmailVerifiedAt: &verifiedAt, PhoneNumber: "+15550100"},
+				{ID: noApp, PhoneNumber: "+15550199"},
+				{ID: unverified, Email: &email},
+			}},
+			PreferencesRepo: &fakeResolverPrefsRepo{prefs: []*models.NotificationPreferences{
+				prefs(emailOnly, false, true, false),
+				prefs(everything, true, true, true),
+				prefs(noApp, false, false, true),
+				prefs(unverified, false, true, false),
+			}},
+			Channels: []services.NotificationChannel{
+				services.NewEmailChannel(emails),
+				services.NewSMSChannel(texts),
+			},
+		})
+		return svc, expo, emails, texts
+	}
+
+	t.Run("batch routes each user to their enabled channels", func(t *testing.T) {
+		svc, expo, emails, texts := setup()
+		resp, err := svc.SendNotificationBatch(ctx, models.SendBulkNotificationRequest{
+			UserIDs: []uuid.UUID{pushOnly, emailOnly, everything, noApp},
+			Title:   "New poll",
+			Body:    "Where should we eat?",
+		})
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{"ExponentPushToken[push]", "ExponentPushToken[everything]"}, expo.SendNotificationsTokens)
+		assert.Equal(t, []services.RecordedEmail{
+			{To: email, Subject: "New poll", Body: "Where should we eat?"},
+			{To: email, Subject: "New poll", Body: "Where should we eat?"},
+		}, emails.Sent())
+		assert.ElementsMatch(t, []services.RecordedSMS{
+			{To: "+15550100", Body: "New poll\nWhere should we eat?"},
+			{To: "+15550199", Body: "New poll\nWhere should we eat?"},
+		}, texts.Sent())
+		assert.Equal(t, 6, resp.SuccessCount)
+		assert.Zero(t, resp.FailureCount)
+	})
+
+	t.Run("users without the app are reached by SMS", func(t *testing.T) {
+		svc, expo, _, texts := setup()
+		require.NoError(t, svc.SendNotification(ctx, models.SendNotificationRequest{
+			UserID: noApp,
+			Title:  "Don't forget to vote!",
+			Body:   "The poll closes soon",
+		}))
+
+		assert.False(t, expo.SendNotificationsCalled)
+		require.Len(t, texts.Sent(), 1)
+		assert.Equal(t, "+15550199", texts.Sent()[0].To)
+	})
+
+	t.Run("unverified addresses are not emailed", func(t *testing.T) {
+		svc, _, emails, _ := setup()
+		err := svc.SendNotification(ctx, models.SendNotificationRequest{
+			UserID: unverified,
+			Title:  "New poll",
+			Body:   "Where should we eat?",
+		})
+
+		assert.Error(t, err)
+		assert.Empty(t, emails.Sent())
+	})
+
+	t.Run("twilio sender posts the message form", func(t *testing.T) {
+		var gotPath, gotUser, gotPass string
+		var gotForm map[string][]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			gotUser, gotPass, _ = r.BasicAuth()
+			require.NoError(t, r.ParseForm())
+			gotForm = r.PostForm
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		sender := services.NewTwilioSMSSender(config.SMSConfig{
+			AccountSID: "AC123",
+			AuthToken:  "secret",
+			FromNumber: "+15550000",
+			BaseURL:    server.URL,
+		}, server.Client())
+		require.NoError(t, sender.SendSMS(ctx, "+15550100", "hello"))
+
+		assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", gotPath)
+		assert.Equal(t, "AC123", gotUser)
+		assert.Equal(t, "secret", gotPass)
+		assert.Equal(t, []string{"+15550100"}, gotForm["To"])
+		assert.Equal(t, []string{"+15550000"}, gotForm["From"])
+		assert.Equal(t, []string{"hello"}, gotForm["Body"])
+	})
+
+	t.Run("twilio sender surfaces provider errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code": 21211, "message": "The 'To' number is not a valid phone number."}`))
+		}))
+		defer server.Close()
+
+		sender := services.NewTwilioSMSSender(config.SMSConfig{AccountSID: "AC123", BaseURL: server.URL}, server.Client())
+		err := sender.SendSMS(ctx, "nope", "hello")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not a valid phone number")
+	})
+}
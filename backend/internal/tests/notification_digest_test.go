@@ -0,0 +1,244 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+	"toggo/internal/templates"
+	"toggo/internal/workflows/notifications"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeferNotificationUntil(t *testing.T) {
+	quiet := models.DefaultNotificationPreferences(uuid.New())
+	quiet.QuietHoursEnabled = true
+
+	digest := models.DefaultNotificationPreferences(uuid.New())
+	digest.DigestEnabled = true
+	digest.DigestTime = "23:00"
+	digest.QuietHoursEnabled = true
+
+	tests := []struct {
+		name     string
+		prefs    *models.NotificationPreferences
+		timezone string
+		now      time.Time
+		want     time.Time
+		deferred bool
+	}{
+		{
+			name:     "defaults deliver immediately",
+			prefs:    models.DefaultNotificationPreferences(uuid.New()),
+			timezone: "UTC",
+			now:      time.Date(2026, 4, 23, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "outside quiet hours delivers immediately",
+			prefs:    quiet,
+			timezone: "America/New_York",
+			now:      time.Date(2026, 4, 23, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "3am local is held until quiet hours end",
+			prefs:    quiet,
+			timezone: "America/New_York",
+			now:      time.Date(2026, 4, 23, 7, 0, 0, 0, time.UTC),
+			want:     time.Date(2026, 4, 23, 12, 0, 0, 0, time.UTC),
+			deferred: true,
+		},
+		{
+			name:     "late evening wraps to the next morning",
+			prefs:    quiet,
+			timezone: "UTC",
+			now:      time.Date(2026, 4, 23, 22, 30, 0, 0, time.UTC),
+			want:     time.Date(2026, 4, 24, 8, 0, 0, 0, time.UTC),
+			deferred: true,
+		},
+		{
+			name:     "unknown timezone falls back to UTC",
+			prefs:    quiet,
+			timezone: "Mars/Olympus",
+			now:      time.Date(2026, 4, 23, 1, 0, 0, 0, time.UTC),
+			want:     time.Date(2026, 4, 23, 8, 0, 0, 0, time.UTC),
+			deferred: true,
+		},
+		{
+			name:     "digest inside quiet hours moves to quiet hours end",
+			prefs:    digest,
+			timezone: "UTC",
+			now:      time.Date(2026, 4, 23, 12, 0, 0, 0, time.UTC),
+			want:     time.Date(2026, 4, 24, 8, 0, 0, 0, time.UTC),
+			deferred: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, deferred := services.DeferNotificationUntil(tt.prefs, tt.timezone, tt.now)
+			assert.Equal(t, tt.deferred, deferred)
+			assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+type fakeDigestUserRepo struct {
+	repository.UserRepository
+	users []*models.User
+}
+
+func (f *fakeDigestUserRepo) FindByIDs(context.Context, []uuid.UUID) ([]*models.User, error) {
+	return f.users, nil
+}
+
+type fakeDigestRepo struct {
+	items     []*models.NotificationDigestItem
+	delivered []uuid.UUID
+}
+
+func (f *fakeDigestRepo) CreateMany(_ context.Context, items []*models.NotificationDigestItem) error {
+	f.items = append(f.items, items...)
+	return nil
+}
+
+func (f *fakeDigestRepo) FindDueByUserID(_ context.Context, userID uuid.UUID, now time.Time) ([]*models.NotificationDigestItem, error) {
+	var due []*models.NotificationDigestItem
+	for _, item := range f.items {
+		if item.UserID == userID && !item.DeliverAt.After(now) {
+			due = append(due, item)
+		}
+	}
+	return due, nil
+}
+
+func (f *fakeDigestRepo) MarkDelivered(_ context.Context, ids []uuid.UUID) error {
+	f.delivered = append(f.delivered, ids...)
+	return nil
+}
+
+type recordingDigestScheduler struct {
+	scheduled map[uuid.UUID]time.Time
+}
+
+func (r *recordingDigestScheduler) ScheduleDigest(_ context.Context, userID uuid.UUID, deliverAt time.Time) error {
+	if r.scheduled == nil {
+		r.scheduled = map[uuid.UUID]time.Time{}
+	}
+	r.scheduled[userID] = deliverAt
+	return nil
+}
+
+func TestNotifyTripMembers_HoldsBackDigestRecipients(t *testing.T) {
+	tripID := uuid.New()
+	instant, digestUser := uuid.New(), uuid.New()
+
+	digestPrefs := models.DefaultNotificationPreferences(digestUser)
+	digestPrefs.DigestEnabled = true
+
+	devices := &mockUserDeviceRepo{devices: []*models.UserDevice{
+		{UserID: instant, Token: "ExponentPushToken[instant]"},
+		{UserID: digestUser, Token: "ExponentPushToken[digest]"},
+	}}
+	digests := &fakeDigestRepo{}
+	scheduler := &recordingDigestScheduler{}
+	expo := &services.MockExpoClient{}
+
+	svc := services.NewNotificationService(services.NotificationServiceConfig{
+		DeviceRepo:  devices,
+		Preferences: &recordingResolver{recipients: []uuid.UUID{instant, digestUser}},
+		ExpoClient:  expo,
+		UserRepo: &fakeDigestUserRepo{users: []*models.User{
+			{ID: instant, Timezone: "UTC"},
+			{ID: digestUser, Timezone: "Europe/Lisbon"},
+		}},
+		PreferencesRepo: &fakeResolverPrefsRepo{prefs: []*models.NotificationPreferences{digestPrefs}},
+		DigestRepo:      digests,
+		DigestScheduler: scheduler,
+	})
+
+	t.Run("trip activity is queued for digest users", func(t *testing.T) {
+		require.NoError(t, svc.NotifyTripMembers(context.Background(), tripID, uuid.New(), models.NotificationPreferenceNewComment, templates.Message{Key: templates.NotificationNewComment}, nil))
+
+		assert.Equal(t, []string{"ExponentPushToken[instant]"}, expo.SendNotificationsTokens)
+		require.Len(t, digests.items, 1)
+		assert.Equal(t, digestUser, digests.items[0].UserID)
+		assert.Equal(t, models.NotificationPreferenceNewComment, digests.items[0].Kind)
+		assert.Equal(t, digests.items[0].DeliverAt, scheduler.scheduled[digestUser])
+	})
+
+	t.Run("deadline reminders are never held back", func(t *testing.T) {
+		require.NoError(t, svc.SendNotification(context.Background(), models.SendNotificationRequest{
+			UserID: digestUser,
+			Title:  "Don't forget to vote!",
+			Body:   "The poll closes soon",
+		}))
+		assert.Equal(t, []string{"ExponentPushToken[digest]"}, expo.SendNotificationsTokens)
+		assert.Len(t, digests.items, 1)
+	})
+}
+
+func TestSummarizeDigest(t *testing.T) {
+	tripA, tripB := uuid.New(), uuid.New()
+	item := func(tripID uuid.UUID, kind models.NotificationPreference) *models.NotificationDigestItem {
+		return &models.NotificationDigestItem{TripID: tripID, Kind: kind, Title: "New comment", Body: "Someone commented on your trip"}
+	}
+
+	title, body := notifications.SummarizeDigest("en", []*models.NotificationDigestItem{item(tripA, models.NotificationPreferenceNewComment)})
+	assert.Equal(t, "New comment", title)
+	assert.Equal(t, "Someone commented on your trip", body)
+
+	several := []*models.NotificationDigestItem{
+		item(tripA, models.NotificationPreferenceNewComment),
+		item(tripA, models.NotificationPreferenceNewComment),
+		item(tripB, models.NotificationPreferenceNewPitch),
+	}
+	title, body = notifications.SummarizeDigest("en", several)
+	assert.Equal(t, "While you were away", title)
+	assert.Equal(t, "2 new comments and 1 new pitch across 2 trips", body)
+
+	title, body = notifications.SummarizeDigest("es", several)
+	assert.Equal(t, "Mientras no estabas", title)
+	assert.Equal(t, "2 comentarios nuevos y 1 propuesta nueva en 2 viajes", body)
+}
+
+func TestDispatchNotification_Digest(t *testing.T) {
+	userID := uuid.New()
+	past := time.Now().Add(-time.Minute)
+	digests := &fakeDigestRepo{items: []*models.NotificationDigestItem{
+		{ID: uuid.New(), UserID: userID, TripID: uuid.New(), Kind: models.NotificationPreferenceNewComment, DeliverAt: past},
+		{ID: uuid.New(), UserID: userID, TripID: uuid.New(), Kind: models.NotificationPreferenceNewPoll, DeliverAt: past},
+		{ID: uuid.New(), UserID: userID, TripID: uuid.New(), Kind: models.NotificationPreferenceNewPoll, DeliverAt: time.Now().Add(time.Hour)},
+	}}
+	sender := &mockNotificationSender{}
+	activities := &notifications.NotificationActivities{NotificationSender: sender, Digests: digests}
+
+	payload, err := json.Marshal(notifications.NotificationDigestPayload{UserID: userID})
+	require.NoError(t, err)
+	require.NoError(t, activities.DispatchNotification(context.Background(), notifications.ScheduledNotificationInput{
+		JobType: notifications.JobTypeNotificationDigest,
+		Payload: payload,
+	}))
+
+	assert.Equal(t, 1, sender.callCount)
+	assert.Equal(t, []uuid.UUID{userID}, sender.userIDs)
+	assert.Equal(t, []uuid.UUID{digests.items[0].ID, digests.items[1].ID}, digests.delivered)
+
+	t.Run("items stay queued when the send fails", func(t *testing.T) {
+		digests.delivered = nil
+		sender.err = errors.New("expo unavailable")
+
+		err := activities.DispatchNotification(context.Background(), notifications.ScheduledNotificationInput{
+			JobType: notifications.JobTypeNotificationDigest,
+			Payload: payload,
+		})
+		assert.Error(t, err)
+		assert.Empty(t, digests.delivered)
+	})
+}
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"toggo/internal/models"
+	"toggo/internal/services"
+	"toggo/internal/workflows/notifications"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDeliveryRepo struct {
+	created []*models.NotificationDelivery
+	updates map[string]models.NotificationDeliveryStatus
+	codes   map[string]string
+}
+
+func (f *fakeDeliveryRepo) CreateMany(_ context.Context, deliveries []*models.NotificationDelivery) error {
+	f.created = append(f.created, deliveries...)
+	return nil
+}
+
+func (f *fakeDeliveryRepo) FindByTicketIDs(_ context.Context, ticketIDs []string) ([]*models.NotificationDelivery, error) {
+	wanted := make(map[string]bool, len(ticketIDs))
+	for _, id := range ticketIDs {
+		wanted[id] = true
+	}
+	var found []*models.NotificationDelivery
+	for _, delivery := range f.created {
+		if delivery.TicketID != nil && wanted[*delivery.TicketID] {
+			found = append(found, delivery)
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeDeliveryRepo) UpdateStatus(_ context.Context, ticketID string, status models.NotificationDeliveryStatus, errorCode, _ *string) error {
+	if f.updates == nil {
+		f.updates = map[string]models.NotificationDeliveryStatus{}
+		f.codes = map[string]string{}
+	}
+	f.updates[ticketID] = status
+	if errorCode != nil {
+		f.codes[ticketID] = *errorCode
+	}
+	return nil
+}
+
+type recordingReceiptScheduler struct {
+	ticketIDs []string
+}
+
+func (r *recordingReceiptScheduler) ScheduleReceiptCheck(_ context.Context, ticketIDs []string) error {
+	r.ticketIDs = append(r.ticketIDs, ticketIDs...)
+	return nil
+}
+
+func TestNotificationServiceTracksDeliveries(t *testing.T) {
+	userID := uuid.New()
+	devices := &mockUserDeviceRepo{devices: []*models.UserDevice{
+		{UserID: userID, Token: "ExponentPushToken[phone]"},
+		{UserID: userID, Token: "ExponentPushToken[gone]"},
+	}}
+	expo := &services.MockExpoClient{
+		SendNotificationsResponse: &services.ExpoBulkResponse{Data: []services.ExpoNotificationResponse{
+			{Status: "ok", ID: "ticket-1"},
+			{Status: "error", Message: "not registered", Details: map[string]interface{}{"error": services.ExpoDeviceNotRegistered}},
+		}},
+	}
+	deliveries := &fakeDeliveryRepo{}
+	scheduler := &recordingReceiptScheduler{}
+
+	service := services.NewNotificationService(services.NotificationServiceConfig{
+		DeviceRepo:       devices,
+		DeliveryRepo:     deliveries,
+		Preferences:      services.NewNotificationPreferenceResolver(&noopMembershipRepo{}, nil),
+		ExpoClient:       expo,
+		ReceiptScheduler: scheduler,
+	})
+
+	require.NoError(t, service.SendNotification(context.Background(), models.SendNotificationRequest{
+		UserID: userID,
+		Title:  "Dinner poll",
+		Body:   "Vote now",
+	}))
+
+	require.Len(t, deliveries.created, 2)
+	assert.Equal(t, models.NotificationDeliveryPending, deliveries.created[0].Status)
+	assert.Equal(t, "ticket-1", *deliveries.created[0].TicketID)
+	assert.Equal(t, models.NotificationDeliveryFailed, deliveries.created[1].Status)
+	assert.Equal(t, services.ExpoDeviceNotRegistered, *deliveries.created[1].ErrorCode)
+	assert.Equal(t, []string{"ticket-1"}, scheduler.ticketIDs)
+}
+
+func TestCheckExpoReceipts(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/getReceipts", r.URL.Path)
+		var body struct {
+			IDs []string `json:"ids"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requested = body.IDs
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"ok-ticket":   map[string]any{"status": "ok"},
+				"dead-ticket": map[string]any{"status": "error", "message": "gone", "details": map[string]any{"error": services.ExpoDeviceNotRegistered}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	okTicket, deadTicket, lateTicket := "ok-ticket", "dead-ticket", "late-ticket"
+	deliveries := &fakeDeliveryRepo{created: []*models.NotificationDelivery{
+		{TicketID: &okTicket, Token: "ExponentPushToken[ok]"},
+		{TicketID: &deadTicket, Token: "ExponentPushToken[dead]"},
+		{TicketID: &lateTicket, Token: "ExponentPushToken[late]"},
+	}}
+	devices := &mockUserDeviceRepo{}
+	activities := &notifications.ReceiptActivities{
+		ExpoClient: services.NewExpoClientWithBaseURL("", server.URL),
+		Deliveries: deliveries,
+		Devices:    devices,
+	}
+
+	pending, err := activities.CheckExpoReceipts(context.Background(), []string{okTicket, deadTicket, lateTicket})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{okTicket, deadTicket, lateTicket}, requested)
+	assert.Equal(t, []string{lateTicket}, pending)
+	assert.Equal(t, models.NotificationDeliveryDelivered, deliveries.updates[okTicket])
+	assert.Equal(t, models.NotificationDeliveryFailed, deliveries.updates[deadTicket])
+	assert.Equal(t, services.ExpoDeviceNotRegistered, deliveries.codes[deadTicket])
+	assert.Equal(t, []string{"ExponentPushToken[dead]"}, devices.deletedTokens)
+}
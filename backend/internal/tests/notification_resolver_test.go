@@ -0,0 +1,237 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+	"toggo/internal/templates"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResolverMembershipRepo struct {
+	repository.MembershipRepository
+	members []*models.MembershipDatabaseResponse
+}
+
+func (f *fakeResolverMembershipRepo) FindByTripID(context.Context, uuid.UUID) ([]*models.MembershipDatabaseResponse, error) {
+	return f.members, nil
+}
+
+type fakeResolverPrefsRepo struct {
+	repository.NotificationPreferencesRepository
+	prefs []*models.NotificationPreferences
+}
+
+func (f *fakeResolverPrefsRepo) FindByUserIDs(_ context.Context, userIDs []uuid.UUID) ([]*models.NotificationPreferences, error) {
+	wanted := make(map[uuid.UUID]bool, len(userIDs))
+	for _, id := range userIDs {
+		wanted[id] = true
+	}
+	var found []*models.NotificationPreferences
+	for _, p := range f.prefs {
+		if wanted[p.UserID] {
+			found = append(found, p)
+		}
+	}
+	return found, nil
+}
+
+func allowedPrefs(category models.NotificationCategory, pushEnabled, categoryEnabled bool) *models.NotificationPreferences {
+	prefs := models.DefaultNotificationPreferences(uuid.New())
+	prefs.PushEnabled = pushEnabled
+	switch category {
+	case models.NotificationCategoryTripActivity:
+		prefs.TripActivity = categoryEnabled
+	case models.NotificationCategoryVotingReminders:
+		prefs.VotingReminders = categoryEnabled
+	case models.NotificationCategoryDeadlineReminders:
+		prefs.DeadlineReminders = categoryEnabled
+	case models.NotificationCategoryUpcomingTrip:
+		prefs.UpcomingTrip = categoryEnabled
+	case models.NotificationCategoryFinalizedDecisions:
+		prefs.FinalizedDecisions = categoryEnabled
+	}
+	return prefs
+}
+
+func membershipWith(preference models.NotificationPreference, enabled bool) *models.MembershipDatabaseResponse {
+	membership := &models.MembershipDatabaseResponse{
+		NotifyNewPitches:  true,
+		NotifyNewPolls:    true,
+		NotifyNewComments: true,
+	}
+	switch preference {
+	case models.NotificationPreferenceNewPitch:
+		membership.NotifyNewPitches = enabled
+	case models.NotificationPreferenceNewPoll:
+		membership.NotifyNewPolls = enabled
+	case models.NotificationPreferenceNewComment:
+		membership.NotifyNewComments = enabled
+	}
+	return membership
+}
+
+func TestAllowsNotification_Matrix(t *testing.T) {
+	categories := []models.NotificationCategory{
+		models.NotificationCategoryTripActivity,
+		models.NotificationCategoryVotingReminders,
+		models.NotificationCategoryDeadlineReminders,
+		models.NotificationCategoryUpcomingTrip,
+		models.NotificationCategoryFinalizedDecisions,
+	}
+	tripPreferences := []models.NotificationPreference{
+		"",
+		models.NotificationPreferenceNewPitch,
+		models.NotificationPreferenceNewPoll,
+		models.NotificationPreferenceNewComment,
+	}
+
+	for _, category := range categories {
+		for _, tripPreference := range tripPreferences {
+			for _, pushEnabled := range []bool{true, false} {
+				for _, categoryEnabled := range []bool{true, false} {
+					for _, tripEnabled := range []bool{true, false} {
+						name := fmt.Sprintf("%s/%q/push=%t/category=%t/trip=%t", category, tripPreference, pushEnabled, categoryEnabled, tripEnabled)
+						t.Run(name, func(t *testing.T) {
+							prefs := allowedPrefs(category, pushEnabled, categoryEnabled)
+							membership := membershipWith(tripPreference, tripEnabled)
+
+							want := pushEnabled && categoryEnabled && (tripPreference == "" || tripEnabled)
+							assert.Equal(t, want, services.AllowsNotification(prefs, membership, category, tripPreference))
+						})
+					}
+				}
+			}
+		}
+	}
+
+	t.Run("users without saved preferences get the defaults", func(t *testing.T) {
+		for _, category := range categories {
+			assert.True(t, services.AllowsNotification(nil, nil, category, ""), category)
+		}
+		assert.False(t, services.AllowsNotification(nil, membershipWith(models.NotificationPreferenceNewPoll, false), models.NotificationCategoryTripActivity, models.NotificationPreferenceNewPoll))
+	})
+
+	t.Run("unknown category is never allowed", func(t *testing.T) {
+		assert.False(t, services.AllowsNotification(nil, nil, "unknown", ""))
+	})
+
+	t.Run("trip preferences fall under trip activity", func(t *testing.T) {
+		for _, preference := range tripPreferences[1:] {
+			assert.Equal(t, models.NotificationCategoryTripActivity, preference.Category())
+		}
+	})
+}
+
+func TestNotificationPreferenceResolver(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	actor, optedIn, noPrefs, pushOff, pollsMuted, outsider := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	membershipsRepo := &fakeResolverMembershipRepo{}
+	for _, id := range []uuid.UUID{actor, optedIn, noPrefs, pushOff, pollsMuted} {
+		membership := membershipWith("", true)
+		membership.UserID = id
+		membership.TripID = tripID
+		if id == pollsMuted {
+			membership.NotifyNewPolls = false
+		}
+		membershipsRepo.members = append(membershipsRepo.members, membership)
+	}
+
+	optedInPrefs := models.DefaultNotificationPreferences(optedIn)
+	pushOffPrefs := models.DefaultNotificationPreferences(pushOff)
+	pushOffPrefs.PushEnabled = false
+	outsiderPrefs := models.DefaultNotificationPreferences(outsider)
+	prefsRepo := &fakeResolverPrefsRepo{prefs: []*models.NotificationPreferences{optedInPrefs, pushOffPrefs, outsiderPrefs}}
+
+	resolver := services.NewNotificationPreferenceResolver(membershipsRepo, prefsRepo)
+
+	t.Run("trip-wide audience excludes actor, muted trips and push-disabled users", func(t *testing.T) {
+		recipients, err := resolver.ResolveRecipients(ctx, models.NotificationAudience{
+			TripID:         tripID,
+			ExcludeUserID:  actor,
+			Category:       models.NotificationCategoryTripActivity,
+			TripPreference: models.NotificationPreferenceNewPoll,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{optedIn, noPrefs}, recipients)
+	})
+
+	t.Run("explicit audience drops non-members", func(t *testing.T) {
+		recipients, err := resolver.ResolveRecipients(ctx, models.NotificationAudience{
+			TripID:   tripID,
+			UserIDs:  []uuid.UUID{pollsMuted, outsider, pushOff},
+			Category: models.NotificationCategoryVotingReminders,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{pollsMuted}, recipients)
+	})
+
+	t.Run("category toggle applies to the whole audience", func(t *testing.T) {
+		optedInPrefs.VotingReminders = false
+		defer func() { optedInPrefs.VotingReminders = true }()
+
+		recipients, err := resolver.ResolveRecipients(ctx, models.NotificationAudience{
+			TripID:   tripID,
+			UserIDs:  []uuid.UUID{optedIn, noPrefs},
+			Category: models.NotificationCategoryVotingReminders,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{noPrefs}, recipients)
+	})
+}
+
+type recordingResolver struct {
+	audience   models.NotificationAudience
+	recipients []uuid.UUID
+}
+
+func (r *recordingResolver) ResolveRecipients(_ context.Context, audience models.NotificationAudience) ([]uuid.UUID, error) {
+	r.audience = audience
+	return r.recipients, nil
+}
+
+func TestNotifyTripMembers_UsesPreferenceResolver(t *testing.T) {
+	tripID, actorID := uuid.New(), uuid.New()
+
+	t.Run("resolves recipients by trip preference and category", func(t *testing.T) {
+		token := "ExponentPushToken[zzz]"
+		recipient := &models.UserDevice{UserID: uuid.New(), Token: token}
+		resolver := &recordingResolver{recipients: []uuid.UUID{recipient.UserID}}
+		expo := &services.MockExpoClient{}
+
+		svc := services.NewNotificationService(services.NotificationServiceConfig{
+			DeviceRepo:  &mockUserDeviceRepo{devices: []*models.UserDevice{recipient}},
+			Preferences: resolver,
+			ExpoClient:  expo,
+		})
+		require.NoError(t, svc.NotifyTripMembers(context.Background(), tripID, actorID, models.NotificationPreferenceNewComment, templates.Message{Key: templates.NotificationNewComment}, nil))
+
+		assert.Equal(t, models.NotificationAudience{
+			TripID:         tripID,
+			ExcludeUserID:  actorID,
+			Category:       models.NotificationCategoryTripActivity,
+			TripPreference: models.NotificationPreferenceNewComment,
+		}, resolver.audience)
+		assert.Equal(t, []string{token}, expo.SendNotificationsTokens)
+	})
+
+	t.Run("sends nothing when nobody is opted in", func(t *testing.T) {
+		expo := &services.MockExpoClient{}
+		svc := services.NewNotificationService(services.NotificationServiceConfig{
+			DeviceRepo:  &mockUserDeviceRepo{},
+			Preferences: &recordingResolver{},
+			ExpoClient:  expo,
+		})
+
+		require.NoError(t, svc.NotifyTripMembers(context.Background(), tripID, actorID, models.NotificationPreferenceNewPoll, templates.Message{Key: templates.NotificationNewPoll}, nil))
+		assert.False(t, expo.SendNotificationsCalled)
+	})
+}
@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"toggo/internal/models"
+	"toggo/internal/templates"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationTemplates(t *testing.T) {
+	t.Run("renders in the user's locale", func(t *testing.T) {
+		msg := templates.Message{
+			Key:  templates.NotificationVotingOpen,
+			Data: map[string]any{"TripName": "Lisbon"},
+		}
+
+		title, body := templates.Notification("en", msg)
+		assert.Equal(t, "Voting is open", title)
+		assert.Equal(t, "Pitching for Lisbon has closed. Rank your favorite destinations!", body)
+
+		title, body = templates.Notification("es", msg)
+		assert.Equal(t, "La votación está abierta", title)
+		assert.Equal(t, "Las propuestas para Lisbon han cerrado. ¡Ordena tus destinos favoritos!", body)
+	})
+
+	t.Run("regional and unknown locales resolve to a supported locale", func(t *testing.T) {
+		assert.Equal(t, "es", templates.ResolveLocale("es-MX"))
+		assert.Equal(t, "es", templates.ResolveLocale("ES_es"))
+		assert.Equal(t, models.DefaultLocale, templates.ResolveLocale("de"))
+		assert.Equal(t, models.DefaultLocale, templates.ResolveLocale(""))
+	})
+
+	t.Run("pluralises counts", func(t *testing.T) {
+		assert.Equal(t, "1 new poll", templates.Text("en", "digest.part.new_poll", map[string]any{"Count": 1}))
+		assert.Equal(t, "3 new polls", templates.Text("en", "digest.part.new_poll", map[string]any{"Count": 3}))
+		assert.Equal(t, "3 encuestas nuevas", templates.Text("es", "digest.part.new_poll", map[string]any{"Count": 3}))
+	})
+
+	t.Run("falls back to the default locale when a message fails to render", func(t *testing.T) {
+		_, ok := templates.Lookup("es", "poll_deadline_reminder.body", map[string]any{"Question": "Where?"})
+		assert.False(t, ok, "missing template data should not render")
+
+		text, ok := templates.Lookup("es", templates.NotificationNewPoll+".title", nil)
+		require.True(t, ok)
+		assert.Equal(t, "Nueva encuesta", text)
+	})
+
+	t.Run("every locale translates the same messages", func(t *testing.T) {
+		keys := func(locale string) []string {
+			raw, err := os.ReadFile(filepath.Join("..", "templates", "locales", locale+".json"))
+			require.NoError(t, err)
+			var file struct {
+				Messages map[string]string `json:"messages"`
+			}
+			require.NoError(t, json.Unmarshal(raw, &file))
+			out := make([]string, 0, len(file.Messages))
+			for key := range file.Messages {
+				out = append(out, key)
+			}
+			return out
+		}
+
+		english := keys(models.DefaultLocale)
+		for _, locale := range models.SupportedLocales {
+			assert.ElementsMatch(t, english, keys(locale), "locale %s", locale)
+		}
+	})
+}
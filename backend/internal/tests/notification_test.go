@@ -9,16 +9,22 @@ import (
 	"github.com/google/uuid"
 )
 
+func newTestNotificationService(devices *mockUserDeviceRepo, expo services.ExpoClient) services.NotificationService {
+	return services.NewNotificationService(services.NotificationServiceConfig{
+		DeviceRepo:  devices,
+		Preferences: services.NewNotificationPreferenceResolver(&noopMembershipRepo{}, nil),
+		ExpoClient:  expo,
+	})
+}
+
 func TestNotificationServiceSendNotification(t *testing.T) {
 	t.Run("returns error when user has no device token", func(t *testing.T) {
 		userID := uuid.New()
 
-		mockRepo := &mockNotificationUserRepo{
-			users: []*models.User{},
-		}
+		mockRepo := &mockUserDeviceRepo{}
 		mockExpoClient := &services.MockExpoClient{}
 
-		notifService := services.NewNotificationService(mockRepo, &noopMembershipRepo{}, mockExpoClient)
+		notifService := newTestNotificationService(mockRepo, mockExpoClient)
 
 		req := models.SendNotificationRequest{
 			UserID: userID,
@@ -38,18 +44,11 @@ func TestNotificationServiceSendNotification(t *testing.T) {
 	t.Run("sends notification to user with device token", func(t *testing.T) {
 		userID := uuid.New()
 		token := "ExponentPushToken[xxx]"
-		user := &models.User{
-			ID:          userID,
-			Name:        "Test User",
-			DeviceToken: &token,
-		}
 
-		mockRepo := &mockNotificationUserRepo{
-			users: []*models.User{user},
-		}
+		mockRepo := &mockUserDeviceRepo{devices: []*models.UserDevice{{UserID: userID, Token: token}}}
 		mockExpoClient := &services.MockExpoClient{}
 
-		notifService := services.NewNotificationService(mockRepo, &noopMembershipRepo{}, mockExpoClient)
+		notifService := newTestNotificationService(mockRepo, mockExpoClient)
 		req := models.SendNotificationRequest{
 			UserID: userID,
 			Title:  "Test Title",
@@ -70,6 +69,32 @@ func TestNotificationServiceSendNotification(t *testing.T) {
 			t.Errorf("expected title %q, got %q", "Test Title", mockExpoClient.SendNotificationsTitle)
 		}
 	})
+
+	t.Run("sends to every device the user registered", func(t *testing.T) {
+		userID := uuid.New()
+		phone := "ExponentPushToken[phone]"
+		tablet := "ExponentPushToken[tablet]"
+
+		mockRepo := &mockUserDeviceRepo{devices: []*models.UserDevice{
+			{UserID: userID, Token: phone},
+			{UserID: userID, Token: tablet},
+		}}
+		mockExpoClient := &services.MockExpoClient{}
+
+		notifService := newTestNotificationService(mockRepo, mockExpoClient)
+		err := notifService.SendNotification(context.Background(), models.SendNotificationRequest{
+			UserID: userID,
+			Title:  "Test Title",
+			Body:   "Test Body",
+		})
+
+		if err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if len(mockExpoClient.SendNotificationsTokens) != 2 {
+			t.Errorf("expected both devices to be notified, got %v", mockExpoClient.SendNotificationsTokens)
+		}
+	})
 }
 
 func TestNotificationServiceBatch(t *testing.T) {
@@ -79,15 +104,13 @@ func TestNotificationServiceBatch(t *testing.T) {
 		token1 := "ExponentPushToken[xxx]"
 		token2 := "ExponentPushToken[yyy]"
 
-		users := map[uuid.UUID]*models.User{
-			userID1: {ID: userID1, Name: "User 1", DeviceToken: &token1},
-			userID2: {ID: userID2, Name: "User 2", DeviceToken: &token2},
-		}
-
-		mockRepo := &mockNotificationUserRepo{users: []*models.User{users[userID1], users[userID2]}}
+		mockRepo := &mockUserDeviceRepo{devices: []*models.UserDevice{
+			{UserID: userID1, Token: token1},
+			{UserID: userID2, Token: token2},
+		}}
 		mockExpoClient := &services.MockExpoClient{}
 
-		notifService := services.NewNotificationService(mockRepo, &noopMembershipRepo{}, mockExpoClient)
+		notifService := newTestNotificationService(mockRepo, mockExpoClient)
 
 		req := models.SendBulkNotificationRequest{
 			UserIDs: []uuid.UUID{userID1, userID2},
@@ -104,39 +127,99 @@ func TestNotificationServiceBatch(t *testing.T) {
 			t.Error("expected Expo client to be called")
 		}
 	})
-}
 
-type mockNotificationUserRepo struct {
-	users []*models.User
+	t.Run("prunes tokens Expo reports as not registered", func(t *testing.T) {
+		userID := uuid.New()
+		live := "ExponentPushToken[live]"
+		dead := "ExponentPushToken[dead]"
+
+		mockRepo := &mockUserDeviceRepo{devices: []*models.UserDevice{
+			{UserID: userID, Token: live},
+			{UserID: userID, Token: dead},
+		}}
+		mockExpoClient := &services.MockExpoClient{
+			SendNotificationsResponse: &services.ExpoBulkResponse{Data: []services.ExpoNotificationResponse{
+				{Status: "ok", ID: "ticket-1"},
+				{Status: "error", Message: "not registered", Details: map[string]interface{}{"error": "DeviceNotRegistered"}},
+			}},
+		}
+
+		notifService := newTestNotificationService(mockRepo, mockExpoClient)
+		resp, err := notifService.SendNotificationBatch(context.Background(), models.SendBulkNotificationRequest{
+			UserIDs: []uuid.UUID{userID},
+			Title:   "Batch Test",
+			Body:    "Batch Body",
+		})
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if resp.SuccessCount != 1 || resp.FailureCount != 1 {
+			t.Errorf("expected 1 success and 1 failure, got %d/%d", resp.SuccessCount, resp.FailureCount)
+		}
+		if len(mockRepo.deletedTokens) != 1 || mockRepo.deletedTokens[0] != dead {
+			t.Errorf("expected %q to be pruned, got %v", dead, mockRepo.deletedTokens)
+		}
+	})
+
+	t.Run("keeps tokens on other ticket errors", func(t *testing.T) {
+		userID := uuid.New()
+		mockRepo := &mockUserDeviceRepo{devices: []*models.UserDevice{{UserID: userID, Token: "ExponentPushToken[busy]"}}}
+		mockExpoClient := &services.MockExpoClient{
+			SendNotificationsResponse: &services.ExpoBulkResponse{Data: []services.ExpoNotificationResponse{
+				{Status: "error", Message: "rate limited", Details: map[string]interface{}{"error": "MessageRateExceeded"}},
+			}},
+		}
+
+		notifService := newTestNotificationService(mockRepo, mockExpoClient)
+		if _, err := notifService.SendNotificationBatch(context.Background(), models.SendBulkNotificationRequest{
+			UserIDs: []uuid.UUID{userID},
+			Title:   "Batch Test",
+			Body:    "Batch Body",
+		}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(mockRepo.deletedTokens) != 0 {
+			t.Errorf("expected no tokens to be pruned, got %v", mockRepo.deletedTokens)
+		}
+	})
 }
 
-func (m *mockNotificationUserRepo) Create(ctx context.Context, user *models.User) (*models.User, error) {
-	return user, nil
+type mockUserDeviceRepo struct {
+	devices       []*models.UserDevice
+	deletedTokens []string
 }
 
-func (m *mockNotificationUserRepo) Find(ctx context.Context, id uuid.UUID) (*models.User, error) {
-	for _, u := range m.users {
-		if u.ID == id {
-			return u, nil
-		}
-	}
-	return nil, nil
+func (m *mockUserDeviceRepo) Upsert(ctx context.Context, device *models.UserDevice) (*models.UserDevice, error) {
+	m.devices = append(m.devices, device)
+	return device, nil
 }
 
-func (m *mockNotificationUserRepo) Update(ctx context.Context, id uuid.UUID, user *models.UpdateUserRequest) (*models.User, error) {
-	return &models.User{ID: id}, nil
+func (m *mockUserDeviceRepo) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.UserDevice, error) {
+	return m.FindByUserIDs(ctx, []uuid.UUID{userID})
 }
 
-func (m *mockNotificationUserRepo) Delete(ctx context.Context, id uuid.UUID) error {
-	return nil
+func (m *mockUserDeviceRepo) FindByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.UserDevice, error) {
+	wanted := make(map[uuid.UUID]bool, len(userIDs))
+	for _, id := range userIDs {
+		wanted[id] = true
+	}
+	var devices []*models.UserDevice
+	for _, d := range m.devices {
+		if wanted[d.UserID] {
+			devices = append(devices, d)
+		}
+	}
+	return devices, nil
 }
 
-func (m *mockNotificationUserRepo) UpdateDeviceToken(ctx context.Context, id uuid.UUID, deviceToken string) (*models.User, error) {
-	return nil, nil
+func (m *mockUserDeviceRepo) Delete(ctx context.Context, userID uuid.UUID, token string) error {
+	return nil
 }
 
-func (m *mockNotificationUserRepo) GetUsersWithDeviceTokens(ctx context.Context, userIDs []uuid.UUID) ([]*models.User, error) {
-	return m.users, nil
+func (m *mockUserDeviceRepo) DeleteByTokens(ctx context.Context, tokens []string) (int, error) {
+	m.deletedTokens = append(m.deletedTokens, tokens...)
+	return len(tokens), nil
 }
 
 type noopMembershipRepo struct{}
@@ -177,9 +260,24 @@ func (n *noopMembershipRepo) GetMemberStatsForTrips(ctx context.Context, tripIDs
 func (n *noopMembershipRepo) Update(ctx context.Context, userID, tripID uuid.UUID, req *models.UpdateMembershipRequest) (*models.Membership, error) {
 	return nil, nil
 }
+func (n *noopMembershipRepo) FindRole(ctx context.Context, tripID, userID uuid.UUID) (models.TripRole, error) {
+	return "", nil
+}
+func (n *noopMembershipRepo) FindAccess(ctx context.Context, tripID, userID uuid.UUID) (models.TripAccess, error) {
+	return models.TripAccess{}, nil
+}
+func (n *noopMembershipRepo) UpdateRole(ctx context.Context, userID, tripID uuid.UUID, role models.TripRole) (*models.Membership, error) {
+	return nil, nil
+}
+func (n *noopMembershipRepo) TransferOwnership(ctx context.Context, tripID, fromUserID, toUserID uuid.UUID) error {
+	return nil
+}
 func (n *noopMembershipRepo) UpdateNotificationPreferences(ctx context.Context, userID, tripID uuid.UUID, req *models.UpdateNotificationPreferencesRequest) (*models.Membership, error) {
 	return nil, nil
 }
 func (n *noopMembershipRepo) Delete(ctx context.Context, userID, tripID uuid.UUID) error {
 	return nil
 }
+func (n *noopMembershipRepo) DeleteAndHandOverContent(ctx context.Context, userID, tripID uuid.UUID, newAuthorID *uuid.UUID) error {
+	return nil
+}
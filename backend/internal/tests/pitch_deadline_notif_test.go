@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+	"toggo/internal/models"
+	"toggo/internal/workflows/notifications"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePitchDeadlineTrips struct {
+	trip       *models.Trip
+	closeCalls int
+}
+
+func (f *fakePitchDeadlineTrips) Find(context.Context, uuid.UUID) (*models.Trip, error) {
+	return f.trip, nil
+}
+
+func (f *fakePitchDeadlineTrips) ClosePitching(_ context.Context, _ uuid.UUID, closedAt time.Time) (bool, error) {
+	f.closeCalls++
+	if f.trip.PitchingClosedAt != nil {
+		return false, nil
+	}
+	f.trip.PitchingClosedAt = &closedAt
+	return true, nil
+}
+
+type fakePitchers struct {
+	userIDs []uuid.UUID
+}
+
+func (f *fakePitchers) FindPitcherIDs(context.Context, uuid.UUID) ([]uuid.UUID, error) {
+	return f.userIDs, nil
+}
+
+type fakeTripMembers struct {
+	userIDs []uuid.UUID
+}
+
+func (f *fakeTripMembers) FindByTripID(context.Context, uuid.UUID) ([]*models.MembershipDatabaseResponse, error) {
+	members := make([]*models.MembershipDatabaseResponse, 0, len(f.userIDs))
+	for _, id := range f.userIDs {
+		members = append(members, &models.MembershipDatabaseResponse{UserID: id})
+	}
+	return members, nil
+}
+
+// fakeUsersByID returns a user for every requested ID, as if all had devices.
+type fakeUsersByID struct{}
+
+func (fakeUsersByID) GetUsersWithDeviceTokens(_ context.Context, userIDs []uuid.UUID) ([]*models.User, error) {
+	users := make([]*models.User, 0, len(userIDs))
+	for _, id := range userIDs {
+		users = append(users, &models.User{ID: id, Timezone: "UTC"})
+	}
+	return users, nil
+}
+
+// membersResolver returns the explicit audience, or every trip member when none is given.
+type membersResolver struct {
+	members  []uuid.UUID
+	audience models.NotificationAudience
+}
+
+func (r *membersResolver) ResolveRecipients(_ context.Context, audience models.NotificationAudience) ([]uuid.UUID, error) {
+	r.audience = audience
+	if audience.UserIDs != nil {
+		return audience.UserIDs, nil
+	}
+	return r.members, nil
+}
+
+func TestPitchDeadlineJobs(t *testing.T) {
+	ctx := context.Background()
+	pitcher, lurker := uuid.New(), uuid.New()
+	deadline := time.Now().UTC().Add(12 * time.Hour).Truncate(time.Second)
+
+	setup := func() (*notifications.NotificationActivities, *fakePitchDeadlineTrips, *mockNotificationSender, *membersResolver) {
+		rankPollID := uuid.New()
+		trips := &fakePitchDeadlineTrips{trip: &models.Trip{
+			ID:            uuid.New(),
+			Name:          "Lisbon",
+			PitchDeadline: &deadline,
+			RankPollID:    &rankPollID,
+		}}
+		sender := &mockNotificationSender{}
+		resolver := &membersResolver{members: []uuid.UUID{pitcher, lurker}}
+		return &notifications.NotificationActivities{
+			UserRepo:           fakeUsersByID{},
+			Preferences:        resolver,
+			NotificationSender: sender,
+			TripRepo:           trips,
+			PitchRepo:          &fakePitchers{userIDs: []uuid.UUID{pitcher}},
+			Members:            &fakeTripMembers{userIDs: []uuid.UUID{pitcher, lurker}},
+		}, trips, sender, resolver
+	}
+
+	dispatch := func(a *notifications.NotificationActivities, jobType string, tripID uuid.UUID, at time.Time) error {
+		return a.DispatchNotification(ctx, notifications.ScheduledNotificationInput{
+			JobType: jobType,
+			Payload: mustMarshalPayload(notifications.PitchDeadlinePayload{TripID: tripID, Deadline: at}),
+		})
+	}
+
+	t.Run("reminder goes only to members who have not pitched", func(t *testing.T) {
+		activities, trips, sender, resolver := setup()
+		require.NoError(t, dispatch(activities, notifications.JobTypePitchDeadlineReminder, trips.trip.ID, deadline))
+
+		assert.Equal(t, []uuid.UUID{lurker}, sender.userIDs)
+		assert.Equal(t, models.NotificationCategoryDeadlineReminders, resolver.audience.Category)
+	})
+
+	t.Run("reminder for a superseded deadline is skipped", func(t *testing.T) {
+		activities, trips, sender, _ := setup()
+		require.NoError(t, dispatch(activities, notifications.JobTypePitchDeadlineReminder, trips.trip.ID, deadline.Add(-time.Hour)))
+
+		assert.False(t, sender.called)
+	})
+
+	t.Run("close locks pitching and announces voting once", func(t *testing.T) {
+		activities, trips, sender, resolver := setup()
+		require.NoError(t, dispatch(activities, notifications.JobTypePitchDeadlineClose, trips.trip.ID, deadline))
+
+		assert.NotNil(t, trips.trip.PitchingClosedAt)
+		assert.ElementsMatch(t, []uuid.UUID{pitcher, lurker}, sender.userIDs)
+		assert.Equal(t, models.NotificationCategoryVotingReminders, resolver.audience.Category)
+
+		require.NoError(t, dispatch(activities, notifications.JobTypePitchDeadlineClose, trips.trip.ID, deadline))
+		assert.Equal(t, 1, trips.closeCalls)
+		assert.Equal(t, 2, sender.callCount)
+	})
+}
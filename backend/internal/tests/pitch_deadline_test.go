@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -8,6 +9,9 @@ import (
 	"toggo/internal/models"
 	testkit "toggo/internal/tests/testkit/builders"
 	"toggo/internal/tests/testkit/fakes"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTripPitchDeadline(t *testing.T) {
@@ -119,3 +123,116 @@ func TestTripPitchDeadline(t *testing.T) {
 			AssertStatus(http.StatusBadRequest)
 	})
 }
+
+func TestTripRankPollVotingLock(t *testing.T) {
+	app := fakes.GetSharedTestApp()
+
+	t.Run("rank poll created for a pitch deadline is locked until pitching closes", func(t *testing.T) {
+		t.Parallel()
+		owner := createUser(t, app)
+		trip := createTrip(t, app, owner)
+		deadline := time.Now().UTC().Add(72 * time.Hour)
+
+		resp := testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  fmt.Sprintf("/api/v1/trips/%s", trip),
+				Method: testkit.PATCH,
+				UserID: &owner,
+				Body: models.UpdateTripRequest{
+					PitchDeadline: &deadline,
+				},
+			}).
+			AssertStatus(http.StatusOK).
+			GetBody()
+
+		pollID, ok := resp["rank_poll_id"].(string)
+		if !ok {
+			t.Fatalf("expected rank_poll_id in response, got %v", resp["rank_poll_id"])
+		}
+
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  singleRankPollRoute(trip, pollID),
+				Method: testkit.GET,
+				UserID: &owner,
+			}).
+			AssertStatus(http.StatusOK).
+			AssertField("voting_locked", true)
+
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  submitRankingRoute(trip, pollID),
+				Method: testkit.POST,
+				UserID: &owner,
+				Body: models.SubmitRankingRequest{Rankings: []models.RankingItem{
+					{OptionID: uuid.New(), Rank: 1},
+				}},
+			}).
+			AssertStatus(http.StatusBadRequest).
+			AssertMessage("voting opens when pitching closes")
+	})
+	t.Run("voting opens when the deadline passes and locks again when it moves", func(t *testing.T) {
+		t.Parallel()
+		owner := createUser(t, app)
+		trip := createTrip(t, app, owner)
+		deadline := time.Now().UTC().Add(72 * time.Hour)
+
+		pollID := testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  fmt.Sprintf("/api/v1/trips/%s", trip),
+				Method: testkit.PATCH,
+				UserID: &owner,
+				Body:   models.UpdateTripRequest{PitchDeadline: &deadline},
+			}).
+			AssertStatus(http.StatusOK).
+			GetBody()["rank_poll_id"].(string)
+
+		assertVotingLocked := func(locked bool) {
+			testkit.New(t).
+				Request(testkit.Request{
+					App:    app,
+					Route:  singleRankPollRoute(trip, pollID),
+					Method: testkit.GET,
+					UserID: &owner,
+				}).
+				AssertStatus(http.StatusOK).
+				AssertField("voting_locked", locked)
+		}
+
+		// The deadline passes before the close job has run.
+		db := fakes.GetSharedDB()
+		_, err := db.NewUpdate().TableExpr("trips").
+			Set("pitch_deadline = ?", time.Now().UTC().Add(-time.Minute)).
+			Where("id = ?", trip).
+			Exec(context.Background())
+		require.NoError(t, err)
+		assertVotingLocked(false)
+
+		// The close job runs, then the organiser moves the deadline.
+		_, err = db.NewUpdate().TableExpr("trips").
+			Set("pitching_closed_at = ?", time.Now().UTC()).
+			Where("id = ?", trip).
+			Exec(context.Background())
+		require.NoError(t, err)
+		_, err = db.NewUpdate().TableExpr("polls").
+			Set("voting_locked = false").
+			Where("id = ?", pollID).
+			Exec(context.Background())
+		require.NoError(t, err)
+
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  fmt.Sprintf("/api/v1/trips/%s", trip),
+				Method: testkit.PATCH,
+				UserID: &owner,
+				Body:   models.UpdateTripRequest{PitchDeadline: &deadline},
+			}).
+			AssertStatus(http.StatusOK)
+		assertVotingLocked(true)
+	})
+}
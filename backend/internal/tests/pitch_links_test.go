@@ -7,6 +7,7 @@ import (
 	"testing"
 	"toggo/internal/errs"
 	"toggo/internal/models"
+	"toggo/internal/realtime"
 	"toggo/internal/services"
 
 	"github.com/google/uuid"
@@ -81,6 +82,11 @@ func (m *mockPitchRepoForLinks) GetImageIDsForPitches(ctx context.Context, pitch
 func (m *mockPitchRepoForLinks) GetImagesForPitch(ctx context.Context, pitchID uuid.UUID) ([]models.PitchImageKey, error) {
 	return nil, nil
 }
+func (m *mockPitchRepoForLinks) FindPitcherIDs(ctx context.Context, tripID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, tripID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
 func (m *mockPitchRepoForLinks) GetImagesForPitches(ctx context.Context, pitchIDs []uuid.UUID) (map[uuid.UUID][]models.PitchImageKey, error) {
 	return nil, nil
 }
@@ -123,7 +129,7 @@ func TestPitchLinks_LinkParserIntegration(t *testing.T) {
 				l.ThumbnailURL != nil && *l.ThumbnailURL == "https://example.com/image.png"
 		})).Return(&models.PitchLink{ID: uuid.New()}, nil)
 
-		svc := services.NewPitchLinkService(linkRepo, pitchRepo, parser)
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, parser, nil)
 		_, err := svc.AddLink(context.Background(), tripID, pitchID, userID, models.CreatePitchLinkRequest{URL: "https://example.com"})
 		assert.NoError(t, err)
 		linkRepo.AssertExpectations(t)
@@ -144,7 +150,7 @@ func TestPitchLinks_LinkParserIntegration(t *testing.T) {
 			return l.Title == nil && l.Description == nil && l.ThumbnailURL == nil
 		})).Return(&models.PitchLink{ID: uuid.New()}, nil)
 
-		svc := services.NewPitchLinkService(linkRepo, pitchRepo, parser)
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, parser, nil)
 		_, err := svc.AddLink(context.Background(), tripID, pitchID, userID, models.CreatePitchLinkRequest{URL: "https://example.com"})
 		assert.NoError(t, err)
 		linkRepo.AssertExpectations(t)
@@ -165,7 +171,7 @@ func TestPitchLinkService_AddLink(t *testing.T) {
 		pitchRepo.On("FindByIDAndTripID", mock.Anything, pitchID, tripID).
 			Return(&models.PitchDatabaseResponse{ID: pitchID, UserID: ownerID}, nil)
 
-		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil)
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil, nil)
 		_, err := svc.AddLink(context.Background(), tripID, pitchID, otherUser, models.CreatePitchLinkRequest{URL: "https://example.com"})
 
 		var apiErr errs.APIError
@@ -183,7 +189,7 @@ func TestPitchLinkService_AddLink(t *testing.T) {
 		pitchRepo.On("FindByIDAndTripID", mock.Anything, pitchID, tripID).
 			Return(nil, errs.ErrNotFound)
 
-		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil)
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil, nil)
 		_, err := svc.AddLink(context.Background(), tripID, pitchID, userID, models.CreatePitchLinkRequest{URL: "https://example.com"})
 
 		assert.ErrorIs(t, err, errs.ErrNotFound)
@@ -198,7 +204,7 @@ func TestPitchLinkService_AddLink(t *testing.T) {
 		pitchRepo.On("FindByIDAndTripID", mock.Anything, pitchID, tripID).
 			Return(&models.PitchDatabaseResponse{ID: pitchID, UserID: userID}, nil)
 
-		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil)
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil, nil)
 		_, err := svc.AddLink(context.Background(), tripID, pitchID, userID, models.CreatePitchLinkRequest{URL: "not-a-url"})
 
 		assert.Error(t, err)
@@ -216,7 +222,7 @@ func TestPitchLinkService_GetLinks(t *testing.T) {
 
 		linkRepo.On("FindByPitchID", mock.Anything, pitchID).Return(expected, nil)
 
-		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil)
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil, nil)
 		links, err := svc.GetLinks(context.Background(), pitchID)
 
 		assert.NoError(t, err)
@@ -231,7 +237,7 @@ func TestPitchLinkService_GetLinks(t *testing.T) {
 
 		linkRepo.On("FindByPitchID", mock.Anything, pitchID).Return(nil, errors.New("db error"))
 
-		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil)
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil, nil)
 		_, err := svc.GetLinks(context.Background(), pitchID)
 
 		assert.Error(t, err)
@@ -243,12 +249,12 @@ func TestPitchLinkService_DeleteLink(t *testing.T) {
 		t.Parallel()
 		linkRepo := &mockPitchLinkRepo{}
 		pitchRepo := &mockPitchRepoForLinks{}
-		pitchID, linkID := uuid.New(), uuid.New()
+		tripID, pitchID, linkID, userID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
 
 		linkRepo.On("Delete", mock.Anything, linkID, pitchID).Return(nil)
 
-		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil)
-		err := svc.DeleteLink(context.Background(), pitchID, linkID)
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil, nil)
+		err := svc.DeleteLink(context.Background(), tripID, pitchID, linkID, userID)
 
 		assert.NoError(t, err)
 	})
@@ -257,13 +263,59 @@ func TestPitchLinkService_DeleteLink(t *testing.T) {
 		t.Parallel()
 		linkRepo := &mockPitchLinkRepo{}
 		pitchRepo := &mockPitchRepoForLinks{}
-		pitchID, linkID := uuid.New(), uuid.New()
+		tripID, pitchID, linkID, userID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+		linkRepo.On("Delete", mock.Anything, linkID, pitchID).Return(errs.ErrNotFound)
+
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil, nil)
+		err := svc.DeleteLink(context.Background(), tripID, pitchID, linkID, userID)
+
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
+
+func TestPitchLinkService_PublishesEvents(t *testing.T) {
+	t.Run("publishes link added and removed to the trip channel", func(t *testing.T) {
+		t.Parallel()
+		linkRepo := &mockPitchLinkRepo{}
+		pitchRepo := &mockPitchRepoForLinks{}
+		tripID, pitchID, userID, linkID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+		redisClient := &recordingRedisClient{}
+
+		pitchRepo.On("FindByIDAndTripID", mock.Anything, pitchID, tripID).
+			Return(&models.PitchDatabaseResponse{ID: pitchID, UserID: userID}, nil)
+		linkRepo.On("Create", mock.Anything, mock.Anything).
+			Return(&models.PitchLink{ID: linkID, PitchID: pitchID, AddedBy: userID, URL: "https://example.com"}, nil)
+		linkRepo.On("Delete", mock.Anything, linkID, pitchID).Return(nil)
+
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil, realtime.NewRedisEventPublisher(redisClient))
+		_, err := svc.AddLink(context.Background(), tripID, pitchID, userID, models.CreatePitchLinkRequest{URL: "https://example.com"})
+		assert.NoError(t, err)
+		assert.NoError(t, svc.DeleteLink(context.Background(), tripID, pitchID, linkID, userID))
+
+		assert.Equal(t, []string{
+			string(realtime.EventTopicPitchLinkAdded),
+			string(realtime.EventTopicPitchLinkRemoved),
+		}, redisClient.topics())
+		for _, event := range redisClient.events {
+			assert.Equal(t, tripID.String(), event.TripID)
+			assert.Equal(t, userID.String(), event.ActorID)
+		}
+	})
+
+	t.Run("does not publish when the delete fails", func(t *testing.T) {
+		t.Parallel()
+		linkRepo := &mockPitchLinkRepo{}
+		pitchRepo := &mockPitchRepoForLinks{}
+		tripID, pitchID, linkID, userID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+		redisClient := &recordingRedisClient{}
 
 		linkRepo.On("Delete", mock.Anything, linkID, pitchID).Return(errs.ErrNotFound)
 
-		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil)
-		err := svc.DeleteLink(context.Background(), pitchID, linkID)
+		svc := services.NewPitchLinkService(linkRepo, pitchRepo, nil, realtime.NewRedisEventPublisher(redisClient))
+		err := svc.DeleteLink(context.Background(), tripID, pitchID, linkID, userID)
 
 		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.Empty(t, redisClient.events)
 	})
 }
@@ -52,6 +52,23 @@ func (m *mockTokenFetcher) GetUsersWithDeviceTokens(_ context.Context, _ []uuid.
 	return m.users, m.err
 }
 
+// mockRecipientResolver passes every candidate through except those in optedOut.
+type mockRecipientResolver struct {
+	optedOut map[uuid.UUID]bool
+	audience models.NotificationAudience
+}
+
+func (m *mockRecipientResolver) ResolveRecipients(_ context.Context, audience models.NotificationAudience) ([]uuid.UUID, error) {
+	m.audience = audience
+	var recipients []uuid.UUID
+	for _, id := range audience.UserIDs {
+		if !m.optedOut[id] {
+			recipients = append(recipients, id)
+		}
+	}
+	return recipients, nil
+}
+
 type mockNotificationSender struct {
 	called    bool
 	callCount int
@@ -82,6 +99,7 @@ func buildActivity(
 		PollRankingRepo:    rankingVoters,
 		PollVotingRepo:     votingVoters,
 		UserRepo:           users,
+		Preferences:        &mockRecipientResolver{},
 		NotificationSender: sender,
 	}
 }
@@ -468,3 +486,43 @@ func TestPollDeadlineReminderActivity_UnknownJobType(t *testing.T) {
 		t.Error("expected error for unknown job type")
 	}
 }
+
+func TestPollDeadlineReminderActivity_RespectsVotingReminderPreference(t *testing.T) {
+	t.Parallel()
+
+	deadline := futureDeadline()
+	poll := &models.Poll{
+		ID:       uuid.New(),
+		Question: "Where to?",
+		PollType: models.PollTypeSingle,
+		Deadline: &deadline,
+	}
+	optedOutID := uuid.New()
+
+	resolver := &mockRecipientResolver{optedOut: map[uuid.UUID]bool{optedOutID: true}}
+	sender := &mockNotificationSender{}
+	act := buildActivity(
+		&mockPollRepo{poll: poll},
+		&mockPollRankingRepo{},
+		&mockPollVotingRepo{voters: []models.VoterInfo{{UserID: optedOutID, HasVoted: false}}},
+		&mockTokenFetcher{users: []*models.User{{ID: optedOutID, DeviceToken: tokenPtr("ExponentPushToken[a]")}}},
+		sender,
+	)
+	act.Preferences = resolver
+
+	payload := defaultPayload()
+	payload.Deadline = deadline
+
+	if err := act.DispatchNotification(context.Background(), dispatchReminderInput(payload)); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if resolver.audience.Category != models.NotificationCategoryVotingReminders {
+		t.Errorf("expected category %q, got %q", models.NotificationCategoryVotingReminders, resolver.audience.Category)
+	}
+	if resolver.audience.TripID != payload.TripID {
+		t.Errorf("expected trip %s, got %s", payload.TripID, resolver.audience.TripID)
+	}
+	if sender.called {
+		t.Error("expected notification NOT to be sent when the only unvoted member opted out")
+	}
+}
@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingRedisClient struct {
+	published []string
+	events    []realtime.Event
+}
+
+func (r *recordingRedisClient) Publish(_ context.Context, channel string, message interface{}) error {
+	r.published = append(r.published, channel)
+	if data, ok := message.([]byte); ok {
+		var event realtime.Event
+		if err := json.Unmarshal(data, &event); err == nil {
+			r.events = append(r.events, event)
+		}
+	}
+	return nil
+}
+
+func (r *recordingRedisClient) topics() []string {
+	topics := make([]string, 0, len(r.events))
+	for _, event := range r.events {
+		topics = append(topics, event.Topic)
+	}
+	return topics
+}
+
+func (r *recordingRedisClient) Subscribe(context.Context, ...string) *redis.PubSub  { return nil }
+func (r *recordingRedisClient) PSubscribe(context.Context, ...string) *redis.PubSub { return nil }
+func (r *recordingRedisClient) Close() error                                        { return nil }
+
+func TestEventPayloadSchemas(t *testing.T) {
+	tripID := uuid.New()
+
+	t.Run("new events carry the topic schema version", func(t *testing.T) {
+		event, err := realtime.NewEvent(realtime.EventTopicTripDeleted, tripID.String(), realtime.TripDeletedPayload{TripID: tripID})
+		require.NoError(t, err)
+		assert.Equal(t, 1, event.Version)
+	})
+
+	t.Run("publisher accepts payloads matching the schema", func(t *testing.T) {
+		redisClient := &recordingRedisClient{}
+		publisher := realtime.NewRedisEventPublisher(redisClient)
+
+		event, err := realtime.NewEvent(realtime.EventTopicMembershipAdded, tripID.String(), realtime.MembershipPayload{
+			UserID: uuid.New(),
+			TripID: tripID,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, publisher.Publish(context.Background(), event))
+		assert.Equal(t, []string{"trip:" + tripID.String()}, redisClient.published)
+	})
+
+	t.Run("publisher accepts model snapshot payloads", func(t *testing.T) {
+		publisher := realtime.NewRedisEventPublisher(&recordingRedisClient{})
+
+		event, err := realtime.NewEvent(realtime.EventTopicCommentCreated, tripID.String(), &models.Comment{
+			ID:         uuid.New(),
+			TripID:     tripID,
+			EntityType: models.ActivityEntity,
+			EntityID:   uuid.New(),
+			UserID:     uuid.New(),
+			Content:    "hello",
+		})
+		require.NoError(t, err)
+
+		assert.NoError(t, publisher.Publish(context.Background(), event))
+	})
+
+	t.Run("publisher rejects invalid payloads", func(t *testing.T) {
+		cases := map[string]any{
+			"nil payload":            nil,
+			"missing required field": realtime.MembershipPayload{TripID: tripID},
+			"unknown field":          map[string]any{"user_id": uuid.New(), "trip_id": tripID, "nickname": "admin"},
+			"wrong type":             map[string]any{"user_id": 42, "trip_id": tripID},
+		}
+
+		for name, payload := range cases {
+			t.Run(name, func(t *testing.T) {
+				redisClient := &recordingRedisClient{}
+				publisher := realtime.NewRedisEventPublisher(redisClient)
+
+				event, err := realtime.NewEvent(realtime.EventTopicMembershipAdded, tripID.String(), payload)
+				require.NoError(t, err)
+
+				err = publisher.Publish(context.Background(), event)
+				assert.ErrorIs(t, err, realtime.ErrInvalidPayload)
+				assert.Empty(t, redisClient.published)
+			})
+		}
+	})
+
+	t.Run("topics registered without a schema are not validated", func(t *testing.T) {
+		publisher := realtime.NewRedisEventPublisher(&recordingRedisClient{})
+		publisher.GetRegistry().Register("custom.topic")
+
+		event, err := realtime.NewEvent("custom.topic", tripID.String(), nil)
+		require.NoError(t, err)
+
+		assert.NoError(t, publisher.Publish(context.Background(), event))
+		assert.Equal(t, 0, event.Version)
+	})
+}
+
+func TestEventCatalogue(t *testing.T) {
+	registry := realtime.NewEventRegistry()
+	catalogue := realtime.BuildEventCatalogue(registry.GetAllSchemas())
+
+	assert.Equal(t, realtime.JSONSchemaDialect, catalogue.Schema)
+	assert.Len(t, catalogue.Topics, len(registry.GetAllTopics()))
+
+	body, err := json.Marshal(catalogue)
+	require.NoError(t, err)
+
+	var decoded struct {
+		Topics []struct {
+			Topic   string         `json:"topic"`
+			Version int            `json:"version"`
+			Schema  map[string]any `json:"schema"`
+		} `json:"topics"`
+		Defs map[string]map[string]any `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(body, &decoded))
+
+	for _, topic := range decoded.Topics {
+		assert.Positive(t, topic.Version, topic.Topic)
+		ref, ok := topic.Schema["$ref"].(string)
+		require.True(t, ok, "topic %s should reference a definition", topic.Topic)
+		assert.Contains(t, decoded.Defs, ref[len("#/$defs/"):], topic.Topic)
+	}
+
+	membership := decoded.Defs["MembershipPayload"]
+	require.NotNil(t, membership)
+	assert.ElementsMatch(t, []any{"user_id", "trip_id", "role", "is_admin"}, membership["required"])
+	properties := membership["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "string", "format": "uuid"}, properties["user_id"])
+	assert.Equal(t, map[string]any{"type": "string"}, properties["role"])
+	assert.Equal(t, 2, realtime.SchemaVersion(realtime.EventTopicMembershipUpdated))
+}
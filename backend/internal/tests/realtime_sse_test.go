@@ -0,0 +1,218 @@
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/realtime"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sseTestSecret = "sse-test-secret"
+
+type fakeSSEHub struct {
+	mu         sync.Mutex
+	registered []*realtime.Client
+}
+
+func newFakeSSEHub() *fakeSSEHub {
+	return &fakeSSEHub{}
+}
+
+func (h *fakeSSEHub) Run() {}
+
+func (h *fakeSSEHub) RegisterClient(client *realtime.Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.registered = append(h.registered, client)
+}
+
+func (h *fakeSSEHub) UnregisterClient(client *realtime.Client) {}
+
+func (h *fakeSSEHub) HandleClientMessage(client *realtime.Client, msg *realtime.ClientMessage) {
+	if msg.Type == realtime.MessageTypeSubscribe {
+		h.SubscribeClientToTrip(client, msg.TripID)
+	}
+}
+
+func (h *fakeSSEHub) SubscribeClientToTrip(client *realtime.Client, tripID string) {
+	client.AddSubscription(tripID)
+}
+
+func (h *fakeSSEHub) UnsubscribeClientFromTrip(client *realtime.Client, tripID string) {}
+
+func (h *fakeSSEHub) BroadcastToTrip(tripID string, events []realtime.Event) {}
+
+func (h *fakeSSEHub) Shutdown() {}
+
+func (h *fakeSSEHub) client(t *testing.T) *realtime.Client {
+	t.Helper()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	require.Len(t, h.registered, 1)
+	return h.registered[0]
+}
+
+// sseTripMembers is the set of trips each user belongs to.
+type sseTripMembers map[string][]string
+
+func (m sseTripMembers) IsMember(_ context.Context, tripID, userID uuid.UUID) (bool, error) {
+	for _, id := range m[userID.String()] {
+		if id == tripID.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func startSSETestServer(t *testing.T, hub realtime.Hub, members sseTripMembers) string {
+	t.Helper()
+
+	handler := realtime.NewSSEHandler(hub, realtime.NewAuthMiddleware(sseTestSecret), members)
+	app := fiber.New(fiber.Config{ErrorHandler: errs.ErrorHandler})
+	app.Get("/sse", handler.Handler())
+	app.Post("/sse/:clientID", handler.MessageHandler())
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = app.Listener(ln) }()
+	t.Cleanup(func() { _ = app.ShutdownWithTimeout(100 * time.Millisecond) })
+
+	return "http://" + ln.Addr().String()
+}
+
+func readSSEMessage(t *testing.T, reader *bufio.Reader) (string, realtime.ServerMessage) {
+	t.Helper()
+
+	var eventName string
+	var msg realtime.ServerMessage
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimRight(line, "\n")
+
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			eventName = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &msg))
+		case line == "":
+			return eventName, msg
+		}
+	}
+}
+
+func TestSSEHandler(t *testing.T) {
+	t.Run("rejects connections without a token", func(t *testing.T) {
+		baseURL := startSSETestServer(t, newFakeSSEHub(), sseTripMembers{})
+
+		resp, err := http.Get(baseURL + "/sse")
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("streams connected message and subscribes trips from query", func(t *testing.T) {
+		hub := newFakeSSEHub()
+		userID := uuid.NewString()
+		tripID := uuid.NewString()
+		baseURL := startSSETestServer(t, hub, sseTripMembers{userID: {tripID}})
+
+		token, err := realtime.GenerateTestToken(userID, sseTestSecret, 5)
+		require.NoError(t, err)
+
+		resp, err := http.Get(baseURL + "/sse?token=" + token + "&trip_id=" + tripID + "&trip_id=not-a-uuid")
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		reader := bufio.NewReader(resp.Body)
+		eventName, msg := readSSEMessage(t, reader)
+		assert.Equal(t, realtime.ServerMessageTypeConnected, eventName)
+		assert.NotEmpty(t, msg.ClientID)
+
+		client := hub.client(t)
+		assert.Equal(t, msg.ClientID, client.ID)
+		assert.Equal(t, userID, client.UserID)
+		assert.Equal(t, []string{tripID}, client.GetSubscriptions())
+
+		client.Send <- realtime.ServerMessage{
+			Type:      realtime.ServerMessageTypeEvents,
+			Events:    []realtime.Event{{ID: "evt-1", Topic: "poll.created", TripID: tripID}},
+			Timestamp: time.Now().UTC(),
+		}
+
+		eventName, msg = readSSEMessage(t, reader)
+		assert.Equal(t, realtime.ServerMessageTypeEvents, eventName)
+		require.Len(t, msg.Events, 1)
+		assert.Equal(t, "evt-1", msg.Events[0].ID)
+	})
+
+	t.Run("routes client messages to the hub for the owning user only", func(t *testing.T) {
+		hub := newFakeSSEHub()
+		userID := uuid.NewString()
+		tripID := uuid.NewString()
+		baseURL := startSSETestServer(t, hub, sseTripMembers{userID: {tripID}})
+
+		token, err := realtime.GenerateTestToken(userID, sseTestSecret, 5)
+		require.NoError(t, err)
+
+		resp, err := http.Get(baseURL + "/sse?token=" + token)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		_, connected := readSSEMessage(t, bufio.NewReader(resp.Body))
+
+		post := func(token, tripID string) int {
+			body, err := json.Marshal(realtime.ClientMessage{Type: realtime.MessageTypeSubscribe, TripID: tripID})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, baseURL+"/sse/"+connected.ClientID, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+token)
+			postResp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			_ = postResp.Body.Close()
+			return postResp.StatusCode
+		}
+
+		otherToken, err := realtime.GenerateTestToken(uuid.NewString(), sseTestSecret, 5)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, post(otherToken, tripID))
+		assert.Equal(t, http.StatusForbidden, post(token, uuid.NewString()))
+		assert.Equal(t, http.StatusAccepted, post(token, tripID))
+
+		assert.Equal(t, []string{tripID}, hub.client(t).GetSubscriptions())
+	})
+
+	t.Run("refuses streams for trips the user is not a member of", func(t *testing.T) {
+		hub := newFakeSSEHub()
+		userID := uuid.NewString()
+		baseURL := startSSETestServer(t, hub, sseTripMembers{})
+
+		token, err := realtime.GenerateTestToken(userID, sseTestSecret, 5)
+		require.NoError(t, err)
+
+		resp, err := http.Get(baseURL + "/sse?token=" + token + "&trip_id=" + uuid.NewString())
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Empty(t, hub.registered)
+	})
+}
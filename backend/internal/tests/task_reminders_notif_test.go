@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/workflows/notifications"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTaskFinder struct {
+	task *models.TripTask
+}
+
+func (f *fakeTaskFinder) Find(context.Context, uuid.UUID) (*models.TripTask, error) {
+	if f.task == nil {
+		return nil, errs.ErrNotFound
+	}
+	return f.task, nil
+}
+
+func TestTaskDueReminderJob(t *testing.T) {
+	ctx := context.Background()
+	assignee := uuid.New()
+	dueAt := time.Now().UTC().Add(20 * time.Hour).Truncate(time.Second)
+
+	setup := func() (*notifications.NotificationActivities, *fakeTaskFinder, *mockNotificationSender, *membersResolver) {
+		tripID := uuid.New()
+		tasks := &fakeTaskFinder{task: &models.TripTask{
+			ID:          uuid.New(),
+			TripID:      tripID,
+			Title:       "Buy lift passes",
+			Status:      models.TripTaskInProgress,
+			DueAt:       &dueAt,
+			AssigneeIDs: []uuid.UUID{assignee},
+		}}
+		sender := &mockNotificationSender{}
+		resolver := &membersResolver{}
+		return &notifications.NotificationActivities{
+			UserRepo:           fakeUsersByID{},
+			Preferences:        resolver,
+			NotificationSender: sender,
+			TripRepo:           &fakePitchDeadlineTrips{trip: &models.Trip{ID: tripID, Name: "Ski trip"}},
+			Tasks:              tasks,
+		}, tasks, sender, resolver
+	}
+
+	dispatch := func(a *notifications.NotificationActivities, taskID uuid.UUID, at time.Time) error {
+		return a.DispatchNotification(ctx, notifications.ScheduledNotificationInput{
+			JobType: notifications.JobTypeTaskDueReminder,
+			Payload: mustMarshalPayload(notifications.TaskDueReminderPayload{TaskID: taskID, DueAt: at}),
+		})
+	}
+
+	t.Run("reminder goes to the assignees", func(t *testing.T) {
+		activities, tasks, sender, resolver := setup()
+		require.NoError(t, dispatch(activities, tasks.task.ID, dueAt))
+
+		assert.Equal(t, []uuid.UUID{assignee}, sender.userIDs)
+		assert.Equal(t, models.NotificationCategoryDeadlineReminders, resolver.audience.Category)
+	})
+
+	t.Run("reminder for a changed due date is skipped", func(t *testing.T) {
+		activities, tasks, sender, _ := setup()
+		require.NoError(t, dispatch(activities, tasks.task.ID, dueAt.Add(-time.Hour)))
+
+		assert.False(t, sender.called)
+	})
+
+	t.Run("reminder for a finished task is skipped", func(t *testing.T) {
+		activities, tasks, sender, _ := setup()
+		tasks.task.Status = models.TripTaskDone
+		require.NoError(t, dispatch(activities, tasks.task.ID, dueAt))
+
+		assert.False(t, sender.called)
+	})
+
+	t.Run("reminder for a deleted task is skipped", func(t *testing.T) {
+		activities, tasks, sender, _ := setup()
+		taskID := tasks.task.ID
+		tasks.task = nil
+		require.NoError(t, dispatch(activities, taskID, dueAt))
+
+		assert.False(t, sender.called)
+	})
+}
@@ -118,14 +118,26 @@ func setupRoutesAndMiddlewares(app *fiber.App, cfg *config.Configuration, db *bu
 		nil,
 	)
 
-	serviceParams.NotificationService = services.NewNotificationService(
-		repo.User,
-		repo.Membership,
-		services.NewExpoClient(""),
-	)
+	serviceParams.PreferenceResolver = services.NewNotificationPreferenceResolver(repo.Membership, repo.NotificationPreferences)
+	serviceParams.NotificationService = services.NewNotificationService(services.NotificationServiceConfig{
+		DeviceRepo:   repo.UserDevice,
+		DeliveryRepo: repo.NotificationDelivery,
+		Preferences:  serviceParams.PreferenceResolver,
+		ExpoClient:   services.NewExpoClient(""),
+	})
 
 	serviceParams.HTTPClient = services.DefaultHTTPClient()
 
+	serviceParams.SlackTrips = services.NewSlackTripService(services.SlackTripServiceConfig{
+		ChannelRepo:     repo.TripSlackChannel,
+		MembershipRepo:  repo.Membership,
+		TripRepo:        repo.Trip,
+		UserRepo:        repo.User,
+		PollRepo:        repo.Poll,
+		PollVotingRepo:  repo.PollVoting,
+		PollRankingRepo: repo.PollRanking,
+	})
+
 	routeParams := types.RouteParams{
 		Validator:     utilities.NewValidator(),
 		ServiceParams: serviceParams,
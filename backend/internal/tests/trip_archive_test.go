@@ -0,0 +1,300 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+	"toggo/internal/tests/mocks"
+	"toggo/internal/workflows/trips"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// softDeleteTripRepo keeps one trip's archive and deletion state in memory.
+type softDeleteTripRepo struct {
+	repository.TripRepository
+	trip      models.Trip
+	mediaKeys []string
+	purged    bool
+}
+
+func (r *softDeleteTripRepo) Find(_ context.Context, _ uuid.UUID) (*models.Trip, error) {
+	if r.trip.DeletedAt != nil {
+		return nil, errs.ErrNotFound
+	}
+	trip := r.trip
+	return &trip, nil
+}
+
+func (r *softDeleteTripRepo) SetArchived(_ context.Context, _ uuid.UUID, archivedAt *time.Time) (*models.Trip, error) {
+	if r.trip.DeletedAt != nil {
+		return nil, errs.ErrNotFound
+	}
+	r.trip.ArchivedAt = archivedAt
+	trip := r.trip
+	return &trip, nil
+}
+
+func (r *softDeleteTripRepo) SoftDelete(_ context.Context, _ uuid.UUID, deletedAt time.Time) error {
+	if r.trip.DeletedAt != nil {
+		return errs.ErrNotFound
+	}
+	r.trip.DeletedAt = &deletedAt
+	return nil
+}
+
+func (r *softDeleteTripRepo) Restore(_ context.Context, _ uuid.UUID, deletedAfter time.Time) (*models.Trip, error) {
+	if r.trip.DeletedAt == nil || !r.trip.DeletedAt.After(deletedAfter) {
+		return nil, errs.ErrNotFound
+	}
+	r.trip.DeletedAt = nil
+	trip := r.trip
+	return &trip, nil
+}
+
+func (r *softDeleteTripRepo) FindDeleted(_ context.Context, _ uuid.UUID) (*models.Trip, error) {
+	if r.trip.DeletedAt == nil {
+		return nil, errs.ErrNotFound
+	}
+	trip := r.trip
+	return &trip, nil
+}
+
+func (r *softDeleteTripRepo) FindMediaKeys(_ context.Context, _ uuid.UUID) ([]string, error) {
+	return r.mediaKeys, nil
+}
+
+func (r *softDeleteTripRepo) Purge(_ context.Context, _ uuid.UUID) error {
+	r.purged = true
+	return nil
+}
+
+// recordingPurgeScheduler records scheduled and cancelled purges by trip.
+type recordingPurgeScheduler struct {
+	scheduled map[uuid.UUID]time.Time
+	cancelled []uuid.UUID
+}
+
+func (s *recordingPurgeScheduler) SchedulePurge(_ context.Context, tripID uuid.UUID, purgeAt time.Time) error {
+	s.scheduled[tripID] = purgeAt
+	return nil
+}
+
+func (s *recordingPurgeScheduler) CancelPurge(_ context.Context, tripID uuid.UUID) error {
+	s.cancelled = append(s.cancelled, tripID)
+	return nil
+}
+
+func TestArchivedTripAccess(t *testing.T) {
+	access := models.TripAccess{Role: models.TripRoleOwner, Archived: true}
+	assert.True(t, access.Can(models.TripPermissionArchiveTrip))
+	assert.True(t, access.Can(models.TripPermissionDeleteTrip))
+	assert.False(t, access.Can(models.TripPermissionEditTrip))
+	assert.False(t, access.Can(models.TripPermissionContribute))
+
+	viewer := models.TripAccess{Role: models.TripRoleViewer, Archived: true}
+	assert.False(t, viewer.Can(models.TripPermissionArchiveTrip))
+
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	members.archived = true
+	organiser := members.add(models.TripRoleOrganiser)
+	viewerID := members.add(models.TripRoleViewer)
+
+	app := fiber.New(fiber.Config{ErrorHandler: errs.ErrorHandler})
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", c.Get("X-User-ID"))
+		return c.Next()
+	})
+	repo := &repository.Repository{Membership: members}
+	ok := func(c *fiber.Ctx) error { return c.SendStatus(http.StatusOK) }
+	app.Patch("/trips/:tripID", middlewares.TripPermissionRequired(repo, models.TripPermissionEditTrip), ok)
+	app.Post("/trips/:tripID/unarchive", middlewares.TripPermissionRequired(repo, models.TripPermissionArchiveTrip), ok)
+
+	for _, tc := range []struct {
+		method, path string
+		userID       uuid.UUID
+		status       int
+		message      string
+	}{
+		{http.MethodPatch, "", organiser, http.StatusForbidden, errs.ErrTripArchived.Error()},
+		{http.MethodPatch, "", viewerID, http.StatusForbidden, "forbidden"},
+		{http.MethodPost, "/unarchive", organiser, http.StatusOK, ""},
+	} {
+		req := httptest.NewRequest(tc.method, "/trips/"+tripID.String()+tc.path, nil)
+		req.Header.Set("X-User-ID", tc.userID.String())
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, tc.status, resp.StatusCode)
+		if tc.message != "" {
+			body, _ := io.ReadAll(resp.Body)
+			var apiErr errs.APIError
+			require.NoError(t, json.Unmarshal(body, &apiErr))
+			assert.Equal(t, tc.message, apiErr.Message)
+		}
+	}
+}
+
+func TestArchivedTripRejectsServiceWrites(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	members.archived = true
+	owner := members.add(models.TripRoleOwner)
+	organiser := members.add(models.TripRoleOrganiser)
+	member := members.add(models.TripRoleMember)
+
+	invite := newTestInvite(tripID, member)
+	directInvite := &models.TripDirectInvite{ID: uuid.New(), TripID: tripID, InvitedBy: &member, InviteeID: uuid.New(), Status: models.TripDirectInvitePending}
+	repo := &repository.Repository{
+		Membership:       members,
+		TripInvite:       newFakeInviteRepo(invite),
+		TripDirectInvite: &memoryDirectInviteRepo{invites: map[uuid.UUID]*models.TripDirectInvite{directInvite.ID: directInvite}},
+	}
+
+	assertArchived := func(t *testing.T, err error) {
+		t.Helper()
+		var apiErr errs.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+		assert.Equal(t, errs.ErrTripArchived.Error(), apiErr.Message)
+	}
+
+	t.Run("comments", func(t *testing.T) {
+		_, err := services.NewCommentService(repo, nil, nil, nil).CreateComment(ctx, models.CreateCommentRequest{TripID: tripID, Content: "hi"}, member)
+		assertArchived(t, err)
+	})
+
+	t.Run("tasks", func(t *testing.T) {
+		_, err := services.NewTripTaskService(repo, nil, nil, nil, nil).CreateTask(ctx, tripID, organiser, models.CreateTripTaskRequest{Title: "Pack"})
+		assertArchived(t, err)
+	})
+
+	t.Run("slack channel", func(t *testing.T) {
+		svc := services.NewSlackTripService(services.SlackTripServiceConfig{MembershipRepo: members})
+		_, err := svc.LinkChannel(ctx, tripID, organiser, models.LinkSlackChannelRequest{ChannelID: "C123"})
+		assertArchived(t, err)
+		assertArchived(t, svc.UnlinkChannel(ctx, tripID, organiser))
+	})
+
+	t.Run("invites", func(t *testing.T) {
+		tripSvc := services.NewTripService(repo, nil, nil, nil, nil, nil)
+		assertArchived(t, tripSvc.RevokeTripInvite(ctx, tripID, invite.ID, member))
+
+		directSvc := services.NewTripDirectInviteService(repo, nil, nil, nil)
+		assertArchived(t, directSvc.CancelInvite(ctx, tripID, directInvite.ID, member))
+		assert.Equal(t, models.TripDirectInvitePending, directInvite.Status)
+	})
+
+	t.Run("roles", func(t *testing.T) {
+		svc := services.NewMembershipService(repo, nil, nil)
+		_, err := svc.UpdateMemberRole(ctx, tripID, member, organiser, models.TripRoleViewer)
+		assertArchived(t, err)
+		assertArchived(t, svc.TransferOwnership(ctx, tripID, owner, organiser))
+		assert.Equal(t, models.TripRoleMember, members.members[member].Role)
+	})
+}
+
+func TestArchiveTrip(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	organiser := members.add(models.TripRoleOrganiser)
+	member := members.add(models.TripRoleMember)
+	tripRepo := &softDeleteTripRepo{trip: models.Trip{ID: tripID}}
+	publisher := &capturePublisher{}
+	svc := services.NewTripService(&repository.Repository{Membership: members, Trip: tripRepo}, nil, publisher, nil, nil, nil)
+
+	_, err := svc.ArchiveTrip(ctx, member, tripID)
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	trip, err := svc.ArchiveTrip(ctx, organiser, tripID)
+	require.NoError(t, err)
+	assert.NotNil(t, trip.ArchivedAt)
+	assert.Len(t, publisher.topics(realtime.EventTopicTripUpdated), 1)
+
+	members.archived = true
+	trip, err = svc.UnarchiveTrip(ctx, organiser, tripID)
+	require.NoError(t, err)
+	assert.Nil(t, trip.ArchivedAt)
+}
+
+func TestDeleteAndRestoreTrip(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	owner := members.add(models.TripRoleOwner)
+	organiser := members.add(models.TripRoleOrganiser)
+	tripRepo := &softDeleteTripRepo{trip: models.Trip{ID: tripID}}
+	scheduler := &recordingPurgeScheduler{scheduled: map[uuid.UUID]time.Time{}}
+	publisher := &capturePublisher{}
+	svc := services.NewTripService(&repository.Repository{Membership: members, Trip: tripRepo}, nil, publisher, nil, nil, scheduler)
+
+	err := svc.DeleteTrip(ctx, organiser, tripID)
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	require.NoError(t, svc.DeleteTrip(ctx, owner, tripID))
+	require.NotNil(t, tripRepo.trip.DeletedAt)
+	assert.Equal(t, tripRepo.trip.DeletedAt.Add(models.TripRestoreWindow), scheduler.scheduled[tripID])
+	assert.Len(t, publisher.topics(realtime.EventTopicTripDeleted), 1)
+
+	_, err = svc.RestoreTrip(ctx, organiser, tripID)
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	trip, err := svc.RestoreTrip(ctx, owner, tripID)
+	require.NoError(t, err)
+	assert.Nil(t, trip.DeletedAt)
+	assert.Equal(t, []uuid.UUID{tripID}, scheduler.cancelled)
+
+	// Trips past the restore window are awaiting purge.
+	expired := time.Now().UTC().Add(-models.TripRestoreWindow - time.Hour)
+	tripRepo.trip.DeletedAt = &expired
+	_, err = svc.RestoreTrip(ctx, owner, tripID)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestPurgeTrip(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	input := trips.TripPurgeInput{TripID: tripID}
+
+	// Restored trips are left alone.
+	tripRepo := &softDeleteTripRepo{trip: models.Trip{ID: tripID}, mediaKeys: []string{"covers/a.jpg"}}
+	activities := &trips.TripPurgeActivities{Trips: tripRepo, S3Client: mocks.NewMockS3Client(t), BucketName: "bucket"}
+	require.NoError(t, activities.PurgeTrip(ctx, input))
+	assert.False(t, tripRepo.purged)
+
+	// So are trips still inside their restore window.
+	recent := time.Now().UTC().Add(-time.Hour)
+	tripRepo.trip.DeletedAt = &recent
+	require.NoError(t, activities.PurgeTrip(ctx, input))
+	assert.False(t, tripRepo.purged)
+
+	expired := time.Now().UTC().Add(-models.TripRestoreWindow - time.Minute)
+	tripRepo.trip.DeletedAt = &expired
+	tripRepo.mediaKeys = []string{"covers/a.jpg", "pitches/b.m4a"}
+	s3Client := mocks.NewMockS3Client(t)
+	for _, key := range tripRepo.mediaKeys {
+		s3Client.On("DeleteObject", mock.Anything, mock.MatchedBy(func(in *s3.DeleteObjectInput) bool {
+			return *in.Key == key && *in.Bucket == "bucket"
+		})).Return(&s3.DeleteObjectOutput{}, nil).Once()
+	}
+	activities.S3Client = s3Client
+	require.NoError(t, activities.PurgeTrip(ctx, input))
+	assert.True(t, tripRepo.purged)
+}
@@ -0,0 +1,362 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+	testkit "toggo/internal/tests/testkit/builders"
+	"toggo/internal/tests/testkit/fakes"
+	"toggo/internal/utilities"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lookupUserRepo finds users by ID, username or phone number.
+type lookupUserRepo struct {
+	repository.UserRepository
+	users []*models.User
+}
+
+func (r *lookupUserRepo) findBy(match func(*models.User) bool) (*models.User, error) {
+	for _, u := range r.users {
+		if match(u) {
+			return u, nil
+		}
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (r *lookupUserRepo) Find(_ context.Context, id uuid.UUID) (*models.User, error) {
+	return r.findBy(func(u *models.User) bool { return u.ID == id })
+}
+
+func (r *lookupUserRepo) FindByUsername(_ context.Context, username string) (*models.User, error) {
+	return r.findBy(func(u *models.User) bool { return u.Username == username })
+}
+
+func (r *lookupUserRepo) FindByPhoneNumber(_ context.Context, phoneNumber string) (*models.User, error) {
+	return r.findBy(func(u *models.User) bool { return u.PhoneNumber == phoneNumber })
+}
+
+// memoryDirectInviteRepo keeps direct invites in memory.
+type memoryDirectInviteRepo struct {
+	repository.TripDirectInviteRepository
+	invites map[uuid.UUID]*models.TripDirectInvite
+}
+
+func (r *memoryDirectInviteRepo) CreatePending(_ context.Context, invite *models.TripDirectInvite) (*models.TripDirectInvite, bool, error) {
+	for _, existing := range r.invites {
+		if existing.TripID == invite.TripID && existing.InviteeID == invite.InviteeID && existing.Status == models.TripDirectInvitePending {
+			return existing, false, nil
+		}
+	}
+	invite.ID = uuid.New()
+	invite.Status = models.TripDirectInvitePending
+	r.invites[invite.ID] = invite
+	return invite, true, nil
+}
+
+func (r *memoryDirectInviteRepo) Find(_ context.Context, id uuid.UUID) (*models.TripDirectInvite, error) {
+	invite, ok := r.invites[id]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	copied := *invite
+	return &copied, nil
+}
+
+func (r *memoryDirectInviteRepo) Respond(_ context.Context, id uuid.UUID, status models.TripDirectInviteStatus) (bool, error) {
+	invite := r.invites[id]
+	if invite.Status != models.TripDirectInvitePending {
+		return false, nil
+	}
+	invite.Status = status
+	return true, nil
+}
+
+// pushRecorder records push notifications sent to individual users.
+type pushRecorder struct {
+	services.NotificationService
+	requests []models.SendNotificationRequest
+}
+
+func (r *pushRecorder) SendNotification(_ context.Context, req models.SendNotificationRequest) error {
+	r.requests = append(r.requests, req)
+	return nil
+}
+
+func TestHashPhoneNumber(t *testing.T) {
+	assert.Equal(t, "4c805be5ebcbe3f2d9988666a50de3c861ceaac1584c07c73b9d1a573e7c5e87", utilities.HashPhoneNumber("+13141592658"))
+}
+
+func TestUserRateLimit(t *testing.T) {
+	app := fiber.New(fiber.Config{ErrorHandler: errs.ErrorHandler})
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", c.Get("X-User-ID"))
+		return c.Next()
+	})
+	app.Post("/discover", middlewares.UserRateLimit(2, time.Hour), func(c *fiber.Ctx) error {
+		return c.SendStatus(http.StatusOK)
+	})
+
+	send := func(userID string) int {
+		req := httptest.NewRequest(http.MethodPost, "/discover", nil)
+		req.Header.Set("X-User-ID", userID)
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	alice, bob := uuid.NewString(), uuid.NewString()
+	assert.Equal(t, http.StatusOK, send(alice))
+	assert.Equal(t, http.StatusOK, send(alice))
+	assert.Equal(t, http.StatusTooManyRequests, send(alice))
+	// Limits are counted per user.
+	assert.Equal(t, http.StatusOK, send(bob))
+}
+
+func TestTripDirectInvites(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	organiser := members.add(models.TripRoleOrganiser)
+	member := members.add(models.TripRoleMember)
+	viewer := members.add(models.TripRoleViewer)
+
+	friend := &models.User{ID: uuid.New(), Name: "Sam", Username: "sam", PhoneNumber: "+16175550123", Locale: "en"}
+	hidden := &models.User{ID: uuid.New(), Username: "hidden", PhoneNumber: "+16175550124", PhoneDiscoveryDisabled: true}
+	users := &lookupUserRepo{users: []*models.User{
+		friend,
+		hidden,
+		{ID: organiser, Name: "Alex"},
+	}}
+	invites := &memoryDirectInviteRepo{invites: map[uuid.UUID]*models.TripDirectInvite{}}
+	notifier := &pushRecorder{}
+	publisher := &capturePublisher{}
+	resolver := &recordingResolver{recipients: []uuid.UUID{friend.ID}}
+	svc := services.NewTripDirectInviteService(&repository.Repository{
+		Membership:       members,
+		User:             users,
+		Trip:             &softDeleteTripRepo{trip: models.Trip{ID: tripID, Name: "Ski trip"}},
+		TripDirectInvite: invites,
+		UserBlock:        newMemoryBlockRepo(),
+	}, publisher, notifier, resolver)
+
+	byUsername := func(username string) models.CreateTripDirectInviteRequest {
+		return models.CreateTripDirectInviteRequest{Username: &username}
+	}
+	byPhone := func(phone string) models.CreateTripDirectInviteRequest {
+		return models.CreateTripDirectInviteRequest{PhoneNumber: &phone}
+	}
+
+	_, err := svc.InviteUser(ctx, tripID, viewer, byUsername("sam"))
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	// Phone number invites look the same whether or not the number matched anyone, and
+	// users who opted out of discovery are never matched.
+	for _, phone := range []string{"617-555-0124", "617-555-0199"} {
+		invite, err := svc.InviteUser(ctx, tripID, organiser, byPhone(phone))
+		require.NoError(t, err)
+		assert.Nil(t, invite)
+	}
+	assert.Empty(t, invites.invites)
+
+	invite, err := svc.InviteUser(ctx, tripID, organiser, byPhone("(617) 555-0123"))
+	require.NoError(t, err)
+	assert.Nil(t, invite)
+	require.Len(t, invites.invites, 1)
+	for _, created := range invites.invites {
+		invite = created
+	}
+	assert.Equal(t, friend.ID, invite.InviteeID)
+	require.Len(t, notifier.requests, 1)
+	assert.Equal(t, friend.ID, notifier.requests[0].UserID)
+	assert.Equal(t, "Alex invited you to join Ski trip.", notifier.requests[0].Body)
+	assert.Equal(t, []uuid.UUID{friend.ID}, resolver.audience.UserIDs)
+	assert.Equal(t, models.NotificationCategoryTripActivity, resolver.audience.Category)
+
+	// Inviting again returns the pending invite without notifying twice.
+	again, err := svc.InviteUser(ctx, tripID, member, byUsername(" SAM "))
+	require.NoError(t, err)
+	assert.Equal(t, invite.ID, again.ID)
+	assert.Len(t, notifier.requests, 1)
+
+	// Only the inviter or someone with manage_invites can cancel.
+	assertAPIStatus(t, svc.CancelInvite(ctx, tripID, invite.ID, member), http.StatusForbidden)
+
+	_, err = svc.AcceptInvite(ctx, invite.ID, member)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+
+	membership, err := svc.AcceptInvite(ctx, invite.ID, friend.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TripRoleMember, membership.Role)
+	assert.Len(t, publisher.topics(realtime.EventTopicMembershipAdded), 1)
+
+	err = svc.DeclineInvite(ctx, invite.ID, friend.ID)
+	assertAPIStatus(t, err, http.StatusBadRequest)
+
+	_, err = svc.InviteUser(ctx, tripID, organiser, byUsername("sam"))
+	assertAPIStatus(t, err, http.StatusBadRequest)
+
+	// Nor does a phone number invite reveal that its owner is already a member.
+	invite, err = svc.InviteUser(ctx, tripID, organiser, byPhone("(617) 555-0123"))
+	require.NoError(t, err)
+	assert.Nil(t, invite)
+}
+
+func TestTripDirectInviteNotificationPreferences(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	organiser := members.add(models.TripRoleOrganiser)
+	friend := &models.User{ID: uuid.New(), Username: "sam"}
+	notifier := &pushRecorder{}
+	svc := services.NewTripDirectInviteService(&repository.Repository{
+		Membership:       members,
+		User:             &lookupUserRepo{users: []*models.User{friend, {ID: organiser}}},
+		Trip:             &softDeleteTripRepo{trip: models.Trip{ID: tripID}},
+		TripDirectInvite: &memoryDirectInviteRepo{invites: map[uuid.UUID]*models.TripDirectInvite{}},
+		UserBlock:        newMemoryBlockRepo(),
+	}, nil, notifier, &recordingResolver{recipients: []uuid.UUID{}})
+
+	username := "sam"
+	invite, err := svc.InviteUser(ctx, tripID, organiser, models.CreateTripDirectInviteRequest{Username: &username})
+	require.NoError(t, err)
+	assert.Equal(t, friend.ID, invite.InviteeID)
+	assert.Empty(t, notifier.requests)
+}
+
+func TestContactDiscoveryAndDirectInvites(t *testing.T) {
+	app := fakes.GetSharedTestApp()
+	owner := createUser(t, app)
+	tripID := createTrip(t, app, owner)
+
+	friendID := fakes.GenerateUUID()
+	friendUsername := fakes.GenerateRandomUsername()
+	friendPhone := fakes.GenerateRandomPhoneNumber()
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/users",
+			Method: testkit.POST,
+			UserID: &friendID,
+			Body: models.CreateUserRequest{
+				Name:        "Friend",
+				Username:    friendUsername,
+				PhoneNumber: friendPhone,
+			},
+		}).
+		AssertStatus(http.StatusCreated)
+
+	friendHash := utilities.HashPhoneNumber(friendPhone)
+	unknownHash := utilities.HashPhoneNumber(fakes.GenerateRandomPhoneNumber())
+	discover := func() []interface{} {
+		return testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  "/api/v1/users/me/contacts/discover",
+				Method: testkit.POST,
+				UserID: &owner,
+				Body:   models.DiscoverContactsRequest{PhoneHashes: []string{friendHash, unknownHash}},
+			}).
+			AssertStatus(http.StatusOK).
+			GetBody()["matches"].([]interface{})
+	}
+
+	matches := discover()
+	require.Len(t, matches, 1)
+	match := matches[0].(map[string]interface{})
+	assert.Equal(t, friendHash, match["phone_hash"])
+	assert.Equal(t, friendID, match["user"].(map[string]interface{})["id"])
+	assert.NotContains(t, match["user"], "phone_number")
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/users/me/contacts/discover",
+			Method: testkit.POST,
+			UserID: &owner,
+			Body:   models.DiscoverContactsRequest{PhoneHashes: []string{"not-a-hash"}},
+		}).
+		AssertStatus(http.StatusUnprocessableEntity)
+
+	unknownPhone := fakes.GenerateRandomPhoneNumber()
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/direct-invites", tripID),
+			Method: testkit.POST,
+			UserID: &owner,
+			Body:   models.CreateTripDirectInviteRequest{PhoneNumber: &unknownPhone},
+		}).
+		AssertStatus(http.StatusAccepted)
+
+	inviteID := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/direct-invites", tripID),
+			Method: testkit.POST,
+			UserID: &owner,
+			Body:   models.CreateTripDirectInviteRequest{Username: &friendUsername},
+		}).
+		AssertStatus(http.StatusCreated).
+		AssertField("status", string(models.TripDirectInvitePending)).
+		GetBody()["id"].(string)
+
+	received := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/users/me/trip-invites",
+			Method: testkit.GET,
+			UserID: &friendID,
+		}).
+		AssertStatus(http.StatusOK).
+		GetBody()["items"].([]interface{})
+	require.Len(t, received, 1)
+	assert.Equal(t, inviteID, received[0].(map[string]interface{})["id"])
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/users/me/trip-invites/%s/accept", inviteID),
+			Method: testkit.POST,
+			UserID: &friendID,
+		}).
+		AssertStatus(http.StatusOK).
+		AssertField("trip_id", tripID)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/direct-invites", tripID),
+			Method: testkit.GET,
+			UserID: &owner,
+		}).
+		AssertStatus(http.StatusOK).
+		AssertFieldExists("items")
+
+	// Opting out of discovery hides the user from contact matches.
+	disabled := true
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/users/%s", friendID),
+			Method: testkit.PATCH,
+			UserID: &friendID,
+			Body:   models.UpdateUserRequest{PhoneDiscoveryDisabled: &disabled},
+		}).
+		AssertStatus(http.StatusOK)
+	assert.Empty(t, discover())
+}
@@ -0,0 +1,299 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInviteRepo keeps one trip's invites and joins in memory.
+type fakeInviteRepo struct {
+	repository.TripInviteRepository
+	invites map[uuid.UUID]*models.TripInvite
+	joins   map[uuid.UUID]*models.TripInviteJoin
+}
+
+func newFakeInviteRepo(invites ...*models.TripInvite) *fakeInviteRepo {
+	repo := &fakeInviteRepo{
+		invites: make(map[uuid.UUID]*models.TripInvite),
+		joins:   make(map[uuid.UUID]*models.TripInviteJoin),
+	}
+	for _, invite := range invites {
+		repo.invites[invite.ID] = invite
+	}
+	return repo
+}
+
+func (f *fakeInviteRepo) Create(_ context.Context, invite *models.TripInvite) (*models.TripInvite, error) {
+	f.invites[invite.ID] = invite
+	return invite, nil
+}
+
+func (f *fakeInviteRepo) FindByID(_ context.Context, id uuid.UUID) (*models.TripInvite, error) {
+	if invite, ok := f.invites[id]; ok {
+		return invite, nil
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (f *fakeInviteRepo) FindByCode(_ context.Context, code string) (*models.TripInvite, error) {
+	for _, invite := range f.invites {
+		if invite.Code == code {
+			copied := *invite
+			return &copied, nil
+		}
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (f *fakeInviteRepo) FindByTripID(_ context.Context, tripID uuid.UUID) ([]*models.TripInvite, error) {
+	var found []*models.TripInvite
+	for _, invite := range f.invites {
+		if invite.TripID == tripID {
+			found = append(found, invite)
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeInviteRepo) Revoke(_ context.Context, tripID, id uuid.UUID) error {
+	invite, ok := f.invites[id]
+	if !ok || invite.TripID != tripID {
+		return errs.ErrNotFound
+	}
+	invite.IsRevoked = true
+	return nil
+}
+
+func (f *fakeInviteRepo) ClaimUse(_ context.Context, id uuid.UUID) (bool, error) {
+	invite := f.invites[id]
+	if invite.IsRevoked || invite.IsUsedUp() {
+		return false, nil
+	}
+	invite.UseCount++
+	return true, nil
+}
+
+func (f *fakeInviteRepo) ReleaseUse(_ context.Context, id uuid.UUID) error {
+	f.invites[id].UseCount = max(f.invites[id].UseCount-1, 0)
+	return nil
+}
+
+func (f *fakeInviteRepo) CreateJoin(_ context.Context, join *models.TripInviteJoin) (*models.TripInviteJoin, error) {
+	f.joins[join.ID] = join
+	return join, nil
+}
+
+func (f *fakeInviteRepo) FindJoin(_ context.Context, tripID, id uuid.UUID) (*models.TripInviteJoin, error) {
+	if join, ok := f.joins[id]; ok && join.TripID == tripID {
+		copied := *join
+		return &copied, nil
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (f *fakeInviteRepo) FindPendingJoin(_ context.Context, tripID, userID uuid.UUID) (*models.TripInviteJoin, error) {
+	for _, join := range f.joins {
+		if join.TripID == tripID && join.UserID == userID && join.Status == models.TripInviteJoinPending {
+			return join, nil
+		}
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (f *fakeInviteRepo) DecideJoin(_ context.Context, id uuid.UUID, status models.TripInviteJoinStatus, decidedBy uuid.UUID) (bool, error) {
+	join := f.joins[id]
+	if join.Status != models.TripInviteJoinPending {
+		return false, nil
+	}
+	join.Status = status
+	join.DecidedBy = &decidedBy
+	return true, nil
+}
+
+func (f *fakeInviteRepo) GetJoinStats(_ context.Context, inviteIDs []uuid.UUID) (map[uuid.UUID]*models.TripInviteJoinStats, error) {
+	stats := make(map[uuid.UUID]*models.TripInviteJoinStats)
+	for _, join := range f.joins {
+		if join.Status != models.TripInviteJoinJoined {
+			continue
+		}
+		if stats[join.InviteID] == nil {
+			stats[join.InviteID] = &models.TripInviteJoinStats{}
+		}
+		stats[join.InviteID].Joined++
+	}
+	return stats, nil
+}
+
+// fakeJoinMembershipRepo records created memberships.
+type fakeJoinMembershipRepo struct {
+	adminMembershipRepo
+	members map[uuid.UUID]*models.Membership
+}
+
+func (f *fakeJoinMembershipRepo) Create(_ context.Context, membership *models.Membership) (*models.Membership, error) {
+	f.members[membership.UserID] = membership
+	return membership, nil
+}
+
+func (f *fakeJoinMembershipRepo) Find(_ context.Context, userID, tripID uuid.UUID) (*models.MembershipDatabaseResponse, error) {
+	m, ok := f.members[userID]
+	if !ok || m.TripID != tripID {
+		return nil, errs.ErrNotFound
+	}
+	return &models.MembershipDatabaseResponse{UserID: m.UserID, TripID: m.TripID, IsAdmin: m.IsAdmin}, nil
+}
+
+func newInviteTestRepo(adminID uuid.UUID, invites ...*models.TripInvite) (*repository.Repository, *fakeInviteRepo, *fakeJoinMembershipRepo) {
+	invitesRepo := newFakeInviteRepo(invites...)
+	members := &fakeJoinMembershipRepo{
+		adminMembershipRepo: adminMembershipRepo{admins: map[uuid.UUID]bool{adminID: true}},
+		members:             make(map[uuid.UUID]*models.Membership),
+	}
+	return &repository.Repository{TripInvite: invitesRepo, Membership: members}, invitesRepo, members
+}
+
+func newTestInvite(tripID, createdBy uuid.UUID) *models.TripInvite {
+	return &models.TripInvite{
+		ID:        uuid.New(),
+		TripID:    tripID,
+		CreatedBy: createdBy,
+		Code:      uuid.NewString()[:12],
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func assertAPIStatus(t *testing.T, err error, status int) {
+	t.Helper()
+	var apiErr errs.APIError
+	require.True(t, errors.As(err, &apiErr), "expected APIError, got %v", err)
+	assert.Equal(t, status, apiErr.StatusCode)
+}
+
+func TestJoinTripByInviteCodeUsageLimit(t *testing.T) {
+	tripID, adminID := uuid.New(), uuid.New()
+	invite := newTestInvite(tripID, adminID)
+	one := 1
+	invite.MaxUses = &one
+	repo, invites, members := newInviteTestRepo(adminID, invite)
+	svc := services.NewMembershipService(repo, nil, nil)
+	ctx := context.Background()
+
+	first := uuid.New()
+	result, err := svc.JoinTripByInviteCode(ctx, first, invite.Code)
+	require.NoError(t, err)
+	require.NotNil(t, result.Membership)
+	assert.Nil(t, result.Request)
+	assert.Equal(t, 1, invites.invites[invite.ID].UseCount)
+	assert.Contains(t, members.members, first)
+
+	// Joining again as a member returns the membership without using the invite.
+	result, err = svc.JoinTripByInviteCode(ctx, first, invite.Code)
+	require.NoError(t, err)
+	assert.Equal(t, first, result.Membership.UserID)
+	assert.Equal(t, 1, invites.invites[invite.ID].UseCount)
+
+	_, err = svc.JoinTripByInviteCode(ctx, uuid.New(), invite.Code)
+	assertAPIStatus(t, err, 400)
+
+	stats, err := invites.GetJoinStats(ctx, []uuid.UUID{invite.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats[invite.ID].Joined)
+}
+
+func TestJoinTripByInviteCodeRevoked(t *testing.T) {
+	tripID, adminID := uuid.New(), uuid.New()
+	invite := newTestInvite(tripID, adminID)
+	repo, _, _ := newInviteTestRepo(adminID, invite)
+	tripSvc := services.NewTripService(repo, nil, nil, nil, nil, nil)
+	svc := services.NewMembershipService(repo, nil, nil)
+	ctx := context.Background()
+
+	// Only admins and the invite's creator can revoke.
+	err := tripSvc.RevokeTripInvite(ctx, tripID, invite.ID, uuid.New())
+	assertAPIStatus(t, err, 403)
+	err = tripSvc.RevokeTripInvite(ctx, uuid.New(), invite.ID, adminID)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+
+	require.NoError(t, tripSvc.RevokeTripInvite(ctx, tripID, invite.ID, adminID))
+
+	_, err = svc.JoinTripByInviteCode(ctx, uuid.New(), invite.Code)
+	assertAPIStatus(t, err, 400)
+}
+
+func TestJoinTripByInviteCodeApproval(t *testing.T) {
+	tripID, adminID := uuid.New(), uuid.New()
+	invite := newTestInvite(tripID, adminID)
+	two := 2
+	invite.MaxUses = &two
+	invite.RequiresApproval = true
+	repo, invites, members := newInviteTestRepo(adminID, invite)
+	svc := services.NewMembershipService(repo, nil, nil)
+	ctx := context.Background()
+
+	requester := uuid.New()
+	result, err := svc.JoinTripByInviteCode(ctx, requester, invite.Code)
+	require.NoError(t, err)
+	require.NotNil(t, result.Request)
+	assert.Nil(t, result.Membership)
+	assert.Equal(t, models.TripInviteJoinPending, result.Request.Status)
+	assert.NotContains(t, members.members, requester)
+
+	// Asking twice returns the same request and does not use the invite again.
+	again, err := svc.JoinTripByInviteCode(ctx, requester, invite.Code)
+	require.NoError(t, err)
+	assert.Equal(t, result.Request.ID, again.Request.ID)
+	assert.Equal(t, 1, invites.invites[invite.ID].UseCount)
+
+	_, err = svc.ApproveJoinRequest(ctx, tripID, result.Request.ID, requester)
+	assertAPIStatus(t, err, 403)
+
+	membership, err := svc.ApproveJoinRequest(ctx, tripID, result.Request.ID, adminID)
+	require.NoError(t, err)
+	assert.Equal(t, requester, membership.UserID)
+	assert.Contains(t, members.members, requester)
+
+	_, err = svc.ApproveJoinRequest(ctx, tripID, result.Request.ID, adminID)
+	assertAPIStatus(t, err, 400)
+
+	// Rejecting frees the request's use of the invite.
+	rejected, err := svc.JoinTripByInviteCode(ctx, uuid.New(), invite.Code)
+	require.NoError(t, err)
+	assert.Equal(t, 2, invites.invites[invite.ID].UseCount)
+	require.NoError(t, svc.RejectJoinRequest(ctx, tripID, rejected.Request.ID, adminID))
+	assert.Equal(t, 1, invites.invites[invite.ID].UseCount)
+	assert.Equal(t, models.TripInviteJoinRejected, invites.joins[rejected.Request.ID].Status)
+}
+
+func TestCreateTripInviteSingleUse(t *testing.T) {
+	tripID, adminID := uuid.New(), uuid.New()
+	repo, _, _ := newInviteTestRepo(adminID)
+	tripSvc := services.NewTripService(repo, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	invite, err := tripSvc.CreateTripInvite(ctx, tripID, adminID, models.CreateTripInviteRequest{SingleUse: true})
+	require.NoError(t, err)
+	require.NotNil(t, invite.MaxUses)
+	assert.Equal(t, 1, *invite.MaxUses)
+
+	three := 3
+	_, err = tripSvc.CreateTripInvite(ctx, tripID, adminID, models.CreateTripInviteRequest{SingleUse: true, MaxUses: &three})
+	assertAPIStatus(t, err, 400)
+
+	invites, err := tripSvc.ListTripInvites(ctx, tripID, adminID)
+	require.NoError(t, err)
+	require.Len(t, invites, 1)
+	assert.Equal(t, &models.TripInviteJoinStats{}, invites[0].Stats)
+
+	_, err = tripSvc.ListTripInvites(ctx, tripID, uuid.New())
+	assertAPIStatus(t, err, 403)
+}
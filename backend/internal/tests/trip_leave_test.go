@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// capturePublisher records published events.
+type capturePublisher struct {
+	events []*realtime.Event
+}
+
+func (p *capturePublisher) Publish(_ context.Context, event *realtime.Event) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *capturePublisher) Close() error { return nil }
+
+func (p *capturePublisher) topics(topic realtime.EventTopic) []*realtime.Event {
+	var matched []*realtime.Event
+	for _, e := range p.events {
+		if e.Topic == string(topic) {
+			matched = append(matched, e)
+		}
+	}
+	return matched
+}
+
+func TestLeaveTripLastAdmin(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	owner := members.add(models.TripRoleOwner)
+	member := members.add(models.TripRoleMember)
+	publisher := &capturePublisher{}
+	svc := services.NewMembershipService(&repository.Repository{
+		Membership: members,
+		Trip:       &policyTripRepo{policy: models.DepartedContentAnonymise},
+	}, nil, publisher)
+
+	err := svc.LeaveTrip(ctx, tripID, owner, models.LeaveTripRequest{})
+	assertAPIStatus(t, err, http.StatusBadRequest)
+	err = svc.LeaveTrip(ctx, tripID, owner, models.LeaveTripRequest{SuccessorID: &owner})
+	assertAPIStatus(t, err, http.StatusBadRequest)
+	stranger := uuid.New()
+	err = svc.LeaveTrip(ctx, tripID, owner, models.LeaveTripRequest{SuccessorID: &stranger})
+	assertAPIStatus(t, err, http.StatusBadRequest)
+	assert.Contains(t, members.members, owner)
+
+	require.NoError(t, svc.LeaveTrip(ctx, tripID, owner, models.LeaveTripRequest{SuccessorID: &member}))
+	assert.NotContains(t, members.members, owner)
+	assert.Equal(t, models.TripRoleOwner, members.members[member].Role)
+
+	// The trip anonymises departed members' content.
+	assert.Contains(t, members.handedTo, owner)
+	assert.Nil(t, members.handedTo[owner])
+
+	removed := publisher.topics(realtime.EventTopicMembershipRemoved)
+	require.Len(t, removed, 1)
+	assert.Equal(t, owner.String(), removed[0].EntityID)
+	assert.Equal(t, owner.String(), removed[0].ActorID)
+	assert.Len(t, publisher.topics(realtime.EventTopicMembershipUpdated), 2)
+}
+
+func TestLeaveTripReassignsContent(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	owner := members.add(models.TripRoleOwner)
+	organiser := members.add(models.TripRoleOrganiser)
+	member := members.add(models.TripRoleMember)
+	svc := services.NewMembershipService(&repository.Repository{
+		Membership: members,
+		Trip:       &policyTripRepo{policy: models.DepartedContentReassign},
+	}, nil, nil)
+
+	require.NoError(t, svc.LeaveTrip(ctx, tripID, member, models.LeaveTripRequest{}))
+	assert.Equal(t, &owner, members.handedTo[member])
+
+	// An organiser who is not the last admin needs no successor.
+	require.NoError(t, svc.LeaveTrip(ctx, tripID, organiser, models.LeaveTripRequest{}))
+	assert.Equal(t, &owner, members.handedTo[organiser])
+
+	err := svc.LeaveTrip(ctx, tripID, member, models.LeaveTripRequest{})
+	assertAPIStatus(t, err, http.StatusBadRequest)
+}
+
+func TestTransferOwnership(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	owner := members.add(models.TripRoleOwner)
+	organiser := members.add(models.TripRoleOrganiser)
+	viewer := members.add(models.TripRoleViewer)
+	svc := services.NewMembershipService(&repository.Repository{Membership: members}, nil, nil)
+
+	assertAPIStatus(t, svc.TransferOwnership(ctx, tripID, organiser, viewer), http.StatusForbidden)
+	assertAPIStatus(t, svc.TransferOwnership(ctx, tripID, owner, owner), http.StatusBadRequest)
+	assertAPIStatus(t, svc.TransferOwnership(ctx, tripID, owner, uuid.New()), http.StatusBadRequest)
+
+	require.NoError(t, svc.TransferOwnership(ctx, tripID, owner, viewer))
+	assert.Equal(t, models.TripRoleOwner, members.members[viewer].Role)
+	assert.Equal(t, models.TripRoleOrganiser, members.members[owner].Role)
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+	"toggo/internal/models"
+	"toggo/internal/workflows/notifications"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUsersWithTimezones returns the requested users with their configured timezone.
+type fakeUsersWithTimezones map[uuid.UUID]string
+
+func (f fakeUsersWithTimezones) GetUsersWithDeviceTokens(_ context.Context, userIDs []uuid.UUID) ([]*models.User, error) {
+	users := make([]*models.User, 0, len(userIDs))
+	for _, id := range userIDs {
+		users = append(users, &models.User{ID: id, Timezone: f[id]})
+	}
+	return users, nil
+}
+
+type fakeItinerary map[string][]*models.Activity
+
+func (f fakeItinerary) FindByTripIDOnDate(_ context.Context, _ uuid.UUID, date string) ([]*models.Activity, error) {
+	return f[date], nil
+}
+
+type recordingSender struct {
+	requests []models.SendNotificationRequest
+}
+
+func (s *recordingSender) SendNotification(_ context.Context, req models.SendNotificationRequest) error {
+	s.requests = append(s.requests, req)
+	return nil
+}
+
+func TestTripReminders(t *testing.T) {
+	ctx := context.Background()
+	tokyo, utc, newYork := uuid.New(), uuid.New(), uuid.New()
+	start := time.Date(2030, 6, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2030, 6, 12, 0, 0, 0, 0, time.UTC)
+	morning := models.ActivityTimeOfDayMorning
+	evening := models.ActivityTimeOfDayEvening
+
+	setup := func() (*notifications.NotificationActivities, notifications.TripRemindersInput, *recordingSender) {
+		trip := &models.Trip{ID: uuid.New(), Name: "Lisbon", StartDate: &start, EndDate: &end}
+		sender := &recordingSender{}
+		activities := &notifications.NotificationActivities{
+			UserRepo: fakeUsersWithTimezones{
+				tokyo:   "Asia/Tokyo",
+				utc:     "UTC",
+				newYork: "America/New_York",
+			},
+			Preferences:        &membersResolver{members: []uuid.UUID{tokyo, utc, newYork}},
+			NotificationSender: sender,
+			TripRepo:           &fakePitchDeadlineTrips{trip: trip},
+			Itinerary: fakeItinerary{
+				"2030-06-10": {
+					{Name: "Surf lesson", TimeOfDay: &morning},
+					{Name: "Museum"},
+					{Name: "Tapas", TimeOfDay: &evening},
+					{Name: "Fado"},
+				},
+				"2030-06-11": {{Name: "Sintra", TimeOfDay: &morning}},
+			},
+		}
+		return activities, notifications.TripRemindersInput{TripID: trip.ID, StartDate: start, EndDate: end}, sender
+	}
+
+	t.Run("plans 8am local for each member timezone within the window", func(t *testing.T) {
+		activities, input, _ := setup()
+		slots, err := activities.PlanTripReminders(ctx, notifications.TripReminderPlanInput{
+			TripRemindersInput: input,
+			From:               time.Date(2030, 6, 2, 0, 0, 0, 0, time.UTC),
+			To:                 time.Date(2030, 6, 3, 12, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []time.Time{
+			time.Date(2030, 6, 2, 23, 0, 0, 0, time.UTC),
+			time.Date(2030, 6, 3, 8, 0, 0, 0, time.UTC),
+		}, slots)
+	})
+
+	t.Run("countdown goes to members at 8am local", func(t *testing.T) {
+		activities, input, sender := setup()
+		require.NoError(t, activities.SendTripReminders(ctx, notifications.TripReminderSendInput{
+			TripRemindersInput: input,
+			At:                 time.Date(2030, 6, 9, 12, 0, 0, 0, time.UTC),
+		}))
+
+		require.Len(t, sender.requests, 1)
+		assert.Equal(t, newYork, sender.requests[0].UserID)
+		assert.Equal(t, "Tomorrow's the day", sender.requests[0].Title)
+	})
+
+	t.Run("morning of the trip includes the itinerary", func(t *testing.T) {
+		activities, input, sender := setup()
+		require.NoError(t, activities.SendTripReminders(ctx, notifications.TripReminderSendInput{
+			TripRemindersInput: input,
+			At:                 time.Date(2030, 6, 9, 23, 0, 0, 0, time.UTC),
+		}))
+
+		require.Len(t, sender.requests, 1)
+		assert.Equal(t, tokyo, sender.requests[0].UserID)
+		assert.Equal(t, "Your trip starts today", sender.requests[0].Title)
+		assert.Equal(t, "Lisbon starts today! On the plan: Surf lesson (morning), Museum, Tapas (evening) and 1 more.", sender.requests[0].Body)
+	})
+
+	t.Run("daily briefing is sent only on days with activities", func(t *testing.T) {
+		activities, input, sender := setup()
+		require.NoError(t, activities.SendTripReminders(ctx, notifications.TripReminderSendInput{
+			TripRemindersInput: input,
+			At:                 time.Date(2030, 6, 11, 8, 0, 0, 0, time.UTC),
+		}))
+		require.NoError(t, activities.SendTripReminders(ctx, notifications.TripReminderSendInput{
+			TripRemindersInput: input,
+			At:                 time.Date(2030, 6, 12, 8, 0, 0, 0, time.UTC),
+		}))
+
+		require.Len(t, sender.requests, 1)
+		assert.Equal(t, utc, sender.requests[0].UserID)
+		assert.Equal(t, "Today in Lisbon", sender.requests[0].Title)
+		assert.Equal(t, "On the plan: Sintra (morning).", sender.requests[0].Body)
+	})
+
+	t.Run("reminders for superseded dates are skipped", func(t *testing.T) {
+		activities, input, sender := setup()
+		input.StartDate = start.AddDate(0, 0, -1)
+		require.NoError(t, activities.SendTripReminders(ctx, notifications.TripReminderSendInput{
+			TripRemindersInput: input,
+			At:                 time.Date(2030, 6, 9, 8, 0, 0, 0, time.UTC),
+		}))
+
+		assert.Empty(t, sender.requests)
+	})
+}
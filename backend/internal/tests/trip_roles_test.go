@@ -0,0 +1,293 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roleMembershipRepo keeps one trip's members and their roles in memory.
+type roleMembershipRepo struct {
+	repository.MembershipRepository
+	tripID  uuid.UUID
+	members map[uuid.UUID]*models.MembershipDatabaseResponse
+	// handedTo records who received each removed member's content; nil means anonymised.
+	handedTo map[uuid.UUID]*uuid.UUID
+	archived bool
+}
+
+func newRoleMembershipRepo(tripID uuid.UUID) *roleMembershipRepo {
+	return &roleMembershipRepo{
+		tripID:   tripID,
+		members:  make(map[uuid.UUID]*models.MembershipDatabaseResponse),
+		handedTo: make(map[uuid.UUID]*uuid.UUID),
+	}
+}
+
+// add joins a member; later members have later created_at timestamps.
+func (r *roleMembershipRepo) add(role models.TripRole) uuid.UUID {
+	userID := uuid.New()
+	r.members[userID] = &models.MembershipDatabaseResponse{
+		UserID:    userID,
+		TripID:    r.tripID,
+		Role:      role,
+		IsAdmin:   role.IsAdmin(),
+		CreatedAt: time.Now().Add(time.Duration(len(r.members)) * time.Minute),
+	}
+	return userID
+}
+
+func (r *roleMembershipRepo) Find(_ context.Context, userID, tripID uuid.UUID) (*models.MembershipDatabaseResponse, error) {
+	if m, ok := r.members[userID]; ok && tripID == r.tripID {
+		return m, nil
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (r *roleMembershipRepo) IsMember(_ context.Context, tripID, userID uuid.UUID) (bool, error) {
+	_, ok := r.members[userID]
+	return ok && tripID == r.tripID, nil
+}
+
+func (r *roleMembershipRepo) Create(_ context.Context, membership *models.Membership) (*models.Membership, error) {
+	r.members[membership.UserID] = &models.MembershipDatabaseResponse{
+		UserID:    membership.UserID,
+		TripID:    membership.TripID,
+		Role:      membership.Role,
+		IsAdmin:   membership.Role.IsAdmin(),
+		CreatedAt: time.Now(),
+	}
+	return membership, nil
+}
+
+func (r *roleMembershipRepo) FindByUserID(_ context.Context, userID uuid.UUID) ([]*models.Membership, error) {
+	m, ok := r.members[userID]
+	if !ok {
+		return nil, nil
+	}
+	return []*models.Membership{{UserID: userID, TripID: r.tripID, Role: m.Role, IsAdmin: m.IsAdmin}}, nil
+}
+
+func (r *roleMembershipRepo) FindRole(ctx context.Context, tripID, userID uuid.UUID) (models.TripRole, error) {
+	m, err := r.Find(ctx, userID, tripID)
+	if err != nil {
+		return "", err
+	}
+	return m.Role, nil
+}
+
+func (r *roleMembershipRepo) FindAccess(ctx context.Context, tripID, userID uuid.UUID) (models.TripAccess, error) {
+	role, err := r.FindRole(ctx, tripID, userID)
+	if err != nil {
+		return models.TripAccess{}, err
+	}
+	return models.TripAccess{Role: role, Archived: r.archived}, nil
+}
+
+func (r *roleMembershipRepo) FindByTripID(_ context.Context, _ uuid.UUID) ([]*models.MembershipDatabaseResponse, error) {
+	var members []*models.MembershipDatabaseResponse
+	for _, m := range r.members {
+		members = append(members, m)
+	}
+	// Newest first, like the real repository.
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].CreatedAt.After(members[j].CreatedAt)
+	})
+	return members, nil
+}
+
+func (r *roleMembershipRepo) CountMembers(_ context.Context, _ uuid.UUID) (int, error) {
+	return len(r.members), nil
+}
+
+func (r *roleMembershipRepo) CountAdmins(_ context.Context, _ uuid.UUID) (int, error) {
+	count := 0
+	for _, m := range r.members {
+		if m.Role.IsAdmin() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *roleMembershipRepo) UpdateRole(_ context.Context, userID, _ uuid.UUID, role models.TripRole) (*models.Membership, error) {
+	m := r.members[userID]
+	m.Role = role
+	m.IsAdmin = role.IsAdmin()
+	return &models.Membership{UserID: userID, TripID: r.tripID, Role: role, IsAdmin: m.IsAdmin}, nil
+}
+
+func (r *roleMembershipRepo) TransferOwnership(_ context.Context, _, fromUserID, toUserID uuid.UUID) error {
+	r.members[fromUserID].Role = models.TripRoleOrganiser
+	r.members[toUserID].Role = models.TripRoleOwner
+	return nil
+}
+
+func (r *roleMembershipRepo) Delete(_ context.Context, userID, _ uuid.UUID) error {
+	delete(r.members, userID)
+	return nil
+}
+
+func (r *roleMembershipRepo) DeleteAndHandOverContent(ctx context.Context, userID, tripID uuid.UUID, newAuthorID *uuid.UUID) error {
+	r.handedTo[userID] = newAuthorID
+	return r.Delete(ctx, userID, tripID)
+}
+
+// policyTripRepo finds a trip with the given departed content policy.
+type policyTripRepo struct {
+	repository.TripRepository
+	policy models.DepartedContentPolicy
+}
+
+func (r *policyTripRepo) Find(_ context.Context, id uuid.UUID) (*models.Trip, error) {
+	return &models.Trip{ID: id, DepartedContentPolicy: r.policy}, nil
+}
+
+func TestTripRolePermissions(t *testing.T) {
+	cases := []struct {
+		role    models.TripRole
+		allowed []models.TripPermission
+		denied  []models.TripPermission
+	}{
+		{models.TripRoleOwner, []models.TripPermission{models.TripPermissionDeleteTrip, models.TripPermissionManageRoles}, nil},
+		{models.TripRoleOrganiser, []models.TripPermission{models.TripPermissionEditTrip, models.TripPermissionModerateContent, models.TripPermissionRemoveMembers, models.TripPermissionManageInvites, models.TripPermissionManageTasks}, []models.TripPermission{models.TripPermissionDeleteTrip}},
+		{models.TripRoleMember, []models.TripPermission{models.TripPermissionContribute, models.TripPermissionCreatePolls, models.TripPermissionInviteMembers}, []models.TripPermission{models.TripPermissionEditTrip, models.TripPermissionModerateContent, models.TripPermissionManageInvites, models.TripPermissionManageTasks}},
+		{models.TripRoleViewer, nil, []models.TripPermission{models.TripPermissionContribute, models.TripPermissionCreatePolls, models.TripPermissionInviteMembers}},
+		{"", nil, []models.TripPermission{models.TripPermissionContribute}},
+	}
+	for _, tc := range cases {
+		for _, p := range tc.allowed {
+			assert.True(t, tc.role.Can(p), "%s should %s", tc.role, p)
+		}
+		for _, p := range tc.denied {
+			assert.False(t, tc.role.Can(p), "%s should not %s", tc.role, p)
+		}
+	}
+}
+
+func TestUpdateMemberRole(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	owner := members.add(models.TripRoleOwner)
+	organiser := members.add(models.TripRoleOrganiser)
+	member := members.add(models.TripRoleMember)
+	viewer := members.add(models.TripRoleViewer)
+	publisher := &capturePublisher{}
+	svc := services.NewMembershipService(&repository.Repository{Membership: members}, nil, publisher)
+
+	// Members cannot change roles.
+	_, err := svc.UpdateMemberRole(ctx, tripID, viewer, member, models.TripRoleMember)
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	// Organisers manage members and viewers, but not other organisers.
+	updated, err := svc.UpdateMemberRole(ctx, tripID, viewer, organiser, models.TripRoleMember)
+	require.NoError(t, err)
+	assert.Equal(t, models.TripRoleMember, updated.Role)
+
+	second := members.add(models.TripRoleOrganiser)
+	_, err = svc.UpdateMemberRole(ctx, tripID, second, organiser, models.TripRoleViewer)
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	// Owners can demote organisers; nobody can change the owner's role.
+	_, err = svc.UpdateMemberRole(ctx, tripID, second, owner, models.TripRoleViewer)
+	require.NoError(t, err)
+	events := publisher.topics(realtime.EventTopicMembershipUpdated)
+	require.Len(t, events, 2)
+	var payload realtime.MembershipPayload
+	require.NoError(t, json.Unmarshal(events[1].Data, &payload))
+	assert.Equal(t, second, payload.UserID)
+	assert.Equal(t, models.TripRoleViewer, payload.Role)
+	assert.Equal(t, 2, events[1].Version)
+	_, err = svc.UpdateMemberRole(ctx, tripID, owner, organiser, models.TripRoleMember)
+	assertAPIStatus(t, err, http.StatusForbidden)
+	_, err = svc.UpdateMemberRole(ctx, tripID, owner, owner, models.TripRoleMember)
+	assertAPIStatus(t, err, http.StatusBadRequest)
+	_, err = svc.UpdateMemberRole(ctx, tripID, member, owner, models.TripRoleOwner)
+	assertAPIStatus(t, err, http.StatusBadRequest)
+
+	// Organisers can step down, but members cannot step up.
+	_, err = svc.UpdateMemberRole(ctx, tripID, organiser, organiser, models.TripRoleMember)
+	require.NoError(t, err)
+	_, err = svc.UpdateMemberRole(ctx, tripID, member, member, models.TripRoleOrganiser)
+	assertAPIStatus(t, err, http.StatusForbidden)
+}
+
+func TestRemoveMemberRoles(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	owner := members.add(models.TripRoleOwner)
+	firstOrganiser := members.add(models.TripRoleOrganiser)
+	secondOrganiser := members.add(models.TripRoleOrganiser)
+	member := members.add(models.TripRoleMember)
+	svc := services.NewMembershipService(&repository.Repository{Membership: members, Trip: &policyTripRepo{}}, nil, nil)
+
+	err := svc.RemoveMember(ctx, tripID, firstOrganiser, member)
+	assertAPIStatus(t, err, http.StatusForbidden)
+	err = svc.RemoveMember(ctx, tripID, secondOrganiser, firstOrganiser)
+	assertAPIStatus(t, err, http.StatusForbidden)
+	err = svc.RemoveMember(ctx, tripID, owner, firstOrganiser)
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	// When the owner leaves, the longest-standing organiser takes over.
+	require.NoError(t, svc.RemoveMember(ctx, tripID, owner, owner))
+	assert.NotContains(t, members.members, owner)
+	assert.Equal(t, models.TripRoleOwner, members.members[firstOrganiser].Role)
+	assert.Equal(t, &firstOrganiser, members.handedTo[owner])
+
+	require.NoError(t, svc.RemoveMember(ctx, tripID, member, secondOrganiser))
+	require.NoError(t, svc.RemoveMember(ctx, tripID, secondOrganiser, firstOrganiser))
+
+	// The last admin cannot leave.
+	err = svc.RemoveMember(ctx, tripID, firstOrganiser, firstOrganiser)
+	assertAPIStatus(t, err, http.StatusBadRequest)
+}
+
+func TestTripPermissionRequired(t *testing.T) {
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	organiser := members.add(models.TripRoleOrganiser)
+	viewer := members.add(models.TripRoleViewer)
+
+	app := fiber.New(fiber.Config{ErrorHandler: errs.ErrorHandler})
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", c.Get("X-User-ID"))
+		return c.Next()
+	})
+	app.Patch("/trips/:tripID",
+		middlewares.TripPermissionRequired(&repository.Repository{Membership: members}, models.TripPermissionEditTrip),
+		func(c *fiber.Ctx) error {
+			return c.SendString(string(c.Locals("tripRole").(models.TripRole)))
+		})
+
+	for _, tc := range []struct {
+		userID uuid.UUID
+		status int
+	}{
+		{organiser, http.StatusOK},
+		{viewer, http.StatusForbidden},
+		{uuid.New(), http.StatusNotFound},
+	} {
+		req := httptest.NewRequest(http.MethodPatch, "/trips/"+tripID.String(), nil)
+		req.Header.Set("X-User-ID", tc.userID.String())
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, tc.status, resp.StatusCode)
+	}
+}
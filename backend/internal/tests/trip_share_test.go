@@ -0,0 +1,216 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"toggo/internal/config"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+	"toggo/internal/templates"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeShareLinkRepo struct {
+	repository.TripShareLinkRepository
+	links map[uuid.UUID]*models.TripShareLink
+}
+
+func (f *fakeShareLinkRepo) Upsert(_ context.Context, link *models.TripShareLink) (*models.TripShareLink, error) {
+	copied := *link
+	f.links[link.TripID] = &copied
+	return link, nil
+}
+
+func (f *fakeShareLinkRepo) Find(_ context.Context, tripID uuid.UUID) (*models.TripShareLink, error) {
+	if link, ok := f.links[tripID]; ok {
+		copied := *link
+		return &copied, nil
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (f *fakeShareLinkRepo) FindByToken(_ context.Context, token string) (*models.TripShareLink, error) {
+	for _, link := range f.links {
+		if link.Token == token {
+			copied := *link
+			return &copied, nil
+		}
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (f *fakeShareLinkRepo) Delete(_ context.Context, tripID uuid.UUID) error {
+	if _, ok := f.links[tripID]; !ok {
+		return errs.ErrNotFound
+	}
+	delete(f.links, tripID)
+	return nil
+}
+
+type fakeShareTripRepo struct {
+	repository.TripRepository
+	trip *models.TripDatabaseResponse
+}
+
+func (f *fakeShareTripRepo) FindWithCoverImage(_ context.Context, id uuid.UUID) (*models.TripDatabaseResponse, error) {
+	if f.trip.TripID != id {
+		return nil, errs.ErrNotFound
+	}
+	return f.trip, nil
+}
+
+type fakeShareActivityRepo struct {
+	repository.ActivityRepository
+	activities []*models.ActivityDatabaseResponse
+}
+
+func (f *fakeShareActivityRepo) FindByTripID(context.Context, uuid.UUID, *models.ActivityCursor, int) ([]*models.ActivityDatabaseResponse, *models.ActivityCursor, error) {
+	return f.activities, nil, nil
+}
+
+type fakeSharePollRepo struct {
+	repository.PollRepository
+	polls []*models.Poll
+}
+
+func (f *fakeSharePollRepo) FindPollsByTripIDWithCursor(context.Context, uuid.UUID, int, *models.PollCursor) ([]*models.Poll, *models.PollCursor, error) {
+	return f.polls, nil, nil
+}
+
+type fakeShareVoteCounts struct {
+	repository.PollVotingRepository
+	counts map[uuid.UUID]int
+}
+
+func (f *fakeShareVoteCounts) GetPollsVotes(_ context.Context, pollIDs []uuid.UUID, _ uuid.UUID) (map[uuid.UUID]*models.PollVoteSummary, error) {
+	summaries := make(map[uuid.UUID]*models.PollVoteSummary, len(pollIDs))
+	for _, id := range pollIDs {
+		summaries[id] = &models.PollVoteSummary{OptionVoteCounts: f.counts}
+	}
+	return summaries, nil
+}
+
+func TestTripShareLinkControls(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	organiser := members.add(models.TripRoleOrganiser)
+	member := members.add(models.TripRoleMember)
+	links := &fakeShareLinkRepo{links: make(map[uuid.UUID]*models.TripShareLink)}
+	svc := services.NewTripShareService(
+		&repository.Repository{Membership: members, TripShareLink: links},
+		nil,
+		config.AppConfig{PublicURL: "https://toggo.example/"},
+	)
+
+	_, err := svc.EnableShareLink(ctx, tripID, member, models.UpdateTripShareLinkRequest{})
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	// A new link shows every tab.
+	link, err := svc.EnableShareLink(ctx, tripID, organiser, models.UpdateTripShareLinkRequest{})
+	require.NoError(t, err)
+	assert.Len(t, link.Token, 32)
+	assert.Equal(t, models.TripShareTabs, link.VisibleTabs)
+	assert.Equal(t, "https://toggo.example/share/"+link.Token, link.URL)
+
+	// Only members who can change the link can read it, even once the trip is archived.
+	_, err = svc.GetShareLink(ctx, tripID, member)
+	assertAPIStatus(t, err, http.StatusForbidden)
+	members.archived = true
+	read, err := svc.GetShareLink(ctx, tripID, organiser)
+	require.NoError(t, err)
+	assert.Equal(t, link.Token, read.Token)
+	members.archived = false
+
+	// Changing tabs keeps the token; rotating replaces it.
+	tabs := []string{models.TripShareTabItinerary}
+	updated, err := svc.EnableShareLink(ctx, tripID, organiser, models.UpdateTripShareLinkRequest{VisibleTabs: &tabs})
+	require.NoError(t, err)
+	assert.Equal(t, link.Token, updated.Token)
+	assert.Equal(t, tabs, updated.VisibleTabs)
+
+	rotated, err := svc.RotateShareLink(ctx, tripID, organiser)
+	require.NoError(t, err)
+	assert.NotEqual(t, link.Token, rotated.Token)
+	assert.Equal(t, tabs, rotated.VisibleTabs)
+
+	page, err := svc.GetTripSharePageData(ctx, link.Token)
+	require.NoError(t, err)
+	assert.NotEmpty(t, page.ErrorMessage)
+
+	assertAPIStatus(t, svc.DisableShareLink(ctx, tripID, member), http.StatusForbidden)
+	require.NoError(t, svc.DisableShareLink(ctx, tripID, organiser))
+	_, err = svc.GetShareLink(ctx, tripID, organiser)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestTripSharePage(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	members.add(models.TripRoleOwner)
+	members.add(models.TripRoleMember)
+
+	evening, morning := models.ActivityTimeOfDayEvening, models.ActivityTimeOfDayMorning
+	activities := []*models.ActivityDatabaseResponse{
+		{Name: "Dinner", TimeOfDay: &evening, Dates: &[]models.DateRange{{Start: "2026-07-01", End: "2026-07-01"}}},
+		{Name: "Hike", TimeOfDay: &morning, Dates: &[]models.DateRange{{Start: "2026-07-01", End: "2026-07-02"}}},
+		{Name: "Museum", ProposerName: "Sam"},
+	}
+
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	beach, city := uuid.New(), uuid.New()
+	closed := &models.Poll{ID: uuid.New(), Question: "Where to stay?", PollType: models.PollTypeSingle, Deadline: &past,
+		Options: []models.PollOption{{ID: beach, Name: "Beach"}, {ID: city, Name: "City"}}}
+	open := &models.Poll{ID: uuid.New(), Question: "Still voting", PollType: models.PollTypeSingle, Deadline: &future}
+
+	startDate := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2026, 7, 4, 0, 0, 0, 0, time.UTC)
+	links := &fakeShareLinkRepo{links: map[uuid.UUID]*models.TripShareLink{
+		tripID: {TripID: tripID, Token: "share-token", VisibleTabs: []string{models.TripShareTabItinerary, models.TripShareTabPolls}},
+	}}
+	svc := services.NewTripShareService(&repository.Repository{
+		Membership:    members,
+		TripShareLink: links,
+		Trip:          &fakeShareTripRepo{trip: &models.TripDatabaseResponse{TripID: tripID, Name: "Lisbon", StartDate: &startDate, EndDate: &endDate}},
+		Activity:      &fakeShareActivityRepo{activities: activities},
+		Poll:          &fakeSharePollRepo{polls: []*models.Poll{open, closed}},
+		PollVoting:    &fakeShareVoteCounts{counts: map[uuid.UUID]int{beach: 3, city: 1}},
+	}, nil, config.AppConfig{})
+
+	data, err := svc.GetTripSharePageData(ctx, "share-token")
+	require.NoError(t, err)
+	assert.Empty(t, data.ErrorMessage)
+	assert.Equal(t, "Lisbon", data.TripName)
+	assert.Equal(t, "Jul 1 – Jul 4, 2026", data.Dates)
+	assert.Equal(t, 2, data.MemberCount)
+
+	// Days are ordered, and each day runs morning to evening.
+	require.Len(t, data.Itinerary, 2)
+	assert.Equal(t, "Wednesday, Jul 1", data.Itinerary[0].Label)
+	require.Len(t, data.Itinerary[0].Activities, 2)
+	assert.Equal(t, "Hike", data.Itinerary[0].Activities[0].Name)
+	assert.Equal(t, "Dinner", data.Itinerary[0].Activities[1].Name)
+	assert.Equal(t, "Hike", data.Itinerary[1].Activities[0].Name)
+
+	// Hidden tabs are not loaded, and only closed polls are shown.
+	assert.False(t, data.ShowActivities)
+	assert.Empty(t, data.Activities)
+	require.Len(t, data.Polls, 1)
+	assert.Equal(t, []string{"Beach"}, data.Polls[0].Winners)
+	assert.True(t, data.Polls[0].Options[0].IsWinner)
+
+	html, err := templates.RenderTripSharePage(templates.TripShareView{PageTitle: "Lisbon", Data: data})
+	require.NoError(t, err)
+	assert.Contains(t, string(html), "Where to stay?")
+	assert.NotContains(t, string(html), "panel-activities")
+	assert.NotContains(t, string(html), "Sam")
+}
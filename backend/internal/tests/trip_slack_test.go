@@ -0,0 +1,414 @@
+package tests
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"toggo/internal/config"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+	"toggo/internal/server/middlewares"
+	"toggo/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSlackNotifier records posts instead of calling Slack.
+type recordingSlackNotifier struct {
+	emails    map[string]string
+	polls     []*models.PollAPIResponse
+	updates   []*models.PollAPIResponse
+	decisions [][]string
+	told      []string
+}
+
+func (n *recordingSlackNotifier) NotifyChannelLinked(context.Context, string, string) error {
+	return nil
+}
+
+func (n *recordingSlackNotifier) NotifyPoll(_ context.Context, _ string, poll *models.PollAPIResponse) error {
+	n.polls = append(n.polls, poll)
+	return nil
+}
+
+func (n *recordingSlackNotifier) UpdatePoll(_ context.Context, _, _ string, poll *models.PollAPIResponse) error {
+	n.updates = append(n.updates, poll)
+	return nil
+}
+
+func (n *recordingSlackNotifier) NotifyPitch(context.Context, string, string, *models.PitchAPIResponse) error {
+	return nil
+}
+
+func (n *recordingSlackNotifier) NotifyDecision(_ context.Context, _, _ string, winners []string) error {
+	n.decisions = append(n.decisions, winners)
+	return nil
+}
+
+func (n *recordingSlackNotifier) NotifyUser(_ context.Context, _, _ string, text string) error {
+	n.told = append(n.told, text)
+	return nil
+}
+
+func (n *recordingSlackNotifier) LookupUserEmail(_ context.Context, slackUserID string) (string, error) {
+	return n.emails[slackUserID], nil
+}
+
+type fakeSlackChannelRepo struct {
+	repository.TripSlackChannelRepository
+	links map[uuid.UUID]string
+}
+
+func (f *fakeSlackChannelRepo) Find(_ context.Context, tripID uuid.UUID) (*models.TripSlackChannel, error) {
+	channelID, ok := f.links[tripID]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	return &models.TripSlackChannel{TripID: tripID, ChannelID: channelID}, nil
+}
+
+type fakeSlackPollRepo struct {
+	repository.PollRepository
+	poll *models.Poll
+}
+
+func (f *fakeSlackPollRepo) FindPollMetaByID(_ context.Context, pollID uuid.UUID) (*models.Poll, error) {
+	if f.poll == nil || f.poll.ID != pollID {
+		return nil, errs.ErrNotFound
+	}
+	return f.poll, nil
+}
+
+func (f *fakeSlackPollRepo) FindPollByID(ctx context.Context, pollID uuid.UUID) (*models.Poll, error) {
+	return f.FindPollMetaByID(ctx, pollID)
+}
+
+type fakeSlackVoteCounts struct {
+	repository.PollVotingRepository
+	counts map[uuid.UUID]int
+}
+
+func (f *fakeSlackVoteCounts) GetPollVotes(context.Context, uuid.UUID, uuid.UUID) (*models.PollVoteSummary, error) {
+	return &models.PollVoteSummary{OptionVoteCounts: f.counts, UserVotedOptions: map[uuid.UUID]bool{}}, nil
+}
+
+type fakeEmailUserRepo struct {
+	repository.UserRepository
+	byEmail    map[string]uuid.UUID
+	unverified map[string]bool
+}
+
+func (f *fakeEmailUserRepo) FindByEmail(_ context.Context, email string) (*models.User, error) {
+	email = strings.ToLower(email)
+	id, ok := f.byEmail[email]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	user := &models.User{ID: id}
+	if !f.unverified[email] {
+		verifiedAt := time.Now()
+		user.EmailVerifiedAt = &verifiedAt
+	}
+	return user, nil
+}
+
+type memberSetRepo struct {
+	noopMembershipRepo
+	members map[uuid.UUID]bool
+}
+
+func (m *memberSetRepo) IsMember(_ context.Context, _, userID uuid.UUID) (bool, error) {
+	return m.members[userID], nil
+}
+
+// fakePollVoting keeps one poll's votes per user in memory.
+type fakePollVoting struct {
+	services.PollVotingServiceInterface
+	poll    *models.Poll
+	votes   map[uuid.UUID][]uuid.UUID
+	castErr error
+}
+
+func (f *fakePollVoting) GetVotePoll(_ context.Context, _, _, userID uuid.UUID) (*models.PollAPIResponse, error) {
+	return f.response(userID), nil
+}
+
+func (f *fakePollVoting) CastVote(_ context.Context, _, _, userID uuid.UUID, req models.CastVoteRequest) (*models.PollAPIResponse, error) {
+	if f.castErr != nil {
+		return nil, f.castErr
+	}
+	f.votes[userID] = req.OptionIDs
+	return f.response(userID), nil
+}
+
+func (f *fakePollVoting) response(userID uuid.UUID) *models.PollAPIResponse {
+	resp := &models.PollAPIResponse{ID: f.poll.ID, TripID: f.poll.TripID, Question: f.poll.Question, PollType: f.poll.PollType}
+	for _, opt := range f.poll.Options {
+		apiOpt := models.PollOptionAPIResponse{ID: opt.ID, Name: opt.Name}
+		for voter, optionIDs := range f.votes {
+			for _, id := range optionIDs {
+				if id == opt.ID {
+					apiOpt.VoteCount++
+					apiOpt.Voted = apiOpt.Voted || voter == userID
+				}
+			}
+		}
+		resp.Options = append(resp.Options, apiOpt)
+	}
+	return resp
+}
+
+func newSlackTestPoll(pollType models.PollType, names ...string) *models.Poll {
+	poll := &models.Poll{ID: uuid.New(), TripID: uuid.New(), Question: "Where to?", PollType: pollType}
+	for _, name := range names {
+		poll.Options = append(poll.Options, models.PollOption{ID: uuid.New(), PollID: poll.ID, Name: name})
+	}
+	return poll
+}
+
+func signSlackRequest(secret, timestamp, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":" + body))
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestSlackRequestVerify(t *testing.T) {
+	const secret = "8f742231b10e8888abcd99yyyzzz85a5"
+
+	newApp := func(cfg config.SlackConfig) *fiber.App {
+		app := fiber.New()
+		app.Post("/slack/interactions", middlewares.SlackRequestVerify(cfg), func(c *fiber.Ctx) error {
+			return c.SendString(c.FormValue("payload"))
+		})
+		return app
+	}
+	send := func(t *testing.T, app *fiber.App, timestamp, signature string) int {
+		body := url.Values{"payload": {`{"type":"block_actions"}`}}.Encode()
+		req := httptest.NewRequest(fiber.MethodPost, "/slack/interactions", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+		if signature == "" {
+			signature = signSlackRequest(secret, timestamp, body)
+		}
+		req.Header.Set("X-Slack-Signature", signature)
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	app := newApp(config.SlackConfig{SigningSecret: secret})
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	t.Run("accepts a correctly signed request", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusOK, send(t, app, now, ""))
+	})
+
+	t.Run("rejects a wrong signature", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusUnauthorized, send(t, app, now, "v0=deadbeef"))
+	})
+
+	t.Run("rejects replayed requests", func(t *testing.T) {
+		old := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+		assert.Equal(t, fiber.StatusUnauthorized, send(t, app, old, ""))
+	})
+
+	t.Run("rejects everything without a signing secret", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusServiceUnavailable, send(t, newApp(config.SlackConfig{}), now, ""))
+	})
+}
+
+func TestSlackPollBlocks(t *testing.T) {
+	t.Run("vote polls get a button per option carrying the poll and option", func(t *testing.T) {
+		poll := &models.PollAPIResponse{ID: uuid.New(), Question: "Dinner?", PollType: models.PollTypeSingle}
+		for i := 0; i < 7; i++ {
+			poll.Options = append(poll.Options, models.PollOptionAPIResponse{ID: uuid.New(), Name: "Option " + strconv.Itoa(i)})
+		}
+
+		var buttons []*slack.ButtonBlockElement
+		actionBlocks := 0
+		for _, block := range services.SlackPollBlocks(poll) {
+			if actions, ok := block.(*slack.ActionBlock); ok {
+				actionBlocks++
+				for _, el := range actions.Elements.ElementSet {
+					buttons = append(buttons, el.(*slack.ButtonBlockElement))
+				}
+			}
+		}
+
+		assert.Equal(t, 2, actionBlocks)
+		require.Len(t, buttons, 7)
+		for i, button := range buttons {
+			pollID, optionID, err := services.ParseSlackPollVoteValue(button.Value)
+			require.NoError(t, err)
+			assert.Equal(t, poll.ID, pollID)
+			assert.Equal(t, poll.Options[i].ID, optionID)
+			assert.True(t, strings.HasPrefix(button.ActionID, models.SlackPollVoteActionID+":"))
+		}
+	})
+
+	t.Run("rank polls have no buttons", func(t *testing.T) {
+		poll := &models.PollAPIResponse{ID: uuid.New(), PollType: models.PollTypeRank,
+			Options: []models.PollOptionAPIResponse{{ID: uuid.New(), Name: "Lisbon"}}}
+		for _, block := range services.SlackPollBlocks(poll) {
+			assert.NotEqual(t, slack.MBTAction, block.BlockType())
+		}
+	})
+
+	t.Run("rejects malformed values", func(t *testing.T) {
+		_, _, err := services.ParseSlackPollVoteValue("not-a-vote")
+		assert.Error(t, err)
+	})
+}
+
+func TestSlackPollVote(t *testing.T) {
+	ctx := context.Background()
+	const channelID = "C123"
+	member, outsider, unverified := uuid.New(), uuid.New(), uuid.New()
+
+	setup := func(pollType models.PollType) (*models.Poll, *fakePollVoting, *recordingSlackNotifier, services.SlackInteractionServiceInterface) {
+		poll := newSlackTestPoll(pollType, "Lisbon", "Porto", "Faro")
+		voting := &fakePollVoting{poll: poll, votes: map[uuid.UUID][]uuid.UUID{}}
+		notifier := &recordingSlackNotifier{emails: map[string]string{
+			"UMEMBER":     "Member@example.com",
+			"UOUTSIDER":   "outsider@example.com",
+			"UUNVERIFIED": "unverified@example.com",
+		}}
+		svc := services.NewSlackInteractionService(services.SlackInteractionServiceConfig{
+			ChannelRepo:    &fakeSlackChannelRepo{links: map[uuid.UUID]string{poll.TripID: channelID}},
+			MembershipRepo: &memberSetRepo{members: map[uuid.UUID]bool{member: true, unverified: true}},
+			UserRepo: &fakeEmailUserRepo{
+				byEmail: map[string]uuid.UUID{
+					"member@example.com":     member,
+					"outsider@example.com":   outsider,
+					"unverified@example.com": unverified,
+				},
+				unverified: map[string]bool{"unverified@example.com": true},
+			},
+			PollRepo: &fakeSlackPollRepo{poll: poll},
+			Voting:   voting,
+			Notifier: notifier,
+		})
+		return poll, voting, notifier, svc
+	}
+	press := func(poll *models.Poll, slackUserID string, option int) models.SlackPollVote {
+		return models.SlackPollVote{
+			SlackUserID: slackUserID,
+			ChannelID:   channelID,
+			MessageTS:   "1700000000.000100",
+			PollID:      poll.ID,
+			OptionID:    poll.Options[option].ID,
+		}
+	}
+
+	t.Run("single-choice polls switch and clear the vote", func(t *testing.T) {
+		poll, voting, notifier, svc := setup(models.PollTypeSingle)
+
+		require.NoError(t, svc.HandlePollVote(ctx, press(poll, "UMEMBER", 0)))
+		require.NoError(t, svc.HandlePollVote(ctx, press(poll, "UMEMBER", 1)))
+		assert.Equal(t, []uuid.UUID{poll.Options[1].ID}, voting.votes[member])
+
+		require.NoError(t, svc.HandlePollVote(ctx, press(poll, "UMEMBER", 1)))
+		assert.Empty(t, voting.votes[member])
+
+		require.Len(t, notifier.updates, 3)
+		assert.Equal(t, "You removed your vote for Porto.", notifier.told[2])
+	})
+
+	t.Run("multi-choice polls add options", func(t *testing.T) {
+		poll, voting, _, svc := setup(models.PollTypeMulti)
+
+		require.NoError(t, svc.HandlePollVote(ctx, press(poll, "UMEMBER", 0)))
+		require.NoError(t, svc.HandlePollVote(ctx, press(poll, "UMEMBER", 2)))
+		assert.ElementsMatch(t, []uuid.UUID{poll.Options[0].ID, poll.Options[2].ID}, voting.votes[member])
+	})
+
+	t.Run("non-members and unknown Slack users cannot vote", func(t *testing.T) {
+		poll, voting, notifier, svc := setup(models.PollTypeSingle)
+
+		require.NoError(t, svc.HandlePollVote(ctx, press(poll, "UOUTSIDER", 0)))
+		require.NoError(t, svc.HandlePollVote(ctx, press(poll, "USTRANGER", 0)))
+		assert.Empty(t, voting.votes)
+		assert.Len(t, notifier.told, 2)
+		assert.Empty(t, notifier.updates)
+	})
+
+	t.Run("members whose email is not verified cannot vote", func(t *testing.T) {
+		poll, voting, notifier, svc := setup(models.PollTypeSingle)
+
+		require.NoError(t, svc.HandlePollVote(ctx, press(poll, "UUNVERIFIED", 0)))
+		assert.Empty(t, voting.votes)
+		require.Len(t, notifier.told, 1)
+		assert.Contains(t, notifier.told[0], "verified")
+	})
+
+	t.Run("votes from another channel are refused", func(t *testing.T) {
+		poll, voting, notifier, svc := setup(models.PollTypeSingle)
+		vote := press(poll, "UMEMBER", 0)
+		vote.ChannelID = "COTHER"
+
+		require.NoError(t, svc.HandlePollVote(ctx, vote))
+		assert.Empty(t, voting.votes)
+		assert.Equal(t, []string{"This channel is no longer linked to the trip."}, notifier.told)
+	})
+
+	t.Run("rejected votes are explained to the voter", func(t *testing.T) {
+		poll, voting, notifier, svc := setup(models.PollTypeSingle)
+		voting.castErr = errs.BadRequest(errors.New("cannot vote after the poll deadline has passed"))
+
+		require.NoError(t, svc.HandlePollVote(ctx, press(poll, "UMEMBER", 0)))
+		require.Len(t, notifier.told, 1)
+		assert.Contains(t, notifier.told[0], "deadline has passed")
+	})
+}
+
+func TestSlackTripPoster(t *testing.T) {
+	ctx := context.Background()
+	poll := newSlackTestPoll(models.PollTypeSingle, "Lisbon", "Porto", "Faro")
+	notifier := &recordingSlackNotifier{}
+	counts := map[uuid.UUID]int{poll.Options[0].ID: 2, poll.Options[1].ID: 2, poll.Options[2].ID: 1}
+	channels := &fakeSlackChannelRepo{links: map[uuid.UUID]string{poll.TripID: "C123"}}
+	admin := uuid.New()
+
+	newService := func(notifier services.SlackTripNotifierInterface) services.SlackTripServiceInterface {
+		return services.NewSlackTripService(services.SlackTripServiceConfig{
+			ChannelRepo:    channels,
+			MembershipRepo: &adminMembershipRepo{admins: map[uuid.UUID]bool{admin: true}},
+			PollRepo:       &fakeSlackPollRepo{poll: poll},
+			PollVotingRepo: &fakeSlackVoteCounts{counts: counts},
+			Notifier:       notifier,
+		})
+	}
+
+	t.Run("decisions name every tied leader", func(t *testing.T) {
+		require.NoError(t, newService(notifier).PostDecision(ctx, poll.ID))
+		assert.Equal(t, [][]string{{"Lisbon", "Porto"}}, notifier.decisions)
+	})
+
+	t.Run("trips without a channel are skipped", func(t *testing.T) {
+		other := &models.PollAPIResponse{ID: uuid.New(), TripID: uuid.New()}
+		newService(notifier).PostPoll(ctx, other)
+		assert.Empty(t, notifier.polls)
+	})
+
+	t.Run("nothing is posted when Slack is not configured", func(t *testing.T) {
+		svc := newService(services.NewSlackTripNotifier(config.SlackConfig{}))
+		assert.NoError(t, svc.PostDecision(ctx, poll.ID))
+
+		_, err := svc.LinkChannel(ctx, poll.TripID, admin, models.LinkSlackChannelRequest{ChannelID: "C123"})
+		var apiErr errs.APIError
+		assert.True(t, errors.As(err, &apiErr))
+	})
+}
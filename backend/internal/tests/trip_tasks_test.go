@@ -0,0 +1,325 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+	"toggo/internal/tests/testkit/fakes"
+
+	testkit "toggo/internal/tests/testkit/builders"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTaskRepo keeps trip tasks in memory.
+type memoryTaskRepo struct {
+	repository.TripTaskRepository
+	tasks map[uuid.UUID]*models.TripTask
+}
+
+func (r *memoryTaskRepo) Create(_ context.Context, task *models.TripTask) (*models.TripTask, error) {
+	task.ID = uuid.New()
+	stored := *task
+	r.tasks[task.ID] = &stored
+	return task, nil
+}
+
+func (r *memoryTaskRepo) Find(_ context.Context, id uuid.UUID) (*models.TripTask, error) {
+	task, ok := r.tasks[id]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	copied := *task
+	return &copied, nil
+}
+
+func (r *memoryTaskRepo) Update(_ context.Context, task *models.TripTask, replaceAssignees bool) (*models.TripTask, error) {
+	stored, ok := r.tasks[task.ID]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	assignees := stored.AssigneeIDs
+	if replaceAssignees {
+		assignees = task.AssigneeIDs
+	}
+	*stored = *task
+	stored.AssigneeIDs = assignees
+	copied := *stored
+	return &copied, nil
+}
+
+func (r *memoryTaskRepo) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.tasks, id)
+	return nil
+}
+
+// recordingTaskScheduler records the due date each task's reminder is scheduled for.
+type recordingTaskScheduler struct {
+	scheduled map[uuid.UUID]time.Time
+}
+
+func (s *recordingTaskScheduler) ScheduleTaskReminder(_ context.Context, taskID, _ uuid.UUID, dueAt time.Time) error {
+	s.scheduled[taskID] = dueAt
+	return nil
+}
+
+func (s *recordingTaskScheduler) CancelTaskReminder(_ context.Context, taskID uuid.UUID) error {
+	delete(s.scheduled, taskID)
+	return nil
+}
+
+// mutingResolver returns the requested users, leaving out the excluded user and
+// anyone in muted.
+type mutingResolver struct {
+	muted map[uuid.UUID]bool
+}
+
+func (r *mutingResolver) ResolveRecipients(_ context.Context, audience models.NotificationAudience) ([]uuid.UUID, error) {
+	recipients := make([]uuid.UUID, 0, len(audience.UserIDs))
+	for _, id := range audience.UserIDs {
+		if id != audience.ExcludeUserID && !r.muted[id] {
+			recipients = append(recipients, id)
+		}
+	}
+	return recipients, nil
+}
+
+func TestTripTasks(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	organiser := members.add(models.TripRoleOrganiser)
+	member := members.add(models.TripRoleMember)
+	other := members.add(models.TripRoleMember)
+	viewer := members.add(models.TripRoleViewer)
+
+	tasks := &memoryTaskRepo{tasks: map[uuid.UUID]*models.TripTask{}}
+	notifier := &pushRecorder{}
+	publisher := &capturePublisher{}
+	reminders := &recordingTaskScheduler{scheduled: map[uuid.UUID]time.Time{}}
+	preferences := &mutingResolver{muted: map[uuid.UUID]bool{}}
+	svc := services.NewTripTaskService(&repository.Repository{
+		Membership: members,
+		User: &lookupUserRepo{users: []*models.User{
+			{ID: organiser, Name: "Alex", Locale: "en"},
+			{ID: member, Name: "Sam", Locale: "en"},
+			{ID: other, Name: "Jo", Locale: "en"},
+		}},
+		Trip:     &softDeleteTripRepo{trip: models.Trip{ID: tripID, Name: "Ski trip"}},
+		TripTask: tasks,
+	}, publisher, notifier, reminders, preferences)
+
+	dueAt := time.Now().Add(72 * time.Hour).Truncate(time.Second)
+	create := models.CreateTripTaskRequest{
+		Title:       "Buy lift passes",
+		DueAt:       &dueAt,
+		AssigneeIDs: []uuid.UUID{member, member},
+	}
+
+	_, err := svc.CreateTask(ctx, tripID, member, create)
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	_, err = svc.CreateTask(ctx, tripID, organiser, models.CreateTripTaskRequest{Title: "Pack", AssigneeIDs: []uuid.UUID{viewer}})
+	assertAPIStatus(t, err, http.StatusBadRequest)
+
+	task, err := svc.CreateTask(ctx, tripID, organiser, create)
+	require.NoError(t, err)
+	assert.Equal(t, models.TripTaskTodo, task.Status)
+	assert.Equal(t, []uuid.UUID{member}, task.AssigneeIDs)
+	assert.Equal(t, dueAt, reminders.scheduled[task.ID])
+	assert.Len(t, publisher.topics(realtime.EventTopicTaskCreated), 1)
+	require.Len(t, notifier.requests, 1)
+	assert.Equal(t, member, notifier.requests[0].UserID)
+	assert.Equal(t, `Alex assigned you "Buy lift passes" in Ski trip.`, notifier.requests[0].Body)
+
+	t.Run("assignees can only change the status of their own tasks", func(t *testing.T) {
+		inProgress := models.TripTaskInProgress
+		_, err := svc.UpdateTask(ctx, tripID, task.ID, other, models.UpdateTripTaskRequest{Status: &inProgress})
+		assertAPIStatus(t, err, http.StatusForbidden)
+
+		title := "Buy ski passes"
+		_, err = svc.UpdateTask(ctx, tripID, task.ID, member, models.UpdateTripTaskRequest{Status: &inProgress, Title: &title})
+		assertAPIStatus(t, err, http.StatusForbidden)
+
+		updated, err := svc.UpdateTask(ctx, tripID, task.ID, member, models.UpdateTripTaskRequest{Status: &inProgress})
+		require.NoError(t, err)
+		assert.Equal(t, models.TripTaskInProgress, updated.Status)
+		assert.Nil(t, updated.CompletedAt)
+	})
+
+	t.Run("finishing a task cancels its reminder", func(t *testing.T) {
+		done := models.TripTaskDone
+		updated, err := svc.UpdateTask(ctx, tripID, task.ID, member, models.UpdateTripTaskRequest{Status: &done})
+		require.NoError(t, err)
+		assert.NotNil(t, updated.CompletedAt)
+		assert.NotContains(t, reminders.scheduled, task.ID)
+
+		todo := models.TripTaskTodo
+		reopened, err := svc.UpdateTask(ctx, tripID, task.ID, organiser, models.UpdateTripTaskRequest{Status: &todo})
+		require.NoError(t, err)
+		assert.Nil(t, reopened.CompletedAt)
+		assert.Equal(t, dueAt, reminders.scheduled[task.ID])
+	})
+
+	t.Run("only new assignees are notified", func(t *testing.T) {
+		sent := len(notifier.requests)
+		updated, err := svc.UpdateTask(ctx, tripID, task.ID, organiser, models.UpdateTripTaskRequest{
+			AssigneeIDs: &[]uuid.UUID{member, other},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{member, other}, updated.AssigneeIDs)
+		require.Len(t, notifier.requests, sent+1)
+		assert.Equal(t, other, notifier.requests[sent].UserID)
+	})
+
+	t.Run("assignees who muted trip activity are not notified", func(t *testing.T) {
+		preferences.muted[member] = true
+		defer delete(preferences.muted, member)
+
+		sent := len(notifier.requests)
+		muted, err := svc.CreateTask(ctx, tripID, organiser, models.CreateTripTaskRequest{
+			Title:       "Book chalet",
+			AssigneeIDs: []uuid.UUID{member, other},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{member, other}, muted.AssigneeIDs)
+		require.Len(t, notifier.requests, sent+1)
+		assert.Equal(t, other, notifier.requests[sent].UserID)
+		delete(tasks.tasks, muted.ID)
+	})
+
+	t.Run("clearing the due date cancels the reminder", func(t *testing.T) {
+		updated, err := svc.UpdateTask(ctx, tripID, task.ID, organiser, models.UpdateTripTaskRequest{ClearDueAt: true})
+		require.NoError(t, err)
+		assert.Nil(t, updated.DueAt)
+		assert.NotContains(t, reminders.scheduled, task.ID)
+	})
+
+	t.Run("tasks belong to their trip", func(t *testing.T) {
+		otherTrip := uuid.New()
+		_, err := svc.UpdateTask(ctx, otherTrip, task.ID, organiser, models.UpdateTripTaskRequest{ClearDueAt: true})
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("only organisers delete tasks", func(t *testing.T) {
+		assertAPIStatus(t, svc.DeleteTask(ctx, tripID, task.ID, member), http.StatusForbidden)
+
+		require.NoError(t, svc.DeleteTask(ctx, tripID, task.ID, organiser))
+		assert.Empty(t, tasks.tasks)
+		deleted := publisher.topics(realtime.EventTopicTaskDeleted)
+		require.Len(t, deleted, 1)
+		assert.Equal(t, task.ID.String(), deleted[0].EntityID)
+	})
+}
+
+func TestTripTasksAPI(t *testing.T) {
+	app := fakes.GetSharedTestApp()
+
+	owner := createTestUser(t, app, "Owner", fakes.GenerateRandomUsername(), fakes.GenerateRandomPhoneNumber())
+	member := createTestUser(t, app, "Member", fakes.GenerateRandomUsername(), fakes.GenerateRandomPhoneNumber())
+	tripID := createTestTrip(t, app, owner, "Val d'Isère", 500, 1500)
+	addUserToTrip(t, app, owner, member, tripID)
+	tasksRoute := fmt.Sprintf("/api/v1/trips/%s/tasks", tripID)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  tasksRoute,
+			Method: testkit.POST,
+			UserID: &member,
+			Body:   models.CreateTripTaskRequest{Title: "Book the chalet"},
+		}).
+		AssertStatus(http.StatusForbidden)
+
+	taskID := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  tasksRoute,
+			Method: testkit.POST,
+			UserID: &owner,
+			Body: models.CreateTripTaskRequest{
+				Title:       "Book the chalet",
+				AssigneeIDs: []uuid.UUID{uuid.MustParse(member)},
+			},
+		}).
+		AssertStatus(http.StatusCreated).
+		AssertField("status", string(models.TripTaskTodo)).
+		GetBody()["id"].(string)
+
+	done := models.TripTaskDone
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("%s/%s", tasksRoute, taskID),
+			Method: testkit.PATCH,
+			UserID: &member,
+			Body:   models.UpdateTripTaskRequest{Status: &done},
+		}).
+		AssertStatus(http.StatusOK).
+		AssertField("status", string(models.TripTaskDone))
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/comments",
+			Method: testkit.POST,
+			UserID: &member,
+			Body: models.CreateCommentRequest{
+				TripID:     uuid.MustParse(tripID),
+				EntityType: models.TaskEntity,
+				EntityID:   uuid.MustParse(taskID),
+				Content:    "Booked!",
+			},
+		}).
+		AssertStatus(http.StatusCreated)
+
+	items := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/task/%s/comments", tripID, taskID),
+			Method: testkit.GET,
+			UserID: &owner,
+		}).
+		AssertStatus(http.StatusOK).
+		GetBody()["items"].([]interface{})
+	assert.Len(t, items, 1)
+
+	tasks := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("%s?assignee_id=%s", tasksRoute, member),
+			Method: testkit.GET,
+			UserID: &member,
+		}).
+		AssertStatus(http.StatusOK).
+		GetBody()["items"].([]interface{})
+	require.Len(t, tasks, 1)
+	assert.Equal(t, []interface{}{member}, tasks[0].(map[string]interface{})["assignee_ids"])
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("%s/%s", tasksRoute, taskID),
+			Method: testkit.DELETE,
+			UserID: &owner,
+		}).
+		AssertStatus(http.StatusNoContent)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("%s/%s", tasksRoute, taskID),
+			Method: testkit.GET,
+			UserID: &member,
+		}).
+		AssertStatus(http.StatusNotFound)
+}
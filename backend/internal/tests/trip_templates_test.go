@@ -0,0 +1,280 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+	testkit "toggo/internal/tests/testkit/builders"
+	"toggo/internal/tests/testkit/fakes"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTemplateRepo keeps templates in memory and snapshots every trip as data.
+type memoryTemplateRepo struct {
+	repository.TripTemplateRepository
+	templates map[uuid.UUID]*models.TripTemplate
+	data      models.TripTemplateData
+}
+
+func (r *memoryTemplateRepo) Create(_ context.Context, template *models.TripTemplate) (*models.TripTemplate, error) {
+	r.templates[template.ID] = template
+	return template, nil
+}
+
+func (r *memoryTemplateRepo) Find(_ context.Context, id uuid.UUID) (*models.TripTemplate, error) {
+	template, ok := r.templates[id]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	return template, nil
+}
+
+func (r *memoryTemplateRepo) FindUsable(_ context.Context, userID uuid.UUID) ([]*models.TripTemplate, error) {
+	var templates []*models.TripTemplate
+	for _, template := range r.templates {
+		if template.CanUse(userID) {
+			templates = append(templates, template)
+		}
+	}
+	return templates, nil
+}
+
+func (r *memoryTemplateRepo) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.templates, id)
+	return nil
+}
+
+func (r *memoryTemplateRepo) Snapshot(_ context.Context, _ *models.Trip) (*models.TripTemplateData, error) {
+	data := r.data
+	return &data, nil
+}
+
+func TestTripTemplateShiftedTo(t *testing.T) {
+	origStart := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	origEnd := time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC)
+	data := models.TripTemplateData{
+		StartDate: &origStart,
+		EndDate:   &origEnd,
+		Activities: []models.TripTemplateActivity{
+			{Name: "Ski school", Dates: &[]models.DateRange{{Start: "2025-01-11", End: "2025-01-13"}}},
+			{Name: "Fondue night"},
+		},
+	}
+
+	newStart := time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC)
+	start, end, activities := data.ShiftedTo(&newStart)
+	require.NotNil(t, start)
+	require.NotNil(t, end)
+	assert.Equal(t, newStart, *start)
+	assert.Equal(t, time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC), *end)
+	assert.Equal(t, []models.DateRange{{Start: "2026-01-10", End: "2026-01-12"}}, *activities[0].Dates)
+	assert.Nil(t, activities[1].Dates)
+	// The source data is left untouched.
+	assert.Equal(t, "2025-01-11", (*data.Activities[0].Dates)[0].Start)
+
+	// Without a new start date the copy is undated.
+	start, end, activities = data.ShiftedTo(nil)
+	assert.Nil(t, start)
+	assert.Nil(t, end)
+	assert.Nil(t, activities[0].Dates)
+}
+
+func TestTripTemplatePermissions(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	members := newRoleMembershipRepo(tripID)
+	members.archived = true
+	organiser := members.add(models.TripRoleOrganiser)
+	member := members.add(models.TripRoleMember)
+	outsider := uuid.New()
+
+	templates := &memoryTemplateRepo{
+		templates: map[uuid.UUID]*models.TripTemplate{},
+		data: models.TripTemplateData{
+			Categories: []models.TripTemplateCategory{{Name: "ski", Label: "Ski"}},
+			Activities: []models.TripTemplateActivity{{Name: "Ski school"}},
+		},
+	}
+	tripRepo := &softDeleteTripRepo{trip: models.Trip{ID: tripID, Name: "Ski trip"}}
+	svc := services.NewTripTemplateService(&repository.Repository{Membership: members, Trip: tripRepo, TripTemplate: templates}, nil, nil)
+
+	_, err := svc.DuplicateTrip(ctx, outsider, tripID, models.DuplicateTripRequest{})
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	_, err = svc.SaveTripAsTemplate(ctx, member, tripID, models.CreateTripTemplateRequest{Name: "Ski"})
+	assertAPIStatus(t, err, http.StatusForbidden)
+
+	// Archived trips can still be saved as templates.
+	private, err := svc.SaveTripAsTemplate(ctx, organiser, tripID, models.CreateTripTemplateRequest{Name: "Ski"})
+	require.NoError(t, err)
+	assert.Equal(t, &tripID, private.SourceTripID)
+	public, err := svc.SaveTripAsTemplate(ctx, organiser, tripID, models.CreateTripTemplateRequest{Name: "Ski", IsPublic: true})
+	require.NoError(t, err)
+
+	_, err = svc.GetTemplate(ctx, member, private.ID)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	_, err = svc.GetTemplate(ctx, member, public.ID)
+	require.NoError(t, err)
+
+	listed, err := svc.ListTemplates(ctx, member)
+	require.NoError(t, err)
+	require.Len(t, listed.Items, 1)
+	assert.Equal(t, public.ID, listed.Items[0].ID)
+	assert.Equal(t, 1, listed.Items[0].CategoryCount)
+	assert.Equal(t, 1, listed.Items[0].ActivityCount)
+
+	err = svc.DeleteTemplate(ctx, member, public.ID)
+	assertAPIStatus(t, err, http.StatusForbidden)
+	require.NoError(t, svc.DeleteTemplate(ctx, organiser, public.ID))
+	assert.NotContains(t, templates.templates, public.ID)
+}
+
+func TestTripDuplicateAndTemplates(t *testing.T) {
+	app := fakes.GetSharedTestApp()
+	owner := createUser(t, app)
+	other := createUser(t, app)
+
+	startDate := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC)
+	tripID := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/trips",
+			Method: testkit.POST,
+			UserID: &owner,
+			Body: models.CreateTripRequest{
+				Name:      "Ski-Trip-" + uuid.NewString(),
+				BudgetMin: 800,
+				BudgetMax: 1500,
+				Currency:  "EUR",
+				StartDate: &startDate,
+				EndDate:   &endDate,
+			},
+		}).
+		AssertStatus(http.StatusCreated).
+		GetBody()["id"].(string)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/categories", tripID),
+			Method: testkit.POST,
+			UserID: &owner,
+			Body: models.CreateCategoryRequest{
+				TripID: uuid.MustParse(tripID),
+				Name:   "apres",
+				Label:  "Après-ski",
+			},
+		}).
+		AssertStatus(http.StatusCreated)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/activities", tripID),
+			Method: testkit.POST,
+			UserID: &owner,
+			Body: models.CreateActivityRequest{
+				TripID:        uuid.MustParse(tripID),
+				Name:          "Ski school",
+				CategoryNames: []string{"apres"},
+				Dates:         &[]models.DateRange{{Start: "2025-01-11", End: "2025-01-13"}},
+			},
+		}).
+		AssertStatus(http.StatusCreated)
+
+	// Duplicating shifts the trip and its activities to the new start date.
+	newStart := time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC)
+	copyID := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/duplicate", tripID),
+			Method: testkit.POST,
+			UserID: &owner,
+			Body:   models.DuplicateTripRequest{StartDate: &newStart},
+		}).
+		AssertStatus(http.StatusCreated).
+		AssertField("budget_max", float64(1500)).
+		AssertField("currency", "EUR").
+		GetBody()["id"].(string)
+
+	items := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/activities", copyID),
+			Method: testkit.GET,
+			UserID: &owner,
+		}).
+		AssertStatus(http.StatusOK).
+		GetBody()["items"].([]any)
+	require.Len(t, items, 1)
+	activity := items[0].(map[string]any)
+	assert.Equal(t, "Ski school", activity["name"])
+	dates := activity["dates"].([]any)
+	assert.Equal(t, "2026-01-10", dates[0].(map[string]any)["start"])
+
+	tabs := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/categories", copyID),
+			Method: testkit.GET,
+			UserID: &owner,
+		}).
+		AssertStatus(http.StatusOK).
+		GetBody()["categories"].([]any)
+	var names []string
+	for _, tab := range tabs {
+		names = append(names, tab.(map[string]any)["name"].(string))
+	}
+	assert.Contains(t, names, "apres")
+
+	// Public templates can be used by anyone.
+	templateID := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/template", tripID),
+			Method: testkit.POST,
+			UserID: &owner,
+			Body:   models.CreateTripTemplateRequest{Name: "Annual ski trip", IsPublic: true},
+		}).
+		AssertStatus(http.StatusCreated).
+		GetBody()["id"].(string)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trip-templates/%s/trips", templateID),
+			Method: testkit.POST,
+			UserID: &other,
+			Body:   models.CreateTripFromTemplateRequest{StartDate: &newStart},
+		}).
+		AssertStatus(http.StatusCreated).
+		AssertField("name", "Annual ski trip")
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trip-templates/%s", templateID),
+			Method: testkit.DELETE,
+			UserID: &other,
+		}).
+		AssertStatus(http.StatusForbidden)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trip-templates/%s", templateID),
+			Method: testkit.DELETE,
+			UserID: &owner,
+		}).
+		AssertStatus(http.StatusNoContent)
+}
@@ -0,0 +1,337 @@
+package tests
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/realtime"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+	"toggo/internal/workflows/webhooks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+)
+
+// fakeWebhookRepo keeps webhooks and deliveries in memory.
+type fakeWebhookRepo struct {
+	repository.TripWebhookRepository
+	webhooks   map[uuid.UUID]*models.TripWebhook
+	deliveries map[string]*models.TripWebhookDelivery
+}
+
+func newFakeWebhookRepo(webhooks ...*models.TripWebhook) *fakeWebhookRepo {
+	repo := &fakeWebhookRepo{
+		webhooks:   make(map[uuid.UUID]*models.TripWebhook),
+		deliveries: make(map[string]*models.TripWebhookDelivery),
+	}
+	for _, w := range webhooks {
+		repo.webhooks[w.ID] = w
+	}
+	return repo
+}
+
+func (f *fakeWebhookRepo) Create(_ context.Context, webhook *models.TripWebhook) (*models.TripWebhook, error) {
+	f.webhooks[webhook.ID] = webhook
+	return webhook, nil
+}
+
+func (f *fakeWebhookRepo) Find(_ context.Context, id uuid.UUID) (*models.TripWebhook, error) {
+	if w, ok := f.webhooks[id]; ok {
+		return w, nil
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (f *fakeWebhookRepo) FindByTripID(_ context.Context, tripID uuid.UUID) ([]*models.TripWebhook, error) {
+	var found []*models.TripWebhook
+	for _, w := range f.webhooks {
+		if w.TripID == tripID {
+			found = append(found, w)
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeWebhookRepo) FindSubscribed(_ context.Context, tripID uuid.UUID, topic string) ([]*models.TripWebhook, error) {
+	var found []*models.TripWebhook
+	for _, w := range f.webhooks {
+		if w.TripID == tripID && w.Enabled && w.Subscribes(topic) {
+			found = append(found, w)
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeWebhookRepo) RecordAttempt(_ context.Context, attempt *models.TripWebhookDelivery) (*models.TripWebhookDelivery, error) {
+	key := attempt.WebhookID.String() + attempt.EventID
+	if existing, ok := f.deliveries[key]; ok {
+		attempt.Attempts = existing.Attempts + 1
+	} else {
+		attempt.Attempts = 1
+	}
+	f.deliveries[key] = attempt
+	return attempt, nil
+}
+
+func (f *fakeWebhookRepo) MarkFailed(_ context.Context, webhookID uuid.UUID, eventID string) error {
+	if d, ok := f.deliveries[webhookID.String()+eventID]; ok {
+		d.Status = models.TripWebhookDeliveryFailed
+	}
+	return nil
+}
+
+type adminMembershipRepo struct {
+	noopMembershipRepo
+	admins map[uuid.UUID]bool
+}
+
+func (a *adminMembershipRepo) IsAdmin(_ context.Context, _, userID uuid.UUID) (bool, error) {
+	return a.admins[userID], nil
+}
+
+func (a *adminMembershipRepo) FindRole(_ context.Context, _, userID uuid.UUID) (models.TripRole, error) {
+	if a.admins[userID] {
+		return models.TripRoleOrganiser, nil
+	}
+	return models.TripRoleMember, nil
+}
+
+func (a *adminMembershipRepo) FindAccess(ctx context.Context, tripID, userID uuid.UUID) (models.TripAccess, error) {
+	role, err := a.FindRole(ctx, tripID, userID)
+	return models.TripAccess{Role: role}, err
+}
+
+type recordingDispatcher struct {
+	webhookIDs []uuid.UUID
+}
+
+func (d *recordingDispatcher) DispatchWebhook(_ context.Context, webhookID uuid.UUID, _ *realtime.Event) error {
+	d.webhookIDs = append(d.webhookIDs, webhookID)
+	return nil
+}
+
+// webhookEndpoint records requests and answers with the given status.
+type webhookEndpoint struct {
+	status  int
+	headers http.Header
+	body    []byte
+}
+
+func (e *webhookEndpoint) start(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e.headers = r.Header.Clone()
+		e.body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(e.status)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTripWebhooks(t *testing.T) {
+	ctx := context.Background()
+	tripID, admin, member := uuid.New(), uuid.New(), uuid.New()
+	members := &adminMembershipRepo{admins: map[uuid.UUID]bool{admin: true}}
+
+	t.Run("only admins manage webhooks", func(t *testing.T) {
+		svc := services.NewTripWebhookService(newFakeWebhookRepo(), members, services.NewWebhookClient(http.DefaultClient))
+		_, err := svc.CreateWebhook(ctx, tripID, member, models.CreateTripWebhookRequest{
+			URL:    "https://example.com/hook",
+			Topics: []string{string(realtime.EventTopicPollCreated)},
+		})
+		var apiErr errs.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+	})
+
+	t.Run("archived trips refuse webhook changes", func(t *testing.T) {
+		archived := newRoleMembershipRepo(tripID)
+		archived.archived = true
+		organiser := archived.add(models.TripRoleOrganiser)
+
+		svc := services.NewTripWebhookService(newFakeWebhookRepo(), archived, services.NewWebhookClient(http.DefaultClient))
+		_, err := svc.CreateWebhook(ctx, tripID, organiser, models.CreateTripWebhookRequest{
+			URL:    "https://example.com/hook",
+			Topics: []string{string(realtime.EventTopicPollCreated)},
+		})
+		var apiErr errs.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+		assert.Equal(t, errs.ErrTripArchived.Error(), apiErr.Message)
+	})
+
+	t.Run("rejects unknown topics and non-http URLs", func(t *testing.T) {
+		svc := services.NewTripWebhookService(newFakeWebhookRepo(), members, services.NewWebhookClient(http.DefaultClient))
+		_, err := svc.CreateWebhook(ctx, tripID, admin, models.CreateTripWebhookRequest{
+			URL:    "https://example.com/hook",
+			Topics: []string{"poll.exploded"},
+		})
+		assert.Error(t, err)
+
+		_, err = svc.CreateWebhook(ctx, tripID, admin, models.CreateTripWebhookRequest{
+			URL:    "ftp://example.com/hook",
+			Topics: []string{string(realtime.EventTopicPollCreated)},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects local and private hosts", func(t *testing.T) {
+		svc := services.NewTripWebhookService(newFakeWebhookRepo(), members, services.NewWebhookClient(http.DefaultClient))
+		for _, url := range []string{"http://localhost:8080/hook", "http://127.0.0.1/hook", "http://10.0.0.5/hook", "http://169.254.169.254/latest", "http://[::1]/hook"} {
+			_, err := svc.CreateWebhook(ctx, tripID, admin, models.CreateTripWebhookRequest{
+				URL:    url,
+				Topics: []string{string(realtime.EventTopicPollCreated)},
+			})
+			var apiErr errs.APIError
+			require.ErrorAs(t, err, &apiErr, url)
+			assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode, url)
+		}
+	})
+
+	t.Run("delivery client refuses private addresses and redirects", func(t *testing.T) {
+		endpoint := &webhookEndpoint{status: http.StatusOK}
+		server := endpoint.start(t)
+		webhook := &models.TripWebhook{ID: uuid.New(), TripID: tripID, URL: server.URL, Secret: "secret", Enabled: true}
+		client := services.NewWebhookHTTPClient()
+
+		_, err := services.NewWebhookClient(client).Deliver(ctx, webhook, &realtime.Event{ID: uuid.NewString(), Topic: models.WebhookPingTopic})
+		require.Error(t, err)
+		assert.Nil(t, endpoint.body)
+		assert.ErrorIs(t, client.CheckRedirect(nil, nil), http.ErrUseLastResponse)
+	})
+
+	t.Run("creation returns a generated secret once", func(t *testing.T) {
+		svc := services.NewTripWebhookService(newFakeWebhookRepo(), members, services.NewWebhookClient(http.DefaultClient))
+		created, err := svc.CreateWebhook(ctx, tripID, admin, models.CreateTripWebhookRequest{
+			URL:    "https://example.com/hook",
+			Topics: []string{string(realtime.EventTopicPollCreated), string(realtime.EventTopicPollCreated)},
+		})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(created.Secret, "whsec_"))
+		assert.Equal(t, []string{string(realtime.EventTopicPollCreated)}, created.Topics)
+
+		listed, err := json.Marshal(created.TripWebhook)
+		require.NoError(t, err)
+		assert.NotContains(t, string(listed), created.Secret)
+	})
+
+	t.Run("ping sends a signed event and logs the delivery", func(t *testing.T) {
+		endpoint := &webhookEndpoint{status: http.StatusOK}
+		server := endpoint.start(t)
+		webhook := &models.TripWebhook{ID: uuid.New(), TripID: tripID, URL: server.URL, Secret: "shh-its-a-secret", Enabled: true}
+		repo := newFakeWebhookRepo(webhook)
+		svc := services.NewTripWebhookService(repo, members, services.NewWebhookClient(server.Client()))
+
+		delivery, err := svc.PingWebhook(ctx, tripID, webhook.ID, admin)
+		require.NoError(t, err)
+		assert.Equal(t, models.TripWebhookDeliverySucceeded, delivery.Status)
+		assert.Equal(t, http.StatusOK, *delivery.ResponseStatus)
+
+		mac := hmac.New(sha256.New, []byte("shh-its-a-secret"))
+		mac.Write(endpoint.body)
+		assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), endpoint.headers.Get(services.WebhookSignatureHeader))
+		assert.Equal(t, models.WebhookPingTopic, endpoint.headers.Get(services.WebhookEventHeader))
+
+		var event realtime.Event
+		require.NoError(t, json.Unmarshal(endpoint.body, &event))
+		assert.Equal(t, tripID.String(), event.TripID)
+		assert.Equal(t, event.ID, endpoint.headers.Get(services.WebhookDeliveryHeader))
+	})
+
+	t.Run("failed ping is logged as failed", func(t *testing.T) {
+		endpoint := &webhookEndpoint{status: http.StatusInternalServerError}
+		server := endpoint.start(t)
+		webhook := &models.TripWebhook{ID: uuid.New(), TripID: tripID, URL: server.URL, Secret: "secret", Enabled: true}
+		svc := services.NewTripWebhookService(newFakeWebhookRepo(webhook), members, services.NewWebhookClient(server.Client()))
+
+		delivery, err := svc.PingWebhook(ctx, tripID, webhook.ID, admin)
+		require.NoError(t, err)
+		assert.Equal(t, models.TripWebhookDeliveryFailed, delivery.Status)
+		require.NotNil(t, delivery.ErrorMessage)
+		assert.Contains(t, *delivery.ErrorMessage, "500")
+	})
+
+	t.Run("webhooks of another trip are not found", func(t *testing.T) {
+		webhook := &models.TripWebhook{ID: uuid.New(), TripID: uuid.New(), URL: "https://example.com", Enabled: true}
+		svc := services.NewTripWebhookService(newFakeWebhookRepo(webhook), members, services.NewWebhookClient(http.DefaultClient))
+
+		_, err := svc.PingWebhook(ctx, tripID, webhook.ID, admin)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	ctx := context.Background()
+	tripID := uuid.New()
+	event := realtime.Event{ID: uuid.New().String(), Topic: string(realtime.EventTopicPollCreated), TripID: tripID.String()}
+
+	t.Run("subscriber dispatches to subscribed webhooks of the trip", func(t *testing.T) {
+		subscribed := &models.TripWebhook{ID: uuid.New(), TripID: tripID, Topics: []string{event.Topic}, Enabled: true}
+		otherTopic := &models.TripWebhook{ID: uuid.New(), TripID: tripID, Topics: []string{string(realtime.EventTopicCommentCreated)}, Enabled: true}
+		disabled := &models.TripWebhook{ID: uuid.New(), TripID: tripID, Topics: []string{event.Topic}}
+		dispatcher := &recordingDispatcher{}
+
+		subscriber := realtime.NewWebhookSubscriber(newFakeWebhookRepo(subscribed, otherTopic, disabled), dispatcher, nil)
+		subscriber.HandleEvent(ctx, &event)
+
+		assert.Equal(t, []uuid.UUID{subscribed.ID}, dispatcher.webhookIDs)
+	})
+
+	t.Run("server errors are retried and attempts counted", func(t *testing.T) {
+		endpoint := &webhookEndpoint{status: http.StatusBadGateway}
+		server := endpoint.start(t)
+		webhook := &models.TripWebhook{ID: uuid.New(), TripID: tripID, URL: server.URL, Topics: []string{event.Topic}, Enabled: true}
+		repo := newFakeWebhookRepo(webhook)
+		activities := &webhooks.WebhookActivities{Webhooks: repo, Client: services.NewWebhookClient(server.Client())}
+		input := webhooks.WebhookDeliveryInput{WebhookID: webhook.ID, Event: event}
+
+		err := activities.DeliverWebhook(ctx, input)
+		require.Error(t, err)
+		assert.False(t, isNonRetryable(err))
+
+		endpoint.status = http.StatusNoContent
+		require.NoError(t, activities.DeliverWebhook(ctx, input))
+
+		delivery := repo.deliveries[webhook.ID.String()+event.ID]
+		assert.Equal(t, 2, delivery.Attempts)
+		assert.Equal(t, models.TripWebhookDeliverySucceeded, delivery.Status)
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		endpoint := &webhookEndpoint{status: http.StatusGone}
+		server := endpoint.start(t)
+		webhook := &models.TripWebhook{ID: uuid.New(), TripID: tripID, URL: server.URL, Topics: []string{event.Topic}, Enabled: true}
+		activities := &webhooks.WebhookActivities{Webhooks: newFakeWebhookRepo(webhook), Client: services.NewWebhookClient(server.Client())}
+
+		err := activities.DeliverWebhook(ctx, webhooks.WebhookDeliveryInput{WebhookID: webhook.ID, Event: event})
+		require.Error(t, err)
+		assert.True(t, isNonRetryable(err))
+	})
+
+	t.Run("deleted and disabled webhooks are skipped", func(t *testing.T) {
+		disabled := &models.TripWebhook{ID: uuid.New(), TripID: tripID, URL: "http://127.0.0.1:0", Topics: []string{event.Topic}}
+		repo := newFakeWebhookRepo(disabled)
+		activities := &webhooks.WebhookActivities{Webhooks: repo, Client: services.NewWebhookClient(http.DefaultClient)}
+
+		require.NoError(t, activities.DeliverWebhook(ctx, webhooks.WebhookDeliveryInput{WebhookID: disabled.ID, Event: event}))
+		require.NoError(t, activities.DeliverWebhook(ctx, webhooks.WebhookDeliveryInput{WebhookID: uuid.New(), Event: event}))
+		assert.Empty(t, repo.deliveries)
+	})
+}
+
+func isNonRetryable(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.NonRetryable()
+}
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"toggo/internal/models"
+	"toggo/internal/repository"
 	testkit "toggo/internal/tests/testkit/builders"
 	"toggo/internal/tests/testkit/fakes"
 )
@@ -213,6 +215,145 @@ func TestTripLifecycle(t *testing.T) {
 		AssertStatus(http.StatusNotFound)
 }
 
+func TestTripArchiveAndRestore(t *testing.T) {
+	app := fakes.GetSharedTestApp()
+	authUserID := fakes.GenerateUUID()
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/users",
+			Method: testkit.POST,
+			UserID: &authUserID,
+			Body: models.CreateUserRequest{
+				Name:        "Archive Tester",
+				Username:    fakes.GenerateRandomUsername(),
+				PhoneNumber: fakes.GenerateRandomPhoneNumber(),
+			},
+		}).
+		AssertStatus(http.StatusCreated)
+
+	tripID := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/trips",
+			Method: testkit.POST,
+			UserID: &authUserID,
+			Body: models.CreateTripRequest{
+				Name:      "Archive-Trip-" + uuid.NewString(),
+				BudgetMin: 100,
+				BudgetMax: 500,
+			},
+		}).
+		AssertStatus(http.StatusCreated).
+		GetBody()["id"].(string)
+
+	listed := func(query string) bool {
+		items := testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  "/api/v1/trips" + query,
+				Method: testkit.GET,
+				UserID: &authUserID,
+			}).
+			AssertStatus(http.StatusOK).
+			GetBody()["items"].([]any)
+		for _, item := range items {
+			if item.(map[string]any)["id"] == tripID {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Archived trips are read-only and only listed on request.
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/archive", tripID),
+			Method: testkit.POST,
+			UserID: &authUserID,
+		}).
+		AssertStatus(http.StatusOK).
+		AssertFieldExists("archived_at")
+
+	require.False(t, listed(""))
+	require.True(t, listed("?include_archived=true"))
+
+	name := "Renamed"
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s", tripID),
+			Method: testkit.PATCH,
+			UserID: &authUserID,
+			Body:   models.UpdateTripRequest{Name: &name},
+		}).
+		AssertStatus(http.StatusForbidden)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s/unarchive", tripID),
+			Method: testkit.POST,
+			UserID: &authUserID,
+		}).
+		AssertStatus(http.StatusOK)
+
+	require.True(t, listed(""))
+
+	// Deleted trips disappear until restored.
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s", tripID),
+			Method: testkit.DELETE,
+			UserID: &authUserID,
+		}).
+		AssertStatus(http.StatusNoContent)
+
+	require.False(t, listed("?include_archived=true"))
+
+	// Nothing reaches the members of a deleted trip, e.g. the inbox or notifications.
+	memberships := repository.NewMembershipRepository(fakes.GetSharedDB())
+	tripMembers, err := memberships.FindByTripID(context.Background(), uuid.MustParse(tripID))
+	require.NoError(t, err)
+	require.Empty(t, tripMembers)
+	userTrips, err := memberships.FindByUserID(context.Background(), uuid.MustParse(authUserID))
+	require.NoError(t, err)
+	require.Empty(t, userTrips)
+
+	deleted := testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/trips/deleted",
+			Method: testkit.GET,
+			UserID: &authUserID,
+		}).
+		AssertStatus(http.StatusOK).
+		GetBody()["items"].([]any)
+	require.Len(t, deleted, 1)
+	require.Equal(t, tripID, deleted[0].(map[string]any)["id"])
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/deleted/%s/restore", tripID),
+			Method: testkit.POST,
+			UserID: &authUserID,
+		}).
+		AssertStatus(http.StatusOK)
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  fmt.Sprintf("/api/v1/trips/%s", tripID),
+			Method: testkit.GET,
+			UserID: &authUserID,
+		}).
+		AssertStatus(http.StatusOK)
+}
+
 func TestTripPagination(t *testing.T) {
 	app := fakes.GetSharedTestApp()
 	authUserID := fakes.GenerateUUID()
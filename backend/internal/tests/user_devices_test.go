@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+	"toggo/internal/models"
+	testkit "toggo/internal/tests/testkit/builders"
+	"toggo/internal/tests/testkit/fakes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserDeviceEndpoints(t *testing.T) {
+	app := fakes.GetSharedTestApp()
+	authUserID := fakes.GenerateUUID()
+
+	testkit.New(t).
+		Request(testkit.Request{
+			App:    app,
+			Route:  "/api/v1/users",
+			Method: testkit.POST,
+			UserID: &authUserID,
+			Body: models.CreateUserRequest{
+				Name:        "Device User",
+				Username:    fakes.GenerateRandomUsername(),
+				PhoneNumber: fakes.GenerateRandomPhoneNumber(),
+			},
+		}).
+		AssertStatus(http.StatusCreated)
+
+	phone := "ExponentPushToken[phone-" + authUserID + "]"
+	tablet := "ExponentPushToken[tablet-" + authUserID + "]"
+	ios := models.DevicePlatformIOS
+	version := "1.4.0"
+
+	listDevices := func(t *testing.T) []interface{} {
+		return testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  "/api/v1/users/me/devices",
+				Method: testkit.GET,
+				UserID: &authUserID,
+			}).
+			AssertStatus(http.StatusOK).
+			GetBodyAsArray()
+	}
+
+	t.Run("registers multiple devices", func(t *testing.T) {
+		for _, token := range []string{phone, tablet} {
+			testkit.New(t).
+				Request(testkit.Request{
+					App:    app,
+					Route:  "/api/v1/users/me/devices",
+					Method: testkit.POST,
+					UserID: &authUserID,
+					Body: models.RegisterDeviceRequest{
+						Token:      token,
+						Platform:   &ios,
+						AppVersion: &version,
+					},
+				}).
+				AssertStatus(http.StatusOK).
+				AssertField("token", token).
+				AssertField("platform", "ios")
+		}
+
+		assert.Len(t, listDevices(t), 2)
+	})
+
+	t.Run("re-registering a token does not duplicate it", func(t *testing.T) {
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  "/api/v1/users/me/devices",
+				Method: testkit.POST,
+				UserID: &authUserID,
+				Body:   models.RegisterDeviceRequest{Token: phone},
+			}).
+			AssertStatus(http.StatusOK)
+
+		assert.Len(t, listDevices(t), 2)
+	})
+
+	t.Run("rejects unknown platform", func(t *testing.T) {
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  "/api/v1/users/me/devices",
+				Method: testkit.POST,
+				UserID: &authUserID,
+				Body: map[string]any{
+					"token":    "ExponentPushToken[other]",
+					"platform": "blackberry",
+				},
+			}).
+			AssertStatus(http.StatusUnprocessableEntity)
+	})
+
+	t.Run("unregisters a device", func(t *testing.T) {
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  "/api/v1/users/me/devices",
+				Method: testkit.DELETE,
+				UserID: &authUserID,
+				Body:   models.UnregisterDeviceRequest{Token: tablet},
+			}).
+			AssertStatus(http.StatusNoContent)
+
+		assert.Len(t, listDevices(t), 1)
+	})
+	t.Run("a token registered by another account is revoked from its previous owner", func(t *testing.T) {
+		otherUserID := fakes.GenerateUUID()
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  "/api/v1/users",
+				Method: testkit.POST,
+				UserID: &otherUserID,
+				Body: models.CreateUserRequest{
+					Name:        "Next Device User",
+					Username:    fakes.GenerateRandomUsername(),
+					PhoneNumber: fakes.GenerateRandomPhoneNumber(),
+				},
+			}).
+			AssertStatus(http.StatusCreated)
+
+		testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  "/api/v1/users/me/devices",
+				Method: testkit.POST,
+				UserID: &otherUserID,
+				Body:   models.RegisterDeviceRequest{Token: phone},
+			}).
+			AssertStatus(http.StatusOK).
+			AssertField("token", phone)
+
+		assert.Empty(t, listDevices(t))
+	})
+}
@@ -1,13 +1,21 @@
 package tests
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
+	"toggo/internal/errs"
 	"toggo/internal/models"
+	"toggo/internal/repository"
 	testkit "toggo/internal/tests/testkit/builders"
 	"toggo/internal/tests/testkit/fakes"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUserLifecycle(t *testing.T) {
@@ -374,3 +382,57 @@ func TestDeviceTokenUpdate(t *testing.T) {
 			AssertStatus(http.StatusNotFound)
 	})
 }
+
+func TestUserEmailVerification(t *testing.T) {
+	ctx := context.Background()
+	app := fakes.GetSharedTestApp()
+	users := repository.NewUserRepository(fakes.GetSharedDB())
+	first := createUser(t, app)
+	second := createUser(t, app)
+	email := fmt.Sprintf("Sam.%s@Example.com", uuid.NewString())
+
+	setEmail := func(t *testing.T, userID, email string) *testkit.IntegrationTestBuilder {
+		return testkit.New(t).
+			Request(testkit.Request{
+				App:    app,
+				Route:  fmt.Sprintf("/api/v1/users/%s", userID),
+				Method: testkit.PATCH,
+				UserID: &userID,
+				Body:   models.UpdateUserRequest{Email: &email},
+			})
+	}
+
+	t.Run("an address belongs to one account regardless of case", func(t *testing.T) {
+		setEmail(t, first, email).AssertStatus(http.StatusOK)
+		setEmail(t, second, strings.ToLower(email)).AssertStatus(http.StatusConflict)
+	})
+
+	t.Run("lookups only match verified addresses", func(t *testing.T) {
+		_, err := users.FindByEmail(ctx, email)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+
+		now := time.Now()
+		require.NoError(t, users.SetEmailVerificationCode(ctx, uuid.MustParse(first), "code-hash", now.Add(time.Minute)))
+		_, err = users.VerifyEmail(ctx, uuid.MustParse(first), "other-hash", now)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		verified, err := users.VerifyEmail(ctx, uuid.MustParse(first), "code-hash", now)
+		require.NoError(t, err)
+		assert.NotNil(t, verified.EmailVerifiedAt)
+
+		found, err := users.FindByEmail(ctx, strings.ToUpper(email))
+		require.NoError(t, err)
+		assert.Equal(t, first, found.ID.String())
+	})
+
+	t.Run("changing the address clears its verification", func(t *testing.T) {
+		setEmail(t, first, strings.ToLower(email)).AssertStatus(http.StatusOK)
+		user, err := users.Find(ctx, uuid.MustParse(first))
+		require.NoError(t, err)
+		assert.NotNil(t, user.EmailVerifiedAt)
+
+		setEmail(t, first, "new."+email).AssertStatus(http.StatusOK)
+		user, err = users.Find(ctx, uuid.MustParse(first))
+		require.NoError(t, err)
+		assert.Nil(t, user.EmailVerifiedAt)
+	})
+}
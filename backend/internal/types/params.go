@@ -22,8 +22,15 @@ type ServiceParams struct {
 	EventPublisher      realtime.EventPublisher
 	FileService         services.FileServiceInterface
 	NotificationService services.NotificationService
+	PreferenceResolver  services.NotificationPreferenceResolver
+	EmailSender         services.EmailSender
 	PollService         services.PollServiceInterface
+	PitchScheduler      services.PitchDeadlineScheduler
+	ReminderScheduler   services.TripReminderScheduler
+	PurgeScheduler      services.TripPurgeScheduler
+	TaskScheduler       services.TaskReminderScheduler
 	ActivityFeedService services.ActivityFeedServiceInterface
+	SlackTrips          services.SlackTripServiceInterface
 	HTTPClient          *http.Client
 	TemporalClient      client.Client
 }
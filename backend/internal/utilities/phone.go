@@ -1,6 +1,8 @@
 package utilities // nolint:revive // utilities is an appropriate package name for utility functions
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/nyaruka/phonenumbers"
@@ -20,3 +22,10 @@ func NormalizeUSPhone(phone string) (string, error) {
 
 	return phonenumbers.Format(parsed, phonenumbers.E164), nil
 }
+
+// HashPhoneNumber returns the lowercase hex SHA-256 of an E.164 phone number, the form
+// clients send address book entries in for contact discovery.
+func HashPhoneNumber(e164 string) string {
+	sum := sha256.Sum256([]byte(e164))
+	return hex.EncodeToString(sum[:])
+}
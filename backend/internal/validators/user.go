@@ -2,7 +2,9 @@ package validators
 
 import (
 	"regexp"
+	"slices"
 	"time"
+	"toggo/internal/models"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -24,5 +26,9 @@ func registerUserValidator(v *validator.Validate) *validator.Validate {
 		return err == nil
 	})
 
+	registerValidation(v, "locale", func(fl validator.FieldLevel) bool {
+		return slices.Contains(models.SupportedLocales, fl.Field().String())
+	})
+
 	return v
 }
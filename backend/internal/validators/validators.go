@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"toggo/internal/errs"
+	"toggo/internal/models"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -96,6 +97,8 @@ func buildMessage(e validator.FieldError) string {
 		return fmt.Sprintf("%s must be less than or equal to %s", e.Field(), e.Param())
 	case "iso4217":
 		return fmt.Sprintf("%s must be a valid ISO 4217 currency code", e.Field())
+	case "locale":
+		return fmt.Sprintf("%s must be one of: %s", e.Field(), strings.Join(models.SupportedLocales, ", "))
 	case "image_size":
 		sizes := make([]string, len(allowedImageSizes))
 		for i, s := range allowedImageSizes {
@@ -7,7 +7,9 @@ import (
 	"log"
 	"time"
 	"toggo/internal/models"
+	"toggo/internal/repository"
 	"toggo/internal/services"
+	"toggo/internal/templates"
 
 	"github.com/google/uuid"
 )
@@ -24,18 +26,38 @@ type TokenFetcher interface {
 	GetUsersWithDeviceTokens(ctx context.Context, userIDs []uuid.UUID) ([]*models.User, error)
 }
 
+type RecipientResolver interface {
+	ResolveRecipients(ctx context.Context, audience models.NotificationAudience) ([]uuid.UUID, error)
+}
+
 type NotificationSender interface {
 	SendNotification(ctx context.Context, req models.SendNotificationRequest) error
 }
 
-const pollDeadlineReminderTitle = "Don't forget to vote!"
+type DigestStore interface {
+	FindDueByUserID(ctx context.Context, userID uuid.UUID, now time.Time) ([]*models.NotificationDigestItem, error)
+	MarkDelivered(ctx context.Context, ids []uuid.UUID) error
+}
+
+// DecisionAnnouncer posts a poll's outcome once voting has closed.
+type DecisionAnnouncer interface {
+	PostDecision(ctx context.Context, pollID uuid.UUID) error
+}
 
 type NotificationActivities struct {
 	PollRepo           PollMetaFinder
 	PollRankingRepo    VoterStatusProvider
 	PollVotingRepo     VoterStatusProvider
 	UserRepo           TokenFetcher
+	Preferences        RecipientResolver
 	NotificationSender NotificationSender
+	Digests            DigestStore
+	TripRepo           PitchDeadlineTripStore
+	PitchRepo          PitcherFinder
+	Members            TripMemberLister
+	Itinerary          ItineraryFinder
+	Decisions          DecisionAnnouncer
+	Tasks              TaskFinder
 }
 
 // DispatchNotification is the single activity entry point for all scheduled
@@ -52,6 +74,33 @@ func (a *NotificationActivities) DispatchNotification(
 			return fmt.Errorf("failed to decode poll deadline reminder payload: %w", err)
 		}
 		return a.handlePollDeadlineReminder(ctx, payload)
+	case JobTypePollDeadlineClose:
+		var payload PollDeadlineReminderPayload
+		if err := json.Unmarshal(input.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to decode poll deadline close payload: %w", err)
+		}
+		return a.handlePollDeadlineClose(ctx, payload)
+	case JobTypeNotificationDigest:
+		var payload NotificationDigestPayload
+		if err := json.Unmarshal(input.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to decode notification digest payload: %w", err)
+		}
+		return a.handleNotificationDigest(ctx, payload)
+	case JobTypePitchDeadlineReminder, JobTypePitchDeadlineClose:
+		var payload PitchDeadlinePayload
+		if err := json.Unmarshal(input.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to decode pitch deadline payload: %w", err)
+		}
+		if input.JobType == JobTypePitchDeadlineReminder {
+			return a.handlePitchDeadlineReminder(ctx, payload)
+		}
+		return a.handlePitchDeadlineClose(ctx, payload)
+	case JobTypeTaskDueReminder:
+		var payload TaskDueReminderPayload
+		if err := json.Unmarshal(input.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to decode task due reminder payload: %w", err)
+		}
+		return a.handleTaskDueReminder(ctx, payload)
 	default:
 		return fmt.Errorf("unknown job type: %s", input.JobType)
 	}
@@ -75,7 +124,20 @@ func (a *NotificationActivities) handlePollDeadlineReminder(
 		return nil
 	}
 
-	users, err := a.UserRepo.GetUsersWithDeviceTokens(ctx, unvotedIDs)
+	recipientIDs, err := a.Preferences.ResolveRecipients(ctx, models.NotificationAudience{
+		TripID:   payload.TripID,
+		UserIDs:  unvotedIDs,
+		Category: models.NotificationCategoryVotingReminders,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resolve notification recipients: %w", err)
+	}
+	if len(recipientIDs) == 0 {
+		log.Printf("poll_deadline_reminder: no members opted in to voting reminders for poll %s, skipping", payload.PollID)
+		return nil
+	}
+
+	users, err := a.UserRepo.GetUsersWithDeviceTokens(ctx, recipientIDs)
 	if err != nil {
 		return fmt.Errorf("failed to get users with device tokens: %w", err)
 	}
@@ -140,10 +202,16 @@ func (a *NotificationActivities) sendReminders(ctx context.Context, poll *models
 	}
 	sent := 0
 	for _, u := range users {
-		body := formatDeadlineBody(poll.Question, *poll.Deadline, u.Timezone)
+		title, body := templates.Notification(u.Locale, templates.Message{
+			Key: templates.NotificationPollDeadlineReminder,
+			Data: map[string]any{
+				"Question": poll.Question,
+				"Deadline": formatLocalTime(*poll.Deadline, u.Timezone, u.Locale),
+			},
+		})
 		req := models.SendNotificationRequest{
 			UserID: u.ID,
-			Title:  pollDeadlineReminderTitle,
+			Title:  title,
 			Body:   body,
 			Data:   data,
 		}
@@ -156,13 +224,19 @@ func (a *NotificationActivities) sendReminders(ctx context.Context, poll *models
 	return sent
 }
 
-func formatDeadlineBody(question string, deadline time.Time, timezone string) string {
-	loc, err := time.LoadLocation(timezone)
-	if err != nil {
-		loc = time.UTC
-	}
-	formatted := deadline.In(loc).Format("Jan 2 at 3:04 PM MST")
-	return fmt.Sprintf("The poll \"%s\" closes on %s.", question, formatted)
+// formatLocalTime renders t in the user's timezone, falling back to UTC, using the
+// locale's date layout.
+func formatLocalTime(t time.Time, timezone, locale string) string {
+	return t.In(loadLocation(timezone)).Format(templates.Text(locale, templates.DateTimeLayoutKey, nil))
 }
 
-var _ NotificationSender = (services.NotificationService)(nil)
+var (
+	_ NotificationSender     = (services.NotificationService)(nil)
+	_ RecipientResolver      = (services.NotificationPreferenceResolver)(nil)
+	_ DigestStore            = (repository.NotificationDigestRepository)(nil)
+	_ PitchDeadlineTripStore = (repository.TripRepository)(nil)
+	_ PitcherFinder          = (repository.PitchRepository)(nil)
+	_ TripMemberLister       = (repository.MembershipRepository)(nil)
+	_ ItineraryFinder        = (repository.ActivityRepository)(nil)
+	_ TaskFinder             = (repository.TripTaskRepository)(nil)
+)
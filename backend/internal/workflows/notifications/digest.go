@@ -0,0 +1,101 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+	"toggo/internal/models"
+	"toggo/internal/templates"
+
+	"github.com/google/uuid"
+)
+
+// digestKindOrder lists the kinds named in a digest summary, each with a
+// "digest.part.<kind>" catalogue message. Other kinds are counted as "other updates".
+var digestKindOrder = []models.NotificationPreference{
+	models.NotificationPreferenceNewComment,
+	models.NotificationPreferenceNewPoll,
+	models.NotificationPreferenceNewPitch,
+}
+
+// handleNotificationDigest sends every due item for the user as one push and marks
+// them delivered. Items that are not yet due stay queued for their own run, and a
+// failed send leaves everything queued so the activity's retry sends it again.
+func (a *NotificationActivities) handleNotificationDigest(ctx context.Context, payload NotificationDigestPayload) error {
+	items, err := a.Digests.FindDueByUserID(ctx, payload.UserID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to load digest items: %w", err)
+	}
+	if len(items) == 0 {
+		log.Printf("notification_digest: nothing due for user %s, skipping", payload.UserID)
+		return nil
+	}
+
+	title, body := SummarizeDigest(a.userLocale(ctx, payload.UserID), items)
+	if err := a.NotificationSender.SendNotification(ctx, models.SendNotificationRequest{
+		UserID: payload.UserID,
+		Title:  title,
+		Body:   body,
+		Data:   map[string]interface{}{"type": JobTypeNotificationDigest},
+	}); err != nil {
+		return fmt.Errorf("failed to send digest to user %s: %w", payload.UserID, err)
+	}
+
+	ids := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	if err := a.Digests.MarkDelivered(ctx, ids); err != nil {
+		return fmt.Errorf("failed to mark digest items delivered: %w", err)
+	}
+
+	log.Printf("notification_digest: delivered %d items to user %s", len(items), payload.UserID)
+	return nil
+}
+
+// SummarizeDigest renders held-back notifications as one push in locale. A single item
+// is sent as-is; several become e.g. "3 new comments and 1 new poll across 2 trips".
+func SummarizeDigest(locale string, items []*models.NotificationDigestItem) (string, string) {
+	if len(items) == 1 {
+		return items[0].Title, items[0].Body
+	}
+
+	counts := make(map[models.NotificationPreference]int)
+	trips := make(map[uuid.UUID]bool)
+	for _, item := range items {
+		counts[item.Kind]++
+		trips[item.TripID] = true
+	}
+
+	var parts []string
+	other := len(items)
+	for _, kind := range digestKindOrder {
+		count := counts[kind]
+		if count == 0 {
+			continue
+		}
+		parts = append(parts, templates.Text(locale, "digest.part."+string(kind), map[string]any{"Count": count}))
+		other -= count
+	}
+	if other > 0 {
+		parts = append(parts, templates.Text(locale, "digest.part.other", map[string]any{"Count": other}))
+	}
+
+	return templates.Notification(locale, templates.Message{
+		Key:  templates.NotificationDigest,
+		Data: map[string]any{"Parts": parts, "TripCount": len(trips)},
+	})
+}
+
+// userLocale returns the user's locale, or the default when it cannot be loaded.
+func (a *NotificationActivities) userLocale(ctx context.Context, userID uuid.UUID) string {
+	if a.UserRepo == nil {
+		return models.DefaultLocale
+	}
+	users, err := a.UserRepo.GetUsersWithDeviceTokens(ctx, []uuid.UUID{userID})
+	if err != nil || len(users) == 0 {
+		return models.DefaultLocale
+	}
+	return users[0].Locale
+}
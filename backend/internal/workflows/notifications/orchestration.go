@@ -6,7 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
+	"toggo/internal/models"
+	"toggo/internal/services"
 
 	"github.com/google/uuid"
 	"go.temporal.io/api/enums/v1"
@@ -14,6 +17,15 @@ import (
 	"go.temporal.io/sdk/client"
 )
 
+var (
+	_ services.DigestScheduler        = (*DigestScheduler)(nil)
+	_ services.PitchDeadlineScheduler = (*PitchDeadlineScheduler)(nil)
+	_ services.TaskReminderScheduler  = (*TaskReminderScheduler)(nil)
+	_ services.TripReminderScheduler  = (*TripReminderScheduler)(nil)
+)
+
+// PollScheduler schedules a reminder 24 hours before a poll's deadline and the job that
+// announces the decision at the deadline. Rescheduling replaces both.
 type PollScheduler struct {
 	client client.Client
 }
@@ -32,40 +44,232 @@ func (s *PollScheduler) ScheduleDeadlineReminder(ctx context.Context, pollID, tr
 		return fmt.Errorf("failed to marshal poll deadline reminder payload: %w", err)
 	}
 
+	jobs := []struct {
+		jobType   string
+		triggerAt time.Time
+	}{
+		{JobTypePollDeadlineReminder, deadline.Add(-24 * time.Hour)},
+		{JobTypePollDeadlineClose, deadline},
+	}
+	for _, job := range jobs {
+		input := ScheduledNotificationInput{
+			TriggerAt: job.triggerAt,
+			JobType:   job.jobType,
+			Payload:   payload,
+		}
+		workflowOptions := client.StartWorkflowOptions{
+			ID:                       pollDeadlineWorkflowID(job.jobType, pollID),
+			TaskQueue:                ScheduledNotificationTaskQueueName,
+			WorkflowIDConflictPolicy: *enums.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING.Enum(),
+		}
+		we, err := s.client.ExecuteWorkflow(ctx, workflowOptions, ScheduledNotificationWorkflow, input)
+		if err != nil {
+			return fmt.Errorf("failed to schedule %s for poll %s: %w", job.jobType, pollID, err)
+		}
+		log.Printf("poll_scheduler: scheduled %s for poll %s (workflowID=%s, runID=%s)", job.jobType, pollID, we.GetID(), we.GetRunID())
+	}
+	return nil
+}
+
+func (s *PollScheduler) CancelDeadlineReminder(ctx context.Context, pollID uuid.UUID) error {
+	for _, jobType := range []string{JobTypePollDeadlineReminder, JobTypePollDeadlineClose} {
+		err := s.client.CancelWorkflow(ctx, pollDeadlineWorkflowID(jobType, pollID), "")
+		if err != nil && !isNotFound(err) {
+			return fmt.Errorf("failed to cancel %s for poll %s: %w", jobType, pollID, err)
+		}
+	}
+	return nil
+}
+
+// pollDeadlineWorkflowID keeps the reminder's original ID so workflows scheduled before
+// the close job existed are still replaced and cancelled.
+func pollDeadlineWorkflowID(jobType string, pollID uuid.UUID) string {
+	return strings.ReplaceAll(jobType, "_", "-") + "-" + pollID.String()
+}
+
+// PitchDeadlineScheduler schedules a reminder 24 hours before a trip's pitch deadline
+// and the job that closes pitching at the deadline. Rescheduling replaces both.
+type PitchDeadlineScheduler struct {
+	client client.Client
+}
+
+func NewPitchDeadlineScheduler(c client.Client) *PitchDeadlineScheduler {
+	return &PitchDeadlineScheduler{client: c}
+}
+
+func (s *PitchDeadlineScheduler) SchedulePitchDeadline(ctx context.Context, tripID uuid.UUID, deadline time.Time) error {
+	payload, err := json.Marshal(PitchDeadlinePayload{
+		TripID:   tripID,
+		Deadline: deadline,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal pitch deadline payload: %w", err)
+	}
+
+	jobs := []struct {
+		jobType   string
+		triggerAt time.Time
+	}{
+		{JobTypePitchDeadlineReminder, deadline.Add(-24 * time.Hour)},
+		{JobTypePitchDeadlineClose, deadline},
+	}
+	for _, job := range jobs {
+		input := ScheduledNotificationInput{
+			TriggerAt: job.triggerAt,
+			JobType:   job.jobType,
+			Payload:   payload,
+		}
+		workflowOptions := client.StartWorkflowOptions{
+			ID:                       pitchDeadlineWorkflowID(job.jobType, tripID),
+			TaskQueue:                ScheduledNotificationTaskQueueName,
+			WorkflowIDConflictPolicy: *enums.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING.Enum(),
+		}
+		if _, err := s.client.ExecuteWorkflow(ctx, workflowOptions, ScheduledNotificationWorkflow, input); err != nil {
+			return fmt.Errorf("failed to schedule %s for trip %s: %w", job.jobType, tripID, err)
+		}
+	}
+
+	log.Printf("pitch_scheduler: scheduled pitch deadline jobs for trip %s at %s", tripID, deadline.Format(time.RFC3339))
+	return nil
+}
+
+func pitchDeadlineWorkflowID(jobType string, tripID uuid.UUID) string {
+	return strings.ReplaceAll(jobType, "_", "-") + "-" + tripID.String()
+}
+
+// TaskReminderScheduler reminds a task's assignees 24 hours before it is due, or
+// straight away when less time is left. Rescheduling replaces the reminder.
+type TaskReminderScheduler struct {
+	client client.Client
+}
+
+func NewTaskReminderScheduler(c client.Client) *TaskReminderScheduler {
+	return &TaskReminderScheduler{client: c}
+}
+
+func (s *TaskReminderScheduler) ScheduleTaskReminder(ctx context.Context, taskID, tripID uuid.UUID, dueAt time.Time) error {
+	payload, err := json.Marshal(TaskDueReminderPayload{
+		TaskID: taskID,
+		TripID: tripID,
+		DueAt:  dueAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal task due reminder payload: %w", err)
+	}
+
 	input := ScheduledNotificationInput{
-		TriggerAt: deadline.Add(-24 * time.Hour),
-		JobType:   JobTypePollDeadlineReminder,
+		TriggerAt: dueAt.Add(-models.TripTaskReminderLead),
+		JobType:   JobTypeTaskDueReminder,
 		Payload:   payload,
 	}
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                       taskReminderWorkflowID(taskID),
+		TaskQueue:                ScheduledNotificationTaskQueueName,
+		WorkflowIDConflictPolicy: *enums.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING.Enum(),
+	}
+	if _, err := s.client.ExecuteWorkflow(ctx, workflowOptions, ScheduledNotificationWorkflow, input); err != nil {
+		return fmt.Errorf("failed to schedule %s for task %s: %w", JobTypeTaskDueReminder, taskID, err)
+	}
+
+	log.Printf("task_scheduler: scheduled due reminder for task %s due at %s", taskID, dueAt.Format(time.RFC3339))
+	return nil
+}
+
+func (s *TaskReminderScheduler) CancelTaskReminder(ctx context.Context, taskID uuid.UUID) error {
+	err := s.client.CancelWorkflow(ctx, taskReminderWorkflowID(taskID), "")
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed to cancel %s for task %s: %w", JobTypeTaskDueReminder, taskID, err)
+	}
+	return nil
+}
+
+func taskReminderWorkflowID(taskID uuid.UUID) string {
+	return strings.ReplaceAll(JobTypeTaskDueReminder, "_", "-") + "-" + taskID.String()
+}
+
+// TripReminderScheduler runs one TripRemindersWorkflow per trip. Rescheduling replaces the
+// running workflow so changed dates take effect immediately.
+type TripReminderScheduler struct {
+	client client.Client
+}
+
+func NewTripReminderScheduler(c client.Client) *TripReminderScheduler {
+	return &TripReminderScheduler{client: c}
+}
+
+func (s *TripReminderScheduler) ScheduleTripReminders(ctx context.Context, tripID uuid.UUID, startDate, endDate time.Time) error {
+	input := TripRemindersInput{
+		TripID:    tripID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
 
 	workflowOptions := client.StartWorkflowOptions{
-		ID:                       pollDeadlineReminderWorkflowID(pollID),
+		ID:                       tripRemindersWorkflowID(tripID),
 		TaskQueue:                ScheduledNotificationTaskQueueName,
 		WorkflowIDConflictPolicy: *enums.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING.Enum(),
 	}
 
-	we, err := s.client.ExecuteWorkflow(ctx, workflowOptions, ScheduledNotificationWorkflow, input)
-	if err != nil {
-		return fmt.Errorf("failed to schedule deadline reminder for poll %s: %w", pollID, err)
+	if _, err := s.client.ExecuteWorkflow(ctx, workflowOptions, TripRemindersWorkflow, input); err != nil {
+		return fmt.Errorf("failed to schedule trip reminders for trip %s: %w", tripID, err)
 	}
 
-	log.Printf("poll_scheduler: scheduled deadline reminder for poll %s (workflowID=%s, runID=%s)", pollID, we.GetID(), we.GetRunID())
+	log.Printf("trip_reminder_scheduler: scheduled reminders for trip %s (%s to %s)", tripID, startDate.Format(dateLayout), endDate.Format(dateLayout))
 	return nil
 }
 
-func (s *PollScheduler) CancelDeadlineReminder(ctx context.Context, pollID uuid.UUID) error {
-	err := s.client.CancelWorkflow(ctx, pollDeadlineReminderWorkflowID(pollID), "")
+func (s *TripReminderScheduler) CancelTripReminders(ctx context.Context, tripID uuid.UUID) error {
+	err := s.client.CancelWorkflow(ctx, tripRemindersWorkflowID(tripID), "")
 	if err != nil {
 		if isNotFound(err) {
 			return nil
 		}
-		return fmt.Errorf("failed to cancel deadline reminder for poll %s: %w", pollID, err)
+		return fmt.Errorf("failed to cancel trip reminders for trip %s: %w", tripID, err)
+	}
+	return nil
+}
+
+func tripRemindersWorkflowID(tripID uuid.UUID) string {
+	return "trip-reminders-" + tripID.String()
+}
+
+// DigestScheduler starts one digest run per user and delivery time. Items held back for
+// the same time share a run; a run that already finished is started again so late items
+// are not stranded.
+type DigestScheduler struct {
+	client client.Client
+}
+
+func NewDigestScheduler(c client.Client) *DigestScheduler {
+	return &DigestScheduler{client: c}
+}
+
+func (s *DigestScheduler) ScheduleDigest(ctx context.Context, userID uuid.UUID, deliverAt time.Time) error {
+	payload, err := json.Marshal(NotificationDigestPayload{UserID: userID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification digest payload: %w", err)
+	}
+
+	input := ScheduledNotificationInput{
+		TriggerAt: deliverAt,
+		JobType:   JobTypeNotificationDigest,
+		Payload:   payload,
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                       notificationDigestWorkflowID(userID, deliverAt),
+		TaskQueue:                ScheduledNotificationTaskQueueName,
+		WorkflowIDConflictPolicy: *enums.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING.Enum(),
+	}
+
+	if _, err := s.client.ExecuteWorkflow(ctx, workflowOptions, ScheduledNotificationWorkflow, input); err != nil {
+		return fmt.Errorf("failed to schedule notification digest for user %s: %w", userID, err)
 	}
 	return nil
 }
 
-func pollDeadlineReminderWorkflowID(pollID uuid.UUID) string {
-	return "poll-deadline-reminder-" + pollID.String()
+func notificationDigestWorkflowID(userID uuid.UUID, deliverAt time.Time) string {
+	return fmt.Sprintf("notification-digest-%s-%d", userID, deliverAt.Unix())
 }
 
 func isNotFound(err error) bool {
@@ -0,0 +1,165 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+	"toggo/internal/models"
+	"toggo/internal/templates"
+
+	"github.com/google/uuid"
+)
+
+type PitchDeadlineTripStore interface {
+	Find(ctx context.Context, id uuid.UUID) (*models.Trip, error)
+	ClosePitching(ctx context.Context, tripID uuid.UUID, closedAt time.Time) (bool, error)
+}
+
+type PitcherFinder interface {
+	FindPitcherIDs(ctx context.Context, tripID uuid.UUID) ([]uuid.UUID, error)
+}
+
+type TripMemberLister interface {
+	FindByTripID(ctx context.Context, tripID uuid.UUID) ([]*models.MembershipDatabaseResponse, error)
+}
+
+// handlePitchDeadlineReminder nudges members who have not pitched yet.
+func (a *NotificationActivities) handlePitchDeadlineReminder(ctx context.Context, payload PitchDeadlinePayload) error {
+	trip, ok := a.findTripForPitchDeadline(ctx, payload, JobTypePitchDeadlineReminder)
+	if !ok {
+		return nil
+	}
+
+	members, err := a.Members.FindByTripID(ctx, payload.TripID)
+	if err != nil {
+		return fmt.Errorf("failed to get trip members: %w", err)
+	}
+	pitcherIDs, err := a.PitchRepo.FindPitcherIDs(ctx, payload.TripID)
+	if err != nil {
+		return fmt.Errorf("failed to get pitchers: %w", err)
+	}
+	pitched := make(map[uuid.UUID]bool, len(pitcherIDs))
+	for _, id := range pitcherIDs {
+		pitched[id] = true
+	}
+	var pendingIDs []uuid.UUID
+	for _, member := range members {
+		if !pitched[member.UserID] {
+			pendingIDs = append(pendingIDs, member.UserID)
+		}
+	}
+	if len(pendingIDs) == 0 {
+		log.Printf("pitch_deadline_reminder: every member of trip %s has pitched, skipping", payload.TripID)
+		return nil
+	}
+
+	users, err := a.resolveUsers(ctx, models.NotificationAudience{
+		TripID:   payload.TripID,
+		UserIDs:  pendingIDs,
+		Category: models.NotificationCategoryDeadlineReminders,
+	})
+	if err != nil {
+		return err
+	}
+
+	sent := a.sendToUsers(ctx, users, func(u *models.User) templates.Message {
+		return templates.Message{
+			Key: templates.NotificationPitchDeadlineReminder,
+			Data: map[string]any{
+				"TripName": trip.Name,
+				"Deadline": formatLocalTime(*trip.PitchDeadline, u.Timezone, u.Locale),
+			},
+		}
+	}, map[string]interface{}{"trip_id": payload.TripID.String()})
+	log.Printf("pitch_deadline_reminder: sent reminders to %d/%d users for trip %s", sent, len(users), payload.TripID)
+	return nil
+}
+
+// handlePitchDeadlineClose stops new pitches, opens voting on the trip's rank poll and
+// tells members they can vote.
+func (a *NotificationActivities) handlePitchDeadlineClose(ctx context.Context, payload PitchDeadlinePayload) error {
+	trip, ok := a.findTripForPitchDeadline(ctx, payload, JobTypePitchDeadlineClose)
+	if !ok {
+		return nil
+	}
+
+	closed, err := a.TripRepo.ClosePitching(ctx, trip.ID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to close pitching: %w", err)
+	}
+	if !closed || trip.RankPollID == nil {
+		return nil
+	}
+
+	users, err := a.resolveUsers(ctx, models.NotificationAudience{
+		TripID:   trip.ID,
+		Category: models.NotificationCategoryVotingReminders,
+	})
+	if err != nil {
+		return err
+	}
+
+	msg := templates.Message{Key: templates.NotificationVotingOpen, Data: map[string]any{"TripName": trip.Name}}
+	sent := a.sendToUsers(ctx, users, func(*models.User) templates.Message { return msg }, map[string]interface{}{
+		"trip_id": trip.ID.String(),
+		"poll_id": trip.RankPollID.String(),
+	})
+	log.Printf("pitch_deadline_close: closed pitching for trip %s and notified %d/%d users", trip.ID, sent, len(users))
+	return nil
+}
+
+// findTripForPitchDeadline loads the trip and skips runs whose deadline was changed or
+// removed after scheduling, or whose pitching is already closed.
+func (a *NotificationActivities) findTripForPitchDeadline(ctx context.Context, payload PitchDeadlinePayload, jobType string) (*models.Trip, bool) {
+	trip, err := a.TripRepo.Find(ctx, payload.TripID)
+	if err != nil {
+		log.Printf("%s: trip %s not found, skipping", jobType, payload.TripID)
+		return nil, false
+	}
+	if trip.PitchDeadline == nil || !trip.PitchDeadline.Truncate(time.Second).Equal(payload.Deadline.Truncate(time.Second)) {
+		log.Printf("%s: pitch deadline for trip %s changed, skipping", jobType, payload.TripID)
+		return nil, false
+	}
+	if trip.PitchingClosedAt != nil {
+		log.Printf("%s: pitching already closed for trip %s, skipping", jobType, payload.TripID)
+		return nil, false
+	}
+	return trip, true
+}
+
+func (a *NotificationActivities) resolveUsers(ctx context.Context, audience models.NotificationAudience) ([]*models.User, error) {
+	recipientIDs, err := a.Preferences.ResolveRecipients(ctx, audience)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve notification recipients: %w", err)
+	}
+	if len(recipientIDs) == 0 {
+		return nil, nil
+	}
+
+	users, err := a.UserRepo.GetUsersWithDeviceTokens(ctx, recipientIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users with device tokens: %w", err)
+	}
+	return users, nil
+}
+
+// sendToUsers renders each user's message in their locale and sends it.
+func (a *NotificationActivities) sendToUsers(ctx context.Context, users []*models.User, message func(*models.User) templates.Message, data map[string]interface{}) int {
+	sent := 0
+	for _, u := range users {
+		title, body := templates.Notification(u.Locale, message(u))
+		req := models.SendNotificationRequest{
+			UserID: u.ID,
+			Title:  title,
+			Body:   body,
+			Data:   data,
+		}
+		if err := a.NotificationSender.SendNotification(ctx, req); err != nil {
+			log.Printf("failed to notify user %s: %v", u.ID, err)
+			continue
+		}
+		sent++
+	}
+	return sent
+}
@@ -0,0 +1,31 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// handlePollDeadlineClose announces the poll's outcome. Runs made stale by a later
+// deadline change, or for deleted polls, are skipped.
+func (a *NotificationActivities) handlePollDeadlineClose(ctx context.Context, payload PollDeadlineReminderPayload) error {
+	if a.Decisions == nil {
+		return nil
+	}
+
+	poll, err := a.PollRepo.FindPollMetaByID(ctx, payload.PollID)
+	if err != nil {
+		log.Printf("poll_deadline_close: poll %s not found, skipping", payload.PollID)
+		return nil
+	}
+	if poll.Deadline == nil || !poll.Deadline.Truncate(time.Second).Equal(payload.Deadline.Truncate(time.Second)) {
+		log.Printf("poll_deadline_close: deadline for poll %s changed, skipping", payload.PollID)
+		return nil
+	}
+
+	if err := a.Decisions.PostDecision(ctx, poll.ID); err != nil {
+		return fmt.Errorf("failed to announce decision for poll %s: %w", poll.ID, err)
+	}
+	return nil
+}
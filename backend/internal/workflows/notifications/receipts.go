@@ -0,0 +1,170 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Expo recommends waiting about 15 minutes before fetching receipts. Receipts that are
+// still missing are retried a few times before the delivery is left pending.
+const (
+	ExpoReceiptCheckDelay = 15 * time.Minute
+	maxReceiptChecks      = 4
+)
+
+type DeliveryTracker interface {
+	FindByTicketIDs(ctx context.Context, ticketIDs []string) ([]*models.NotificationDelivery, error)
+	UpdateStatus(ctx context.Context, ticketID string, status models.NotificationDeliveryStatus, errorCode, errorMessage *string) error
+}
+
+type TokenPruner interface {
+	DeleteByTokens(ctx context.Context, tokens []string) (int, error)
+}
+
+type ReceiptActivities struct {
+	ExpoClient services.ExpoClient
+	Deliveries DeliveryTracker
+	Devices    TokenPruner
+}
+
+// ExpoReceiptWorkflow waits for Expo to process the tickets, then records each receipt.
+func ExpoReceiptWorkflow(ctx workflow.Context, input ExpoReceiptCheckInput) error {
+	logger := workflow.GetLogger(ctx)
+
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	})
+
+	activities := &ReceiptActivities{}
+	pending := input.TicketIDs
+	for attempt := 0; attempt < maxReceiptChecks && len(pending) > 0; attempt++ {
+		if err := workflow.Sleep(ctx, ExpoReceiptCheckDelay); err != nil {
+			return err
+		}
+
+		var stillPending []string
+		if err := workflow.ExecuteActivity(activityCtx, activities.CheckExpoReceipts, pending).Get(activityCtx, &stillPending); err != nil {
+			logger.Error("CheckExpoReceipts failed", "tickets", len(pending), "error", err)
+			return err
+		}
+		pending = stillPending
+	}
+
+	if len(pending) > 0 {
+		logger.Warn("Expo receipts never became available", "tickets", len(pending))
+	}
+	return nil
+}
+
+// CheckExpoReceipts fetches receipts for the tickets, records delivered or failed status,
+// removes tokens Expo reports as unregistered, and returns tickets without a receipt yet.
+func (a *ReceiptActivities) CheckExpoReceipts(ctx context.Context, ticketIDs []string) ([]string, error) {
+	deliveries, err := a.Deliveries.FindByTicketIDs(ctx, ticketIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load deliveries: %w", err)
+	}
+	tokenByTicket := make(map[string]string, len(deliveries))
+	for _, delivery := range deliveries {
+		if delivery.TicketID != nil {
+			tokenByTicket[*delivery.TicketID] = delivery.Token
+		}
+	}
+
+	var pending, deadTokens []string
+	for start := 0; start < len(ticketIDs); start += services.ExpoMaxReceiptIDs {
+		end := min(start+services.ExpoMaxReceiptIDs, len(ticketIDs))
+		chunk := ticketIDs[start:end]
+
+		receipts, err := a.ExpoClient.GetReceipts(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ticketID := range chunk {
+			receipt, ok := receipts.Data[ticketID]
+			if !ok {
+				pending = append(pending, ticketID)
+				continue
+			}
+
+			status, errorCode, errorMessage := receiptOutcome(receipt)
+			if err := a.Deliveries.UpdateStatus(ctx, ticketID, status, errorCode, errorMessage); err != nil {
+				return nil, fmt.Errorf("failed to record receipt for ticket %s: %w", ticketID, err)
+			}
+			if receipt.ErrorCode() == services.ExpoDeviceNotRegistered {
+				if token, ok := tokenByTicket[ticketID]; ok {
+					deadTokens = append(deadTokens, token)
+				}
+			}
+		}
+	}
+
+	if len(deadTokens) > 0 {
+		deleted, err := a.Devices.DeleteByTokens(ctx, deadTokens)
+		if err != nil {
+			return nil, fmt.Errorf("failed to disable dead tokens: %w", err)
+		}
+		log.Printf("expo_receipts: disabled %d unregistered device tokens", deleted)
+	}
+
+	return pending, nil
+}
+
+func receiptOutcome(receipt services.ExpoNotificationResponse) (models.NotificationDeliveryStatus, *string, *string) {
+	if receipt.Status == "ok" {
+		return models.NotificationDeliveryDelivered, nil, nil
+	}
+
+	var errorCode, errorMessage *string
+	if code := receipt.ErrorCode(); code != "" {
+		errorCode = &code
+	}
+	if receipt.Message != "" {
+		message := receipt.Message
+		errorMessage = &message
+	}
+	return models.NotificationDeliveryFailed, errorCode, errorMessage
+}
+
+// ExpoReceiptScheduler starts ExpoReceiptWorkflow runs for newly issued push tickets.
+type ExpoReceiptScheduler struct {
+	client client.Client
+}
+
+func NewExpoReceiptScheduler(c client.Client) *ExpoReceiptScheduler {
+	return &ExpoReceiptScheduler{client: c}
+}
+
+func (s *ExpoReceiptScheduler) ScheduleReceiptCheck(ctx context.Context, ticketIDs []string) error {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        "expo-receipts-" + uuid.NewString(),
+		TaskQueue: ScheduledNotificationTaskQueueName,
+	}
+
+	if _, err := s.client.ExecuteWorkflow(ctx, workflowOptions, ExpoReceiptWorkflow, ExpoReceiptCheckInput{TicketIDs: ticketIDs}); err != nil {
+		return fmt.Errorf("failed to schedule receipt check for %d tickets: %w", len(ticketIDs), err)
+	}
+	return nil
+}
+
+var (
+	_ services.ReceiptScheduler = (*ExpoReceiptScheduler)(nil)
+	_ DeliveryTracker           = (repository.NotificationDeliveryRepository)(nil)
+	_ TokenPruner               = (repository.UserDeviceRepository)(nil)
+)
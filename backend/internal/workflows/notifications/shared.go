@@ -8,7 +8,14 @@ import (
 
 const ScheduledNotificationTaskQueueName = "SCHEDULED_NOTIFICATION_TASK_QUEUE"
 
-const JobTypePollDeadlineReminder = "poll_deadline_reminder"
+const (
+	JobTypePollDeadlineReminder  = "poll_deadline_reminder"
+	JobTypePollDeadlineClose     = "poll_deadline_close"
+	JobTypeNotificationDigest    = "notification_digest"
+	JobTypePitchDeadlineReminder = "pitch_deadline_reminder"
+	JobTypePitchDeadlineClose    = "pitch_deadline_close"
+	JobTypeTaskDueReminder       = "task_due_reminder"
+)
 
 type ScheduledNotificationInput struct {
 	TriggerAt time.Time
@@ -21,3 +28,48 @@ type PollDeadlineReminderPayload struct {
 	TripID   uuid.UUID
 	Deadline time.Time
 }
+
+// PitchDeadlinePayload carries the deadline the job was scheduled for so runs made stale
+// by a later deadline change can be skipped.
+type PitchDeadlinePayload struct {
+	TripID   uuid.UUID
+	Deadline time.Time
+}
+
+// TaskDueReminderPayload carries the due date the reminder was scheduled for so runs made
+// stale by a later change can be skipped.
+type TaskDueReminderPayload struct {
+	TaskID uuid.UUID
+	TripID uuid.UUID
+	DueAt  time.Time
+}
+
+type NotificationDigestPayload struct {
+	UserID uuid.UUID
+}
+
+// TripRemindersInput carries the trip dates the reminders were scheduled for so runs made
+// stale by a later date change can be skipped.
+type TripRemindersInput struct {
+	TripID    uuid.UUID
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// TripReminderPlanInput asks for the delivery times in [From, To).
+type TripReminderPlanInput struct {
+	TripRemindersInput
+	From time.Time
+	To   time.Time
+}
+
+// TripReminderSendInput delivers the reminders due at At.
+type TripReminderSendInput struct {
+	TripRemindersInput
+	At time.Time
+}
+
+// ExpoReceiptCheckInput lists Expo push ticket IDs whose receipts should be fetched.
+type ExpoReceiptCheckInput struct {
+	TicketIDs []string
+}
@@ -0,0 +1,74 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/templates"
+
+	"github.com/google/uuid"
+)
+
+type TaskFinder interface {
+	Find(ctx context.Context, id uuid.UUID) (*models.TripTask, error)
+}
+
+// handleTaskDueReminder reminds a task's assignees that it is due soon. Tasks that were
+// deleted, finished or given another due date since the reminder was scheduled are skipped.
+func (a *NotificationActivities) handleTaskDueReminder(ctx context.Context, payload TaskDueReminderPayload) error {
+	task, err := a.Tasks.Find(ctx, payload.TaskID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			log.Printf("task_due_reminder: task %s not found, skipping", payload.TaskID)
+			return nil
+		}
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+	if task.DueAt == nil || !task.DueAt.Truncate(time.Second).Equal(payload.DueAt.Truncate(time.Second)) {
+		log.Printf("task_due_reminder: due date for task %s changed, skipping", payload.TaskID)
+		return nil
+	}
+	if task.Status == models.TripTaskDone {
+		log.Printf("task_due_reminder: task %s is done, skipping", payload.TaskID)
+		return nil
+	}
+	if len(task.AssigneeIDs) == 0 {
+		log.Printf("task_due_reminder: task %s has no assignees, skipping", payload.TaskID)
+		return nil
+	}
+
+	trip, err := a.TripRepo.Find(ctx, task.TripID)
+	if err != nil {
+		log.Printf("task_due_reminder: trip %s not found, skipping", task.TripID)
+		return nil
+	}
+
+	users, err := a.resolveUsers(ctx, models.NotificationAudience{
+		TripID:   task.TripID,
+		UserIDs:  task.AssigneeIDs,
+		Category: models.NotificationCategoryDeadlineReminders,
+	})
+	if err != nil {
+		return err
+	}
+
+	sent := a.sendToUsers(ctx, users, func(u *models.User) templates.Message {
+		return templates.Message{
+			Key: templates.NotificationTaskDueReminder,
+			Data: map[string]any{
+				"TaskTitle": task.Title,
+				"TripName":  trip.Name,
+				"DueAt":     formatLocalTime(*task.DueAt, u.Timezone, u.Locale),
+			},
+		}
+	}, map[string]interface{}{
+		"trip_id": task.TripID.String(),
+		"task_id": task.ID.String(),
+	})
+	log.Printf("task_due_reminder: sent reminders to %d/%d assignees of task %s", sent, len(users), task.ID)
+	return nil
+}
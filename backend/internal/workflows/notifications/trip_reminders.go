@@ -0,0 +1,283 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+	"toggo/internal/models"
+	"toggo/internal/templates"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type ItineraryFinder interface {
+	FindByTripIDOnDate(ctx context.Context, tripID uuid.UUID, date string) ([]*models.Activity, error)
+}
+
+const (
+	dateLayout = "2006-01-02"
+
+	// tripReminderHour is the local hour members receive countdowns and briefings.
+	tripReminderHour = 8
+	// tripReminderPlanWindow is how far ahead each run plans deliveries before
+	// continuing as new, keeping workflow history small over long trips.
+	tripReminderPlanWindow = 24 * time.Hour
+	// Timezones range from UTC-12 to UTC+14, so a local 8am on a given day falls between
+	// 18:00 UTC the day before and 20:00 UTC that day.
+	earliestUTCOffset = 14 * time.Hour
+	latestUTCOffset   = 12 * time.Hour
+
+	maxItineraryItems = 3
+)
+
+// tripCountdownDays are the days before the trip starts that members get a countdown.
+var tripCountdownDays = []int{7, 1}
+
+// TripRemindersWorkflow sends a trip's countdown, day-of and daily itinerary notifications
+// at 8am in each member's timezone. Each run plans one window of deliveries from the
+// members' current timezones, then continues as new until the trip is over.
+func TripRemindersWorkflow(ctx workflow.Context, input TripRemindersInput) error {
+	logger := workflow.GetLogger(ctx)
+
+	first, last := tripReminderWindow(input.StartDate, input.EndDate)
+	if delay := first.Sub(workflow.Now(ctx)); delay > 0 {
+		if err := workflow.Sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+	now := workflow.Now(ctx)
+	if !now.Before(last) {
+		return nil
+	}
+
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    1 * time.Minute,
+			MaximumAttempts:    3,
+		},
+	})
+
+	activities := &NotificationActivities{}
+	windowEnd := now.Add(tripReminderPlanWindow)
+	var slots []time.Time
+	planInput := TripReminderPlanInput{TripRemindersInput: input, From: now, To: windowEnd}
+	if err := workflow.ExecuteActivity(activityCtx, activities.PlanTripReminders, planInput).Get(activityCtx, &slots); err != nil {
+		logger.Error("PlanTripReminders failed", "tripID", input.TripID, "error", err)
+		return err
+	}
+
+	for _, slot := range slots {
+		if delay := slot.Sub(workflow.Now(ctx)); delay > 0 {
+			if err := workflow.Sleep(ctx, delay); err != nil {
+				return err
+			}
+		}
+		sendInput := TripReminderSendInput{TripRemindersInput: input, At: slot}
+		if err := workflow.ExecuteActivity(activityCtx, activities.SendTripReminders, sendInput).Get(activityCtx, nil); err != nil {
+			// A failed delivery should not cancel the rest of the trip's reminders.
+			logger.Error("SendTripReminders failed", "tripID", input.TripID, "at", slot, "error", err)
+		}
+	}
+
+	if delay := windowEnd.Sub(workflow.Now(ctx)); delay > 0 {
+		if err := workflow.Sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+	return workflow.NewContinueAsNewError(ctx, TripRemindersWorkflow, input)
+}
+
+// PlanTripReminders returns the instants in [From, To) at which some member of the trip
+// reaches 8am local time on a countdown or trip day.
+func (a *NotificationActivities) PlanTripReminders(ctx context.Context, input TripReminderPlanInput) ([]time.Time, error) {
+	users, err := a.resolveUsers(ctx, models.NotificationAudience{
+		TripID:   input.TripID,
+		Category: models.NotificationCategoryUpcomingTrip,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	days := tripReminderDays(input.StartDate, input.EndDate)
+	seen := make(map[time.Time]bool)
+	var slots []time.Time
+	for _, u := range users {
+		loc := loadLocation(u.Timezone)
+		for _, day := range days {
+			d, err := time.Parse(dateLayout, day)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trip reminder day %q: %w", day, err)
+			}
+			slot := time.Date(d.Year(), d.Month(), d.Day(), tripReminderHour, 0, 0, 0, loc).UTC()
+			if slot.Before(input.From) || !slot.Before(input.To) || seen[slot] {
+				continue
+			}
+			seen[slot] = true
+			slots = append(slots, slot)
+		}
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i].Before(slots[j]) })
+	return slots, nil
+}
+
+// SendTripReminders notifies members for whom At is 8am local time, choosing the
+// countdown, day-of or itinerary message by their local date.
+func (a *NotificationActivities) SendTripReminders(ctx context.Context, input TripReminderSendInput) error {
+	trip, err := a.TripRepo.Find(ctx, input.TripID)
+	if err != nil {
+		log.Printf("trip_reminders: trip %s not found, skipping", input.TripID)
+		return nil
+	}
+	if !tripDatesMatch(trip, input.TripRemindersInput) {
+		log.Printf("trip_reminders: dates for trip %s changed, skipping", input.TripID)
+		return nil
+	}
+
+	users, err := a.resolveUsers(ctx, models.NotificationAudience{
+		TripID:   input.TripID,
+		Category: models.NotificationCategoryUpcomingTrip,
+	})
+	if err != nil {
+		return err
+	}
+
+	byDate := make(map[string][]*models.User)
+	for _, u := range users {
+		local := input.At.In(loadLocation(u.Timezone))
+		if local.Hour() != tripReminderHour {
+			continue
+		}
+		date := local.Format(dateLayout)
+		byDate[date] = append(byDate[date], u)
+	}
+
+	startDate := input.StartDate.UTC().Format(dateLayout)
+	sent, total := 0, 0
+	for date, recipients := range byDate {
+		var itinerary []*models.Activity
+		if date >= startDate {
+			itinerary, err = a.Itinerary.FindByTripIDOnDate(ctx, trip.ID, date)
+			if err != nil {
+				return fmt.Errorf("failed to get itinerary for %s: %w", date, err)
+			}
+		}
+		key, ok := tripReminderKey(input.TripRemindersInput, date, len(itinerary) > 0)
+		if !ok {
+			continue
+		}
+		total += len(recipients)
+		sent += a.sendToUsers(ctx, recipients, func(u *models.User) templates.Message {
+			return templates.Message{Key: key, Data: map[string]any{
+				"TripName":  trip.Name,
+				"Itinerary": summarizeItinerary(u.Locale, itinerary),
+			}}
+		}, map[string]interface{}{
+			"trip_id": trip.ID.String(),
+			"date":    date,
+		})
+	}
+	if total > 0 {
+		log.Printf("trip_reminders: sent %d/%d reminders for trip %s", sent, total, input.TripID)
+	}
+	return nil
+}
+
+// tripReminderKey returns the catalogue message for a member whose local date is date,
+// or false when there is nothing to send that day.
+func tripReminderKey(dates TripRemindersInput, date string, hasItinerary bool) (string, bool) {
+	start := dates.StartDate.UTC()
+	startDate := start.Format(dateLayout)
+	endDate := dates.EndDate.UTC().Format(dateLayout)
+
+	switch {
+	case date == start.AddDate(0, 0, -7).Format(dateLayout):
+		return templates.NotificationTripCountdownWeek, true
+	case date == start.AddDate(0, 0, -1).Format(dateLayout):
+		return templates.NotificationTripCountdownTomorrow, true
+	case date == startDate:
+		return templates.NotificationTripStartsToday, true
+	case date > startDate && date <= endDate && hasItinerary:
+		return templates.NotificationTripDailyBriefing, true
+	default:
+		return "", false
+	}
+}
+
+// summarizeItinerary lists the day's first few activities in locale, e.g.
+// "On the plan: Surf lesson (morning), Museum and 2 more." It is empty without activities.
+func summarizeItinerary(locale string, itinerary []*models.Activity) string {
+	if len(itinerary) == 0 {
+		return ""
+	}
+	items := make([]string, 0, maxItineraryItems+1)
+	for i, activity := range itinerary {
+		if i == maxItineraryItems {
+			items = append(items, templates.Text(locale, "itinerary.more", map[string]any{"Count": len(itinerary) - maxItineraryItems}))
+			break
+		}
+		timeOfDay := ""
+		if activity.TimeOfDay != nil {
+			timeOfDay = string(*activity.TimeOfDay)
+		}
+		items = append(items, templates.Text(locale, "itinerary.item", map[string]any{
+			"Name":      activity.Name,
+			"TimeOfDay": timeOfDay,
+		}))
+	}
+	return templates.Text(locale, "itinerary.summary", map[string]any{"Items": items})
+}
+
+// tripReminderDays returns the YYYY-MM-DD dates that get a notification: the countdown
+// days followed by every day of the trip.
+func tripReminderDays(startDate, endDate time.Time) []string {
+	start := startDate.UTC()
+	days := make([]string, 0, len(tripCountdownDays))
+	for _, before := range tripCountdownDays {
+		days = append(days, start.AddDate(0, 0, -before).Format(dateLayout))
+	}
+	last := endDate.UTC().Format(dateLayout)
+	for d := start; d.Format(dateLayout) <= last; d = d.AddDate(0, 0, 1) {
+		days = append(days, d.Format(dateLayout))
+	}
+	return days
+}
+
+// tripReminderWindow bounds when any member anywhere can be due a reminder.
+func tripReminderWindow(startDate, endDate time.Time) (time.Time, time.Time) {
+	firstDay := truncateToDate(startDate).AddDate(0, 0, -tripCountdownDays[0])
+	lastDay := truncateToDate(endDate)
+	first := firstDay.Add(tripReminderHour*time.Hour - earliestUTCOffset)
+	last := lastDay.Add(tripReminderHour*time.Hour + latestUTCOffset + time.Hour)
+	return first, last
+}
+
+func tripDatesMatch(trip *models.Trip, dates TripRemindersInput) bool {
+	if trip.StartDate == nil || !truncateToDate(*trip.StartDate).Equal(truncateToDate(dates.StartDate)) {
+		return false
+	}
+	endDate := *trip.StartDate
+	if trip.EndDate != nil {
+		endDate = *trip.EndDate
+	}
+	return truncateToDate(endDate).Equal(truncateToDate(dates.EndDate))
+}
+
+func truncateToDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func loadLocation(timezone string) *time.Location {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
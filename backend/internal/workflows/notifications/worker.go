@@ -9,19 +9,45 @@ import (
 	"go.temporal.io/sdk/worker"
 )
 
-func StartNotificationWorker(c client.Client, repo repository.Repository, expoClient services.ExpoClient) worker.Worker {
+func StartNotificationWorker(c client.Client, repo repository.Repository, expoClient services.ExpoClient, channels []services.NotificationChannel, decisions DecisionAnnouncer) worker.Worker {
 	w := worker.New(c, ScheduledNotificationTaskQueueName, worker.Options{})
 
 	w.RegisterWorkflow(ScheduledNotificationWorkflow)
+	w.RegisterWorkflow(ExpoReceiptWorkflow)
+	w.RegisterWorkflow(TripRemindersWorkflow)
 
-	notificationService := services.NewNotificationService(repo.User, repo.Membership, expoClient)
+	preferences := services.NewNotificationPreferenceResolver(repo.Membership, repo.NotificationPreferences)
+	notificationService := services.NewNotificationService(services.NotificationServiceConfig{
+		DeviceRepo:       repo.UserDevice,
+		DeliveryRepo:     repo.NotificationDelivery,
+		Preferences:      preferences,
+		ExpoClient:       expoClient,
+		ReceiptScheduler: NewExpoReceiptScheduler(c),
+		UserRepo:         repo.User,
+		PreferencesRepo:  repo.NotificationPreferences,
+		Channels:         channels,
+	})
 
 	w.RegisterActivity(&NotificationActivities{
 		PollRepo:           repo.Poll,
 		PollRankingRepo:    repo.PollRanking,
 		PollVotingRepo:     repo.PollVoting,
 		UserRepo:           repo.User,
+		Preferences:        preferences,
 		NotificationSender: notificationService,
+		Digests:            repo.NotificationDigest,
+		TripRepo:           repo.Trip,
+		PitchRepo:          repo.Pitch,
+		Members:            repo.Membership,
+		Itinerary:          repo.Activity,
+		Decisions:          decisions,
+		Tasks:              repo.TripTask,
+	})
+
+	w.RegisterActivity(&ReceiptActivities{
+		ExpoClient: expoClient,
+		Deliveries: repo.NotificationDelivery,
+		Devices:    repo.UserDevice,
 	})
 
 	log.Println("Notification worker registered on task queue:", ScheduledNotificationTaskQueueName)
@@ -8,6 +8,8 @@ import (
 	"toggo/internal/services"
 	"toggo/internal/workflows/example"
 	"toggo/internal/workflows/notifications"
+	"toggo/internal/workflows/trips"
+	"toggo/internal/workflows/webhooks"
 
 	"go.temporal.io/sdk/worker"
 )
@@ -66,9 +68,26 @@ func StartAllWorkersWithContext(ctx context.Context, repo *repository.Repository
 	manager.StartWorker(userWorker)
 
 	expoClient := services.NewExpoClient("")
-	notificationWorker := notifications.StartNotificationWorker(c, *repo, expoClient)
+	channels := services.NewNotificationChannels(config.NotificationChannels, config.Environment)
+	slackTrips := services.NewSlackTripService(services.SlackTripServiceConfig{
+		ChannelRepo:     repo.TripSlackChannel,
+		MembershipRepo:  repo.Membership,
+		TripRepo:        repo.Trip,
+		UserRepo:        repo.User,
+		PollRepo:        repo.Poll,
+		PollVotingRepo:  repo.PollVoting,
+		PollRankingRepo: repo.PollRanking,
+		Notifier:        services.NewSlackTripNotifier(config.Slack),
+	})
+	notificationWorker := notifications.StartNotificationWorker(c, *repo, expoClient, channels, slackTrips)
 	manager.StartWorker(notificationWorker)
 
+	webhookWorker := webhooks.StartWebhookWorker(c, *repo, services.NewWebhookClient(services.NewWebhookHTTPClient()))
+	manager.StartWorker(webhookWorker)
+
+	purgeWorker := trips.StartTripPurgeWorker(c, *repo, config.AWS.S3Client, config.AWS.BucketName)
+	manager.StartWorker(purgeWorker)
+
 	<-ctx.Done()
 	manager.StopAllWorkers()
 
@@ -0,0 +1,59 @@
+package trips
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+	"toggo/internal/errs"
+	"toggo/internal/interfaces"
+	"toggo/internal/repository"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+type TripPurgeActivities struct {
+	Trips      repository.TripRepository
+	S3Client   interfaces.S3Client
+	BucketName string
+}
+
+// PurgeTrip permanently removes a deleted trip once its restore window has passed.
+// Trips restored since the purge was scheduled are skipped. S3 objects are deleted
+// before the database rows so a failed run can be retried without losing track of them.
+func (a *TripPurgeActivities) PurgeTrip(ctx context.Context, input TripPurgeInput) error {
+	trip, err := a.Trips.FindDeleted(ctx, input.TripID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			log.Printf("trip_purge: trip %s is no longer deleted, skipping", input.TripID)
+			return nil
+		}
+		return fmt.Errorf("failed to load trip %s: %w", input.TripID, err)
+	}
+	if purgeAt := trip.PurgeAt(); purgeAt.After(time.Now().UTC()) {
+		log.Printf("trip_purge: trip %s is restorable until %s, skipping", input.TripID, purgeAt)
+		return nil
+	}
+
+	keys, err := a.Trips.FindMediaKeys(ctx, input.TripID)
+	if err != nil {
+		return fmt.Errorf("failed to load media for trip %s: %w", input.TripID, err)
+	}
+	for _, key := range keys {
+		if _, err := a.S3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(a.BucketName),
+			Key:    aws.String(key),
+		}); err != nil {
+			return fmt.Errorf("failed to delete S3 object %s: %w", key, err)
+		}
+	}
+
+	if err := a.Trips.Purge(ctx, input.TripID); err != nil {
+		return fmt.Errorf("failed to purge trip %s: %w", input.TripID, err)
+	}
+
+	log.Printf("trip_purge: purged trip %s and %d media objects", input.TripID, len(keys))
+	return nil
+}
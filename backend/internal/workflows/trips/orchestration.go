@@ -0,0 +1,59 @@
+package trips
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+	"toggo/internal/services"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+var _ services.TripPurgeScheduler = (*PurgeScheduler)(nil)
+
+// PurgeScheduler runs one TripPurgeWorkflow per deleted trip. Deleting a trip again
+// after restoring it replaces the earlier workflow.
+type PurgeScheduler struct {
+	client client.Client
+}
+
+func NewPurgeScheduler(c client.Client) *PurgeScheduler {
+	return &PurgeScheduler{client: c}
+}
+
+func (s *PurgeScheduler) SchedulePurge(ctx context.Context, tripID uuid.UUID, purgeAt time.Time) error {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                       tripPurgeWorkflowID(tripID),
+		TaskQueue:                TripPurgeTaskQueueName,
+		WorkflowIDConflictPolicy: *enums.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING.Enum(),
+	}
+
+	input := TripPurgeInput{TripID: tripID, PurgeAt: purgeAt}
+	if _, err := s.client.ExecuteWorkflow(ctx, workflowOptions, TripPurgeWorkflow, input); err != nil {
+		return fmt.Errorf("failed to schedule purge for trip %s: %w", tripID, err)
+	}
+
+	log.Printf("trip_purge_scheduler: scheduled purge for trip %s at %s", tripID, purgeAt.Format(time.RFC3339))
+	return nil
+}
+
+func (s *PurgeScheduler) CancelPurge(ctx context.Context, tripID uuid.UUID) error {
+	err := s.client.CancelWorkflow(ctx, tripPurgeWorkflowID(tripID), "")
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to cancel purge for trip %s: %w", tripID, err)
+	}
+	return nil
+}
+
+func tripPurgeWorkflowID(tripID uuid.UUID) string {
+	return "trip-purge-" + tripID.String()
+}
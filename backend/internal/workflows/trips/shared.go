@@ -0,0 +1,15 @@
+package trips
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const TripPurgeTaskQueueName = "TRIP_PURGE_TASK_QUEUE"
+
+// TripPurgeInput is a deleted trip and when it may be permanently removed.
+type TripPurgeInput struct {
+	TripID  uuid.UUID
+	PurgeAt time.Time
+}
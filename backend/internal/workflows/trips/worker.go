@@ -0,0 +1,25 @@
+package trips
+
+import (
+	"log"
+	"toggo/internal/interfaces"
+	"toggo/internal/repository"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+)
+
+func StartTripPurgeWorker(c client.Client, repo repository.Repository, s3Client interfaces.S3Client, bucketName string) worker.Worker {
+	w := worker.New(c, TripPurgeTaskQueueName, worker.Options{})
+
+	w.RegisterWorkflow(TripPurgeWorkflow)
+
+	w.RegisterActivity(&TripPurgeActivities{
+		Trips:      repo.Trip,
+		S3Client:   s3Client,
+		BucketName: bucketName,
+	})
+
+	log.Println("Trip purge worker registered on task queue:", TripPurgeTaskQueueName)
+	return w
+}
@@ -0,0 +1,35 @@
+package trips
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// TripPurgeWorkflow waits out a deleted trip's restore window, then deletes its media
+// from S3 and the trip from the database. Restoring the trip cancels the workflow.
+func TripPurgeWorkflow(ctx workflow.Context, input TripPurgeInput) error {
+	if delay := input.PurgeAt.Sub(workflow.Now(ctx)); delay > 0 {
+		if err := workflow.Sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Minute,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Hour,
+			MaximumAttempts:    10,
+		},
+	})
+
+	activities := &TripPurgeActivities{}
+	if err := workflow.ExecuteActivity(activityCtx, activities.PurgeTrip, input).Get(activityCtx, nil); err != nil {
+		workflow.GetLogger(ctx).Error("PurgeTrip failed", "tripID", input.TripID, "error", err)
+		return err
+	}
+	return nil
+}
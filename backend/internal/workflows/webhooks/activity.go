@@ -0,0 +1,64 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"toggo/internal/errs"
+	"toggo/internal/models"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+type WebhookActivities struct {
+	Webhooks repository.TripWebhookRepository
+	Client   services.WebhookClient
+}
+
+// DeliverWebhook makes one delivery attempt. It returns an error while the endpoint
+// keeps failing so Temporal retries; client errors other than timeouts and rate limits
+// are not retried. Webhooks deleted, disabled or unsubscribed since the event are skipped.
+func (a *WebhookActivities) DeliverWebhook(ctx context.Context, input WebhookDeliveryInput) error {
+	webhook, err := a.Webhooks.Find(ctx, input.WebhookID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to load webhook %s: %w", input.WebhookID, err)
+	}
+	if !webhook.Enabled || !webhook.Subscribes(input.Event.Topic) {
+		return nil
+	}
+
+	delivery, err := services.DeliverTripWebhook(ctx, a.Webhooks, a.Client, webhook, &input.Event)
+	if err != nil {
+		return err
+	}
+	if delivery.Status == models.TripWebhookDeliverySucceeded {
+		return nil
+	}
+
+	reason := "webhook delivery failed"
+	if delivery.ErrorMessage != nil {
+		reason = *delivery.ErrorMessage
+	}
+	if delivery.ResponseStatus != nil && !retryableStatus(*delivery.ResponseStatus) {
+		return temporal.NewNonRetryableApplicationError(reason, "WebhookRejected", nil)
+	}
+	return errors.New(reason)
+}
+
+// MarkWebhookDeliveryFailed logs that the event will not be retried.
+func (a *WebhookActivities) MarkWebhookDeliveryFailed(ctx context.Context, input WebhookDeliveryInput) error {
+	return a.Webhooks.MarkFailed(ctx, input.WebhookID, input.Event.ID)
+}
+
+func retryableStatus(status int) bool {
+	if status == http.StatusRequestTimeout || status == http.StatusTooManyRequests {
+		return true
+	}
+	return status < 400 || status >= 500
+}
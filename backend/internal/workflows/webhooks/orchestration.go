@@ -0,0 +1,47 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"toggo/internal/realtime"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+var _ realtime.WebhookDispatcher = (*DeliveryScheduler)(nil)
+
+// DeliveryScheduler starts one delivery workflow per webhook and event. Every API
+// instance receives each event, so duplicates are rejected by workflow ID.
+type DeliveryScheduler struct {
+	client client.Client
+}
+
+func NewDeliveryScheduler(c client.Client) *DeliveryScheduler {
+	return &DeliveryScheduler{client: c}
+}
+
+func (s *DeliveryScheduler) DispatchWebhook(ctx context.Context, webhookID uuid.UUID, event *realtime.Event) error {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                    webhookDeliveryWorkflowID(webhookID, event.ID),
+		TaskQueue:             TripWebhookTaskQueueName,
+		WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}
+
+	input := WebhookDeliveryInput{WebhookID: webhookID, Event: *event}
+	if _, err := s.client.ExecuteWorkflow(ctx, workflowOptions, WebhookDeliveryWorkflow, input); err != nil {
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStarted) {
+			return nil
+		}
+		return fmt.Errorf("failed to schedule webhook delivery for webhook %s: %w", webhookID, err)
+	}
+	return nil
+}
+
+func webhookDeliveryWorkflowID(webhookID uuid.UUID, eventID string) string {
+	return "trip-webhook-" + webhookID.String() + "-" + eventID
+}
@@ -0,0 +1,15 @@
+package webhooks
+
+import (
+	"toggo/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+const TripWebhookTaskQueueName = "TRIP_WEBHOOK_TASK_QUEUE"
+
+// WebhookDeliveryInput is one event to deliver to one webhook.
+type WebhookDeliveryInput struct {
+	WebhookID uuid.UUID
+	Event     realtime.Event
+}
@@ -0,0 +1,24 @@
+package webhooks
+
+import (
+	"log"
+	"toggo/internal/repository"
+	"toggo/internal/services"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+)
+
+func StartWebhookWorker(c client.Client, repo repository.Repository, webhookClient services.WebhookClient) worker.Worker {
+	w := worker.New(c, TripWebhookTaskQueueName, worker.Options{})
+
+	w.RegisterWorkflow(WebhookDeliveryWorkflow)
+
+	w.RegisterActivity(&WebhookActivities{
+		Webhooks: repo.TripWebhook,
+		Client:   webhookClient,
+	})
+
+	log.Println("Webhook worker registered on task queue:", TripWebhookTaskQueueName)
+	return w
+}
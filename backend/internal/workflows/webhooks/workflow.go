@@ -0,0 +1,42 @@
+package webhooks
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// WebhookDeliveryWorkflow delivers an event to a webhook, retrying with backoff for about
+// an hour before logging the delivery as failed.
+func WebhookDeliveryWorkflow(ctx workflow.Context, input WebhookDeliveryInput) error {
+	logger := workflow.GetLogger(ctx)
+
+	deliverCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    30 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Minute,
+			MaximumAttempts:    8,
+		},
+	})
+	bookkeepingCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	})
+
+	activities := &WebhookActivities{}
+	err := workflow.ExecuteActivity(deliverCtx, activities.DeliverWebhook, input).Get(deliverCtx, nil)
+	if err == nil {
+		return nil
+	}
+
+	logger.Warn("Webhook delivery gave up", "webhookID", input.WebhookID, "eventID", input.Event.ID, "error", err)
+	return workflow.ExecuteActivity(bookkeepingCtx, activities.MarkWebhookDeliveryFailed, input).Get(bookkeepingCtx, nil)
+}
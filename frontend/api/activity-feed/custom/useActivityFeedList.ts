@@ -0,0 +1,97 @@
+import { getTripActivityFeed } from "@/api/activity-feed/useGetTripActivityFeed";
+import { PAGE_SIZE } from "@/constants/pagination";
+import type { ModelsActivityFeedItem } from "@/types/types.gen";
+import { useInfiniteQuery, useQueryClient } from "@tanstack/react-query";
+import { useCallback, useMemo, useRef } from "react";
+
+export const activityFeedQueryKey = (tripID: string) =>
+  ["activity-feed", tripID] as const;
+
+// Unread feed items for a trip, a page at a time. Items leave the list once
+// they are marked as read.
+export function useActivityFeedList(tripID: string | undefined) {
+  const queryClient = useQueryClient();
+  const isFetchingNextRef = useRef(false);
+
+  const {
+    data,
+    isLoading,
+    isError,
+    refetch,
+    isFetchingNextPage,
+    fetchNextPage,
+    hasNextPage,
+  } = useInfiniteQuery({
+    queryKey: activityFeedQueryKey(tripID ?? ""),
+    queryFn: ({ pageParam }: { pageParam: string | undefined }) =>
+      getTripActivityFeed(tripID!, {
+        limit: PAGE_SIZE,
+        cursor: pageParam,
+        unread_only: true,
+      }),
+    initialPageParam: undefined as string | undefined,
+    getNextPageParam: (lastPage) =>
+      lastPage?.items?.length && lastPage.next_cursor
+        ? lastPage.next_cursor
+        : undefined,
+    refetchOnWindowFocus: false,
+    enabled: !!tripID,
+  });
+
+  const items = useMemo(() => {
+    const seen = new Set<string>();
+    return (
+      data?.pages.flatMap((page) =>
+        (page?.items ?? []).filter((item: ModelsActivityFeedItem) => {
+          if (!item.id || seen.has(item.id)) return false;
+          seen.add(item.id);
+          return true;
+        }),
+      ) ?? []
+    );
+  }, [data]);
+
+  const fetchMore = useCallback(() => {
+    if (!hasNextPage || isFetchingNextPage || isFetchingNextRef.current) return;
+    isFetchingNextRef.current = true;
+    fetchNextPage().finally(() => {
+      isFetchingNextRef.current = false;
+    });
+  }, [hasNextPage, isFetchingNextPage, fetchNextPage]);
+
+  // Drops items from the cached pages; pass no IDs to clear the feed.
+  const removeItems = useCallback(
+    (itemIds?: string[]) => {
+      if (!tripID) return;
+      queryClient.setQueryData(
+        activityFeedQueryKey(tripID),
+        (old: typeof data) => {
+          if (!old) return old;
+          return {
+            ...old,
+            pages: old.pages.map((page) => ({
+              ...page,
+              items: itemIds
+                ? (page?.items ?? []).filter(
+                    (item) => !item.id || !itemIds.includes(item.id),
+                  )
+                : [],
+            })),
+          };
+        },
+      );
+    },
+    [queryClient, tripID],
+  );
+
+  return {
+    items,
+    isLoading,
+    isError,
+    refetch,
+    hasMore: !!hasNextPage,
+    isLoadingMore: isFetchingNextPage,
+    fetchMore,
+    removeItems,
+  };
+}
@@ -2,7 +2,9 @@ export type { GetTripActivityFeedQueryKey } from "./useGetTripActivityFeed.ts";
 export type { GetTripActivityFeedSuspenseQueryKey } from "./useGetTripActivityFeedSuspense.ts";
 export type { GetUnreadActivityCountQueryKey } from "./useGetUnreadActivityCount.ts";
 export type { GetUnreadActivityCountSuspenseQueryKey } from "./useGetUnreadActivityCountSuspense.ts";
-export type { MarkActivityEventReadMutationKey } from "./useMarkActivityEventRead.ts";
+export type { MarkActivityItemReadMutationKey } from "./useMarkActivityItemRead.ts";
+export type { MarkActivityItemUnreadMutationKey } from "./useMarkActivityItemUnread.ts";
+export type { MarkAllActivityReadMutationKey } from "./useMarkAllActivityRead.ts";
 export { getTripActivityFeed } from "./useGetTripActivityFeed.ts";
 export { getTripActivityFeedQueryKey } from "./useGetTripActivityFeed.ts";
 export { getTripActivityFeedQueryOptions } from "./useGetTripActivityFeed.ts";
@@ -19,7 +21,15 @@ export { getUnreadActivityCountSuspense } from "./useGetUnreadActivityCountSuspe
 export { getUnreadActivityCountSuspenseQueryKey } from "./useGetUnreadActivityCountSuspense.ts";
 export { getUnreadActivityCountSuspenseQueryOptions } from "./useGetUnreadActivityCountSuspense.ts";
 export { useGetUnreadActivityCountSuspense } from "./useGetUnreadActivityCountSuspense.ts";
-export { markActivityEventRead } from "./useMarkActivityEventRead.ts";
-export { markActivityEventReadMutationKey } from "./useMarkActivityEventRead.ts";
-export { markActivityEventReadMutationOptions } from "./useMarkActivityEventRead.ts";
-export { useMarkActivityEventRead } from "./useMarkActivityEventRead.ts";
+export { markActivityItemRead } from "./useMarkActivityItemRead.ts";
+export { markActivityItemReadMutationKey } from "./useMarkActivityItemRead.ts";
+export { markActivityItemReadMutationOptions } from "./useMarkActivityItemRead.ts";
+export { useMarkActivityItemRead } from "./useMarkActivityItemRead.ts";
+export { markActivityItemUnread } from "./useMarkActivityItemUnread.ts";
+export { markActivityItemUnreadMutationKey } from "./useMarkActivityItemUnread.ts";
+export { markActivityItemUnreadMutationOptions } from "./useMarkActivityItemUnread.ts";
+export { useMarkActivityItemUnread } from "./useMarkActivityItemUnread.ts";
+export { markAllActivityRead } from "./useMarkAllActivityRead.ts";
+export { markAllActivityReadMutationKey } from "./useMarkAllActivityRead.ts";
+export { markAllActivityReadMutationOptions } from "./useMarkAllActivityRead.ts";
+export { useMarkAllActivityRead } from "./useMarkAllActivityRead.ts";
@@ -8,6 +8,7 @@ import type { Client, RequestConfig, ResponseErrorConfig } from "../client";
 import type {
   GetTripActivityFeedQueryResponse,
   GetTripActivityFeedPathParams,
+  GetTripActivityFeedQueryParams,
   GetTripActivityFeed400,
   GetTripActivityFeed401,
   GetTripActivityFeed500,
@@ -22,9 +23,11 @@ import { queryOptions, useQuery } from "@tanstack/react-query";
 
 export const getTripActivityFeedQueryKey = (
   tripID: GetTripActivityFeedPathParams["tripID"],
+  params: GetTripActivityFeedQueryParams = {},
 ) =>
   [
     { url: "/api/v1/trips/:tripID/activity", params: { tripID: tripID } },
+    ...(params ? [params] : []),
   ] as const;
 
 export type GetTripActivityFeedQueryKey = ReturnType<
@@ -32,12 +35,13 @@ export type GetTripActivityFeedQueryKey = ReturnType<
 >;
 
 /**
- * @description Returns all unread events in the caller's trip feed. Events persist until explicitly dismissed via the mark-read endpoint.
+ * @description Returns the caller's trip feed newest first. Repeated actions on the same subject are aggregated into one item (e.g. "Ana and 3 others voted on Where to eat"). Reading the feed does not mark items as read.
  * @summary Get trip activity feed
  * {@link /api/v1/trips/:tripID/activity}
  */
 export async function getTripActivityFeed(
   tripID: GetTripActivityFeedPathParams["tripID"],
+  params?: GetTripActivityFeedQueryParams,
   config: Partial<RequestConfig> & { client?: Client } = {},
 ) {
   const { client: request = fetch, ...requestConfig } = config;
@@ -51,6 +55,7 @@ export async function getTripActivityFeed(
   >({
     method: "GET",
     url: `/api/v1/trips/${tripID}/activity`,
+    params,
     ...requestConfig,
   });
   return res.data;
@@ -58,9 +63,10 @@ export async function getTripActivityFeed(
 
 export function getTripActivityFeedQueryOptions(
   tripID: GetTripActivityFeedPathParams["tripID"],
+  params?: GetTripActivityFeedQueryParams,
   config: Partial<RequestConfig> & { client?: Client } = {},
 ) {
-  const queryKey = getTripActivityFeedQueryKey(tripID);
+  const queryKey = getTripActivityFeedQueryKey(tripID, params);
   return queryOptions<
     GetTripActivityFeedQueryResponse,
     ResponseErrorConfig<
@@ -75,13 +81,13 @@ export function getTripActivityFeedQueryOptions(
       if (!config.signal) {
         config.signal = signal;
       }
-      return getTripActivityFeed(tripID, config);
+      return getTripActivityFeed(tripID, params, config);
     },
   });
 }
 
 /**
- * @description Returns all unread events in the caller's trip feed. Events persist until explicitly dismissed via the mark-read endpoint.
+ * @description Returns the caller's trip feed newest first. Repeated actions on the same subject are aggregated into one item (e.g. "Ana and 3 others voted on Where to eat"). Reading the feed does not mark items as read.
  * @summary Get trip activity feed
  * {@link /api/v1/trips/:tripID/activity}
  */
@@ -91,6 +97,7 @@ export function useGetTripActivityFeed<
   TQueryKey extends QueryKey = GetTripActivityFeedQueryKey,
 >(
   tripID: GetTripActivityFeedPathParams["tripID"],
+  params?: GetTripActivityFeedQueryParams,
   options: {
     query?: Partial<
       QueryObserverOptions<
@@ -111,11 +118,11 @@ export function useGetTripActivityFeed<
   const { query: queryConfig = {}, client: config = {} } = options ?? {};
   const { client: queryClient, ...queryOptions } = queryConfig;
   const queryKey =
-    queryOptions?.queryKey ?? getTripActivityFeedQueryKey(tripID);
+    queryOptions?.queryKey ?? getTripActivityFeedQueryKey(tripID, params);
 
   const query = useQuery(
     {
-      ...getTripActivityFeedQueryOptions(tripID, config),
+      ...getTripActivityFeedQueryOptions(tripID, params, config),
       queryKey,
       ...queryOptions,
     } as unknown as QueryObserverOptions,
@@ -8,6 +8,7 @@ import type { Client, RequestConfig, ResponseErrorConfig } from "../client";
 import type {
   GetTripActivityFeedQueryResponse,
   GetTripActivityFeedPathParams,
+  GetTripActivityFeedQueryParams,
   GetTripActivityFeed400,
   GetTripActivityFeed401,
   GetTripActivityFeed500,
@@ -22,9 +23,11 @@ import { queryOptions, useSuspenseQuery } from "@tanstack/react-query";
 
 export const getTripActivityFeedSuspenseQueryKey = (
   tripID: GetTripActivityFeedPathParams["tripID"],
+  params: GetTripActivityFeedQueryParams = {},
 ) =>
   [
     { url: "/api/v1/trips/:tripID/activity", params: { tripID: tripID } },
+    ...(params ? [params] : []),
   ] as const;
 
 export type GetTripActivityFeedSuspenseQueryKey = ReturnType<
@@ -32,12 +35,13 @@ export type GetTripActivityFeedSuspenseQueryKey = ReturnType<
 >;
 
 /**
- * @description Returns all unread events in the caller's trip feed. Events persist until explicitly dismissed via the mark-read endpoint.
+ * @description Returns the caller's trip feed newest first. Repeated actions on the same subject are aggregated into one item (e.g. "Ana and 3 others voted on Where to eat"). Reading the feed does not mark items as read.
  * @summary Get trip activity feed
  * {@link /api/v1/trips/:tripID/activity}
  */
 export async function getTripActivityFeedSuspense(
   tripID: GetTripActivityFeedPathParams["tripID"],
+  params?: GetTripActivityFeedQueryParams,
   config: Partial<RequestConfig> & { client?: Client } = {},
 ) {
   const { client: request = fetch, ...requestConfig } = config;
@@ -51,6 +55,7 @@ export async function getTripActivityFeedSuspense(
   >({
     method: "GET",
     url: `/api/v1/trips/${tripID}/activity`,
+    params,
     ...requestConfig,
   });
   return res.data;
@@ -58,9 +63,10 @@ export async function getTripActivityFeedSuspense(
 
 export function getTripActivityFeedSuspenseQueryOptions(
   tripID: GetTripActivityFeedPathParams["tripID"],
+  params?: GetTripActivityFeedQueryParams,
   config: Partial<RequestConfig> & { client?: Client } = {},
 ) {
-  const queryKey = getTripActivityFeedSuspenseQueryKey(tripID);
+  const queryKey = getTripActivityFeedSuspenseQueryKey(tripID, params);
   return queryOptions<
     GetTripActivityFeedQueryResponse,
     ResponseErrorConfig<
@@ -75,13 +81,13 @@ export function getTripActivityFeedSuspenseQueryOptions(
       if (!config.signal) {
         config.signal = signal;
       }
-      return getTripActivityFeedSuspense(tripID, config);
+      return getTripActivityFeedSuspense(tripID, params, config);
     },
   });
 }
 
 /**
- * @description Returns all unread events in the caller's trip feed. Events persist until explicitly dismissed via the mark-read endpoint.
+ * @description Returns the caller's trip feed newest first. Repeated actions on the same subject are aggregated into one item (e.g. "Ana and 3 others voted on Where to eat"). Reading the feed does not mark items as read.
  * @summary Get trip activity feed
  * {@link /api/v1/trips/:tripID/activity}
  */
@@ -90,6 +96,7 @@ export function useGetTripActivityFeedSuspense<
   TQueryKey extends QueryKey = GetTripActivityFeedSuspenseQueryKey,
 >(
   tripID: GetTripActivityFeedPathParams["tripID"],
+  params?: GetTripActivityFeedQueryParams,
   options: {
     query?: Partial<
       UseSuspenseQueryOptions<
@@ -109,11 +116,12 @@ export function useGetTripActivityFeedSuspense<
   const { query: queryConfig = {}, client: config = {} } = options ?? {};
   const { client: queryClient, ...queryOptions } = queryConfig;
   const queryKey =
-    queryOptions?.queryKey ?? getTripActivityFeedSuspenseQueryKey(tripID);
+    queryOptions?.queryKey ??
+    getTripActivityFeedSuspenseQueryKey(tripID, params);
 
   const query = useSuspenseQuery(
     {
-      ...getTripActivityFeedSuspenseQueryOptions(tripID, config),
+      ...getTripActivityFeedSuspenseQueryOptions(tripID, params, config),
       queryKey,
       ...queryOptions,
     } as unknown as UseSuspenseQueryOptions,
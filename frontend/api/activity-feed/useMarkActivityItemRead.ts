@@ -0,0 +1,157 @@
+/**
+ * Generated by Kubb (https://kubb.dev/).
+ * Do not edit manually.
+ */
+
+import fetch from "../client";
+import type { Client, RequestConfig, ResponseErrorConfig } from "../client";
+import type {
+  MarkActivityItemReadMutationResponse,
+  MarkActivityItemReadPathParams,
+  MarkActivityItemRead400,
+  MarkActivityItemRead401,
+  MarkActivityItemRead500,
+} from "../../types/types.gen.ts";
+import type {
+  UseMutationOptions,
+  UseMutationResult,
+  QueryClient,
+} from "@tanstack/react-query";
+import { mutationOptions, useMutation } from "@tanstack/react-query";
+
+export const markActivityItemReadMutationKey = () =>
+  [{ url: "/api/v1/trips/:tripID/activity/:itemID/read" }] as const;
+
+export type MarkActivityItemReadMutationKey = ReturnType<
+  typeof markActivityItemReadMutationKey
+>;
+
+/**
+ * @description Marks every event in an activity feed item as read.
+ * @summary Mark activity item as read
+ * {@link /api/v1/trips/:tripID/activity/:itemID/read}
+ */
+export async function markActivityItemRead(
+  tripID: MarkActivityItemReadPathParams["tripID"],
+  itemID: MarkActivityItemReadPathParams["itemID"],
+  config: Partial<RequestConfig> & { client?: Client } = {},
+) {
+  const { client: request = fetch, ...requestConfig } = config;
+
+  const res = await request<
+    MarkActivityItemReadMutationResponse,
+    ResponseErrorConfig<
+      | MarkActivityItemRead400
+      | MarkActivityItemRead401
+      | MarkActivityItemRead500
+    >,
+    unknown
+  >({
+    method: "POST",
+    url: `/api/v1/trips/${tripID}/activity/${itemID}/read`,
+    ...requestConfig,
+  });
+  return res.data;
+}
+
+export function markActivityItemReadMutationOptions<TContext = unknown>(
+  config: Partial<RequestConfig> & { client?: Client } = {},
+) {
+  const mutationKey = markActivityItemReadMutationKey();
+  return mutationOptions<
+    MarkActivityItemReadMutationResponse,
+    ResponseErrorConfig<
+      | MarkActivityItemRead400
+      | MarkActivityItemRead401
+      | MarkActivityItemRead500
+    >,
+    {
+      tripID: MarkActivityItemReadPathParams["tripID"];
+      itemID: MarkActivityItemReadPathParams["itemID"];
+    },
+    TContext
+  >({
+    mutationKey,
+    mutationFn: async ({ tripID, itemID }) => {
+      return markActivityItemRead(tripID, itemID, config);
+    },
+  });
+}
+
+/**
+ * @description Marks every event in an activity feed item as read.
+ * @summary Mark activity item as read
+ * {@link /api/v1/trips/:tripID/activity/:itemID/read}
+ */
+export function useMarkActivityItemRead<TContext>(
+  options: {
+    mutation?: UseMutationOptions<
+      MarkActivityItemReadMutationResponse,
+      ResponseErrorConfig<
+        | MarkActivityItemRead400
+        | MarkActivityItemRead401
+        | MarkActivityItemRead500
+      >,
+      {
+        tripID: MarkActivityItemReadPathParams["tripID"];
+        itemID: MarkActivityItemReadPathParams["itemID"];
+      },
+      TContext
+    > & { client?: QueryClient };
+    client?: Partial<RequestConfig> & { client?: Client };
+  } = {},
+) {
+  const { mutation = {}, client: config = {} } = options ?? {};
+  const { client: queryClient, ...mutationOptions } = mutation;
+  const mutationKey =
+    mutationOptions.mutationKey ?? markActivityItemReadMutationKey();
+
+  const baseOptions = markActivityItemReadMutationOptions(
+    config,
+  ) as UseMutationOptions<
+    MarkActivityItemReadMutationResponse,
+    ResponseErrorConfig<
+      | MarkActivityItemRead400
+      | MarkActivityItemRead401
+      | MarkActivityItemRead500
+    >,
+    {
+      tripID: MarkActivityItemReadPathParams["tripID"];
+      itemID: MarkActivityItemReadPathParams["itemID"];
+    },
+    TContext
+  >;
+
+  return useMutation<
+    MarkActivityItemReadMutationResponse,
+    ResponseErrorConfig<
+      | MarkActivityItemRead400
+      | MarkActivityItemRead401
+      | MarkActivityItemRead500
+    >,
+    {
+      tripID: MarkActivityItemReadPathParams["tripID"];
+      itemID: MarkActivityItemReadPathParams["itemID"];
+    },
+    TContext
+  >(
+    {
+      ...baseOptions,
+      mutationKey,
+      ...mutationOptions,
+    },
+    queryClient,
+  ) as UseMutationResult<
+    MarkActivityItemReadMutationResponse,
+    ResponseErrorConfig<
+      | MarkActivityItemRead400
+      | MarkActivityItemRead401
+      | MarkActivityItemRead500
+    >,
+    {
+      tripID: MarkActivityItemReadPathParams["tripID"];
+      itemID: MarkActivityItemReadPathParams["itemID"];
+    },
+    TContext
+  >;
+}
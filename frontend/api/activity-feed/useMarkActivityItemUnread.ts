@@ -0,0 +1,157 @@
+/**
+ * Generated by Kubb (https://kubb.dev/).
+ * Do not edit manually.
+ */
+
+import fetch from "../client";
+import type { Client, RequestConfig, ResponseErrorConfig } from "../client";
+import type {
+  MarkActivityItemUnreadMutationResponse,
+  MarkActivityItemUnreadPathParams,
+  MarkActivityItemUnread400,
+  MarkActivityItemUnread401,
+  MarkActivityItemUnread500,
+} from "../../types/types.gen.ts";
+import type {
+  UseMutationOptions,
+  UseMutationResult,
+  QueryClient,
+} from "@tanstack/react-query";
+import { mutationOptions, useMutation } from "@tanstack/react-query";
+
+export const markActivityItemUnreadMutationKey = () =>
+  [{ url: "/api/v1/trips/:tripID/activity/:itemID/unread" }] as const;
+
+export type MarkActivityItemUnreadMutationKey = ReturnType<
+  typeof markActivityItemUnreadMutationKey
+>;
+
+/**
+ * @description Restores an activity feed item to unread.
+ * @summary Mark activity item as unread
+ * {@link /api/v1/trips/:tripID/activity/:itemID/unread}
+ */
+export async function markActivityItemUnread(
+  tripID: MarkActivityItemUnreadPathParams["tripID"],
+  itemID: MarkActivityItemUnreadPathParams["itemID"],
+  config: Partial<RequestConfig> & { client?: Client } = {},
+) {
+  const { client: request = fetch, ...requestConfig } = config;
+
+  const res = await request<
+    MarkActivityItemUnreadMutationResponse,
+    ResponseErrorConfig<
+      | MarkActivityItemUnread400
+      | MarkActivityItemUnread401
+      | MarkActivityItemUnread500
+    >,
+    unknown
+  >({
+    method: "POST",
+    url: `/api/v1/trips/${tripID}/activity/${itemID}/unread`,
+    ...requestConfig,
+  });
+  return res.data;
+}
+
+export function markActivityItemUnreadMutationOptions<TContext = unknown>(
+  config: Partial<RequestConfig> & { client?: Client } = {},
+) {
+  const mutationKey = markActivityItemUnreadMutationKey();
+  return mutationOptions<
+    MarkActivityItemUnreadMutationResponse,
+    ResponseErrorConfig<
+      | MarkActivityItemUnread400
+      | MarkActivityItemUnread401
+      | MarkActivityItemUnread500
+    >,
+    {
+      tripID: MarkActivityItemUnreadPathParams["tripID"];
+      itemID: MarkActivityItemUnreadPathParams["itemID"];
+    },
+    TContext
+  >({
+    mutationKey,
+    mutationFn: async ({ tripID, itemID }) => {
+      return markActivityItemUnread(tripID, itemID, config);
+    },
+  });
+}
+
+/**
+ * @description Restores an activity feed item to unread.
+ * @summary Mark activity item as unread
+ * {@link /api/v1/trips/:tripID/activity/:itemID/unread}
+ */
+export function useMarkActivityItemUnread<TContext>(
+  options: {
+    mutation?: UseMutationOptions<
+      MarkActivityItemUnreadMutationResponse,
+      ResponseErrorConfig<
+        | MarkActivityItemUnread400
+        | MarkActivityItemUnread401
+        | MarkActivityItemUnread500
+      >,
+      {
+        tripID: MarkActivityItemUnreadPathParams["tripID"];
+        itemID: MarkActivityItemUnreadPathParams["itemID"];
+      },
+      TContext
+    > & { client?: QueryClient };
+    client?: Partial<RequestConfig> & { client?: Client };
+  } = {},
+) {
+  const { mutation = {}, client: config = {} } = options ?? {};
+  const { client: queryClient, ...mutationOptions } = mutation;
+  const mutationKey =
+    mutationOptions.mutationKey ?? markActivityItemUnreadMutationKey();
+
+  const baseOptions = markActivityItemUnreadMutationOptions(
+    config,
+  ) as UseMutationOptions<
+    MarkActivityItemUnreadMutationResponse,
+    ResponseErrorConfig<
+      | MarkActivityItemUnread400
+      | MarkActivityItemUnread401
+      | MarkActivityItemUnread500
+    >,
+    {
+      tripID: MarkActivityItemUnreadPathParams["tripID"];
+      itemID: MarkActivityItemUnreadPathParams["itemID"];
+    },
+    TContext
+  >;
+
+  return useMutation<
+    MarkActivityItemUnreadMutationResponse,
+    ResponseErrorConfig<
+      | MarkActivityItemUnread400
+      | MarkActivityItemUnread401
+      | MarkActivityItemUnread500
+    >,
+    {
+      tripID: MarkActivityItemUnreadPathParams["tripID"];
+      itemID: MarkActivityItemUnreadPathParams["itemID"];
+    },
+    TContext
+  >(
+    {
+      ...baseOptions,
+      mutationKey,
+      ...mutationOptions,
+    },
+    queryClient,
+  ) as UseMutationResult<
+    MarkActivityItemUnreadMutationResponse,
+    ResponseErrorConfig<
+      | MarkActivityItemUnread400
+      | MarkActivityItemUnread401
+      | MarkActivityItemUnread500
+    >,
+    {
+      tripID: MarkActivityItemUnreadPathParams["tripID"];
+      itemID: MarkActivityItemUnreadPathParams["itemID"];
+    },
+    TContext
+  >;
+}
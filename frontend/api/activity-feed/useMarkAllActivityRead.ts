@@ -0,0 +1,151 @@
+/**
+ * Generated by Kubb (https://kubb.dev/).
+ * Do not edit manually.
+ */
+
+import fetch from "../client";
+import type { Client, RequestConfig, ResponseErrorConfig } from "../client";
+import type {
+  MarkAllActivityReadMutationResponse,
+  MarkAllActivityReadPathParams,
+  MarkAllActivityRead400,
+  MarkAllActivityRead401,
+  MarkAllActivityRead500,
+} from "../../types/types.gen.ts";
+import type {
+  UseMutationOptions,
+  UseMutationResult,
+  QueryClient,
+} from "@tanstack/react-query";
+import { mutationOptions, useMutation } from "@tanstack/react-query";
+
+export const markAllActivityReadMutationKey = () =>
+  [{ url: "/api/v1/trips/:tripID/activity/read" }] as const;
+
+export type MarkAllActivityReadMutationKey = ReturnType<
+  typeof markAllActivityReadMutationKey
+>;
+
+/**
+ * @description Marks every item in the caller's trip activity feed as read.
+ * @summary Mark all activity as read
+ * {@link /api/v1/trips/:tripID/activity/read}
+ */
+export async function markAllActivityRead(
+  tripID: MarkAllActivityReadPathParams["tripID"],
+  config: Partial<RequestConfig> & { client?: Client } = {},
+) {
+  const { client: request = fetch, ...requestConfig } = config;
+
+  const res = await request<
+    MarkAllActivityReadMutationResponse,
+    ResponseErrorConfig<
+      | MarkAllActivityRead400
+      | MarkAllActivityRead401
+      | MarkAllActivityRead500
+    >,
+    unknown
+  >({
+    method: "POST",
+    url: `/api/v1/trips/${tripID}/activity/read`,
+    ...requestConfig,
+  });
+  return res.data;
+}
+
+export function markAllActivityReadMutationOptions<TContext = unknown>(
+  config: Partial<RequestConfig> & { client?: Client } = {},
+) {
+  const mutationKey = markAllActivityReadMutationKey();
+  return mutationOptions<
+    MarkAllActivityReadMutationResponse,
+    ResponseErrorConfig<
+      | MarkAllActivityRead400
+      | MarkAllActivityRead401
+      | MarkAllActivityRead500
+    >,
+    {
+      tripID: MarkAllActivityReadPathParams["tripID"];
+    },
+    TContext
+  >({
+    mutationKey,
+    mutationFn: async ({ tripID }) => {
+      return markAllActivityRead(tripID, config);
+    },
+  });
+}
+
+/**
+ * @description Marks every item in the caller's trip activity feed as read.
+ * @summary Mark all activity as read
+ * {@link /api/v1/trips/:tripID/activity/read}
+ */
+export function useMarkAllActivityRead<TContext>(
+  options: {
+    mutation?: UseMutationOptions<
+      MarkAllActivityReadMutationResponse,
+      ResponseErrorConfig<
+        | MarkAllActivityRead400
+        | MarkAllActivityRead401
+        | MarkAllActivityRead500
+      >,
+      {
+        tripID: MarkAllActivityReadPathParams["tripID"];
+      },
+      TContext
+    > & { client?: QueryClient };
+    client?: Partial<RequestConfig> & { client?: Client };
+  } = {},
+) {
+  const { mutation = {}, client: config = {} } = options ?? {};
+  const { client: queryClient, ...mutationOptions } = mutation;
+  const mutationKey =
+    mutationOptions.mutationKey ?? markAllActivityReadMutationKey();
+
+  const baseOptions = markAllActivityReadMutationOptions(
+    config,
+  ) as UseMutationOptions<
+    MarkAllActivityReadMutationResponse,
+    ResponseErrorConfig<
+      | MarkAllActivityRead400
+      | MarkAllActivityRead401
+      | MarkAllActivityRead500
+    >,
+    {
+      tripID: MarkAllActivityReadPathParams["tripID"];
+    },
+    TContext
+  >;
+
+  return useMutation<
+    MarkAllActivityReadMutationResponse,
+    ResponseErrorConfig<
+      | MarkAllActivityRead400
+      | MarkAllActivityRead401
+      | MarkAllActivityRead500
+    >,
+    {
+      tripID: MarkAllActivityReadPathParams["tripID"];
+    },
+    TContext
+  >(
+    {
+      ...baseOptions,
+      mutationKey,
+      ...mutationOptions,
+    },
+    queryClient,
+  ) as UseMutationResult<
+    MarkAllActivityReadMutationResponse,
+    ResponseErrorConfig<
+      | MarkAllActivityRead400
+      | MarkAllActivityRead401
+      | MarkAllActivityRead500
+    >,
+    {
+      tripID: MarkAllActivityReadPathParams["tripID"];
+    },
+    TContext
+  >;
+}
@@ -18,7 +18,9 @@ export type { GetTripActivityFeedQueryKey } from "./activity-feed/useGetTripActi
 export type { GetTripActivityFeedSuspenseQueryKey } from "./activity-feed/useGetTripActivityFeedSuspense.ts";
 export type { GetUnreadActivityCountQueryKey } from "./activity-feed/useGetUnreadActivityCount.ts";
 export type { GetUnreadActivityCountSuspenseQueryKey } from "./activity-feed/useGetUnreadActivityCountSuspense.ts";
-export type { MarkActivityEventReadMutationKey } from "./activity-feed/useMarkActivityEventRead.ts";
+export type { MarkActivityItemReadMutationKey } from "./activity-feed/useMarkActivityItemRead.ts";
+export type { MarkActivityItemUnreadMutationKey } from "./activity-feed/useMarkActivityItemUnread.ts";
+export type { MarkAllActivityReadMutationKey } from "./activity-feed/useMarkAllActivityRead.ts";
 export type { CreateCategoryMutationKey } from "./categories/useCreateCategory.ts";
 export type { DeleteCategoryMutationKey } from "./categories/useDeleteCategory.ts";
 export type { GetCategoriesByTripIDQueryKey } from "./categories/useGetCategoriesByTripID.ts";
@@ -209,10 +211,18 @@ export { getUnreadActivityCountSuspense } from "./activity-feed/useGetUnreadActi
 export { getUnreadActivityCountSuspenseQueryKey } from "./activity-feed/useGetUnreadActivityCountSuspense.ts";
 export { getUnreadActivityCountSuspenseQueryOptions } from "./activity-feed/useGetUnreadActivityCountSuspense.ts";
 export { useGetUnreadActivityCountSuspense } from "./activity-feed/useGetUnreadActivityCountSuspense.ts";
-export { markActivityEventRead } from "./activity-feed/useMarkActivityEventRead.ts";
-export { markActivityEventReadMutationKey } from "./activity-feed/useMarkActivityEventRead.ts";
-export { markActivityEventReadMutationOptions } from "./activity-feed/useMarkActivityEventRead.ts";
-export { useMarkActivityEventRead } from "./activity-feed/useMarkActivityEventRead.ts";
+export { markActivityItemRead } from "./activity-feed/useMarkActivityItemRead.ts";
+export { markActivityItemReadMutationKey } from "./activity-feed/useMarkActivityItemRead.ts";
+export { markActivityItemReadMutationOptions } from "./activity-feed/useMarkActivityItemRead.ts";
+export { useMarkActivityItemRead } from "./activity-feed/useMarkActivityItemRead.ts";
+export { markActivityItemUnread } from "./activity-feed/useMarkActivityItemUnread.ts";
+export { markActivityItemUnreadMutationKey } from "./activity-feed/useMarkActivityItemUnread.ts";
+export { markActivityItemUnreadMutationOptions } from "./activity-feed/useMarkActivityItemUnread.ts";
+export { useMarkActivityItemUnread } from "./activity-feed/useMarkActivityItemUnread.ts";
+export { markAllActivityRead } from "./activity-feed/useMarkAllActivityRead.ts";
+export { markAllActivityReadMutationKey } from "./activity-feed/useMarkAllActivityRead.ts";
+export { markAllActivityReadMutationOptions } from "./activity-feed/useMarkAllActivityRead.ts";
+export { useMarkAllActivityRead } from "./activity-feed/useMarkAllActivityRead.ts";
 export { createCategory } from "./categories/useCreateCategory.ts";
 export { createCategoryMutationKey } from "./categories/useCreateCategory.ts";
 export { createCategoryMutationOptions } from "./categories/useCreateCategory.ts";
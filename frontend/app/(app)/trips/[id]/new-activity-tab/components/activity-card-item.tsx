@@ -5,14 +5,14 @@ import ActivityFeedItem from "./activity-feed-item";
 import type { ActivityItemProps } from "../types";
 
 export default function ActivityCardItem({
-  event,
+  item,
   tripId,
   isUnread,
   onMarkRead,
 }: ActivityItemProps) {
-  const data = event.data as Record<string, any> | undefined;
+  const data = item.data as Record<string, any> | undefined;
   const name = data?.name ?? "New activity";
-  const activityId = event.entity_id ?? data?.id;
+  const activityId = item.entity_id ?? data?.id;
 
   const handleView = useCallback(() => {
     if (activityId) {
@@ -27,8 +27,8 @@ export default function ActivityCardItem({
     <ActivityFeedItem isUnread={isUnread} onMarkRead={onMarkRead}>
       <ActivityCard
         name={name}
-        actorId={event.actor_id}
-        timestamp={event.timestamp ?? ""}
+        actorId={item.actors?.[0]?.user_id}
+        timestamp={item.latest_at ?? ""}
         isUnread={isUnread}
         onMarkRead={onMarkRead}
         onView={handleView}
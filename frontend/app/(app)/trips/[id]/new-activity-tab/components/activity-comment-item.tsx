@@ -5,25 +5,26 @@ import ActivityFeedItem from "./activity-feed-item";
 import type { ActivityItemProps } from "../types";
 
 export default function ActivityCommentItem({
-  event,
+  item,
   tripId,
   isUnread,
   onMarkRead,
 }: ActivityItemProps) {
-  const data = event.data as Record<string, any> | undefined;
+  const data = item.data as Record<string, any> | undefined;
   const entityType = data?.entity_type as string | undefined;
-  const entityId = (data?.entity_id ?? event.entity_id) as string | undefined;
+  const entityId = (data?.entity_id ?? item.entity_id) as string | undefined;
+  const actor = item.actors?.[0];
 
   const commentData = useMemo(
     () => ({
-      id: event.entity_id,
+      id: item.entity_id,
       body: data?.body ?? data?.content,
-      user_id: event.actor_id,
-      user_name: event.actor_name,
+      user_id: actor?.user_id,
+      user_name: actor?.name,
       entity_type: entityType,
       entity_name: data?.entity_name,
     }),
-    [event.entity_id, data, event.actor_id, event.actor_name, entityType],
+    [item.entity_id, data, actor, entityType],
   );
 
   const handleViewComment = useCallback(() => {
@@ -42,7 +43,7 @@ export default function ActivityCommentItem({
     <ActivityFeedItem isUnread={isUnread} onMarkRead={onMarkRead}>
       <ActivityCommentCard
         comment={commentData}
-        timestamp={event.timestamp ?? ""}
+        timestamp={item.latest_at ?? ""}
         isUnread={isUnread}
         onMarkRead={onMarkRead}
         onViewComment={handleViewComment}
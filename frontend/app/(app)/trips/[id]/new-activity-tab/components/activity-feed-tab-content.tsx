@@ -1,9 +1,10 @@
 import {
-  getTripActivityFeedQueryKey,
-  useGetTripActivityFeed,
-} from "@/api/activity-feed/useGetTripActivityFeed";
+  activityFeedQueryKey,
+  useActivityFeedList,
+} from "@/api/activity-feed/custom/useActivityFeedList";
 import { getUnreadActivityCountQueryKey } from "@/api/activity-feed/useGetUnreadActivityCount";
-import { useMarkActivityEventRead } from "@/api/activity-feed/useMarkActivityEventRead";
+import { useMarkActivityItemRead } from "@/api/activity-feed/useMarkActivityItemRead";
+import { useMarkAllActivityRead } from "@/api/activity-feed/useMarkAllActivityRead";
 import { getPollsByTripIDQueryKey } from "@/api/polls/useGetPollsByTripID";
 import {
   Box,
@@ -16,7 +17,7 @@ import {
 import { ColorPalette } from "@/design-system/tokens/color";
 import { Layout } from "@/design-system/tokens/layout";
 import { useTripRealtime } from "@/hooks/useTripRealtime";
-import { RealtimeEvent } from "@/types/types.gen";
+import { ModelsActivityFeedItem } from "@/types/types.gen";
 import { useQueryClient } from "@tanstack/react-query";
 import { useCallback, useMemo } from "react";
 import { Pressable, StyleSheet, View } from "react-native";
@@ -34,10 +35,10 @@ function resolveActivityType(topic?: string): ActivityType {
   return FEEDWORTHY_TOPICS[topic] ?? "unknown";
 }
 
-function isRecentEvent(timestamp?: string): boolean {
+function isRecentItem(timestamp?: string): boolean {
   if (!timestamp) return true;
-  const eventTime = new Date(timestamp).getTime();
-  return Date.now() - eventTime < RECENT_CUTOFF_MS;
+  const itemTime = new Date(timestamp).getTime();
+  return Date.now() - itemTime < RECENT_CUTOFF_MS;
 }
 
 // ─── Section Divider ────────────────────────────────────────────────────────
@@ -63,17 +64,22 @@ export default function ActivityFeedTabContent({
   const toast = useToast();
 
   const {
-    data: events,
+    items,
     isLoading,
     isError,
     refetch,
-  } = useGetTripActivityFeed(tripId);
+    hasMore,
+    isLoadingMore,
+    fetchMore,
+    removeItems,
+  } = useActivityFeedList(tripId);
 
-  const markRead = useMarkActivityEventRead();
+  const markRead = useMarkActivityItemRead();
+  const markAllRead = useMarkAllActivityRead();
 
   const handleRealtimeEvent = useCallback(() => {
     queryClient.invalidateQueries({
-      queryKey: getTripActivityFeedQueryKey(tripId),
+      queryKey: activityFeedQueryKey(tripId),
     });
     queryClient.invalidateQueries({
       queryKey: getUnreadActivityCountQueryKey(tripId),
@@ -85,87 +91,74 @@ export default function ActivityFeedTabContent({
 
   useTripRealtime(tripId, handleRealtimeEvent);
 
-  // Split events into recent and earlier for visual grouping only.
-  // All events from the feed API are unread — the grouping is presentational.
-  const { recentEvents, earlierEvents } = useMemo(() => {
-    if (!events) return { recentEvents: [], earlierEvents: [] };
+  // Split items into recent and earlier for visual grouping only.
+  // The feed is fetched unread-only — the grouping is presentational.
+  const { recentItems, earlierItems } = useMemo(() => {
+    const recent: ModelsActivityFeedItem[] = [];
+    const earlier: ModelsActivityFeedItem[] = [];
 
-    const recent: RealtimeEvent[] = [];
-    const earlier: RealtimeEvent[] = [];
-
-    for (const event of events) {
-      if (isRecentEvent(event.timestamp)) {
-        recent.push(event);
+    for (const item of items) {
+      if (isRecentItem(item.latest_at)) {
+        recent.push(item);
       } else {
-        earlier.push(event);
+        earlier.push(item);
       }
     }
 
-    return { recentEvents: recent, earlierEvents: earlier };
-  }, [events]);
+    return { recentItems: recent, earlierItems: earlier };
+  }, [items]);
 
   const handleMarkRead = useCallback(
-    async (eventId: string) => {
-      queryClient.setQueryData<RealtimeEvent[]>(
-        getTripActivityFeedQueryKey(tripId),
-        (old) => old?.filter((e) => e.id !== eventId),
-      );
+    async (itemId: string) => {
+      removeItems([itemId]);
       try {
-        await markRead.mutateAsync({ tripID: tripId, eventID: eventId });
+        await markRead.mutateAsync({ tripID: tripId, itemID: itemId });
         queryClient.invalidateQueries({
           queryKey: getUnreadActivityCountQueryKey(tripId),
         });
       } catch {
         queryClient.invalidateQueries({
-          queryKey: getTripActivityFeedQueryKey(tripId),
+          queryKey: activityFeedQueryKey(tripId),
         });
         toast.show({ message: "Couldn't mark as read. Try again." });
       }
     },
-    [markRead, tripId, queryClient, toast],
+    [markRead, removeItems, tripId, queryClient, toast],
   );
 
   const handleMarkAllRead = useCallback(async () => {
-    if (!events) return;
-    queryClient.setQueryData<RealtimeEvent[]>(
-      getTripActivityFeedQueryKey(tripId),
-      () => [],
-    );
+    removeItems();
     try {
-      await Promise.all(
-        events.map((e) =>
-          e.id ? markRead.mutateAsync({ tripID: tripId, eventID: e.id }) : null,
-        ),
-      );
+      await markAllRead.mutateAsync({ tripID: tripId });
       queryClient.invalidateQueries({
         queryKey: getUnreadActivityCountQueryKey(tripId),
       });
     } catch {
       queryClient.invalidateQueries({
-        queryKey: getTripActivityFeedQueryKey(tripId),
+        queryKey: activityFeedQueryKey(tripId),
       });
       toast.show({ message: "Couldn't mark all as read. Try again." });
     }
-  }, [events, markRead, tripId, queryClient, toast]);
+  }, [markAllRead, removeItems, tripId, queryClient, toast]);
 
   // ─── Render Helpers ──────────────────────────────────────────────────────
 
-  const renderEvent = (event: RealtimeEvent) => {
-    const type = resolveActivityType(event.topic);
-    const eventId = event.id ?? "";
-    const onMarkRead = () => handleMarkRead(eventId);
+  const renderItem = (item: ModelsActivityFeedItem) => {
+    const type = resolveActivityType(item.topic);
+    const itemId = item.id ?? "";
+    const onMarkRead = () => handleMarkRead(itemId);
 
-    const props = { event, tripId, isUnread: true, onMarkRead };
+    const props = { item, tripId, isUnread: !item.is_read, onMarkRead };
 
     switch (type) {
       case "poll":
-        return <ActivityPollItem key={eventId} {...props} />;
+        return <ActivityPollItem key={itemId} {...props} />;
       case "activity":
-        return <ActivityCardItem key={eventId} {...props} />;
+        return <ActivityCardItem key={itemId} {...props} />;
       case "pitch":
-        return <ActivityPitchItem key={eventId} {...props} />;
+        return <ActivityPitchItem key={itemId} {...props} />;
       case "comment":
-        return <ActivityCommentItem key={eventId} {...props} />;
+        return <ActivityCommentItem key={itemId} {...props} />;
       default:
         return null;
     }
@@ -185,9 +178,7 @@ export default function ActivityFeedTabContent({
     return <ErrorState title="Couldn't load activity feed" refresh={refetch} />;
   }
 
-  const hasEvents = (events?.length ?? 0) > 0;
-
-  if (!hasEvents) {
+  if (items.length === 0) {
     return (
       <EmptyState
         title="No new activity"
@@ -215,19 +206,33 @@ export default function ActivityFeedTabContent({
         </Pressable>
       </Box>
 
-      {recentEvents.length > 0 && (
-        <Box style={styles.eventList}>
-          {recentEvents.map((event) => renderEvent(event))}
+      {recentItems.length > 0 && (
+        <Box style={styles.itemList}>
+          {recentItems.map((item) => renderItem(item))}
         </Box>
       )}
 
-      {earlierEvents.length > 0 && recentEvents.length > 0 && (
+      {earlierItems.length > 0 && recentItems.length > 0 && (
         <EarlierDivider />
       )}
 
-      {earlierEvents.length > 0 && (
-        <Box style={styles.eventList}>
-          {earlierEvents.map((event) => renderEvent(event))}
+      {earlierItems.length > 0 && (
+        <Box style={styles.itemList}>
+          {earlierItems.map((item) => renderItem(item))}
+        </Box>
+      )}
+
+      {hasMore && (
+        <Box alignItems="center">
+          {isLoadingMore ? (
+            <Spinner />
+          ) : (
+            <Pressable onPress={fetchMore}>
+              <Text variant="bodySmMedium" style={styles.markAllText}>
+                Show more
+              </Text>
+            </Pressable>
+          )}
         </Box>
       )}
     </Box>
@@ -240,7 +245,7 @@ const styles = StyleSheet.create({
   feed: {
     gap: Layout.spacing.sm,
   },
-  eventList: {
+  itemList: {
     gap: Layout.spacing.sm,
   },
   markAllText: {
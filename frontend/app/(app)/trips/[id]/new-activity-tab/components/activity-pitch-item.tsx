@@ -11,12 +11,12 @@ import ActivityPitchCard from "./activity-pitch-card";
 import type { ActivityItemProps } from "../types";
 
 export default function ActivityPitchItem({
-  event,
+  item,
   tripId,
   isUnread,
   onMarkRead,
 }: ActivityItemProps) {
-  const pitchId = event.entity_id ?? "";
+  const pitchId = item.entity_id ?? "";
   const {
     data: pitch,
     isLoading,
@@ -63,7 +63,7 @@ export default function ActivityPitchItem({
       ) : (
         <ActivityPitchCard
           pitch={pitch}
-          timestamp={event.timestamp ?? ""}
+          timestamp={item.latest_at ?? ""}
           isUnread={isUnread}
           onMarkRead={onMarkRead}
           onViewPitch={handleViewPitch}
@@ -12,12 +12,12 @@ import ActivityFeedItem from "./activity-feed-item";
 import type { ActivityItemProps } from "../types";
 
 export default function ActivityPollItem({
-  event,
+  item,
   tripId,
   isUnread,
   onMarkRead,
 }: ActivityItemProps) {
-  const pollId = event.entity_id ?? "";
+  const pollId = item.entity_id ?? "";
   const {
     data: poll,
     isLoading,
@@ -47,7 +47,7 @@ export default function ActivityPollItem({
         <Box style={styles.pollCardInner}>
           <ActivityCardHeader
             activityType="poll"
-            timestamp={event.timestamp ?? ""}
+            timestamp={item.latest_at ?? ""}
             isUnread={isUnread}
             onMarkRead={onMarkRead}
             goToLabel="Go to poll"
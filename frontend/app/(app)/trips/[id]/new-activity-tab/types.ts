@@ -1,4 +1,7 @@
-import type { ModelsPitchAPIResponse, RealtimeEvent } from "@/types/types.gen";
+import type {
+  ModelsActivityFeedItem,
+  ModelsPitchAPIResponse,
+} from "@/types/types.gen";
 
 // ─── Activity Types ─────────────────────────────────────────────────────────
 
@@ -22,7 +25,7 @@ export type ActivityFeedItemProps = {
 };
 
 export type ActivityItemProps = {
-  event: RealtimeEvent;
+  item: ModelsActivityFeedItem;
   tripId: string;
   isUnread: boolean;
   onMarkRead?: () => void;
@@ -18,7 +18,9 @@ export type { GetTripActivityFeedQueryKey } from "./api/activity-feed/useGetTrip
 export type { GetTripActivityFeedSuspenseQueryKey } from "./api/activity-feed/useGetTripActivityFeedSuspense.ts";
 export type { GetUnreadActivityCountQueryKey } from "./api/activity-feed/useGetUnreadActivityCount.ts";
 export type { GetUnreadActivityCountSuspenseQueryKey } from "./api/activity-feed/useGetUnreadActivityCountSuspense.ts";
-export type { MarkActivityEventReadMutationKey } from "./api/activity-feed/useMarkActivityEventRead.ts";
+export type { MarkActivityItemReadMutationKey } from "./api/activity-feed/useMarkActivityItemRead.ts";
+export type { MarkActivityItemUnreadMutationKey } from "./api/activity-feed/useMarkActivityItemUnread.ts";
+export type { MarkAllActivityReadMutationKey } from "./api/activity-feed/useMarkAllActivityRead.ts";
 export type { CreateCategoryMutationKey } from "./api/categories/useCreateCategory.ts";
 export type { DeleteCategoryMutationKey } from "./api/categories/useDeleteCategory.ts";
 export type { GetCategoriesByTripIDQueryKey } from "./api/categories/useGetCategoriesByTripID.ts";
@@ -209,10 +211,18 @@ export { getUnreadActivityCountSuspense } from "./api/activity-feed/useGetUnread
 export { getUnreadActivityCountSuspenseQueryKey } from "./api/activity-feed/useGetUnreadActivityCountSuspense.ts";
 export { getUnreadActivityCountSuspenseQueryOptions } from "./api/activity-feed/useGetUnreadActivityCountSuspense.ts";
 export { useGetUnreadActivityCountSuspense } from "./api/activity-feed/useGetUnreadActivityCountSuspense.ts";
-export { markActivityEventRead } from "./api/activity-feed/useMarkActivityEventRead.ts";
-export { markActivityEventReadMutationKey } from "./api/activity-feed/useMarkActivityEventRead.ts";
-export { markActivityEventReadMutationOptions } from "./api/activity-feed/useMarkActivityEventRead.ts";
-export { useMarkActivityEventRead } from "./api/activity-feed/useMarkActivityEventRead.ts";
+export { markActivityItemRead } from "./api/activity-feed/useMarkActivityItemRead.ts";
+export { markActivityItemReadMutationKey } from "./api/activity-feed/useMarkActivityItemRead.ts";
+export { markActivityItemReadMutationOptions } from "./api/activity-feed/useMarkActivityItemRead.ts";
+export { useMarkActivityItemRead } from "./api/activity-feed/useMarkActivityItemRead.ts";
+export { markActivityItemUnread } from "./api/activity-feed/useMarkActivityItemUnread.ts";
+export { markActivityItemUnreadMutationKey } from "./api/activity-feed/useMarkActivityItemUnread.ts";
+export { markActivityItemUnreadMutationOptions } from "./api/activity-feed/useMarkActivityItemUnread.ts";
+export { useMarkActivityItemUnread } from "./api/activity-feed/useMarkActivityItemUnread.ts";
+export { markAllActivityRead } from "./api/activity-feed/useMarkAllActivityRead.ts";
+export { markAllActivityReadMutationKey } from "./api/activity-feed/useMarkAllActivityRead.ts";
+export { markAllActivityReadMutationOptions } from "./api/activity-feed/useMarkAllActivityRead.ts";
+export { useMarkAllActivityRead } from "./api/activity-feed/useMarkAllActivityRead.ts";
 export { createCategory } from "./api/categories/useCreateCategory.ts";
 export { createCategoryMutationKey } from "./api/categories/useCreateCategory.ts";
 export { createCategoryMutationOptions } from "./api/categories/useCreateCategory.ts";
@@ -41,6 +41,9 @@ import type {
   ModelsActivityAPIResponse,
   ModelsActivityCategoriesPageResult,
   ModelsActivityCursorPageResult,
+  ModelsActivityFeedActor,
+  ModelsActivityFeedItem,
+  ModelsActivityFeedPageResult,
   ModelsRSVPStatus,
   ModelsActivityRSVPAPIResponse,
   ModelsActivityRSVPRequestPayload,
@@ -423,6 +426,7 @@ import type {
   GetTripActivityFeed401,
   GetTripActivityFeed500,
   GetTripActivityFeedPathParams,
+  GetTripActivityFeedQueryParams,
   GetTripActivityFeedQueryResponse,
   GetUnreadActivityCount200,
   GetUnreadActivityCount400,
@@ -430,12 +434,24 @@ import type {
   GetUnreadActivityCount500,
   GetUnreadActivityCountPathParams,
   GetUnreadActivityCountQueryResponse,
-  MarkActivityEventRead204,
-  MarkActivityEventRead400,
-  MarkActivityEventRead401,
-  MarkActivityEventRead500,
-  MarkActivityEventReadMutationResponse,
-  MarkActivityEventReadPathParams,
+  MarkAllActivityRead204,
+  MarkAllActivityRead400,
+  MarkAllActivityRead401,
+  MarkAllActivityRead500,
+  MarkAllActivityReadMutationResponse,
+  MarkAllActivityReadPathParams,
+  MarkActivityItemRead204,
+  MarkActivityItemRead400,
+  MarkActivityItemRead401,
+  MarkActivityItemRead500,
+  MarkActivityItemReadMutationResponse,
+  MarkActivityItemReadPathParams,
+  MarkActivityItemUnread204,
+  MarkActivityItemUnread400,
+  MarkActivityItemUnread401,
+  MarkActivityItemUnread500,
+  MarkActivityItemUnreadMutationResponse,
+  MarkActivityItemUnreadPathParams,
   GetCategoriesByTripID200,
   GetCategoriesByTripID400,
   GetCategoriesByTripID401,
@@ -956,6 +972,38 @@ export const modelsActivityCursorPageResultSchema = z.object({
   next_cursor: z.optional(z.string()),
 }) as unknown as z.ZodType<ModelsActivityCursorPageResult>;
 
+export const modelsActivityFeedActorSchema = z.object({
+  name: z.optional(z.string()),
+  user_id: z.optional(z.string()),
+  username: z.optional(z.string()),
+}) as unknown as z.ZodType<ModelsActivityFeedActor>;
+
+export const modelsActivityFeedItemSchema = z.object({
+  actor_count: z.optional(z.int()),
+  get actors() {
+    return z.array(modelsActivityFeedActorSchema).optional();
+  },
+  data: z.optional(z.object({})),
+  entity_id: z.optional(z.string()),
+  event_count: z.optional(z.int()),
+  id: z.optional(z.string()),
+  is_read: z.optional(z.boolean()),
+  latest_at: z.optional(z.string()),
+  summary: z.optional(z.string()),
+  topic: z.optional(z.string()),
+  trip_id: z.optional(z.string()),
+  trip_name: z.optional(z.string()),
+  version: z.optional(z.int()),
+}) as unknown as z.ZodType<ModelsActivityFeedItem>;
+
+export const modelsActivityFeedPageResultSchema = z.object({
+  get items() {
+    return z.array(modelsActivityFeedItemSchema).optional();
+  },
+  limit: z.optional(z.int()),
+  next_cursor: z.optional(z.string()),
+}) as unknown as z.ZodType<ModelsActivityFeedPageResult>;
+
 export const modelsRSVPStatusSchema = z.enum([
   "yes",
   "maybe",
@@ -3982,15 +4030,32 @@ export const getTripActivityFeedPathParamsSchema = z.object({
   tripID: z.string().describe("Trip ID (UUID)"),
 }) as unknown as z.ZodType<GetTripActivityFeedPathParams>;
 
+export const getTripActivityFeedQueryParamsSchema = z
+  .object({
+    limit: z.optional(
+      z.coerce
+        .number()
+        .int()
+        .describe("Max items to return (default 20, max 100)"),
+    ),
+    cursor: z.optional(
+      z.string().describe("Opaque cursor returned in next_cursor"),
+    ),
+    unread_only: z.optional(
+      z.boolean().describe("Only return items with unread events"),
+    ),
+  })
+  .optional() as unknown as z.ZodType<GetTripActivityFeedQueryParams>;
+
 /**
  * @description OK
  */
-export const getTripActivityFeed200Schema = z.array(
-  z.lazy(() => realtimeEventSchema),
+export const getTripActivityFeed200Schema = z.lazy(
+  () => modelsActivityFeedPageResultSchema,
 ) as unknown as z.ZodType<GetTripActivityFeed200>;
 
 /**
- * @description Invalid trip ID
+ * @description Invalid trip ID or cursor
  */
 export const getTripActivityFeed400Schema = z.lazy(
   () => errsAPIErrorSchema,
@@ -4050,41 +4115,112 @@ export const getUnreadActivityCountQueryResponseSchema = z.lazy(
   () => getUnreadActivityCount200Schema,
 ) as unknown as z.ZodType<GetUnreadActivityCountQueryResponse>;
 
-export const markActivityEventReadPathParamsSchema = z.object({
+export const markAllActivityReadPathParamsSchema = z.object({
+  tripID: z.string().describe("Trip ID"),
+}) as unknown as z.ZodType<MarkAllActivityReadPathParams>;
+
+/**
+ * @description No Content
+ */
+export const markAllActivityRead204Schema =
+  z.any() as unknown as z.ZodType<MarkAllActivityRead204>;
+
+/**
+ * @description Bad Request
+ */
+export const markAllActivityRead400Schema = z.lazy(
+  () => errsAPIErrorSchema,
+) as unknown as z.ZodType<MarkAllActivityRead400>;
+
+/**
+ * @description Unauthorized
+ */
+export const markAllActivityRead401Schema = z.lazy(
+  () => errsAPIErrorSchema,
+) as unknown as z.ZodType<MarkAllActivityRead401>;
+
+/**
+ * @description Internal Server Error
+ */
+export const markAllActivityRead500Schema = z.lazy(
+  () => errsAPIErrorSchema,
+) as unknown as z.ZodType<MarkAllActivityRead500>;
+
+export const markAllActivityReadMutationResponseSchema = z.lazy(
+  () => markAllActivityRead204Schema,
+) as unknown as z.ZodType<MarkAllActivityReadMutationResponse>;
+
+export const markActivityItemReadPathParamsSchema = z.object({
+  tripID: z.string().describe("Trip ID"),
+  itemID: z.string().describe("Feed item ID"),
+}) as unknown as z.ZodType<MarkActivityItemReadPathParams>;
+
+/**
+ * @description No Content
+ */
+export const markActivityItemRead204Schema =
+  z.any() as unknown as z.ZodType<MarkActivityItemRead204>;
+
+/**
+ * @description Bad Request
+ */
+export const markActivityItemRead400Schema = z.lazy(
+  () => errsAPIErrorSchema,
+) as unknown as z.ZodType<MarkActivityItemRead400>;
+
+/**
+ * @description Unauthorized
+ */
+export const markActivityItemRead401Schema = z.lazy(
+  () => errsAPIErrorSchema,
+) as unknown as z.ZodType<MarkActivityItemRead401>;
+
+/**
+ * @description Internal Server Error
+ */
+export const markActivityItemRead500Schema = z.lazy(
+  () => errsAPIErrorSchema,
+) as unknown as z.ZodType<MarkActivityItemRead500>;
+
+export const markActivityItemReadMutationResponseSchema = z.lazy(
+  () => markActivityItemRead204Schema,
+) as unknown as z.ZodType<MarkActivityItemReadMutationResponse>;
+
+export const markActivityItemUnreadPathParamsSchema = z.object({
   tripID: z.string().describe("Trip ID"),
-  eventID: z.string().describe("Event ID"),
-}) as unknown as z.ZodType<MarkActivityEventReadPathParams>;
+  itemID: z.string().describe("Feed item ID"),
+}) as unknown as z.ZodType<MarkActivityItemUnreadPathParams>;
 
 /**
  * @description No Content
  */
-export const markActivityEventRead204Schema =
-  z.any() as unknown as z.ZodType<MarkActivityEventRead204>;
+export const markActivityItemUnread204Schema =
+  z.any() as unknown as z.ZodType<MarkActivityItemUnread204>;
 
 /**
  * @description Bad Request
  */
-export const markActivityEventRead400Schema = z.lazy(
+export const markActivityItemUnread400Schema = z.lazy(
   () => errsAPIErrorSchema,
-) as unknown as z.ZodType<MarkActivityEventRead400>;
+) as unknown as z.ZodType<MarkActivityItemUnread400>;
 
 /**
  * @description Unauthorized
  */
-export const markActivityEventRead401Schema = z.lazy(
+export const markActivityItemUnread401Schema = z.lazy(
   () => errsAPIErrorSchema,
-) as unknown as z.ZodType<MarkActivityEventRead401>;
+) as unknown as z.ZodType<MarkActivityItemUnread401>;
 
 /**
  * @description Internal Server Error
  */
-export const markActivityEventRead500Schema = z.lazy(
+export const markActivityItemUnread500Schema = z.lazy(
   () => errsAPIErrorSchema,
-) as unknown as z.ZodType<MarkActivityEventRead500>;
+) as unknown as z.ZodType<MarkActivityItemUnread500>;
 
-export const markActivityEventReadMutationResponseSchema = z.lazy(
-  () => markActivityEventRead204Schema,
-) as unknown as z.ZodType<MarkActivityEventReadMutationResponse>;
+export const markActivityItemUnreadMutationResponseSchema = z.lazy(
+  () => markActivityItemUnread204Schema,
+) as unknown as z.ZodType<MarkActivityItemUnreadMutationResponse>;
 
 export const getCategoriesByTripIDPathParamsSchema = z.object({
   tripID: z.string().describe("Trip ID"),
@@ -276,6 +276,91 @@ export type ModelsActivityCursorPageResult = {
   next_cursor?: string;
 };
 
+export type ModelsActivityFeedActor = {
+  /**
+   * @type string | undefined
+   */
+  name?: string;
+  /**
+   * @type string | undefined
+   */
+  user_id?: string;
+  /**
+   * @type string | undefined
+   */
+  username?: string;
+};
+
+export type ModelsActivityFeedItem = {
+  /**
+   * @type integer | undefined
+   */
+  actor_count?: number;
+  /**
+   * @type array | undefined
+   */
+  actors?: ModelsActivityFeedActor[];
+  /**
+   * @type object | undefined
+   */
+  data?: object;
+  /**
+   * @type string | undefined
+   */
+  entity_id?: string;
+  /**
+   * @type integer | undefined
+   */
+  event_count?: number;
+  /**
+   * @type string | undefined
+   */
+  id?: string;
+  /**
+   * @type boolean | undefined
+   */
+  is_read?: boolean;
+  /**
+   * @type string | undefined
+   */
+  latest_at?: string;
+  /**
+   * @type string | undefined
+   */
+  summary?: string;
+  /**
+   * @type string | undefined
+   */
+  topic?: string;
+  /**
+   * @type string | undefined
+   */
+  trip_id?: string;
+  /**
+   * @type string | undefined
+   */
+  trip_name?: string;
+  /**
+   * @type integer | undefined
+   */
+  version?: number;
+};
+
+export type ModelsActivityFeedPageResult = {
+  /**
+   * @type array | undefined
+   */
+  items?: ModelsActivityFeedItem[];
+  /**
+   * @type integer | undefined
+   */
+  limit?: number;
+  /**
+   * @type string | undefined
+   */
+  next_cursor?: string;
+};
+
 export const modelsRSVPStatus = {
   RSVPStatusGoing: "yes",
   RSVPStatusMaybe: "maybe",
@@ -4738,13 +4823,31 @@ export type GetTripActivityFeedPathParams = {
   tripID: string;
 };
 
+export type GetTripActivityFeedQueryParams = {
+  /**
+   * @description Max items to return (default 20, max 100)
+   * @type integer | undefined
+   */
+  limit?: number;
+  /**
+   * @description Opaque cursor returned in next_cursor
+   * @type string | undefined
+   */
+  cursor?: string;
+  /**
+   * @description Only return items with unread events
+   * @type boolean | undefined
+   */
+  unread_only?: boolean;
+};
+
 /**
  * @description OK
  */
-export type GetTripActivityFeed200 = RealtimeEvent[];
+export type GetTripActivityFeed200 = ModelsActivityFeedPageResult;
 
 /**
- * @description Invalid trip ID
+ * @description Invalid trip ID or cursor
  */
 export type GetTripActivityFeed400 = ErrsAPIError;
 
@@ -4763,6 +4866,7 @@ export type GetTripActivityFeedQueryResponse = GetTripActivityFeed200;
 export type GetTripActivityFeedQuery = {
   Response: GetTripActivityFeed200;
   PathParams: GetTripActivityFeedPathParams;
+  QueryParams: GetTripActivityFeedQueryParams;
   Errors:
     | GetTripActivityFeed400
     | GetTripActivityFeed401
@@ -4808,48 +4912,131 @@ export type GetUnreadActivityCountQuery = {
     | GetUnreadActivityCount500;
 };
 
-export type MarkActivityEventReadPathParams = {
+export type MarkAllActivityReadPathParams = {
+  /**
+   * @description Trip ID
+   * @type string
+   */
+  tripID: string;
+};
+
+/**
+ * @description No Content
+ */
+export type MarkAllActivityRead204 = any;
+
+/**
+ * @description Bad Request
+ */
+export type MarkAllActivityRead400 = ErrsAPIError;
+
+/**
+ * @description Unauthorized
+ */
+export type MarkAllActivityRead401 = ErrsAPIError;
+
+/**
+ * @description Internal Server Error
+ */
+export type MarkAllActivityRead500 = ErrsAPIError;
+
+export type MarkAllActivityReadMutationResponse = MarkAllActivityRead204;
+
+export type MarkAllActivityReadMutation = {
+  Response: MarkAllActivityRead204;
+  PathParams: MarkAllActivityReadPathParams;
+  Errors:
+    | MarkAllActivityRead400
+    | MarkAllActivityRead401
+    | MarkAllActivityRead500;
+};
+
+export type MarkActivityItemReadPathParams = {
+  /**
+   * @description Trip ID
+   * @type string
+   */
+  tripID: string;
+  /**
+   * @description Feed item ID
+   * @type string
+   */
+  itemID: string;
+};
+
+/**
+ * @description No Content
+ */
+export type MarkActivityItemRead204 = any;
+
+/**
+ * @description Bad Request
+ */
+export type MarkActivityItemRead400 = ErrsAPIError;
+
+/**
+ * @description Unauthorized
+ */
+export type MarkActivityItemRead401 = ErrsAPIError;
+
+/**
+ * @description Internal Server Error
+ */
+export type MarkActivityItemRead500 = ErrsAPIError;
+
+export type MarkActivityItemReadMutationResponse = MarkActivityItemRead204;
+
+export type MarkActivityItemReadMutation = {
+  Response: MarkActivityItemRead204;
+  PathParams: MarkActivityItemReadPathParams;
+  Errors:
+    | MarkActivityItemRead400
+    | MarkActivityItemRead401
+    | MarkActivityItemRead500;
+};
+
+export type MarkActivityItemUnreadPathParams = {
   /**
    * @description Trip ID
    * @type string
    */
   tripID: string;
   /**
-   * @description Event ID
+   * @description Feed item ID
    * @type string
    */
-  eventID: string;
+  itemID: string;
 };
 
 /**
  * @description No Content
  */
-export type MarkActivityEventRead204 = any;
+export type MarkActivityItemUnread204 = any;
 
 /**
  * @description Bad Request
  */
-export type MarkActivityEventRead400 = ErrsAPIError;
+export type MarkActivityItemUnread400 = ErrsAPIError;
 
 /**
  * @description Unauthorized
  */
-export type MarkActivityEventRead401 = ErrsAPIError;
+export type MarkActivityItemUnread401 = ErrsAPIError;
 
 /**
  * @description Internal Server Error
  */
-export type MarkActivityEventRead500 = ErrsAPIError;
+export type MarkActivityItemUnread500 = ErrsAPIError;
 
-export type MarkActivityEventReadMutationResponse = MarkActivityEventRead204;
+export type MarkActivityItemUnreadMutationResponse = MarkActivityItemUnread204;
 
-export type MarkActivityEventReadMutation = {
-  Response: MarkActivityEventRead204;
-  PathParams: MarkActivityEventReadPathParams;
+export type MarkActivityItemUnreadMutation = {
+  Response: MarkActivityItemUnread204;
+  PathParams: MarkActivityItemUnreadPathParams;
   Errors:
-    | MarkActivityEventRead400
-    | MarkActivityEventRead401
-    | MarkActivityEventRead500;
+    | MarkActivityItemUnread400
+    | MarkActivityItemUnread401
+    | MarkActivityItemUnread500;
 };
 
 export type GetCategoriesByTripIDPathParams = {